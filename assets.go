@@ -553,7 +553,7 @@ func docDeploymentLogs_exampleRst() (*asset, error) {
 	return a, nil
 }
 
-var _docDeploymentPipeline_specMd = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x5b\x7b\x6f\xdc\x46\x92\xff\x9f\x9f\xa2\x30\x3e\xc0\x33\xf6\x88\x63\x29\xce\x5d\xa2\x45\x72\x70\x64\xeb\xa2\xdd\xd8\x12\x6c\xe5\x82\x83\x11\x88\x4d\xb2\x38\x6c\x9b\xec\xe6\x76\x37\x67\x3c\xb9\xe4\xbb\x1f\xaa\xba\x9b\x8f\x91\x9c\xe4\xee\x16\x2b\xc0\xd2\x0c\xd9\x8f\xea\x7a\xfc\xea\xd1\xe5\x47\x70\x23\x3b\x6c\xa4\x42\x78\xd7\x61\x21\x2b\x59\x08\x27\xb5\x4a\x92\xdb\x5a\x5a\x28\x75\xd1\xb7\xa8\x1c\x94\xd2\x16\xbd\xb5\x68\x01\x45\x51\x83\xae\xc0\xd5\x08\x95\xc4\xa6\xb4\xd0\x19\xb4\x34\x48\x2a\x10\xd0\xc5\xf5\xec\x74\xbd\x14\x6e\x35\x58\x44\xa8\xf5\x1e\x9c\x86\xde\xe2\xf1\xd8\x35\x18\xac\xd0\xd0\x5b\x5a\xfb\x7d\x27\x8a\xba\x70\x0d\x14\x06\x85\xc3\x93\x38\xf6\xe7\x65\x9a\x6e\xc2\xbb\xf8\xf7\xee\x68\x4c\x5a\xbb\xb6\x59\x11\xf5\x69\x92\x3c\x7a\x04\x7f\x7d\x77\xfd\x06\x5e\x0b\x25\x2b\xb4\x0e\x2e\xb5\x69\x85\x4b\x92\x2c\xcb\x92\xff\x4e\x00\x16\x71\xda\xe2\x1c\xe8\x3b\xc0\x42\x89\x96\xbe\x59\x67\xa4\xda\x26\x00\xbf\xad\x69\x9c\x33\x42\xd9\x4a\x9b\x76\x1c\x28\x5b\xb1\x1d\x47\xae\xfd\xc3\xa2\x2d\x17\xe7\xf0\x3e\x3c\x84\x9f\xc3\x63\xeb\x4a\xa9\x66\x2f\xfc\x73\x54\xbb\x61\x41\x7e\x50\x69\xbd\x38\x87\x45\x2e\xcc\x82\x1f\xfe\x16\x17\xc0\xc2\xa0\xb3\xbc\xc4\x64\x78\x20\x36\xbc\xbe\xe3\xaf\xeb\xf1\x75\xab\x7b\xe5\x6e\x84\xab\x69\xcc\xa6\x13\xae\xde\x48\xb5\x29\xb4\x72\x42\x2a\x8c\x5b\x30\x31\xfe\x98\x9d\x30\xa2\x69\xb0\x91\xb6\xbd\x23\xc1\x8c\xa7\xb5\xce\x08\x87\xdb\x03\xad\x74\x71\xfd\xe6\xdd\xed\x8b\x37\xb7\x8b\x5f\x17\x17\xd7\xaf\x2e\x2f\xaf\x2e\xae\x5e\xbd\xb9\x5d\x04\x0e\x68\x65\x9d\x50\x6e\x71\x0e\x52\xb9\x48\xca\x66\x03\xb2\x82\xb8\x08\x7c\xf3\x0d\xc4\x45\xe2\x2c\xac\x2a\x59\x48\xe4\x89\xa5\xee\xf3\x06\x1f\x9e\x35\xec\x47\x44\x13\xcd\x52\x75\xbd\xe7\x0c\x2f\x15\xb9\xb3\x30\xd8\xe9\x39\x73\x8f\x45\x3b\x61\x30\x8d\xef\xd5\xab\xb6\x73\x74\xc2\x4a\x34\x16\x87\x17\x2d\xba\x5a\x93\x58\x17\xad\xe8\x16\x9b\x85\xc1\xb2\x2f\x70\xb1\x59\x6c\x1b\x9d\x8b\x66\x91\x0c\x47\x14\x8d\x43\xa3\x84\x93\x3b\x6c\x0e\x6b\xf0\x13\xa1\x10\x0a\xf2\xc1\x28\xb0\x04\x61\x41\x28\xd0\xf9\x07\x2c\x5c\x3a\xce\x76\x64\x76\xd2\x82\x56\xcd\x01\x2a\x6d\x40\x94\x3b\xa1\x0a\x2c\xd9\x64\x0a\x61\xd1\xfe\x05\x5a\x6d\x5d\xb4\x41\x27\x5b\x5c\x83\x56\x38\x3c\xa8\x0d\xe2\xb8\x60\x60\x9c\x44\x0b\x22\xd7\x3b\x04\x5b\xeb\xbe\x29\xc1\xf6\xc4\x6b\x4c\xef\x1d\x70\xc2\xab\x4e\x18\x27\xc9\x7c\xe9\xdc\xdf\xfd\x70\x7d\xf1\xb7\xc5\x66\x71\x79\xf5\xc3\xab\xc5\x66\xf1\xf6\xd5\xcd\xf5\x54\xcd\xa4\x2a\x0c\x12\x56\x88\x86\x46\xbf\xb9\x7e\x43\xa3\x5e\x5e\x5d\x5e\xc6\x39\x71\xf0\x6f\x49\xfc\xfd\x73\xf2\x1b\x5b\x61\xf2\xe8\xd1\x23\x78\x23\x5a\x4c\x92\x6c\xb0\x61\x12\x54\x46\xac\xa0\x43\xd1\x97\x78\xc0\x01\x35\x5c\x2d\x1c\x1c\x74\x0f\xc2\xa0\x87\x09\xa9\xb6\x29\xc0\x2b\x82\xa8\x61\x94\x42\x2c\x2d\xa1\x4a\x2d\x76\x04\x3a\xbd\x92\x7f\xef\xfd\x8a\xa9\xdf\xf9\x36\xda\x75\x92\x64\x83\x8d\xa7\x6c\xda\x0f\x12\xf0\x52\x17\x1f\xd1\x00\x0f\x18\x88\x30\xf0\x41\xe7\x16\x4c\xaf\x40\xaa\x14\xe0\xa2\x37\x06\x95\x23\x15\xf0\x32\xe5\xd1\x03\x31\xef\xa5\xaa\xd1\x48\x07\x95\xd1\x2d\x08\xb8\x11\x45\x7d\x28\xd1\xb4\x27\x9d\xd1\x3b\x59\x62\x19\x66\x7c\x54\x7a\xaf\x48\x5b\xb2\x0f\x3a\x3f\xb1\xb5\x6c\xb3\x9f\x97\xb5\x73\x9d\x3d\xdf\x6c\xb6\xd2\xd5\x7d\x9e\x16\xba\x65\x28\xe4\x05\x26\x9f\xf2\x46\xe7\x9b\x4a\xe0\xd7\x5f\xe1\x97\xcf\x45\xf5\xac\xfc\xd7\xaf\xbf\x38\xc3\xb3\x2f\xbf\xc2\xe7\xf9\xb3\xb2\x7a\x8e\xe2\xdf\xbe\x7a\xfe\xfc\xf4\x79\xf1\xf5\xd9\x29\x6e\xf0\x93\x68\xbb\x06\xed\xa6\x32\xbd\x74\x77\x64\xc0\xe5\xc6\x9f\xb5\x92\x0d\x3e\xfa\xe1\x74\x95\xce\x38\x54\xb4\xe5\xc0\x9f\x42\xb7\xad\x50\x25\x74\xc2\x5a\x2c\x23\x88\x07\x4e\x79\xa6\xec\x74\xf4\x05\xf0\x46\xbb\xc0\x39\x61\x61\x2f\x5d\x1d\x46\xae\xa1\x68\x4b\x5a\x52\x69\x17\x66\x59\x59\x92\xd4\x6c\x8d\x4d\x03\xfb\x5a\x16\x35\xb4\x28\x94\xf5\xd3\x5d\x2d\xd5\xd6\x42\x23\x3f\x22\xec\x65\x53\x16\xc2\x94\x40\x26\x99\x13\xc0\x2e\xb3\x27\xd9\x6a\xcd\xaa\x60\x61\x99\xfd\x9a\xad\x80\x68\xa4\xd3\x80\xc1\x52\x1a\x2c\x1c\xbd\xf8\x36\xe3\xe7\xd9\xb7\xdf\x66\x2b\x5a\xa6\xe1\xfd\xf7\xda\x7c\x4c\x81\x1c\xd7\x16\x9d\xdf\x2e\xc7\x5a\xec\xa4\x36\x6b\x56\x3b\xb2\x69\x8b\x0e\x32\x66\x84\xd3\x64\xe0\x91\x52\x5d\x79\xa5\x28\x6a\x2d\x0b\x84\x25\xa6\xdb\x14\x32\x5b\x07\x12\x88\x4d\xc3\x58\x5b\x18\xd9\x39\x5a\x80\x5d\xc4\x9c\xc9\xfc\x88\xd9\x2c\x14\x08\x63\xc4\x81\xd6\x26\xcd\x0e\x2c\x20\xe5\x67\xf7\xeb\x74\xd8\x32\x88\x42\xab\xb0\x1e\xc0\x0f\x3c\x9c\x94\x8f\x4f\x86\xaa\x24\x67\xad\x70\xcf\x16\x52\xd4\xc2\x88\xc2\xa1\xb1\xf3\xad\x51\xed\x78\x5f\x68\x45\xe7\xf5\xf4\x23\x1e\x68\x9b\x9d\x68\x7a\xb6\x06\x54\x3b\x69\xb4\xe2\x10\x61\x27\x8c\x14\x79\x13\xc9\x62\x36\xe6\x08\x52\x11\xc2\x91\x3a\xab\xa0\x14\x83\xeb\x99\x9f\xd3\x3b\xb7\x7b\x27\x0d\xcf\xd7\xf1\x83\x8f\x12\x50\x15\x08\x7f\xeb\x73\x34\x0a\x1d\xda\xe1\x65\x7e\xf0\x96\x4a\xe7\xf7\x58\x7b\xa0\x38\x43\xb8\x3a\xea\x0b\x0e\x63\x03\x10\xe6\x08\xec\x22\x59\x6b\x53\x78\x17\xde\x12\x5b\x7b\x8b\x55\xdf\x30\x0c\x63\x9b\x63\x59\x92\x52\x59\x54\x56\x12\xba\x43\x29\x9c\x00\xdb\x17\x35\x69\x71\x61\xb0\x44\xe5\xa4\x68\x6c\x0a\x6f\x51\x94\xd0\x6a\x83\x84\xb9\xbd\x1b\xb6\x94\x6a\x4a\xf4\xfb\x1a\x0d\x7a\x63\x3e\xdf\x6c\x3e\x0e\x2f\x52\xa9\x37\xa5\x2e\xec\xa6\xb7\x68\x4e\xb6\xbd\x2c\x71\x13\x56\xd8\xac\x02\x66\xdd\x8c\x4e\x9a\x23\x37\x42\xce\x23\xbf\x9d\x41\x89\xa4\x5a\x39\x5a\x0e\xbb\x06\x88\x89\x27\x1f\x26\xfc\x82\x5e\x73\x06\xec\x9d\xa2\xd7\x38\xad\x16\x16\xdc\x5e\xc3\x64\xa3\x89\x7b\x39\x87\x2c\xba\xf3\x60\x4e\x13\x3f\x9d\xa5\x49\x72\xc5\x26\xc1\x7e\x8c\xc4\x30\x19\x1d\x9d\xfb\x74\x33\xd6\x1f\xeb\x84\x71\x20\x40\xf5\x6d\x8e\x86\xd4\x81\x8c\x12\x8d\x1d\xb1\x7f\x4b\x92\x90\x8e\x03\x4c\xbf\xb4\xb4\x93\x05\x2d\xba\x31\x54\x85\x2c\xbe\x60\x73\x1d\x29\x58\x7b\x85\x99\x8f\x8d\x41\x0c\x8f\x15\x84\x45\x0e\xb7\x68\x82\xf6\x07\x8b\x3e\x7d\x96\x79\xc3\x25\x42\x4f\x9f\x45\xfa\x56\x0f\x9e\x77\x64\xc7\xff\xf9\xc8\xde\x1e\xfb\xc6\xc9\xae\xc1\x01\x65\x26\x4a\x55\x34\xbd\x75\x68\x1e\x5b\xb0\xf2\x17\xfc\x93\x6c\x99\x44\x5e\xfe\xb4\x21\xfa\x4a\x29\x58\x86\xe0\x18\xd6\x14\x87\x7d\x66\x3b\xd6\x8d\xd3\x67\xa0\x74\x89\xd6\x73\x93\xce\xee\xd1\xf1\x68\xf1\x67\xe9\x97\x9f\x39\x75\x45\xb2\x0c\xc7\x4d\x21\xf0\x8f\xd6\x90\x8c\x6e\x67\xe9\xb3\xcf\x4c\x3c\x1b\x18\x0f\x4b\xd6\x50\x9c\x11\x49\x54\x91\x44\xee\xee\xc8\xf1\x9c\xdf\xdd\x4d\x56\x71\x14\x37\x58\xcf\x9f\x42\xab\x4a\x6e\x43\x68\xd6\x77\xb4\x4a\xae\x7b\x55\xa6\x93\xf1\xad\x38\x10\xa8\x6b\xe2\x69\x94\x7b\x85\x7b\x34\x53\x39\xa9\x49\xa0\x27\xe7\x51\xcb\x63\x42\x81\xae\x77\x01\x3d\x90\x45\x6a\x5b\x41\x3e\xc3\x80\x76\x35\x9a\xbd\xb4\x08\xa5\x46\xab\x1e\xbb\x99\x91\xee\xc9\x5b\x2c\xab\xfb\x22\xf1\xf9\x94\x0a\x2b\x87\x90\x53\x57\xde\xd3\x91\x30\x88\x02\xff\x92\x22\x62\x1f\x5d\x92\xc8\x28\x6e\xe4\x41\x52\x81\x74\x11\x5c\xae\x38\x9a\x4e\x92\xcc\x87\xd5\xd9\x70\x1a\xf6\x59\xc8\xb1\xe7\x5b\xec\xf4\x11\xd4\xef\xa4\x95\x14\xb2\x07\x9c\xe7\x78\xa8\xec\x39\xd5\x31\xbd\xa2\x40\x35\x85\x0b\xdd\xb6\xd2\xd9\x30\xc6\x22\xd3\x63\xfd\x12\xa2\x77\xba\x15\x4e\x16\xa2\x69\x0e\xe0\x8c\xdc\x92\xb9\xcd\x43\x3e\x1d\xf2\x41\x72\x5e\x7e\x07\xa7\xa1\x33\xba\x40\xcb\x81\x48\x9b\x0e\x64\xa7\x31\x98\x9f\xd2\xbf\x27\x82\x6b\xd1\x75\xa8\xe8\x0b\x2a\x62\x1b\xd2\x28\xf6\x9b\xd2\xc1\x52\xa6\x98\x82\xd2\x2c\xa0\x15\x3d\x45\x3b\x3a\xaf\x29\x13\x2b\xaf\x35\x03\x71\xf7\x24\x33\x48\x64\x18\xd2\x19\x4d\x79\x43\x39\xac\xcf\x6a\xce\xcb\x54\x8d\xd8\xb2\x2e\x20\x2b\xbb\x33\x3d\x3e\xa0\xed\xf4\x9b\x02\xa3\x19\x5e\x03\xee\x50\xd1\x86\xd2\xb1\x54\x95\x66\xf6\x4c\xf4\x6c\x64\x52\x3a\x26\xfa\x4d\x73\x38\xf7\xea\x79\x7f\x7b\xce\x7e\x60\x49\x47\x2e\xb1\x12\x7d\xe3\x56\x14\xc8\x3e\xc0\xaf\xbd\x26\x35\x8d\xd2\x12\x24\x94\xbf\x70\xc6\x36\x3d\xc7\xbd\x49\x74\x8e\xd9\x9c\x89\xdc\xbc\xfe\x4e\xa5\x46\x36\x5d\xca\x8a\x1d\xbf\xa3\x93\x74\x68\x1c\xf9\x9c\xe4\x84\x3c\xa1\x4f\x52\x28\xb2\x77\xe7\xf0\xbd\x9e\x9d\x7c\xd0\xce\x21\x99\xa1\xe4\xab\x30\xda\xda\xc1\xb6\xc6\x90\xc4\xa6\xc9\x09\x5c\x8d\xa9\x8c\x74\x87\x73\xf8\xa9\x46\x32\x4b\x96\x3f\xb9\x78\x72\xeb\x3e\xc4\x63\xf6\xd0\x2e\xda\xc0\x87\xde\x7a\x4c\x25\xd6\xf3\xc3\x25\x91\xbc\x22\xa6\x06\xde\x63\x99\x26\xc9\x2d\x0f\xf9\xc4\x41\x01\x93\x8d\x9f\xba\x46\x48\x65\x67\xd6\xcb\xb1\x42\x89\x4e\xc8\x26\x7a\xfc\x28\x6c\xb6\x4e\x78\xed\x87\x25\x09\x23\xf4\x2c\xe3\xe1\x75\x7c\x88\x4a\x68\x35\xc6\x41\x31\xc9\x4b\x01\x5e\x44\x94\x28\x65\xe1\x04\x81\x24\x7e\x12\x85\x6b\x0e\x73\x0b\x91\x6a\x6e\x7f\xde\x62\xa2\x14\x3f\x6b\x1b\x69\x92\x0c\x1b\x90\x1b\x95\xd6\x59\xe6\xd7\x5e\x4f\xa5\x37\xca\x84\x65\xc7\x48\x25\x67\xcc\xf7\x67\x7f\x34\x11\xf2\x8f\x4a\xba\x64\x2e\x73\x58\x86\xe4\x74\x0d\x21\xd3\x24\x79\xf8\x0c\x75\x35\xd5\xa2\x1a\x61\x6b\x84\xea\x1b\x61\xa4\x3b\x00\x41\x17\x27\x14\x13\x75\x91\x76\x8a\xb8\x83\xa6\x4c\x14\x04\xe0\xca\xc5\x74\x9e\x55\xc0\x20\xfa\x98\xc0\x9e\x27\xc9\x69\x0a\x90\x31\x35\xd9\xf9\x44\x65\xf3\x46\x17\x1f\x6d\x54\x19\x4b\x21\x2a\xc3\x2e\xc9\xc7\xeb\x26\x36\x0d\x7e\x66\xc7\xe4\x2c\x85\x8c\x0e\x96\x9d\x07\xa7\x4d\x51\x36\x65\x67\xda\x80\xcf\x62\xb4\xa1\x03\x1a\xb4\x92\x63\xd4\x5e\x95\x41\x5f\x8d\xd6\x6e\x18\x73\x80\xe5\x66\x05\x2d\x29\x6a\x4e\xac\xd0\x7d\xc7\x51\xef\x96\xf5\x3b\x05\xf6\xf6\x92\xa3\x9e\x62\xf4\x2d\x9c\x37\x57\x84\x37\x7e\x63\x2e\xf3\x8d\x4b\x5a\x67\xfa\xc2\xf5\x06\x39\x0b\x3b\xf7\x75\xb5\x4d\xa5\x75\xb2\xc9\x85\x49\x36\x79\xff\xcb\x2f\x94\xf1\x6f\x04\xff\xce\xf9\x3d\x23\x09\x6d\x8a\x1c\x68\xb3\x3f\xf2\x9c\x74\xba\x3b\x69\x70\x87\x4d\xa8\x8d\xd8\x35\x64\xb4\x5a\x46\x7f\x73\x61\x42\xac\x96\xf1\xba\xd9\x7a\xa8\x47\x7a\x49\xb2\xb1\x1b\x6c\x85\x54\xc3\xf9\x82\x0e\x33\xd3\x07\xae\xa6\xc9\x17\x29\x64\xa4\x22\x81\xa9\xc1\xb0\x59\x7b\x01\xae\x54\x88\x08\x84\xc5\xf5\x44\xb9\x3d\xd8\x1d\xa3\x89\x23\x3c\x88\xaa\x3a\x47\x8f\x24\x99\x7f\x87\xa5\x2f\x8d\xac\xc1\xd7\x46\x58\x53\x59\x69\x57\x93\x70\x9d\x6d\x90\xf5\x71\x28\x18\x50\x6e\xb9\x13\xb2\xa1\x14\x2b\x9a\x21\x21\x4d\x30\x45\x69\xa1\x15\x25\x71\x72\xf4\x3a\xfe\x2c\x6f\x44\xcb\x85\xa8\x92\xd3\x43\xd8\x0b\x9f\x2a\x46\x9f\x79\x17\x78\x3f\xe2\xd6\x9d\xe7\x98\x60\xfb\xf6\x2e\x91\x5e\x7b\x7a\x57\xeb\x50\x9a\x62\x55\xe0\xd4\x5d\xa8\xc3\x04\xf3\xc2\x61\xd8\x04\x29\x86\xb9\x07\x95\x9c\x85\xfa\x03\x19\x1c\x80\x31\xf2\x65\xf5\xef\x1e\xd1\x1e\xd6\x42\xe1\x3d\x2f\x6f\x1b\x2d\x21\xa8\x07\x11\x1d\x78\x71\x3a\x66\xf9\x5e\x65\x26\xef\xce\xbc\x17\x3b\x4f\x92\x27\xde\xf3\x46\xc9\xce\x71\x87\xf8\xe9\x0f\xbc\x0e\x1b\x19\xeb\xc8\x4d\x81\x45\xb4\xb3\x7d\x63\x78\x65\xb1\xd0\xaa\xbc\x37\x86\xf6\x4f\xef\xef\x46\x4e\x5a\x9d\x4c\x36\x8d\xe7\x5f\x93\x33\x37\x87\x71\x1d\xe2\x5f\x64\x5e\x0a\xb7\xff\x2f\x62\x86\x31\xc7\xc4\x2d\x2e\x65\x83\x0b\x58\xde\x1e\x5b\xdf\x6a\x5a\x6d\xe1\x90\xe6\xc0\xba\xef\x51\x80\x97\x59\x6a\x33\x2f\x7a\xf2\x20\x7e\x45\x82\x9a\xc3\xc5\x0a\x8a\x5a\xa8\x2d\x25\x09\x8c\x01\x96\x32\xd3\xe9\x11\x07\xe5\xf3\x6b\x8f\x13\x8f\x72\x91\xe8\xdd\x58\x2f\x76\xa8\x4a\xd2\x37\x9a\x3f\x80\x94\x45\x42\x72\x87\x33\x88\xcc\x09\xb0\xe8\xe1\xc9\x09\x6f\x79\x21\x1a\x59\x69\xa3\xe4\x14\xd2\x02\x4a\xd8\x78\xc2\x2a\xba\xd8\x62\x1c\xed\xb7\x5c\x03\xba\x82\x20\x7f\x62\xe5\xe7\xb0\xa0\x59\x8b\x0c\xf6\x9c\x66\x13\x03\xe7\x65\xc2\x10\xcc\x21\x4e\x91\x67\xdc\x9e\xd8\xec\xa0\x41\x61\xdd\x2c\x40\xe7\x35\xc6\x61\x14\xe9\x79\x66\x96\x1c\x45\xb2\x5d\xd2\xf8\xc0\x8d\x71\x1a\x01\x86\x6e\x30\x18\x8f\xb0\xfc\x2f\x4c\xf5\x4e\xd7\xf1\xa3\x30\xf8\x42\x37\xda\x88\x52\x8f\x7b\xad\x59\x44\xc3\xf3\xc9\x06\x36\x1c\x92\x20\xd1\x5f\x03\xad\x21\xef\x7d\xb9\x8c\x52\x1d\x17\x61\xf1\x42\xb7\xb9\x54\xe4\x9c\x6e\xee\x3b\xfd\x63\xcc\x24\x49\x17\x5a\xed\x50\x49\x64\x10\xd8\xa3\x17\x74\x89\x95\x64\xc8\x6d\xa4\xb0\xa4\xd7\xda\x8c\x45\x6f\x5f\x17\x27\x43\x67\x56\xf4\x0c\x2b\xb4\x3c\x3f\xaf\x44\x2b\x1b\x29\xcc\x6a\x1e\x67\x85\x8a\xfe\x1a\x62\x49\xdf\xfb\x97\x58\xd7\x67\xeb\x78\xe1\xc7\xc0\x92\x1d\x3b\x3c\x05\x42\x86\xd5\x1a\x66\xf1\x3e\x85\x04\x63\x3c\x13\x7c\xb4\x8f\x08\xd9\xfb\x83\xb7\x2b\xe1\xcd\x32\x54\xf8\x23\x18\x46\x5d\x88\x38\xea\x8d\xf2\xad\xa7\x08\x96\x84\xa9\xf0\x14\x08\x21\x56\x94\x9e\x4a\xe2\xad\x3b\x74\x21\x55\xb2\x48\xe1\xbf\x82\xef\x45\xa9\x75\xb7\x06\x83\x7f\xef\xa5\x09\xd8\x41\x34\x71\xe4\x11\x94\xd9\x43\x2f\x4f\xc9\x0f\x0f\x78\xc6\x01\xc2\x63\x16\xef\x55\x76\x70\x44\x03\x68\xc3\x9d\x68\x9a\xbb\x23\xc3\x9d\xa9\x5a\xc4\x34\x7f\x9a\xff\xf0\x1c\x85\x25\x79\xdd\x78\x9a\xf5\xbc\x9a\x3b\xf3\xc3\x33\xe7\x17\x09\xbe\x63\x78\xbc\x9b\x78\x72\xb8\x0d\x77\x26\x73\xc9\x0f\x7e\xa3\x9c\xb9\x0d\x0e\xd3\x09\x18\x5a\x74\x68\x42\xad\xc2\x43\xee\xc8\x02\xda\x78\x14\x4c\x84\xa7\x61\x96\x3f\x2f\xd7\x71\x7b\xf2\x6e\xbd\x27\x5d\xcf\x6a\xb2\x06\x1b\xdc\x05\x67\xcb\x66\x70\x5b\xe3\x81\xdf\x44\x25\xce\xb1\xd1\xfb\x10\x30\xc1\xef\xfd\x3c\x3d\xf9\xb3\x3f\x4f\x7f\x7f\xa1\x5f\x67\xdf\xe6\x41\xf5\xd1\xc8\xe4\x77\xb7\x7d\xfa\xc0\xa7\x87\x07\x10\x45\x7e\xdb\xa3\x40\x28\x52\xb4\xf8\x8e\xac\x63\x41\x9f\x58\xef\x82\x13\xda\x35\x70\x9d\x7f\x58\xd1\x63\x0e\xe4\x3d\x45\xdf\x7c\xf6\xe7\xe9\x03\x9f\x1e\x1e\xe0\x29\xf2\xaa\x09\xcb\x23\x37\xbc\x9a\xf0\x28\x7e\x8a\xc8\x10\x1f\x47\x6c\xf8\x07\xf3\x28\x04\x84\xcb\x19\x35\x81\x0e\x8f\x3f\x47\xf2\xbb\x2f\xd6\x7f\x34\x45\x41\x20\x2e\x08\xc4\xc7\x04\x2b\x78\x80\x47\xff\x04\x8a\xe2\x4d\xe1\x23\x78\xe9\x2b\x12\x36\xb9\xaa\x40\xe9\x98\x68\x4a\x3b\x56\xdd\x7c\xd4\x96\xb5\xa2\xcb\xe2\xeb\x39\x78\xd3\x68\x86\x89\xfc\x10\x0b\x1c\x21\xcf\x7e\x1d\x8b\xab\xb1\x0a\xf6\x62\x92\x59\x33\xa4\xea\xbd\xc7\x06\xf6\x48\x43\x2d\x36\x14\x2f\x80\x43\x34\xd9\x76\xda\x38\xb6\xfe\x9a\x7c\x9e\xd3\x3e\x27\xe3\x4b\x35\x5a\xe6\x81\x3a\xd4\x34\x9c\x9f\x64\xd6\x93\xab\xdd\x31\xac\xb7\x3e\x47\xb1\xb5\x36\x6e\x3d\xed\xa4\x88\x01\xb5\xcf\x76\x9f\x3c\x09\x85\x0e\x2e\x3b\xb9\x27\x4f\x68\x25\xe9\xec\x48\xec\x4f\xe8\x83\x91\x50\x40\x0c\xde\x8c\x31\xab\x69\x7a\x5f\x30\x4e\x93\xe4\x42\xf3\x45\x9c\x99\x6e\xe5\xfc\x11\x7c\x75\x66\x42\xda\x39\x64\x43\x9c\x99\x71\x84\x09\xe1\x49\x1f\xe9\xca\xc8\x13\x6d\x26\x8a\x3e\x48\x69\x98\x34\x19\xec\xcd\x2f\x0e\xe1\xeb\xc3\x3d\x34\x48\x3e\xd0\x8a\xc3\xe8\x3a\x2a\x4d\xb2\x21\x76\xe3\x0e\x15\x39\xbd\xa2\xe8\x4d\x00\xd8\xd3\x14\x6e\x7e\xbc\x85\x0d\xed\x7c\x72\x3a\x66\x04\xfc\xb1\xd2\x9a\x02\x42\xa5\x43\x49\xd1\x17\xa6\xb0\xa4\xcc\x7b\x9c\x25\x8e\x66\xe5\xc2\x70\x18\xe9\x47\x5b\x9a\x7b\x4a\x69\xe5\x38\xe3\x6c\x9c\x71\x36\xd9\x67\x3a\xe3\x2c\x79\x3e\x9d\x91\x1f\xcd\x78\x60\x8f\x2f\xbc\x25\x8c\x99\x80\x93\x2d\xce\xcb\x34\x72\x72\x86\xa1\x00\xb6\x0f\x39\x77\x4c\x0e\x98\x49\xb4\x57\xd7\xf0\xad\x13\x0c\x8e\x34\xc7\x42\x90\x42\xec\xfd\x85\xf5\xe0\xde\x73\xed\xea\x50\xb0\xcd\xb1\xd2\x86\x47\x50\xdc\x63\xfa\x79\x9d\x28\x4d\x92\xef\x29\xb7\xe7\xdb\x8a\xdc\xa0\xf8\x58\xea\xbd\x1a\xdb\x86\x86\x5b\x42\x8e\xaa\x63\x96\x12\x24\x45\x6c\x3c\x67\x3f\xb6\xe9\x2a\x4b\x39\x4b\x10\xda\xf8\x2c\x17\x26\x88\x24\xa1\xe1\x67\x0f\x0d\x3f\xfb\xec\xf0\x2f\xfe\x60\xf5\x3f\x58\xe2\xfe\x33\x5f\xd3\x48\x32\xa2\x3c\xf3\x09\x57\x76\xb4\x7a\x30\x87\xa3\xb5\xb2\x81\xd5\xae\xd6\xd6\xd7\x42\x7c\x24\x31\xa4\xd8\x43\xd2\x9f\xfa\x0d\xce\x1e\xde\xe0\xec\x8f\x36\xf0\x21\xe3\xa0\x14\xf9\x61\xaa\x65\x99\x2f\xb0\xf0\x0a\xa3\xa5\x72\x21\x61\x30\xd1\xf9\xe5\xc2\xf2\xbe\x09\xaf\x02\x85\x5f\x64\xf3\xc4\xd5\x9f\xe3\xcf\xd1\x31\x29\xf0\x8c\x20\x20\x8e\x73\xe5\x63\x52\x02\x40\xf8\xeb\x0b\x78\x15\xfa\x18\x58\x9b\x3e\x58\xad\xfe\xa0\xcb\x6b\xd1\x1e\x86\xce\xb1\xc5\x9f\x69\xf6\xa2\x09\xfe\xf3\x51\xc3\x17\xbd\xc8\xa5\x12\xe6\x40\xf9\x84\x30\xdb\xd3\xf0\xf7\x6c\x71\xaf\x09\x6c\xd2\xa0\x75\x38\xb1\xae\x3c\xe1\x33\xf9\x3e\xa2\x07\x7b\xb1\x68\xe3\xe7\xbc\xe3\xff\xbe\xdb\x89\x29\x1e\xd7\x9f\x76\x3c\xcd\x1b\x9b\xee\xb7\xe6\xdc\xce\xae\x38\x4c\x1f\xdd\x15\x17\x17\x29\xaa\xce\xe2\xda\x19\x6c\xf9\xe2\x7c\xe2\xc7\x82\x37\x1c\xcb\xc7\x9c\xf7\x76\x62\xaf\xe0\xf9\x58\x7d\x27\xcc\x3d\x2e\xe4\xf1\x46\xd3\xc6\x92\x6c\xe0\x6d\x16\x33\x8d\x59\x1f\x4e\x16\x85\x92\xad\x7d\xa0\x9f\x11\xff\x83\x55\x90\x08\x32\xbe\xd7\x33\x5b\x6e\x68\x8c\x17\x50\xc3\xf2\x71\x8b\x41\x12\x3c\x9c\xd1\x92\x5c\xb6\x30\xa5\x57\xba\xd8\x5e\x34\x64\xf1\x5b\x74\xe3\xa5\x58\x28\xf3\x72\xaf\x44\x4c\xe7\x82\x47\xe7\xac\x2d\xd6\xf8\xa3\x31\x64\xf1\xaa\x63\xbc\x78\xe1\x98\xc5\x2b\xf2\xcd\xcd\x3b\x78\xad\x7b\xa2\x96\xc8\xbb\xa4\xec\xed\x45\x41\xee\x3d\x44\x2a\xf4\x0e\x6e\x84\xab\xad\x77\x07\x5c\xec\xe5\x66\x06\xe8\xb4\x54\xfe\xb6\xd8\x79\x50\x20\xb6\x30\x67\x63\x71\xe3\x3c\x49\x4e\x02\x5e\x30\x51\x77\x24\xcd\x2c\x56\xc4\x59\xca\x06\x7d\xc9\x90\x33\xfc\x4a\x86\xda\x52\x23\x1c\x86\x3c\x3b\xf6\x2f\xb1\xec\x26\x57\x63\x7c\x6f\x1b\x63\xb1\x34\x01\x38\xf1\x5c\x9b\x0c\x89\x5e\xa9\xd2\xbd\x2a\x81\xf7\x0a\xcd\x1b\x3c\x9c\x2f\x6a\xe1\x47\xe5\x3b\x7b\x48\xe1\xf8\x5c\xe4\xdc\x1b\xed\xb3\x5e\xca\xbe\x4b\xcc\xfb\xed\x56\xaa\xed\x3a\x54\x93\xb9\xb0\x06\x99\xbf\x66\xcc\xe0\xea\xe5\x70\x85\x21\x5c\x9d\xde\xf3\x70\x51\x05\xe2\x95\x94\x68\xf6\xe2\x10\x6b\x23\xeb\xa1\x9c\x46\xcb\xf0\x6d\xec\x34\xa9\xe3\x55\x7d\xb8\xc5\x7d\x67\xb7\xe3\x5a\xf1\xaa\x1e\x3f\x75\x9a\x73\xd1\x78\xb7\xdc\x33\xe6\x09\x7a\xa6\x3d\x5d\xd9\xbc\xf0\x98\xf9\x8b\x38\x6e\xd5\x1b\xe4\xa3\x49\x1d\x1f\x12\x8c\x91\x0e\xb9\xda\xa6\x7b\xd7\xf5\x6e\x98\xd0\x19\xdc\x4d\x66\x70\x29\x3b\xfb\xab\xce\x33\xd0\x06\xb2\x78\x93\x94\x3d\xe6\xde\xdd\x9d\xd4\xbd\x0d\x2b\xac\xc3\x2d\x22\x7e\x92\x96\xe2\xc3\xe5\x7f\x85\x3e\x27\x0a\x63\x3f\xfa\xe8\xd1\x1b\x86\xe4\x58\xe4\x71\x9c\x18\x4e\xfe\x98\xaf\x4f\x50\x0d\x57\xc9\xd7\xfe\xad\xef\xb9\xb5\x49\x72\x73\xf9\x0e\x6c\xdf\x51\x74\x6c\x87\xab\xc9\x9c\x72\xe2\x46\xb6\xd2\x79\x8f\x40\xc4\xad\xb9\x6b\x97\xcb\xc8\xde\xec\xc9\xb8\x72\x9b\xc2\xfb\xb7\xdc\x26\xcc\x4c\x20\x15\xe0\x36\x1b\xa9\x2a\xde\x81\x92\x59\xad\x86\xd5\x8c\x1d\xda\x85\xf9\x16\xf5\x8e\x7c\xd1\x9d\x3d\x58\x87\x2d\x37\x09\x3f\x62\x8b\x3d\x19\xc7\xaf\xbc\x0b\x99\xf4\x35\xfd\x67\xec\x6b\x4a\x92\x9f\x22\xf0\xcd\xf0\x70\x7a\x3f\xc0\x7d\x57\x53\x86\xc0\xd5\x4b\xdf\x4b\x14\x55\x61\x27\xc5\xc3\x6d\x53\xde\x1e\xff\xe5\xe6\xc5\xc5\xf7\x77\xd7\x3f\xde\xde\xfc\x78\x7b\x77\x71\xfd\xfa\xf5\xd5\xed\xdd\xd5\xcb\x6c\x2c\x49\x72\x78\x30\xdb\x21\x44\x55\x84\x48\xd2\x59\x6c\xaa\xe4\x04\x86\x4b\xc1\xf1\xe5\xd0\x78\xc0\x01\x9c\xf4\xf5\xd0\x68\x36\xd1\x1a\x05\x14\xda\x18\xb4\x9d\x56\x7c\x91\xf4\x10\xa5\xb0\x9f\x1c\x98\x69\x28\xe1\xea\xe5\x39\x9b\x2d\x37\xc5\xf8\x8e\x87\x70\xb5\x73\x94\x1c\x1c\xe5\x06\xeb\xa3\xc0\x7d\xb8\xac\xd5\x5d\x4f\x38\x53\xfa\x50\x6d\x60\xcc\xe5\xf5\xf5\x84\x2b\xf3\x77\xdf\xbd\x78\x3b\x7d\xc7\x82\xbc\x6c\x84\xad\x4f\x0a\xa3\xf7\xe5\xd0\xbc\x97\x24\x57\x0a\x4a\x69\x9d\x91\x79\x4f\x1a\xe7\xf5\xc1\x52\x1e\x55\x3d\x30\xde\xa7\x11\x43\x96\xd6\x08\xb3\xc5\x49\x7b\x0e\xb7\xbc\x80\x45\x82\x0a\x23\xaa\x4a\x16\xbe\x83\x86\x6b\x81\x45\xdf\x08\xc3\x43\xb8\x60\xae\xa0\x57\x85\xd6\xa6\x94\x8a\x0e\x07\x95\xb0\xb5\xd4\x6a\x0d\x84\x46\x9c\x27\x92\xd3\xa0\xd1\x6c\x15\x94\x05\x82\x80\x5a\x3b\xdb\x69\xb7\x06\x83\x96\xb2\x4d\xb5\xa5\xc5\x3a\x34\xac\xf3\xaa\x20\xeb\xd9\x1a\x51\xfa\xee\xca\x24\xb9\x9d\x25\x9b\xb5\xde\x87\x26\xb5\xf1\x44\x64\xd1\x7c\x2a\x5a\x68\xe8\x31\x58\xfb\xe0\x6c\x92\xbb\xce\x16\xe2\xe2\x9e\x38\x70\x75\x11\x6d\x68\x91\xb3\xb5\x30\xa5\xbf\x64\x9b\x34\xb1\xc6\xb6\xa0\x14\xa6\xcf\x62\x4f\x19\x60\xdb\x35\xfa\xc0\xcd\x24\x92\xd3\x4d\x5e\x85\x9b\xeb\x8a\x1a\xdb\x90\x5a\xf2\x43\x3b\xa2\x25\x57\x2f\xc9\x41\xf8\x54\x85\x11\x76\x3d\x52\xd3\xa1\x21\x0b\x09\xd9\xb6\x80\xc2\x7f\x8f\x17\x11\x5e\xaf\x62\xa3\xa4\x18\xfe\x7b\x02\xb3\x3b\x05\x78\x61\xb9\x4c\x48\x0c\x3e\xbe\x73\x3a\x96\xf5\x08\xfa\x83\x3a\x10\x92\x71\x2f\x8f\x1a\xc2\x9a\xc0\x4d\xee\xdf\x76\x62\x8b\x04\xb2\xa1\x5c\xdd\x4e\x2f\xe0\x26\x97\xaa\x46\xa8\x52\xb7\xa0\x4d\x89\x26\xbd\x77\x0f\xd6\x8a\xed\xac\xeb\x39\x50\x17\xb7\x0e\x37\x76\x01\x26\x38\x3c\xbf\x7b\x91\xad\xc3\xa7\xef\x86\xf0\x9e\xbe\x5d\x4c\x2e\x4e\x87\x90\x4b\x5a\x38\xbd\xfd\x8e\x6f\x4e\xb8\x73\x8c\xd2\xec\x71\x18\x17\x82\xfd\xf9\x27\x71\x0f\x69\x0a\x63\x6e\x35\x85\x1b\x1b\x82\xfe\x14\x42\x13\x57\x9c\x29\x55\x89\x1d\xaa\xd2\xab\x81\x6f\x9e\x9a\x70\x73\x64\x45\xd3\xd5\x42\xf5\x2d\x1a\x59\x78\x76\x30\x50\x1c\xa2\x93\x8e\x7d\x5f\x3e\xd0\x1b\x8e\x1a\xad\x88\x33\x8f\xd1\x16\x27\x72\x1f\xed\xcb\x17\x2f\x54\x49\x3b\xc6\x15\x7c\x51\x85\x78\x68\x84\x75\x63\xbf\xdb\x4c\xec\x9f\x13\xd8\x7a\xd8\x32\x2a\xdb\x14\x63\x72\x74\x7b\x64\xef\x61\xd0\x93\x60\xd3\x24\xf9\x9f\x00\x00\x00\xff\xff\x7b\x20\xb4\x61\x7f\x33\x00\x00")
+var _docDeploymentPipeline_specMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbd\x5c\x7b\x73\x1b\xc7\x91\xff\x1f\x9f\x62\x8a\xba\x2a\x11\x32\x08\x88\xb4\x9c\x4b\x98\xc4\x77\xb2\x44\xd9\xb4\x2d\x91\x27\x51\xf1\xf9\x14\x1d\x76\x81\x1d\x00\x2b\x2e\x76\x91\x7d\x10\x82\x13\x7f\xf7\xeb\x5f\x77\xcf\x63\x41\xc8\x76\xa5\x52\xa7\x38\x12\xb9\x3b\x8f\x9e\x7e\xbf\x66\x1f\x98\xeb\x7c\x63\x8b\xbc\xb4\xe6\xcd\xc6\xce\xf3\x45\x3e\x4f\xdb\xbc\x2a\x07\x83\x9b\x55\xde\x98\xac\x9a\x77\x6b\x5b\xb6\x26\xcb\x9b\x79\xd7\x34\xb6\x31\x36\x9d\xaf\x4c\xb5\x30\xed\xca\x9a\x45\x6e\x8b\xac\x31\x9b\xda\x36\x18\x94\x97\x26\x35\x1b\xb7\x5e\x13\xaf\x37\x36\x37\x95\x69\xac\x35\xab\x6a\x6b\xda\xca\x74\x8d\xdd\x1f\x3b\x32\xb5\x5d\xd8\x1a\x6f\xb1\xf6\xbb\x0d\x6d\x34\x6f\x0b\x33\xaf\x6d\xda\xda\x13\x37\xf6\xfd\xf1\x78\x3c\xd1\x77\xee\xdf\xe9\xde\x98\xf1\xaa\x5d\x17\x43\x40\x3f\x1e\x0c\x1e\x3c\x30\xdf\xbe\xb9\x7a\x65\x5e\xa6\x65\xbe\xb0\x4d\x6b\x5e\x54\xf5\x3a\x6d\x07\x83\x24\x49\x06\x7f\x1f\x18\x73\xe4\xa6\x1d\x9d\x1b\xfc\x4e\x4f\xca\x74\x8d\xdf\x9a\xb6\xce\xcb\x25\x3d\xfa\x79\x84\x71\x6d\x9d\x96\xcd\x82\x66\x87\x81\xf9\x3a\x5d\x86\x91\x23\x79\x38\x5f\x67\xf4\xe8\x9d\x3e\x34\xef\xf5\x71\xd3\x66\x79\xd9\x7b\x21\xcf\x6d\x79\xe7\x17\xe4\x07\x8b\xaa\xa2\x07\x47\xb3\xb4\x3e\xe2\x87\x3f\xbb\x05\x2c\x9d\xb3\x6d\x78\x89\x68\xb8\x02\xab\xaf\xa7\xfc\xeb\x28\xbc\x5e\x57\x5d\xd9\x5e\xa7\xed\x0a\x63\x08\x63\xed\x6a\x92\x97\x93\x79\x55\xb6\x29\x9d\xd9\x6d\xe1\xa1\xdc\x2c\x9a\xe9\xba\xca\x78\xc5\x17\x6f\xdf\x5c\x1c\x4d\x8e\x9e\x5d\x5d\xff\xa8\x2b\x1e\xd1\x0e\xf5\x6e\xba\xa9\x8a\x7c\xbe\x8b\xa0\x3e\x5a\xa7\x1f\xa7\x69\xdb\xda\xf5\x86\x01\xcc\xcb\xd6\x81\x40\xe7\x98\xdf\x56\x8b\xc5\x94\xe0\xab\xca\x6c\xff\xad\x2c\x58\x95\x53\xfb\x31\x6f\xa7\x73\xd9\xf9\x1d\x86\x28\x7e\x7e\xf6\xf8\xdf\xa4\x75\x5a\x14\x44\xaa\x66\x3d\x05\xc7\x04\x32\x10\x42\x89\xfe\x4b\x40\x44\xd0\xbe\x7a\x73\xf3\xf4\xd5\xcd\xd1\x3f\xe8\xc7\x8b\x17\x2f\x2e\x9f\x5d\x5e\xd0\x6f\x4a\x9a\xaa\x6c\xda\xb4\x6c\x05\x08\x87\xa3\xc9\xc4\xe4\x0b\xe3\x16\x31\x7f\xfe\xb3\x71\x8b\xb8\x59\x76\x41\x9c\x9c\x5b\x9e\x98\x55\xdd\xac\xb0\x87\x67\xf9\xfd\x06\x02\x38\x4d\x5d\xcf\xf2\x32\x6d\xab\x9a\x61\x7b\x7d\xf5\xe6\x0d\x61\xf4\xed\xab\xcb\xab\x57\xf4\xef\xb7\x57\x97\xaf\xa6\x57\xaf\xa6\xd7\x4f\x6f\xbe\x89\x7e\x7d\xf6\xf4\xfa\xe6\xed\xeb\x0b\xc6\xf9\x51\x5e\x6e\x3a\x21\x3a\x03\xf3\xf7\x80\xb8\x4d\xd5\xe7\x9b\x7d\xae\x8d\x78\x07\xe3\xbb\xf2\x82\xa8\x03\x1c\x2d\xd2\xa2\xb1\xfe\xc5\xbc\xae\xca\xfe\x42\x8a\xdc\x4f\x2c\xb4\x2c\xaa\xd9\x1e\xcb\x83\x01\x6c\xbb\xaa\xc0\xf7\xc4\x0a\x1b\x3a\x4b\x6d\xb3\x6e\x6e\xe9\x07\x0c\x4f\x8b\xa3\x81\x47\x75\x5a\xb4\xb6\x26\x94\xe4\x77\xb6\xd8\x8d\x8c\x4c\x34\xf3\xb4\x34\x33\xaf\x35\x6c\x66\xd2\xc6\xd0\xa3\x6a\xf6\xc1\xce\xdb\x71\x98\xdd\x42\x2f\xd1\x7f\x55\x59\xec\x0c\x09\xa3\x49\xb3\xbb\xb4\x9c\xd3\x04\xe8\x94\x79\x4a\x3a\xea\x8f\x66\x5d\x91\x9c\xab\x92\x6a\xf3\xb5\x1d\xd1\x70\xeb\x1f\xac\x6a\x6b\xc3\x82\x4a\xc0\x9c\x74\x5b\x3a\xab\xee\x08\x84\x55\xd5\x15\x99\x69\x3a\xd0\xdc\x8e\xef\x1d\x30\x42\x14\x71\x64\x9b\x43\xbf\xe1\xdc\x5f\x7d\x7f\xf5\xec\x3b\x3a\xf0\x8b\xcb\xef\x21\x35\xaf\x2f\xae\xaf\x62\x39\xcc\x4b\x12\x4f\x28\x53\x42\x06\x8d\x7e\x75\xf5\x0a\xa3\x9e\x5f\xbe\x78\xe1\xe6\x44\x83\x17\x79\x61\xa7\xab\xb4\x59\xf5\xf6\xeb\xa1\xf9\xe6\xea\x7a\xfa\xfd\xc5\x5f\x2e\xbe\xa7\xe9\xca\x3f\xcf\x2f\x5f\x5f\x3c\xbb\xb9\x7a\xfd\x23\x1e\xbd\xbe\x78\x71\xf9\xdf\xd3\xd7\x4f\x5f\x7d\x8d\x7d\xbe\xbb\xf8\x31\x5a\x9f\x16\xba\xb5\xbb\x7d\x12\x0b\xcb\x86\x7f\xf1\xf7\xfb\xc1\xcf\xac\x28\x49\x8d\x3e\x30\xaf\x88\xc1\x48\x6f\x7a\x35\x0b\x86\x4b\x40\x0c\xa0\x15\xbf\x38\x14\x7b\xc5\xde\xae\xd2\xd6\xec\xaa\xce\xa4\xb5\x15\x4d\x4e\xdb\x8d\x8d\xb9\x80\x15\xf1\xa3\x4a\x6b\xc9\x8c\x90\xe2\x5f\xa5\x77\xb0\x0b\x5d\x99\xff\xad\x93\x15\xc7\xb2\xf3\x8d\x53\xbd\xb4\xbd\x57\xc3\x63\xd6\xbe\x07\x01\x78\x5e\xcd\x6f\xc9\x96\xf0\x00\x0f\x44\x6d\x3e\x54\xb3\xc6\x90\x20\x90\xf0\x13\x10\xcf\xba\xba\x26\x7a\x80\x09\x85\xab\x78\xb4\x07\xe6\x5d\x5e\xae\x6c\x9d\xb7\x66\x51\x57\x6b\x82\xea\x9a\x60\xde\x65\xb6\x5e\x9f\x6c\xea\xea\x2e\xcf\x88\xe5\x64\xc6\x6d\x59\x6d\x4b\xf0\x6b\x42\xeb\x9f\x34\xab\x7c\x9d\xbc\x3f\x5e\xb5\xed\xa6\x39\x9f\x4c\x96\x79\xbb\xea\x66\x63\xd2\x03\x6c\xad\x78\x81\xe8\xa7\x19\x49\xc7\x64\x91\xda\x3f\xfc\xde\x7e\xf1\x24\x5d\x3c\xce\x7e\xf7\x87\xcf\xcf\xec\xd9\x17\xbf\xb7\x4f\x66\x8f\xb3\xc5\x13\x9b\xfe\xfb\xef\x9f\x3c\x39\x7d\x32\xff\xc3\xd9\xa9\x9d\xd8\x8f\xe9\x7a\x53\xd8\x66\xb2\xa8\x3b\xd2\x96\x50\x65\xd9\x44\xce\x0a\x8e\x79\xf0\xfd\xe9\x70\xdc\xc3\x10\x99\x22\x8f\x1f\x02\x61\x4d\xe3\xcd\x26\x25\x43\x9e\x39\x3b\xab\x98\x12\xa4\xdc\x55\xce\x5c\x9b\x57\x55\xab\x98\xa3\x83\x6d\xe9\x10\x3a\x72\x64\x68\x4d\x2c\x59\x56\xad\xce\x6a\x08\x17\x84\x9f\x66\x65\x8b\xc2\x6c\x57\x39\x91\x76\x6d\x09\x02\x99\x4e\xa8\x2d\x97\x8d\x29\xf2\x5b\x4b\xeb\x14\xd9\x3c\xad\x33\x03\xa5\x30\x83\x0d\x3c\x4e\x1e\x25\xc3\x11\xb3\x42\x43\xbf\xfc\x23\x19\x1a\xc0\x88\xd3\x90\x3f\x90\xe5\x35\x49\x3f\x5e\x7c\x99\xf0\xf3\xe4\xcb\x2f\x69\x04\x2d\x53\xf0\xfe\xdb\xaa\xbe\x25\x58\xc9\xb7\x58\xda\x56\xb6\x9b\x59\x62\xa2\xbc\x22\x38\xc1\x76\xd0\x2a\x0d\xbd\x4a\x18\x11\x74\xe4\x59\x80\x94\x58\x85\x99\x62\xbe\xaa\x48\xce\xcd\xb1\x1d\x13\x67\x26\xcd\x4a\x41\x00\x9a\xfc\xd8\x66\x5e\xe7\x9b\x16\x0b\xb0\x15\xef\x23\x99\x1f\x31\x9a\x69\xb7\xb4\xae\xd3\x1d\xd6\x06\x67\x2b\x0a\xc0\xfc\xec\x21\xd1\x7c\xd9\x52\x49\x51\x95\xba\x9e\x31\xdf\xf3\x70\x30\x1f\x9f\xcc\xd2\x5b\xf2\xa7\x4a\xbb\x65\x09\x99\xaf\xc8\xf6\xcd\x49\x6f\x36\xfd\xad\xc9\x77\xe0\x7d\x0d\x29\x5d\xe1\x53\x92\x6b\x6c\x73\x97\x16\x1d\x4b\x03\x8d\xc8\x49\xc1\xb3\x17\x77\x97\xd6\x79\x4a\xb6\x4b\xc1\x62\x34\x12\x42\xf2\x12\x3a\x16\xec\x5c\x2a\x53\x78\xef\xa0\x7f\x4e\xf1\x3f\xee\x9d\x54\x9f\x8f\xdc\x0f\xe2\xc8\x59\x52\xca\xe6\xbb\x6e\x46\xba\xde\xb6\xb4\xa5\x7b\x39\xdb\x89\xa4\xe2\xfc\xa2\xed\x77\x70\x05\xc9\x2f\x71\xfc\x62\xfd\x58\x55\xc5\x04\x23\x7b\x31\xcc\xb5\x63\xf3\x46\xdf\x02\xad\xa4\xf4\x17\x5d\xc1\x86\xc0\xae\x67\x36\xcb\xc0\x54\x84\xeb\x26\x87\x7d\x31\x59\xda\x12\x09\x3b\xe2\x48\xe2\x62\x9a\x95\x11\x1a\x72\xb2\x7e\x63\xf3\xda\xa6\x19\xad\x4a\x4b\x90\xd6\xef\x5a\xbf\x25\x21\x3d\x02\xfa\x1d\x69\x00\x2b\xc2\x4c\xb2\x7c\xeb\x5f\x8c\xf3\x6a\x42\xee\x65\x33\xa1\xfd\xeb\x93\x65\x47\x12\x30\xd1\x15\x26\x7b\x22\xe8\x1c\x2a\x46\x1b\xdc\xdf\xa0\x89\xd2\xf9\xdc\x12\x93\xd1\x88\x11\x3b\xc7\xef\xae\x5f\xbc\x31\x2f\x69\x6c\xf3\xfe\xf8\x01\x3d\x3d\xc1\xbc\x66\x08\x0e\x27\xa4\x64\x76\x91\x76\x45\x3b\x32\x09\xfc\xb2\x64\x24\x38\xe1\xe9\x86\xf0\x96\x4c\xe8\x87\x44\xe5\xaf\xb6\x7f\xeb\x48\x76\x44\xf0\x69\xaf\x87\x34\xac\x12\xb5\x06\x99\xdd\xd4\xf9\x1d\x49\xd8\xd2\x66\x7d\x58\x63\xef\xce\xc3\xcb\x0c\xb1\xea\xca\xdb\xc6\x8b\x8c\x40\x5f\x43\x44\xc9\x80\x10\x59\xb6\x2b\x5b\x62\x20\x99\xe4\x34\x2f\xf4\x34\xaf\xf9\x25\xce\x22\xc3\x9a\xa1\x6a\xf3\xeb\xe0\xc8\x71\xd8\x01\x9b\xb2\xe7\xdb\x25\x74\x5c\x08\xdd\xcc\x0a\x10\x5e\xf9\x3a\x9e\xf0\x13\x7e\xb2\x02\x93\xb7\x4a\xb1\x5e\x0f\xd3\xc8\x98\x9a\x76\x5b\x99\x68\xa3\xc8\xf4\x9f\x9b\xc4\xb9\x7c\xaa\x68\x22\x5f\x2e\x21\xb0\x2f\xf9\xe4\xec\x63\x00\x1f\xd1\x68\xe7\x00\xc6\x9b\xb1\x64\x91\x76\xae\x49\xf6\x4d\xd9\x11\x63\xd6\xc0\x1d\xd4\x15\x89\x70\xb0\x8a\x4b\xf0\x68\xde\x72\x74\x24\x4b\x13\xce\xc3\x82\x8d\x6d\x43\x9c\x45\xaa\x49\x5f\xb0\x22\x0b\x10\x8c\x44\x94\xfa\x63\x9d\xa3\xcb\x63\x53\x68\x69\x9a\x49\x50\x88\x5e\x50\x5d\x77\xfa\x38\x11\x95\x06\x40\x4f\x1f\x3b\xf8\x86\x07\xcf\x1b\xd0\xf1\x4f\x1f\x59\x34\x15\xf1\x70\x4e\x46\xcc\x33\x53\x24\x6e\xf3\xa2\x6b\x48\xc9\x11\xb7\x36\x44\xd7\xdf\x88\x96\xc8\x3b\x97\xd3\xaa\x87\x3e\x46\xa4\x67\xd4\x64\x8e\xe0\xab\x7f\x62\x3b\xe6\x0d\x3a\x7e\x09\x69\x13\x6c\xe2\xec\x62\x37\xf6\x16\x7f\x3c\xfe\xe2\x13\xa7\x5e\x80\x96\x7a\xdc\xb1\x51\xfc\x61\x8d\x9c\xf5\xfe\xd9\xf8\xf1\x27\x26\x9e\x79\xc4\x9b\x63\xe6\x50\xdb\x03\x12\x50\x81\x22\xd3\x29\x4c\xf2\xf9\x74\x1a\xad\xd2\xc2\xa3\x6a\x04\x3f\x44\xf1\x45\xbe\x54\xb7\xb9\xdb\x60\x15\x52\x6c\x65\x36\x8e\xc6\xaf\x49\x61\x93\xb9\xab\x80\x53\x47\xf7\x85\xdd\xd2\xd0\x88\x4e\x65\xe4\x84\xe7\x7d\x7f\xee\x21\xf4\x23\x85\x23\xaa\x57\x2d\x93\xb4\x59\xa7\xb0\xa6\x44\x6e\x1a\x5a\x6f\x73\x5a\x3c\xab\x6c\x53\x3e\x6c\x7b\x42\xba\x85\x1d\x3d\x5e\xdc\x27\x89\x24\x03\x4a\x5d\x59\xc3\x01\x62\x0e\xf6\x01\x40\x0c\x40\x20\x2f\x11\xf3\x88\xe7\x0f\x92\xc1\xa7\xe7\x41\xa4\xb3\xf3\xd6\x29\x97\x4b\x8e\x97\x48\xa5\x48\xe0\x94\xf8\xd3\xb0\x35\xb7\x1c\x17\xbc\xa6\x75\xf6\x8c\xe0\x5d\xde\xe4\x08\xeb\xd4\x02\xb2\x86\xcb\x3a\x8e\xd3\x49\x63\x22\x88\x20\xc5\x42\x66\x3b\x6f\x1b\x1d\xd3\x58\x86\xa7\x91\x25\xd2\xae\xad\xd6\xe4\x3c\xcd\xe9\xc0\x64\x81\xeb\x7c\x09\x71\xeb\x3b\xc3\x95\x26\x33\x60\xd6\x65\x07\x7a\x44\xce\x24\x5b\x01\x1a\xba\x1e\x7b\xb0\xc7\x2e\x5c\x8b\xe1\xdf\x02\xe0\x55\x4a\xb4\x2d\x1b\x51\xb9\x84\x36\x84\xdc\x3b\xf6\x28\x88\xd1\x8e\xf3\x31\xc1\x59\x56\x4c\xa0\x21\x9e\xda\x26\x98\xf5\x18\x89\x0b\xe1\x1a\x0f\xdc\x3d\xca\x78\x8a\xf8\x21\x04\x29\x62\xba\xcc\xaf\xcf\x6c\xce\xcb\x2c\x8a\x74\xc9\xbc\x60\x99\xd9\xdb\xba\xb3\x07\xb8\x1d\x7f\xc3\xfc\xf4\xf4\xb5\xb1\x77\x74\x10\xda\x30\x6f\x99\xaa\xb4\x38\xd0\x13\xf1\x59\x40\xd2\x38\x64\xa9\x08\xcb\xe7\xc2\x9e\xf7\xb7\xe7\xf8\x96\x84\x29\x18\xcd\x21\x5c\xfc\x03\xf8\xda\x56\x60\x53\x47\xad\x14\x44\xf9\x23\x47\xf5\xf1\x39\xee\x4d\xc2\x39\x7a\x73\x22\xba\x09\xff\xc6\x54\x83\x4c\x67\xf9\x82\x5d\xa2\x16\x27\x21\xd1\x6c\x61\x73\x06\x27\xb0\x84\x12\x40\x22\xe6\x69\xcf\xcd\x37\x55\xef\xe4\x9e\x3b\x7d\xa0\x89\xc0\x98\x02\x76\x78\x0d\x2a\x5b\xc1\x59\x23\xdf\xf0\x84\xd8\xdf\x87\x99\x79\x4b\x28\xfa\x61\x65\x21\x96\x4c\x7f\x38\x3f\x70\x78\xc4\xf9\x65\xf4\x60\x17\x22\xfc\x07\x52\x84\x12\x44\x11\xea\xf9\xe1\x31\x40\x1e\x02\xa9\x8a\x7b\xf6\x15\x6e\x78\xc8\x47\x76\x97\x18\x6c\xfb\x71\x53\xd0\xe6\x4d\x4f\x7a\xd9\x8b\xca\x2c\x41\x55\xa8\x50\x3e\x23\x17\x74\x5f\x32\xc7\x48\x3c\x24\xa4\x93\x6e\x6d\x13\xb8\x6d\x01\x08\x2b\x64\x12\x9b\xf9\xca\x66\x1d\x8b\x37\x69\xe9\x94\x75\x02\x33\x7d\x43\x5c\x0d\xd9\x9f\x07\x71\xcc\xc9\x2d\x02\x03\x93\xcb\x90\x12\x7a\x49\x8b\x97\xf9\x72\x45\x2e\x80\x49\x97\xcb\xda\x2e\x39\xaa\x69\x58\x9b\xa4\xe5\x8e\x43\x12\x63\x8b\x46\x23\x1c\x75\x29\xd8\x33\xa2\x88\xaf\xca\x84\xbf\xc8\xef\x8a\x41\x1d\x8b\x5f\x42\x18\xb1\x39\xa3\x34\x35\x1c\x80\x21\x9e\x61\xe5\x2f\xf6\x08\x43\x35\xa1\x99\x1c\xad\xf3\xb2\x6b\x91\xf5\x24\x86\xcf\xd2\xdd\x49\xb5\x20\x9f\xae\x24\x57\x57\xfe\xd6\x47\x5b\x6b\x6f\x8f\x12\xef\xa7\x26\x47\x8f\xcd\x99\x79\x84\xff\xd1\x53\x1c\xeb\x2c\x5d\x43\x4c\xea\x1d\x66\x8c\x38\x2a\xa9\x6a\xa8\xea\x2c\x9a\xf4\x9f\xd8\xa5\xd8\xd1\x14\x9a\x41\xbf\x66\x84\x7e\xfa\x6d\x84\x5f\xd5\x03\xa8\xc9\xfc\xc7\x33\x64\xcd\xd3\x2f\xd6\x47\x09\x9c\xcc\x1c\xda\x02\x6e\x1d\x91\xef\xed\xcd\x33\xa2\x5d\x6c\x3a\x4a\x8a\x75\x9b\xa0\x47\x1e\x36\xac\x49\x48\x08\xf1\x23\x5c\xfa\x0c\xbe\xbd\xd7\x5f\xf4\x4e\xf6\x4e\xfe\xe4\x44\xfd\xcb\xa9\x90\x9c\xc5\x36\xe5\x69\x15\x58\xa9\x15\xa3\x2b\xca\x91\x28\x54\x54\x44\x1e\x8e\x39\x63\xfd\x49\x86\x1f\x84\x26\x07\xa2\x69\x21\xb5\xf6\x63\x4e\x4c\x9b\x16\x34\x2b\x03\xad\xae\x60\x17\x34\xcf\x93\xc6\x26\x8b\x29\x22\x60\x71\x40\x58\x58\xa4\x19\x7a\x80\x92\xf8\x11\x18\x2e\x31\x01\x6d\x2f\x71\x88\x63\xc1\x05\x3b\xd3\x08\xdb\x33\xcf\x76\xa9\x18\x1f\x39\x39\xa8\x14\xb2\x4d\x1e\xc5\xf0\xc9\x27\xeb\x9d\xcf\x4c\x33\x02\x26\x67\x8f\x4f\x7f\x77\x72\x7a\x7a\xf2\xf8\xf4\xe6\xf1\xd9\xf9\xe3\xc7\xf4\xdf\xff\x10\xae\x54\x4b\x03\x20\x82\x3a\x59\xa7\x70\x4e\x12\x33\x23\xf7\x7c\xbe\x1a\xa9\x67\xaf\x7a\xa7\xe9\x9b\x16\x8e\xae\x89\xb3\xc5\x06\x2b\x88\x8c\x30\x77\x04\x66\xef\x46\x32\x2c\x98\xda\x35\x1d\xf1\x02\x32\x19\xb6\x44\xfe\x8c\x29\x40\xaa\xcc\x90\x3e\x6d\xab\x1a\x08\x25\xe5\x2e\x27\xe6\x4a\xc0\xb6\xf4\x5e\xfe\x3e\x5e\x40\x14\x96\xe2\x20\x95\x32\x96\xfe\x15\x13\x84\x1c\xf0\x08\x8e\x3c\x38\x0e\xbc\xb4\x63\x4c\x35\xf7\x57\xe3\xb0\x77\x66\x5b\x92\x09\x84\xc5\x4f\x23\xea\x11\x52\xd4\x3b\x50\xad\xce\xa2\x9f\x44\x89\xb5\xa9\x64\x1d\x13\x3e\x39\x57\x2a\x70\x22\x0a\x46\x34\x5f\xa8\x1a\x0e\x55\x00\xb1\xe8\x1c\x58\xb1\x41\xc4\x19\x6d\x61\x7f\x81\xff\xc6\x52\x32\xe8\x27\x64\x35\x01\xbd\x97\x43\x75\x19\xd4\x48\x90\xfb\xb9\xec\xbf\x0f\x0e\xe5\x70\x7d\x2a\x1f\x26\x91\xb6\xe8\x65\xff\x43\xe6\x2f\x64\x54\x7f\x1e\xbc\x0f\xd9\x39\x5f\xc9\x61\x3d\x6b\x5e\x8a\x2a\x1e\x0c\xd8\x0b\xee\xe5\xdb\xf8\x04\x92\x20\x81\x47\x18\xa2\x70\xb7\x09\x23\xde\xe1\x3a\x9f\xb7\x29\x1c\x51\x72\x0e\xe6\xd0\xa8\x3d\x2f\x24\x2f\xfb\x8c\x28\x5e\x89\x63\x82\x4f\xfa\x1f\x84\x4b\xbf\x01\x42\x15\xe2\x39\x8e\x2e\xd9\xff\x0d\x16\x32\xd8\x3d\xb6\x8f\xcc\xa3\x79\xcf\xc0\x89\x7d\x79\x10\x19\xd2\xb7\x34\x70\xd0\xb7\xab\xe6\x58\x93\xb3\x23\xa3\x99\x56\xe8\x25\xc9\xd0\x0e\x63\x4b\x4d\x30\x2e\x49\xda\xba\x22\xad\x69\x6d\x44\xd5\x22\x74\x91\x49\xce\x9b\xd8\xab\xf5\xd6\x38\x32\xc2\x24\x38\xad\x4b\x67\xb3\x99\xad\x29\x18\xe6\xb8\x8b\x4c\xfe\xe0\x14\x56\x85\xa1\x49\xce\x23\xb7\x60\x56\x54\x73\x09\xb0\x59\x22\x90\x20\x61\xed\x02\xfa\x88\xfd\x27\xf7\xd9\x7e\x62\xc7\xc1\x19\x05\x73\x38\x18\x2d\x29\x81\x51\xc1\x26\x35\x9b\xd0\x31\x25\x87\x56\x21\xfe\x26\xd4\x37\x39\x67\x48\x28\x2e\x50\x9f\xa0\xae\xaa\xd6\x8f\xd9\x99\xe3\xc9\x90\x15\x2c\x36\x5d\xd6\x55\xb7\xe1\x9c\xcb\x92\x7d\x08\x82\x1c\xbc\x94\x73\x64\x39\x0f\xfe\x3b\xeb\x94\x05\x4c\x9c\x6c\xcc\x75\xc0\xb0\x24\x05\x6e\xdd\xbc\xed\x6a\xd1\x52\xe7\x22\x45\x93\x45\x55\x0d\x26\xb3\xb4\xa6\xbf\xba\x9f\x7e\x02\x8b\x4f\x52\xfe\x7b\xc6\xef\x5b\x55\x35\x70\x57\xd8\x21\x60\xe9\x05\x26\xdb\x6a\x73\x52\x90\x80\x14\x5a\x1b\x20\x5b\x9f\x60\xb5\x04\xff\xd2\x82\x1a\x0f\x27\xbc\x2e\xfd\xe2\x0a\x96\x42\x49\x76\xa8\x88\x7f\x08\x73\xfe\x7c\xca\xc3\x8c\x74\x8f\xd5\xf1\xe0\x73\x42\x2a\x58\x44\x91\xaa\xce\x13\x73\x2f\x91\xb8\xd4\xa8\x2b\x6d\xec\x28\x62\x6e\x71\x28\xf7\x3d\x36\x98\x29\xb8\x42\x70\xef\x94\x38\x75\xc0\xef\x4c\x14\x93\x50\x30\x62\x79\xe5\xa1\x39\xd9\xf9\x25\x72\x2c\x50\x48\x89\xaf\x0c\x24\x84\xc9\x47\x26\xf1\x65\x80\xa4\xef\xf0\x9e\xeb\x46\x5e\x8d\xf1\xf1\xf6\x91\xd7\x83\xc3\xd1\x79\xc4\x41\x24\x6a\x21\x63\xec\x80\xe2\x02\xe1\x40\x7c\x05\x89\xb7\x48\x06\x8a\x14\x91\x00\x54\x33\x24\x77\x5b\x3a\xfb\xd4\x6c\x60\x8f\x1b\xdd\x1d\x8a\x8c\x28\xe7\x5c\x7c\xce\x10\x35\x2b\x6c\xca\x3a\x96\xc6\x2e\xf2\x8f\xb6\xe1\x7d\x7c\xf5\x22\x39\x0f\x8c\xe4\x21\x0f\xfc\x74\x08\x64\x01\x34\x2a\x79\x00\x60\x10\xde\xd3\x53\xac\x40\xca\x0f\xf2\x65\x57\x75\x24\x0d\xc0\x2b\x58\x03\xc9\x47\x62\xa3\x1c\xae\xc1\xc7\x7c\x5e\x91\x0e\xd8\xd0\x61\x48\x49\x64\xba\xf4\x77\x17\x01\xaa\x3d\xd2\xa5\x9c\x72\x25\x9f\x98\x13\xb4\x99\x64\x61\x09\xa6\xbc\x96\xa4\xa6\x52\x97\x9c\x50\xa8\x15\x38\xcc\x24\x85\x0d\xe8\x9b\xd0\xc4\x44\x6d\x10\xd6\xd0\x3c\x3d\x59\x41\x92\xbf\xa6\x9b\x45\x43\x55\x2f\x50\x80\x09\x4f\x40\x7d\x8d\xed\xaa\x2a\xf4\x99\x22\x57\xa5\x85\x42\xa8\xde\xf4\x46\x25\x57\xc3\x3b\xf2\xfc\xa4\xfa\x73\xf4\xbf\xef\xfe\x77\xf2\xfe\xd1\xe4\x98\xff\x19\x4e\xc8\x75\xe4\x83\x39\xea\x29\xe8\x52\xb8\x85\x32\xdf\x10\x3b\x97\xae\xb2\xa6\x07\xc4\xda\xb9\xcf\x29\x67\xcc\xff\x02\x13\x40\x09\x7c\x22\x33\x88\x53\x48\x0e\x7e\x00\x53\xc2\x09\x70\xd1\xbe\x86\x28\xc4\xf0\x31\x43\x0b\xcb\xed\x91\x50\xfd\x60\xc7\x0e\x23\x33\x23\xb1\xf3\xd6\xdd\x33\x0e\xd8\x92\x0f\xe3\x2c\xc4\xd7\x64\x35\xa3\xe0\x03\x46\x14\x49\xc6\x85\xa6\xe9\x2d\x5b\x8a\xc6\xe5\xac\x18\xc9\xa4\xf0\xbb\x35\x31\x46\x15\x45\xc3\xb2\x31\x72\x8b\xb4\x09\x9f\xce\x17\xbd\x7a\x36\xc7\x17\xb9\xc0\x03\x5a\x14\x01\x56\x9c\x99\xa1\xed\x25\x1f\xc6\x9b\x88\xce\xd2\x5f\xf8\x79\xb1\x4d\x77\x08\x68\x49\x70\x66\x3b\x6f\x7b\xc5\xc1\x52\x7c\x8c\x62\x4f\x85\xd1\x22\x81\x8d\x28\x77\x06\x21\xa2\xba\xa8\x0b\x57\xbd\x3d\x42\x68\x21\x4e\x1b\xcb\x49\x50\x0c\x2c\xe1\x91\xdd\xd8\x05\x18\xd9\xcb\x3c\xa4\xaa\xc6\xfd\xa5\x27\x7b\x8b\x47\xe6\xa5\x12\x23\x31\xe4\x93\xc8\x86\xec\x5a\xfa\x3d\xc8\x71\x4b\xd8\x41\x9e\x3c\x3e\xd5\x54\xac\xfe\x7a\x96\xb0\x46\x5c\x56\xf0\xfa\x82\xe5\x8c\xed\x60\x1f\x0a\x76\xb3\x1f\x8d\x3f\x34\xe4\x9b\xf5\xe0\x89\xfc\x76\x3e\x52\x12\x8d\x4c\xc4\x7c\x05\x7c\xdc\xc3\x03\x31\x14\xf3\x92\x4f\x8e\x36\x3b\x82\xe0\x23\xd8\xe0\x6b\x38\xee\xef\x58\x2a\x5e\x82\xd8\x51\x19\xb0\x2a\x48\xdb\x8c\xab\x7a\x39\xd9\xdc\x2e\xa5\x0d\xe3\x01\x8f\x19\x42\x73\x42\x72\x93\x47\x89\x4f\x9c\x89\x00\x25\x13\xb2\x5f\x9b\xa2\x63\x97\x83\xce\x8a\x9c\x53\xe3\x14\xed\x3c\xdd\xb0\x51\x05\x11\x24\xe4\x61\xe8\x90\xe9\x07\xcb\x21\x20\x79\xf7\xa1\x22\x63\xfd\xfe\xf8\x81\x74\x21\x10\x6f\x9c\x08\xf7\x0f\x59\x68\x44\x56\xc5\xbc\xe8\xa2\xac\x09\x65\xf7\x3f\xf2\xf9\x92\xbf\x1e\x2b\x15\xfe\x3a\xe4\x04\xa8\x40\x16\xc1\xc3\x72\x80\x69\x5e\x11\xc8\x52\x18\xf1\x50\x9a\x82\xca\x9d\x32\xb6\x3e\x73\x5c\x8d\x17\x31\x37\x97\x59\xcf\x5b\xf1\x86\x9f\xa2\xdd\x6d\xb0\x1f\x73\x0e\xd6\x75\x9e\xca\x28\x3b\xac\x2c\x53\x64\x9f\x21\x94\xfd\x40\xd5\x33\xaa\x8b\x21\x89\x91\xc4\x4c\x0b\x66\x0e\x58\x57\xd1\x2b\x39\x57\x84\x62\x87\x53\xd3\x58\xe4\xfe\x16\x39\x0c\x97\x28\x97\x7e\xd6\x65\x30\xe8\xff\x4e\xfe\x27\x97\xfb\xc9\xfd\xe4\x7a\x3f\x7b\x9f\xec\x88\x0e\xa3\x32\x07\xfb\xd5\xec\x63\xfa\x12\x34\xaa\x95\x77\x14\xc6\xa3\x68\xe7\x5c\x6b\x64\x68\xd4\xbd\xce\x41\xaa\x4c\xd2\x25\x65\xec\x5d\x73\xa5\x1e\x3a\x2a\xe3\x82\xa3\xd9\xa6\x52\x7c\x74\xb9\xc6\x69\x1c\x0d\xf1\x9e\x53\x31\xb6\x29\xfb\xec\x92\x4a\xc4\x6b\x81\x77\x38\xd2\x76\x8b\xe0\x51\x80\x74\x21\x57\xa4\x87\x11\xa5\x59\x81\x74\x7b\x29\x26\xae\x6b\xca\x81\x08\x40\x97\x50\x72\x78\x19\xfe\x87\x44\x29\x87\x3d\xcb\x54\x02\x34\xef\xc8\xb0\xf4\x8a\xcb\x07\xa0\x15\x17\xa7\xa1\x6e\x2c\x6e\x60\xf4\xee\x4c\xb2\x7f\xac\x01\x2f\x17\x91\xb7\xb6\x47\x5a\xc2\xa7\x1c\x78\x14\x19\x62\x1f\x41\xc6\xfb\xba\xb4\xb4\x9a\xc6\xfd\x31\xd8\x7f\x7c\x7f\x37\xe4\x3b\xca\x93\x68\x53\x77\xfe\x91\x5a\x35\xbf\x0e\xf0\xe7\x90\x37\x66\x07\xe1\x9f\x07\xc6\x8f\xd9\x07\xee\x08\xe2\x7a\x64\x8e\x6f\xf6\x3d\xea\x61\x5c\xbf\xe7\x54\xf0\x4e\xd4\x0c\xab\x46\x5e\x06\x8a\xbc\xd7\xc8\xc3\x83\xf8\x15\x08\xd5\x0f\x01\x86\xaa\x64\x1a\xcd\xc2\x42\x9e\x7b\x47\xf4\xcc\x27\x6b\x87\x89\x7b\x35\x1c\x17\xb1\x32\x5f\x90\x4f\x99\x89\x91\x4e\x83\xd9\x6f\x2c\xa2\xb3\xd6\xf6\x14\xc9\x0c\x52\x8b\x87\x27\x27\xbc\xe5\x33\x22\x37\xe9\xc7\x32\x8f\xc3\x14\x55\x28\x3e\xaf\xb3\x70\x61\xf3\x3c\x8c\x96\x2d\x89\x5a\xed\x1c\x61\x5c\x24\xe5\xe7\xd2\xb3\x43\x76\x66\xcb\xea\x09\x08\xec\x37\x9e\x68\x12\xdc\xda\x38\x9a\x08\xdb\x03\xcd\x2d\x52\x53\xe8\x5c\x8a\x0a\x1b\xe2\x55\xf9\x61\xc8\x90\x6b\x40\xc0\xd9\xf7\xca\x25\xbd\x14\x1b\x61\x9a\xf3\x0f\x45\x78\xd0\xc4\x11\xa6\x4a\x20\xdd\xf2\x23\x1d\xfc\xac\x2a\xaa\x3a\xcd\xaa\xd8\xb3\x02\x89\xfc\xf3\x68\x83\x46\x0f\x89\x30\x47\x7a\x3f\x45\x5b\x6a\x52\x8f\x3c\x0f\x55\x8b\xcf\x9c\xdd\xd9\x4b\x74\xf3\xfe\xfb\x3a\x13\x94\x26\x22\xd0\x3e\xb9\x65\x25\xb0\xb5\x42\x68\x71\xd2\x90\x97\xc9\xd1\xde\x15\x72\x6d\x1c\x0f\x72\xaf\x17\x04\x9d\x51\xd1\xb1\x5a\xc1\xf2\xfc\x7c\x91\xae\x73\x9a\x55\x0f\xfb\xf9\x69\xed\x52\x1b\x19\xd7\xa6\x26\xc6\xc7\x65\x56\x58\x3a\x9e\xca\x18\x73\xcc\xc1\xba\xf9\xcc\x40\x33\x0c\x25\xcd\xec\x19\x12\x0e\x49\xf0\xfb\x34\x3e\x90\x4c\x3a\x47\xf4\xea\xe2\x68\x6e\x4d\xb3\x50\x4e\x19\x3a\x5e\x70\x7a\x54\x84\xf2\xb5\x40\x64\x8e\xa1\x53\x69\x5b\x68\x88\x21\x22\x32\xce\xb3\xb6\xbb\x8d\x96\x98\xd8\x88\x12\xf1\xbe\x21\xe2\x54\x9b\x51\x28\xf9\x83\x6a\x80\xa9\x11\xaf\x80\x39\x42\x54\xaf\xb3\xbb\xf7\xa3\x5d\xaf\xc2\x5d\xf5\x53\x58\xd6\x1b\x22\xaf\xb4\xcd\x94\x96\x9f\xee\x09\x6e\x8f\xd5\x9c\x4e\x93\xd3\x7c\x2d\x18\x35\xc7\x88\xa4\xdd\x69\x46\xfd\xfe\xa0\x5e\x6c\xdd\x33\x7e\x0e\xe0\x29\xab\xc7\x69\x14\x9d\x9b\x1b\xed\x03\xec\x53\xde\xdb\x8d\xac\x67\x36\xb8\xbc\x01\xc5\x40\xf4\xe7\xea\x00\x4e\xb9\x1f\x5d\x60\xe3\x40\x18\xa7\x9e\xfc\x2c\x39\x2f\x77\x06\x75\xb0\x6e\x9d\x80\x5e\xf5\xba\x7c\x6a\x4b\x04\x57\x63\xcb\x62\x70\x03\x8f\x05\x6f\x1c\x13\xcf\x6c\x51\x6d\x35\x09\x62\x7e\xe9\xcf\x67\x27\xbf\xf5\xcf\x67\xbf\xbc\xd0\x3f\x7a\xbf\xf5\x13\x65\x7b\x23\x07\xbf\xb8\xed\x67\x07\x7e\x3a\x3c\x00\x10\xc9\xb6\x7b\x8e\x90\x83\xe8\xe8\x2b\x48\xc7\x11\x7e\x62\xbe\x53\x23\x74\x57\x98\xab\xd9\x87\x21\x1e\x73\x72\x4e\x20\xfa\xf3\x27\xff\x7c\x76\xe0\xa7\xc3\x03\x04\x22\x61\x4d\x73\xbc\x67\x86\x87\x11\x8e\xdc\x4f\x4e\x33\xb8\xc7\x4e\x37\xfc\x8b\x71\xa4\x0e\xe1\x71\x0f\x1a\x85\x43\xf4\xcf\x1e\xfd\xee\x93\xf5\x5f\x0d\x91\x12\xa4\x55\x82\x88\x4f\x30\x34\x07\x70\xf4\xff\x00\x91\xcb\x6e\x3f\x30\xcf\x35\xe7\x8f\xd6\x94\xb2\x72\x99\x03\x14\x7d\x5d\xb7\x82\x78\x6d\x09\x61\x2d\x71\xaf\xfb\xca\x1b\xa3\x59\x4d\x90\x4a\xd1\x3c\x99\xd6\x27\x5f\xba\xa6\x14\x57\xa3\x7c\x1a\x65\xcb\x59\xa5\x56\x5b\xd1\x0d\x6c\x91\x7c\x0f\x8b\x26\x13\x24\x87\x93\xaf\x37\x55\xdd\xb2\xf4\x73\x1c\x8e\x7b\x0e\x08\xc5\xb9\x4a\x88\x65\x0e\xd4\xef\x63\x77\x3e\xca\x96\x47\xed\xca\xc1\xad\x6f\x24\xef\xc8\xb5\xbf\x51\x7c\x7d\xc2\x39\xd4\x12\xf4\x3c\x7a\xa4\x05\x62\x2e\xd7\xb7\x8f\x1e\x61\x25\x09\x64\x14\xd8\x1f\xac\x38\x23\xda\x78\xe1\xd2\x42\xd0\x59\x05\x5a\x62\xe0\x40\x11\x62\x9e\x55\xdc\xda\x59\xc7\x5b\xb5\x72\x04\xa9\x6a\x47\xa0\x9d\x53\xec\xe4\xfc\xcc\x84\x3d\x4c\xa3\x4f\x3a\x07\x17\x07\x57\x93\x88\xd1\x3d\x95\xfc\xa4\x68\xb0\x56\x72\x74\x08\x37\xa4\x6e\xc9\x9a\xc2\x06\x36\xe9\x2e\x98\x8e\x45\x05\xda\x70\x3d\x97\x8b\x26\xa6\x9a\xcf\xbb\x5a\x15\xec\xe9\xd8\x5c\xbf\xbd\x31\x13\xec\x7c\x72\x1a\x22\x02\xfe\x91\xa0\x83\x43\x48\xbc\x24\xad\x18\x52\x58\xb3\x19\xb2\xe9\x61\x56\xba\x37\x8b\xc0\x64\x37\xd2\x95\xe1\x68\xee\x29\x52\xc5\x61\xc6\x59\x98\x71\x16\xed\x13\xcf\x38\x1b\x3c\x89\x67\xcc\xf6\x66\x1c\xd8\xe3\x73\x91\x84\x10\x09\xf8\x1a\x65\xcc\xa7\xfe\x0c\xbe\x71\x20\x94\xec\x24\x38\x60\x24\x71\x2a\xaf\xe0\x3e\x46\xe3\x0d\xe9\xcc\xce\x53\x30\xc4\x56\x5a\xa0\xbd\x79\x9f\x55\xed\x4a\x1b\x5d\x66\x76\x81\x86\xc9\xad\x65\xbf\x07\xe5\xf2\xbd\xfa\xd8\x37\xc8\x63\x70\x56\x6b\x56\xdb\xf4\x96\x33\x3b\xfe\xae\x90\xcf\x11\xf6\x0a\x73\x4a\x29\xa0\xf1\x9c\xed\x18\x97\x4c\x09\x67\x4a\xb4\xf0\x8c\xb0\xa2\x24\x19\x60\xf8\xd9\xa1\xe1\x67\x9f\x1c\xfe\xf9\xaf\xac\xfe\x2b\x4b\xdc\x7f\x26\x75\x8a\x01\x7a\xbe\x4f\x13\x09\xb8\x92\xbd\xd5\x5d\xfe\xaa\xbf\x56\xe2\x51\x4d\x9c\xdd\x48\xc6\x56\x3c\x09\x1f\x62\xfb\xa0\x7f\x2c\x1b\x9c\x1d\xde\xe0\xec\xd7\x36\x10\x97\xd1\x33\xc5\x6c\x17\x73\x59\x22\x45\x13\x5e\x21\x48\x2a\x27\x12\xbc\x88\xf6\x9b\xb2\x8e\xef\x8b\xf0\x50\x21\xfc\x3c\xe9\x07\xae\x9a\x40\xfe\x4d\x70\x44\x45\x9b\xa0\x04\xd2\xfd\x58\x79\x1f\x14\x55\x10\xae\xed\x2b\x84\x1a\xbe\xcd\x24\xdc\xbd\x49\xa0\x33\x42\x37\xab\xe4\x8c\xc0\x99\x7b\x8a\x5c\xae\x29\xb8\x94\x10\x6b\xe2\xbd\xac\x30\x9f\x44\xb4\xb3\x24\x54\xf9\x4a\xcf\xbd\xda\x8b\xf8\x96\x9b\xdc\x4a\x7a\x98\x39\xde\x27\x01\x42\xfe\x23\x64\x6e\xc3\x58\x66\x05\xce\x87\x0b\x4c\x5c\x8d\x71\x59\xa2\x4c\xeb\x32\x9a\x5c\x0e\x4b\x46\xd5\xa6\xb8\x26\xa3\xa9\x81\x1a\x6d\x10\x69\xe4\xeb\x86\xe0\x56\x71\x14\x15\x19\x18\x12\x49\xa6\xf2\x3d\x25\x57\x4a\xf9\xd5\xd3\x84\x8d\xa5\x70\xdf\xcf\xdb\x57\x51\x7b\x86\xb7\x6b\x92\x11\x90\xf3\x36\x2e\x3d\xe8\x7a\xbd\x39\x8b\xaf\xc5\x33\x4e\xdc\xe1\x76\x98\xef\xff\xd5\x78\x84\x93\x8d\xdc\x55\x40\xeb\x8a\x24\xfc\x89\x97\xfe\x32\x91\x0e\x91\x71\xcf\x09\x75\x84\x96\x1c\x25\x8c\x79\xc8\x81\x66\xa6\xc9\xd1\x09\xc1\x48\xa8\xed\x5d\x8e\x22\x11\xf4\x94\xc2\x15\x4e\x9a\x2e\xe9\x60\x1a\x45\x70\x67\x58\xc4\x3e\xcd\x6d\xbe\x41\x69\x88\x8c\xa8\x2f\x6a\x34\xe4\x4c\x6d\xd8\x25\x90\xd6\x42\xc6\x0f\x6b\xc0\x96\x03\x35\xa2\x70\x53\x55\xa5\x34\x6f\xea\x6a\x6c\x65\x53\xdf\xca\x11\x2f\xb7\x4d\x73\xdc\xa3\x91\xeb\x4f\x3e\xd3\xa6\x45\x04\x36\xce\xa1\xcf\x41\x7d\x8b\x3e\xd9\xbc\x15\xaf\x4a\xa9\xf1\xc5\x17\xd1\x50\x97\x16\x01\xe9\xd7\x0e\x39\xad\x8c\x8c\xab\x4f\xbd\x47\xa7\x0e\xb8\xd9\xab\x16\xfb\x50\xd9\x2f\xc6\x69\xda\x84\x0b\x10\x95\x9a\x17\xc7\x0b\x41\xbf\x21\x23\xbf\xaf\xdd\xf4\x19\x77\xb0\xf5\xaa\x23\x87\xaa\xb7\xd1\xa1\xf4\x3a\x5d\x74\x2e\xd6\xbb\x4b\x4e\xe2\xbb\x34\xba\x5f\x43\x2f\x67\x7e\xf2\x50\x41\xf0\x48\xa6\xf8\x2c\x5c\x2f\x67\xbf\x30\x2e\xd3\xf8\x14\x39\x17\xed\x21\xa0\xbd\xdc\x01\x61\xe0\xf8\xd1\xd0\x15\x27\xaa\x32\xf6\x9f\x8e\x1e\x4d\xf0\x6e\xde\xdc\xe9\x2b\x56\x8b\x8c\xeb\x80\x21\xdc\x3c\x38\x0d\x25\x8b\x03\x31\xbd\x6f\x40\x72\xc8\xd3\x39\xb4\x2e\x12\x80\xdf\xa2\x38\xe0\xd2\x06\xaa\x85\x94\x3d\xfc\x4d\x2c\x9c\x0f\xcc\xaa\x5a\x5d\x09\xce\x79\x05\xd4\x16\x84\xe1\x37\x05\x72\xd1\xae\xc9\x83\x81\x08\x0d\xe0\xa2\x4d\x34\xb9\x7f\x88\xad\x6e\x35\x40\x56\x9a\xc6\x47\xe1\xb9\x92\x5a\x8a\x95\x17\x32\x80\x69\xd1\x48\x9c\xde\x23\x29\xad\xa5\x8a\x06\x4e\x76\x5a\x73\x46\xb0\x5a\xdf\xd3\x39\x4d\xc5\xe0\x83\xce\xb1\x4e\x91\xda\x46\xb7\x71\x8b\x92\x36\xb3\xc5\xa2\x27\x7a\x69\xd3\x74\x6b\x77\x31\x2a\x88\x9e\xe0\x0b\x44\x96\x42\x14\x6e\x1d\x5f\xe8\x65\x2d\x76\x70\x40\xa7\x5f\xb9\x6d\x7c\x14\xf5\x89\x1d\xfd\x96\x4b\xc7\x98\x20\x3f\xef\x5d\x3c\xc6\x0b\x68\xf5\x7a\x87\x14\x57\x5a\x2f\x4f\xf5\xdf\xb3\xa3\x7b\x97\x91\xa3\x8b\xc2\xbb\x13\x7a\x2a\x15\x22\x69\x3d\x7a\x7f\xe8\xea\x2d\x36\x7e\xf2\x4f\x5e\x4d\x65\x88\xc3\xfa\xf1\xad\xd2\xfe\xfd\xd1\xfb\xf7\x0f\x6f\x7a\xdd\xca\xdc\xcb\xe6\x7d\x5b\x4e\xf4\x24\x6e\xed\x04\x97\xc1\x9a\x5e\x68\xa5\x01\x5a\xe8\x52\xe2\x54\xec\x26\x25\xf7\xf4\x49\x68\xa4\x45\x18\xb0\xdf\x2f\xc2\x1b\xc5\xb7\xe7\x12\x8f\x5b\x2f\x76\xbd\xcb\x86\x89\x23\x4a\xa2\x2a\x2f\x01\xfe\x55\xb0\x41\x82\x84\xb5\x7c\xbd\xe4\x8b\xf5\xae\x97\xdc\x2f\xef\xb6\xf0\x94\xe0\xe1\x2c\x11\xae\xd7\x94\x1f\x3b\x45\xe4\x13\xcb\xb8\x00\xe7\xfb\xdb\xb5\x9b\xc8\xb5\x22\xc4\x41\x26\x27\x12\x5d\xbb\xae\xf3\xcf\x12\xd7\xb5\x1c\x7a\xa8\x39\x8c\x16\x46\xbe\xbe\xc6\xad\x24\xbe\x6b\x04\xf0\x50\x35\x30\x4f\xf9\xea\x92\x06\xcf\x78\x67\x70\xc5\xbc\x91\x08\x85\x7b\x8a\xd8\x8a\x9b\x4d\x85\x7b\xd6\x79\x74\x43\xc9\xd7\x2f\x35\xdf\x4e\x6e\xd4\x89\xea\x29\x06\x6a\x2a\x0d\x99\xda\x78\xd5\x68\x71\x94\xab\x58\x2c\xa4\x8b\x5c\xcb\x1d\x05\xba\xd2\xbc\x81\xe3\xb3\x46\x26\x8e\x79\x82\xaf\x60\xb8\xf4\x00\x6e\xf2\x9e\xc4\x7e\x93\xe6\x07\x25\x50\x5a\xe0\xae\x84\xe1\xbd\xf4\x86\x1a\x0f\xe7\x3b\x17\xe6\x6d\x29\xd7\x17\xc1\x70\x7c\x2e\x68\x0e\xc2\x31\x27\x62\xa1\xd4\x33\x3b\xeb\x96\x4b\xdc\x87\xd6\x36\x0c\xae\xf5\x90\x77\xc8\xc0\x25\xe6\xf2\xb9\xef\x94\xe3\x96\x89\xfd\xa0\xcb\xb1\x80\xeb\x2e\x57\x65\x28\x7e\x89\x77\xe3\x78\x19\xae\x0f\xc7\x79\x46\x71\x57\xd8\x52\xf1\xe5\xda\x9b\xb0\x96\xbb\x75\x63\x3f\x52\xf8\x66\x33\xe3\xaf\x89\x74\xec\x86\xa7\x8d\x6f\xcd\xe8\xb5\x51\xe6\xb8\x76\xc0\x3d\xf5\xec\x6d\x7a\xfa\x54\x60\xc7\x43\x84\xa9\xf3\x56\xdb\x4f\xbb\x96\x30\xeb\x27\xc0\x8b\x8a\x66\x70\xc7\x54\xf2\x2d\x1a\x2f\xd0\x17\xec\x1a\x16\x93\x87\x4d\xf0\xb7\x64\x85\x91\x5e\x08\x60\x0f\x8e\xa2\xd4\xe3\x1f\xf5\x32\x27\x32\x2b\xb7\x92\xd0\x10\xc1\xc8\x1b\xbd\xdf\x26\x13\xf5\xe4\x0f\x5d\xc9\xdc\x85\x07\xcc\xb4\x2f\x6d\x9b\x22\xac\x1d\x0c\x5e\xb8\x0e\xb9\x5e\x9d\x48\x1a\x27\xf4\x22\x1d\x6b\xf4\x9a\xfb\xce\xb9\x85\xe9\x63\x6b\xcb\x8c\x9b\xb9\xc8\x41\x20\x93\x14\xf5\x56\xa1\x13\x66\xc1\xcd\xa9\xbc\xbc\xe3\x70\x77\x01\x95\x17\x11\x5d\x40\x52\xba\xc0\x02\xe6\x24\x33\x27\x6b\x44\x3a\x35\x2e\x08\x4a\x68\x39\x1e\x8f\x13\x69\xd6\xde\x99\x8f\x3c\xaa\xc8\x67\x35\x69\x1a\x11\x11\x37\x78\x2c\x47\xc4\xa5\x42\xe6\x6b\xed\x4f\xf3\x7d\xb7\xd8\xba\x40\x19\xc9\x0d\xf0\x01\xd9\xb8\xb7\x0c\x1c\x13\x9d\x8b\x1f\xe3\x40\x9d\xe3\xfe\x1d\xf2\x03\xbd\x19\xd2\x76\xc6\xa3\x56\xf6\xa3\xb1\x25\x8c\x67\x66\xde\x7c\xf3\xf4\xe4\xec\x8b\xdf\xc5\x0b\x3c\x94\xfe\x44\xae\x0c\x49\x28\x08\x24\x72\x6b\x24\xbf\x5a\x13\xa9\x24\x10\x04\x72\x9c\x67\x2c\x55\x04\x8c\x77\x75\x7a\xe1\x76\x32\x3b\xad\x16\xad\xd8\xbb\xd5\x3a\x72\x9a\x39\x51\x25\xad\xcd\x17\xda\x02\x7a\x4b\xd3\x83\x3a\x20\xb8\x7f\xa2\xd0\xff\xac\xe7\x8a\x1a\xa2\xf7\x8f\x23\x7e\x38\x5c\xbb\x04\x9a\x63\x32\x57\xd9\xc6\x4d\x2b\xc9\x7f\xc4\x2b\x13\x53\xea\x92\x24\xb4\xba\x14\x3d\x13\x1f\xd0\x97\x2b\x23\xb9\x62\x04\x09\xb3\xbe\xa6\x93\x92\x36\x13\x1d\x6b\x7e\x80\x74\x91\x66\xfd\xca\x27\x2d\x0f\xce\x77\x5d\x9b\x10\xc6\x16\x45\x56\x72\xec\xe4\xb6\x6b\xc1\x77\xe6\xec\x2d\xd0\x4c\xec\x51\xc8\x18\x0e\x20\xd2\xbc\xe0\x1c\x50\x55\x15\x2e\x26\x52\x61\x6e\xd1\x1e\x07\x28\xaa\xc5\xa2\x91\xdb\xbd\xda\xd4\xfe\xe6\xbf\xbe\xa7\x11\x23\xf3\xcd\xf3\x17\x5f\x30\x4d\x7e\xca\x37\x32\x8b\xef\x5d\x58\x8e\x7d\x78\xaa\xde\x6f\xe5\x77\xbe\x6f\x25\x4a\x26\x12\xa2\x67\xda\x67\x46\x5b\x30\x44\xc9\xf5\x8b\x37\x53\xdc\x6f\x45\x1b\xdf\xf3\xab\x97\xd3\x1f\x5e\x5f\xde\x5c\xbc\x91\x2b\x8f\xb8\x8e\xc3\x56\xb7\x7f\x03\x3a\xf1\x9d\x27\xd5\xc6\x96\xda\x8c\xef\x8e\xa8\xd9\x15\xc4\x60\xe9\x32\xa8\xbb\x4d\x95\x11\x51\x59\x85\xe3\xe3\x36\xb7\x7c\x10\xed\x1d\x39\xc0\xc5\x4e\x7b\xf7\x94\x4c\xb8\xf5\xfa\x10\x69\x83\x82\x55\x2c\x2e\xa1\xec\xf0\x3d\x0a\x58\x68\x69\x99\x2c\xba\x06\x5e\x65\x51\xa1\x2f\x32\x5a\x7b\x81\x3a\x92\x17\x13\xf8\xb3\x34\x91\x2d\x0b\xb9\x07\x2e\x85\x42\x30\xf1\xad\x88\x62\xa7\x8a\x1c\x77\x9b\xef\xdc\x77\x11\xfc\x85\xe1\x1e\x7b\xa8\x06\xe3\x44\x1c\x70\x29\x36\x6b\x14\xf5\x2f\xb2\xf0\xb0\xc9\xe4\xdb\xc3\x8d\xbb\x96\x9e\xc9\x2b\xb9\x8d\xc6\x38\x71\xec\xa4\x86\xa6\x8f\x00\x76\x26\x94\xbe\x72\x81\xcd\xc8\x86\xbf\x70\xff\x98\x8b\xc6\xee\xfa\xf1\xc8\xb7\xc6\xa1\x15\x48\xae\x63\x72\x81\x77\x96\x03\x81\x6f\x1c\x57\x1c\xba\x4e\x2d\x97\x60\xaf\x7f\x4c\x82\x93\xc6\x7b\x74\x65\x58\xff\x3c\xc6\x05\xf1\x0d\x47\xeb\xcf\x73\x8a\xa6\xee\xaa\xa2\x5b\x6b\xeb\xae\xfb\x6c\x03\xb7\xa2\x05\x32\x09\xdc\x2e\xc8\xf5\xa1\xa8\x18\x35\x8e\x77\xf2\xd6\xe5\x44\x09\x04\x0e\x27\x7a\xd7\xfb\xf1\xff\x7d\xba\xef\x8b\xed\x61\xbc\x2e\x50\x70\xec\xad\x45\xd2\x37\x47\x65\x54\x5d\x59\x59\xca\xc1\x0b\xe5\x18\xc7\xb0\x95\xeb\x69\x0c\xd4\x57\xef\x09\xad\x17\xa8\x15\xc3\x2b\x6a\x9d\xde\xbd\xd7\x26\x75\xa8\xd1\x9e\x6b\x0f\x8a\x70\x10\x80\x8d\xd2\x8d\x17\x25\x16\x22\xa7\x83\x84\x81\x6d\x59\x75\x4b\xe9\x44\xcb\xf9\x32\x3f\x37\x74\xc5\x91\x98\xf6\x21\x38\xe3\x2d\xe6\x26\xe0\x46\xb3\x23\x28\xb5\x32\xe1\x1c\x07\x47\x3c\x99\x4b\xdd\x95\x6b\x2a\x59\xf8\xaa\x45\x0f\x9d\x58\xf5\x4a\x1e\xa0\x00\xb4\x51\x8c\xb1\x59\x67\x11\xee\x21\x9a\xfc\xcb\xbc\x59\x59\x89\x12\xcb\x4a\x4a\x39\x79\xd8\x54\x1c\x13\xd1\x9c\xb4\xf0\xc5\x01\xbf\x80\x75\xc9\x01\x15\xe8\x12\x49\x3e\xbd\x3b\x3a\xc8\x12\xda\xf7\xed\x37\x94\x0e\x3a\x72\x08\x9d\x79\x90\xdb\xf4\x83\xc1\x85\x86\x2a\xa9\xb2\xaa\xde\xd2\xef\x25\xe5\xb8\x43\x99\x48\x34\xf2\x77\x79\xab\x4c\xa0\x1f\x79\xef\xb3\x77\x7e\x7c\x87\xc9\x35\x79\x89\x46\x50\x2f\x52\xbb\xf9\x22\x59\xc4\xe1\x36\x70\xd8\xdb\xae\x2e\xf9\xdb\x4d\xec\xbf\xc4\x4e\xed\x46\x6a\x74\xa4\x1b\xdb\x51\xf8\x92\x00\x1e\xb9\x4f\x07\x30\x80\x08\x50\x36\x5c\xe6\xb9\xe2\xbb\x48\x3a\x0c\xd6\x1e\xb0\xd2\xb0\x4f\x7c\xa5\x60\x1c\x7f\x78\x2a\x91\x4b\x45\x23\xf3\x79\x54\xe1\xf3\x22\xee\xad\x5d\xd2\x5b\x60\xef\xf3\x54\xe8\x09\x2e\xe0\x7b\x73\x2c\xc1\x23\x65\x05\x7e\xac\x57\xcb\x9b\x38\x73\xab\xbb\x33\xbf\x28\xda\x74\x4d\xd0\xe6\xf4\x71\x5c\x7e\xd1\xb1\x3e\xef\xe7\x3a\x7e\x68\x94\xee\xaf\xb2\x1f\xfa\xcf\x18\x6a\x1f\x01\x10\x3b\xd6\x59\x98\x77\x76\x68\x9e\xee\xa5\x2c\x3a\x78\x83\x04\x08\x7e\xe9\xea\xc0\x82\x5b\xdc\x1f\x94\xe3\x71\xd4\xe2\x8c\x7b\x8f\x15\x6a\xfb\x41\xb5\x84\x0f\x19\xe5\x5a\x58\x1f\x83\xf7\x3f\xe1\xe5\x02\xc9\x91\xa7\xa4\xfb\x62\x93\xa3\xba\xbb\x8d\x1f\x73\x9d\x4b\x88\x6b\x92\x18\x7b\xce\xdd\x95\xfc\x70\xb3\x2d\xb3\x8a\x12\x47\x56\xd4\x2b\x71\xd9\xd3\xa4\x10\x11\x32\x40\xee\xa8\xbd\x3e\xd4\x7b\xbb\xf5\x8e\xed\x58\x75\x66\x25\xb2\x03\xb7\x46\x49\x29\x85\x7a\xcc\x1f\xaa\x90\xcf\xd2\x51\xe4\x0a\x15\x7a\x02\x3f\x8f\xb5\xaa\x94\x17\xa2\xab\x6d\x92\x5f\xf7\x7c\x3c\x43\xb2\x52\xe9\xaf\xce\xec\x76\xb5\xf3\xe9\x06\x9c\x96\xcd\x97\xf0\xbb\x8a\xba\xaa\x2d\xf9\x64\x1d\x49\x3c\xec\x7d\xd3\x6d\x50\x67\x6e\xfc\xe5\xe8\x19\x33\x67\xbe\xce\xd5\xa5\x96\xcb\x92\xf8\xe8\x1d\x37\x64\x4a\xb6\x02\x39\x81\x19\x71\xff\xbb\xd7\xfc\x95\x3d\x8e\xdd\xa0\x99\xf9\x13\x28\x79\xb9\xe0\x1d\xb8\x0a\x50\xfa\xd5\xea\xc6\x7f\x6d\x8f\x2f\x81\x4e\xb9\x3d\xb6\xd9\x91\x95\x5e\xf3\x37\xf6\x1e\x70\xa2\xe1\x24\x8c\x1f\x4a\xe6\x2b\xfa\xe6\xcc\x5f\xdc\x37\x67\x06\x83\x1f\x5c\xbe\xa6\x97\xc6\x89\x6f\xcf\xf0\x37\x71\x7a\x96\xf0\xf2\xb9\x38\x2c\x2e\x82\xbd\xcb\xd3\xc3\x9f\xb4\x91\x18\xe9\xdf\xae\x9f\x3e\xfb\x66\x7a\xf5\xf6\xe6\xfa\xed\xcd\xf4\xd9\xd5\xcb\x97\x97\x37\xd3\xcb\xe7\x49\x68\xee\xbb\x6f\x6b\x83\x6f\xae\xf9\x3e\x5a\xc7\x5f\x99\x0b\x2f\xfd\xa7\x0f\xb8\x14\x9a\x4b\xc4\xe8\xa2\x7d\x97\x44\x80\xc2\xac\x61\x5c\x49\xfe\xb8\x24\x7d\x00\x52\xb3\x8d\x0e\xcc\x30\x64\x74\xca\x73\xce\x36\x70\x14\xd3\xbb\xca\xb1\x57\x66\xdf\xab\xb2\x8f\xf6\x4a\xe0\xfe\xba\x78\xb5\xe9\x90\x1e\xc9\xa4\xe8\xe9\x11\xf3\xe2\xea\x2a\xc2\x4a\xff\xdd\x57\x4f\x5f\xc7\xef\x98\x90\x2f\x28\x8e\x5c\x9d\xcc\xeb\x6a\x9b\xf9\x0f\x2b\xa1\xb9\x19\xd6\x5e\xad\x1d\xb9\x14\xcc\x0f\x90\x52\xe4\x0c\xee\x8d\x97\x82\xbc\xef\x77\x10\xd7\x20\xe4\x87\xf9\xa3\x1b\xf8\x62\x0f\xe9\xb7\x3a\xc5\xb7\x36\xe4\x1b\x1e\xec\x8b\xcc\xf9\xca\x0c\x86\x88\x15\x24\xdf\x6e\x5e\x55\x75\x86\x8a\x15\xfc\x7c\xda\x8d\x9b\xba\x61\x6f\x9c\x33\xc3\xa3\x59\x2a\xd0\x4f\x41\x2b\xad\xaa\x96\x08\xd2\xb2\xd7\x83\x72\x1f\xd4\x19\x5f\xf4\x66\x9e\x87\xb9\xc9\xec\xb2\x46\xe4\xce\x37\x19\x06\x37\xbd\xb6\x0d\x08\xb5\x68\x89\x70\x22\x18\x68\x3e\x15\x16\xf2\x57\xa4\xb5\x38\x18\x75\x81\xf4\x16\xe2\x00\x37\xdd\xb1\x6b\x6c\x1b\x57\x2c\x22\x9f\x28\x93\x52\x57\xf4\x81\x31\xe7\x09\x8f\x4d\xfc\xcc\x7d\xd5\x06\xbe\x50\x51\xed\xf8\x73\x16\x39\x97\x12\x78\x15\xfe\xf0\xd1\x9c\x7c\x70\x7f\xa9\x9d\x1e\x36\x21\xc9\xe3\x2f\x3d\x88\x0b\xc9\x26\x7d\x14\xa0\xc1\x95\xcb\x54\x52\xe2\x8c\xff\xb9\xfc\xee\x5a\x7a\x85\xaf\xdc\x47\xac\x52\xff\x75\x4f\x46\x37\x2a\x04\x0d\x37\xdc\x35\x6c\x70\xfb\xdd\xdb\xfb\xb4\x0e\xb9\x2a\xcf\x0e\xd0\x64\xfc\x35\x91\xd2\x67\x63\x15\x9b\xfc\x75\x3f\x44\x6e\xc8\x0d\x69\xe3\xe7\x3a\x6e\x65\x8f\xae\x1c\xba\x80\x55\x6e\x6d\xed\x77\x94\xaf\xd3\x65\xef\x8b\x74\x0a\x9d\xdb\x5a\x7b\xdf\x55\x4d\xc8\x45\x80\xa7\xb8\x53\xc8\x3f\x7d\xe5\x0b\xe5\xf8\xed\x59\x74\xad\xd0\x67\x8a\xc9\xc2\x9d\xde\x7c\xc5\x15\x07\xfe\x76\x0d\x1a\x56\xc2\x30\x36\x3f\x72\xfe\x28\x5d\x0b\x4e\xd1\xca\x6b\xa4\x6e\x1a\x75\xef\xc5\xd6\x46\x33\x73\xe2\x26\xf6\x73\x99\x0d\xe4\xf3\x2d\x11\x36\x03\x2a\x8a\xcd\x2a\x25\xb4\xdb\xda\x5d\x62\x1b\xc9\xd5\x09\xcd\x2d\xba\x2f\xcf\x44\x77\x1e\x70\x54\x27\x45\x5c\xc3\x0f\xb2\x18\xd1\x3d\xc8\x97\x14\x10\xcb\x0c\x3b\xba\x15\x24\x44\x00\x0e\xeb\xb4\x69\xc3\x17\x77\x7a\x64\xff\x14\xc1\x46\x7e\x4b\xc7\x6c\xb1\x8e\xd1\x0b\xe1\xda\xf2\xcb\x0b\x12\x85\xff\x0f\x50\x23\xe0\xa1\xbe\x56\x00\x00")
 
 func docDeploymentPipeline_specMdBytes() ([]byte, error) {
 	return bindataRead(
@@ -568,7 +568,7 @@ func docDeploymentPipeline_specMd() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "doc/deployment/pipeline_spec.md", size: 13183, mode: os.FileMode(436), modTime: time.Unix(1478287306, 0)}
+	info := bindataFileInfo{name: "doc/deployment/pipeline_spec.md", size: 22206, mode: os.FileMode(436), modTime: time.Unix(1478287306, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -12,6 +12,8 @@ import (
 
 	"github.com/sjezewski/pachyderm/src/client/health"
 	"github.com/sjezewski/pachyderm/src/client/pfs"
+	"github.com/sjezewski/pachyderm/src/client/pkg/grpcutil"
+	"github.com/sjezewski/pachyderm/src/client/pkg/tracing"
 	"github.com/sjezewski/pachyderm/src/client/pps"
 
 	google_protobuf "go.pedge.io/pb/go/google/protobuf"
@@ -32,6 +34,7 @@ type APIClient struct {
 	PpsAPIClient
 	BlockAPIClient
 	addr         string
+	tlsOptions   *grpcutil.TLSOptions
 	clientConn   *grpc.ClientConn
 	healthClient health.HealthClient
 	_ctx         context.Context
@@ -39,9 +42,18 @@ type APIClient struct {
 }
 
 // NewFromAddress constructs a new APIClient for the server at addr.
+// TLS is configured from the PACH_TLS* environment variables, see
+// grpcutil.TLSOptionsFromEnv.
 func NewFromAddress(addr string) (*APIClient, error) {
+	return NewFromAddressWithTLS(addr, grpcutil.TLSOptionsFromEnv())
+}
+
+// NewFromAddressWithTLS constructs a new APIClient for the server at addr
+// which connects using tlsOptions. A nil tlsOptions connects without TLS.
+func NewFromAddressWithTLS(addr string, tlsOptions *grpcutil.TLSOptions) (*APIClient, error) {
 	c := &APIClient{
-		addr: addr,
+		addr:       addr,
+		tlsOptions: tlsOptions,
 	}
 	if err := c.connect(); err != nil {
 		return nil, err
@@ -104,12 +116,17 @@ func (c APIClient) DeleteAll() error {
 }
 
 func (c *APIClient) connect() error {
-	clientConn, err := grpc.Dial(c.addr, grpc.WithInsecure())
+	dialOption, err := c.tlsOptions.DialOption()
+	if err != nil {
+		return err
+	}
+	clientConn, err := grpc.Dial(c.addr, dialOption)
 	if err != nil {
 		return err
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
+	// RPCs are part of the process's trace, if it has one.
+	ctx, cancel := context.WithCancel(tracing.Background())
 	c.PfsAPIClient = pfs.NewAPIClient(clientConn)
 	c.PpsAPIClient = pps.NewAPIClient(clientConn)
 	c.BlockAPIClient = pfs.NewBlockAPIClient(clientConn)
@@ -120,6 +137,13 @@ func (c *APIClient) connect() error {
 	return nil
 }
 
+// WithCtx returns a copy of c which makes its calls with ctx, so that they're
+// part of its trace and are cancelled with it.
+func (c APIClient) WithCtx(ctx context.Context) *APIClient {
+	c._ctx = ctx
+	return &c
+}
+
 // TODO this method only exists because we initialize some APIClient in such a
 // way that ctx will be nil
 func (c *APIClient) ctx() context.Context {
@@ -442,6 +442,72 @@ func (c APIClient) PutFileURL(repoName string, commitID string, path string, url
 	return nil
 }
 
+// BeginUpload starts a resumable upload to a file.  The contents of the
+// file are written in parts with UploadPart, which can be retried, and then
+// appended to the file by CompleteUpload.
+// commitID must be an open commit, or a branch whose head is an open commit.
+func (c APIClient) BeginUpload(repoName string, commitID string, path string, delimiter pfs.Delimiter) (*pfs.Upload, error) {
+	upload, err := c.PfsAPIClient.BeginUpload(
+		c.ctx(),
+		&pfs.BeginUploadRequest{
+			File:      NewFile(repoName, commitID, path),
+			Delimiter: delimiter,
+		},
+	)
+	if err != nil {
+		return nil, sanitizeErr(err)
+	}
+	return upload, nil
+}
+
+// UploadPart writes the part of an upload that starts at offsetBytes from a
+// reader.  Writing a part at the same offset again replaces it, so a part
+// that failed can simply be retried.
+// If the upload's delimiter is LINE or JSON each part should end on a
+// record boundary.
+func (c APIClient) UploadPart(uploadID string, offsetBytes uint64, reader io.Reader) (_ int, retErr error) {
+	writer, err := c.newUploadPartWriteCloser(uploadID, offsetBytes)
+	if err != nil {
+		return 0, sanitizeErr(err)
+	}
+	defer func() {
+		if err := writer.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	written, err := io.Copy(writer, reader)
+	return int(written), err
+}
+
+// InspectUpload returns info about an upload, including the parts of it
+// that have been written.
+func (c APIClient) InspectUpload(uploadID string) (*pfs.UploadInfo, error) {
+	uploadInfo, err := c.PfsAPIClient.InspectUpload(
+		c.ctx(),
+		&pfs.InspectUploadRequest{
+			Upload: &pfs.Upload{ID: uploadID},
+		},
+	)
+	if err != nil {
+		return nil, sanitizeErr(err)
+	}
+	return uploadInfo, nil
+}
+
+// CompleteUpload appends the parts of an upload to its file.  sizeBytes is
+// the total size of the upload, an error is returned if the parts that have
+// been written don't cover exactly that many bytes.
+func (c APIClient) CompleteUpload(uploadID string, sizeBytes uint64) error {
+	_, err := c.PfsAPIClient.CompleteUpload(
+		c.ctx(),
+		&pfs.CompleteUploadRequest{
+			Upload:    &pfs.Upload{ID: uploadID},
+			SizeBytes: sizeBytes,
+		},
+	)
+	return sanitizeErr(err)
+}
+
 // GetFile returns the contents of a file at a specific Commit.
 // offset specifies a number of bytes that should be skipped in the beginning of the file.
 // size limits the total amount of data returned, note you will get fewer bytes
@@ -628,6 +694,38 @@ func (c APIClient) ReplayCommit(repo string, fromCommits []string, to string) ([
 	return commits.Commit, nil
 }
 
+// PullCommit returns a finished commit and the changes it made, so that it
+// can be pushed to another cluster with PushCommit.
+// NOTE: this is lower level function that's used internally and might not be
+// useful to users.
+func (c APIClient) PullCommit(repoName string, commitID string) (*pfs.CommitReplica, error) {
+	replica, err := c.PfsAPIClient.PullCommit(
+		c.ctx(),
+		&pfs.PullCommitRequest{
+			Commit: NewCommit(repoName, commitID),
+		},
+	)
+	if err != nil {
+		return nil, sanitizeErr(err)
+	}
+	return replica, nil
+}
+
+// PushCommit recreates a commit returned by PullCommit, which may be from
+// another cluster.  The commit's parent, its provenance and the blocks it
+// refers to must already be in this cluster.
+// NOTE: this is lower level function that's used internally and might not be
+// useful to users.
+func (c APIClient) PushCommit(replica *pfs.CommitReplica) error {
+	_, err := c.PfsAPIClient.PushCommit(
+		c.ctx(),
+		&pfs.PushCommitRequest{
+			Replica: replica,
+		},
+	)
+	return sanitizeErr(err)
+}
+
 // ArchiveAll archives all commits in all repos.
 func (c APIClient) ArchiveAll() error {
 	_, err := c.PfsAPIClient.ArchiveAll(
@@ -682,6 +780,51 @@ func (w *putFileWriteCloser) Close() error {
 	return sanitizeErr(err)
 }
 
+type uploadPartWriteCloser struct {
+	request          *pfs.UploadPartRequest
+	uploadPartClient pfs.API_UploadPartClient
+	sent             bool
+}
+
+func (c APIClient) newUploadPartWriteCloser(uploadID string, offsetBytes uint64) (*uploadPartWriteCloser, error) {
+	uploadPartClient, err := c.PfsAPIClient.UploadPart(c.ctx())
+	if err != nil {
+		return nil, err
+	}
+	return &uploadPartWriteCloser{
+		request: &pfs.UploadPartRequest{
+			Upload:      &pfs.Upload{ID: uploadID},
+			OffsetBytes: offsetBytes,
+		},
+		uploadPartClient: uploadPartClient,
+	}, nil
+}
+
+func (w *uploadPartWriteCloser) Write(p []byte) (int, error) {
+	w.request.Value = p
+	if err := w.uploadPartClient.Send(w.request); err != nil {
+		return 0, sanitizeErr(err)
+	}
+	w.sent = true
+	w.request.Value = nil
+	// Upload and OffsetBytes are only needed on the first request
+	w.request.Upload = nil
+	w.request.OffsetBytes = 0
+	return len(p), nil
+}
+
+func (w *uploadPartWriteCloser) Close() error {
+	// we always send at least one request, otherwise the server doesn't know
+	// which upload the part belongs to
+	if !w.sent {
+		if err := w.uploadPartClient.Send(w.request); err != nil {
+			return err
+		}
+	}
+	_, err := w.uploadPartClient.CloseAndRecv()
+	return sanitizeErr(err)
+}
+
 type putBlockWriteCloser struct {
 	request        *pfs.PutBlockRequest
 	putBlockClient pfs.BlockAPI_PutBlockClient
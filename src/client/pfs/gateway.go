@@ -0,0 +1,44 @@
+package pfs
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	google_protobuf "go.pedge.io/pb/go/google/protobuf"
+	"golang.org/x/net/context"
+)
+
+func init() {
+	// Files are served over the gateway as their raw contents rather than as
+	// a stream of JSON encoded BytesValues.
+	forward_API_GetFile_0 = forwardGetFile
+	forward_API_GetFile_1 = forwardGetFile
+}
+
+func forwardGetFile(ctx context.Context, marshaler runtime.Marshaler, w http.ResponseWriter, req *http.Request, recv func() (proto.Message, error), opts ...func(context.Context, http.ResponseWriter, proto.Message) error) {
+	wroteHeader := false
+	for {
+		resp, err := recv()
+		if err == io.EOF {
+			if !wroteHeader {
+				w.Header().Set("Content-Type", "application/octet-stream")
+			}
+			return
+		}
+		if err != nil {
+			if !wroteHeader {
+				runtime.HTTPError(ctx, marshaler, w, req, err)
+			}
+			return
+		}
+		if !wroteHeader {
+			w.Header().Set("Content-Type", "application/octet-stream")
+			wroteHeader = true
+		}
+		if _, err := w.Write(resp.(*google_protobuf.BytesValue).Value); err != nil {
+			return
+		}
+	}
+}
@@ -27,6 +27,7 @@ It has these top-level messages:
 	Append
 	BlockInfo
 	BlockInfos
+	FileHash
 	Shard
 	CreateRepoRequest
 	InspectRepoRequest
@@ -49,6 +50,17 @@ It has these top-level messages:
 	DeleteFileRequest
 	SquashCommitRequest
 	ReplayCommitRequest
+	FileDiff
+	CommitReplica
+	PullCommitRequest
+	PushCommitRequest
+	Upload
+	BeginUploadRequest
+	UploadPartRequest
+	UploadPart
+	UploadInfo
+	InspectUploadRequest
+	CompleteUploadRequest
 	PutBlockRequest
 	GetBlockRequest
 	DeleteBlockRequest
@@ -130,6 +142,36 @@ func (x FileType) String() string {
 }
 func (FileType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
 
+type FileHashMethod int32
+
+const (
+	FileHashMethod_TOP_LEVEL    FileHashMethod = 0
+	FileHashMethod_PATH         FileHashMethod = 1
+	FileHashMethod_DIRECTORY    FileHashMethod = 2
+	FileHashMethod_PREFIX_RANGE FileHashMethod = 3
+	FileHashMethod_KEY          FileHashMethod = 4
+)
+
+var FileHashMethod_name = map[int32]string{
+	0: "TOP_LEVEL",
+	1: "PATH",
+	2: "DIRECTORY",
+	3: "PREFIX_RANGE",
+	4: "KEY",
+}
+var FileHashMethod_value = map[string]int32{
+	"TOP_LEVEL":    0,
+	"PATH":         1,
+	"DIRECTORY":    2,
+	"PREFIX_RANGE": 3,
+	"KEY":          4,
+}
+
+func (x FileHashMethod) String() string {
+	return proto.EnumName(FileHashMethod_name, int32(x))
+}
+func (FileHashMethod) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
 type CommitStatus int32
 
 const (
@@ -155,7 +197,7 @@ var CommitStatus_value = map[string]int32{
 func (x CommitStatus) String() string {
 	return proto.EnumName(CommitStatus_name, int32(x))
 }
-func (CommitStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (CommitStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
 type Delimiter int32
 
@@ -179,7 +221,7 @@ var Delimiter_value = map[string]int32{
 func (x Delimiter) String() string {
 	return proto.EnumName(Delimiter_name, int32(x))
 }
-func (Delimiter) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (Delimiter) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 type ListFileMode int32
 
@@ -203,7 +245,7 @@ var ListFileMode_value = map[string]int32{
 func (x ListFileMode) String() string {
 	return proto.EnumName(ListFileMode_name, int32(x))
 }
-func (ListFileMode) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (ListFileMode) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 type Repo struct {
 	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
@@ -407,6 +449,8 @@ type FileInfo struct {
 	Modified       *google_protobuf2.Timestamp `protobuf:"bytes,4,opt,name=modified" json:"modified,omitempty"`
 	CommitModified *Commit                     `protobuf:"bytes,5,opt,name=commit_modified,json=commitModified" json:"commit_modified,omitempty"`
 	Children       []*File                     `protobuf:"bytes,6,rep,name=children" json:"children,omitempty"`
+	// delimiter is the delimiter the file was last written with.
+	Delimiter Delimiter `protobuf:"varint,7,opt,name=delimiter,enum=pfs.Delimiter" json:"delimiter,omitempty"`
 }
 
 func (m *FileInfo) Reset()                    { *m = FileInfo{} }
@@ -583,17 +627,43 @@ func (m *BlockInfos) GetBlockInfo() []*BlockInfo {
 	return nil
 }
 
+// FileHash specifies how files are assigned to file shards.
+type FileHash struct {
+	Method FileHashMethod `protobuf:"varint,1,opt,name=method,enum=pfs.FileHashMethod" json:"method,omitempty"`
+	// key is a regular expression used by the KEY method, the key of a file
+	// is the first submatch of key in the file's path, or the whole match if
+	// key has no subexpressions. Files that don't match are hashed by path.
+	Key string `protobuf:"bytes,2,opt,name=key" json:"key,omitempty"`
+}
+
+func (m *FileHash) Reset()                    { *m = FileHash{} }
+func (m *FileHash) String() string            { return proto.CompactTextString(m) }
+func (*FileHash) ProtoMessage()               {}
+func (*FileHash) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
 type Shard struct {
-	FileNumber   uint64 `protobuf:"varint,1,opt,name=file_number,json=fileNumber" json:"file_number,omitempty"`
-	FileModulus  uint64 `protobuf:"varint,2,opt,name=file_modulus,json=fileModulus" json:"file_modulus,omitempty"`
-	BlockNumber  uint64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber" json:"block_number,omitempty"`
-	BlockModulus uint64 `protobuf:"varint,4,opt,name=block_modulus,json=blockModulus" json:"block_modulus,omitempty"`
+	FileNumber   uint64    `protobuf:"varint,1,opt,name=file_number,json=fileNumber" json:"file_number,omitempty"`
+	FileModulus  uint64    `protobuf:"varint,2,opt,name=file_modulus,json=fileModulus" json:"file_modulus,omitempty"`
+	BlockNumber  uint64    `protobuf:"varint,3,opt,name=block_number,json=blockNumber" json:"block_number,omitempty"`
+	BlockModulus uint64    `protobuf:"varint,4,opt,name=block_modulus,json=blockModulus" json:"block_modulus,omitempty"`
+	FileHash     *FileHash `protobuf:"bytes,5,opt,name=file_hash,json=fileHash" json:"file_hash,omitempty"`
+	// glob, if set, is a pattern which defines the datums of the shard, the
+	// paths which match it are hashed as units, by file_hash if it's set, and
+	// files which aren't in one of them are in no shard.
+	Glob string `protobuf:"bytes,6,opt,name=glob" json:"glob,omitempty"`
 }
 
 func (m *Shard) Reset()                    { *m = Shard{} }
 func (m *Shard) String() string            { return proto.CompactTextString(m) }
 func (*Shard) ProtoMessage()               {}
-func (*Shard) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*Shard) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *Shard) GetFileHash() *FileHash {
+	if m != nil {
+		return m.FileHash
+	}
+	return nil
+}
 
 type CreateRepoRequest struct {
 	Repo       *Repo   `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
@@ -603,7 +673,7 @@ type CreateRepoRequest struct {
 func (m *CreateRepoRequest) Reset()                    { *m = CreateRepoRequest{} }
 func (m *CreateRepoRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateRepoRequest) ProtoMessage()               {}
-func (*CreateRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*CreateRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *CreateRepoRequest) GetRepo() *Repo {
 	if m != nil {
@@ -626,7 +696,7 @@ type InspectRepoRequest struct {
 func (m *InspectRepoRequest) Reset()                    { *m = InspectRepoRequest{} }
 func (m *InspectRepoRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectRepoRequest) ProtoMessage()               {}
-func (*InspectRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*InspectRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *InspectRepoRequest) GetRepo() *Repo {
 	if m != nil {
@@ -642,7 +712,7 @@ type ListRepoRequest struct {
 func (m *ListRepoRequest) Reset()                    { *m = ListRepoRequest{} }
 func (m *ListRepoRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListRepoRequest) ProtoMessage()               {}
-func (*ListRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*ListRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *ListRepoRequest) GetProvenance() []*Repo {
 	if m != nil {
@@ -659,7 +729,7 @@ type DeleteRepoRequest struct {
 func (m *DeleteRepoRequest) Reset()                    { *m = DeleteRepoRequest{} }
 func (m *DeleteRepoRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteRepoRequest) ProtoMessage()               {}
-func (*DeleteRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*DeleteRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *DeleteRepoRequest) GetRepo() *Repo {
 	if m != nil {
@@ -676,7 +746,7 @@ type StartCommitRequest struct {
 func (m *StartCommitRequest) Reset()                    { *m = StartCommitRequest{} }
 func (m *StartCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*StartCommitRequest) ProtoMessage()               {}
-func (*StartCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*StartCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *StartCommitRequest) GetParent() *Commit {
 	if m != nil {
@@ -701,7 +771,7 @@ type ForkCommitRequest struct {
 func (m *ForkCommitRequest) Reset()                    { *m = ForkCommitRequest{} }
 func (m *ForkCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*ForkCommitRequest) ProtoMessage()               {}
-func (*ForkCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*ForkCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *ForkCommitRequest) GetParent() *Commit {
 	if m != nil {
@@ -725,7 +795,7 @@ type FinishCommitRequest struct {
 func (m *FinishCommitRequest) Reset()                    { *m = FinishCommitRequest{} }
 func (m *FinishCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*FinishCommitRequest) ProtoMessage()               {}
-func (*FinishCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*FinishCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *FinishCommitRequest) GetCommit() *Commit {
 	if m != nil {
@@ -741,7 +811,7 @@ type ArchiveCommitRequest struct {
 func (m *ArchiveCommitRequest) Reset()                    { *m = ArchiveCommitRequest{} }
 func (m *ArchiveCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*ArchiveCommitRequest) ProtoMessage()               {}
-func (*ArchiveCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*ArchiveCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *ArchiveCommitRequest) GetCommits() []*Commit {
 	if m != nil {
@@ -757,7 +827,7 @@ type InspectCommitRequest struct {
 func (m *InspectCommitRequest) Reset()                    { *m = InspectCommitRequest{} }
 func (m *InspectCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectCommitRequest) ProtoMessage()               {}
-func (*InspectCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*InspectCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func (m *InspectCommitRequest) GetCommit() *Commit {
 	if m != nil {
@@ -777,7 +847,7 @@ type ListCommitRequest struct {
 func (m *ListCommitRequest) Reset()                    { *m = ListCommitRequest{} }
 func (m *ListCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListCommitRequest) ProtoMessage()               {}
-func (*ListCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*ListCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
 func (m *ListCommitRequest) GetFromCommits() []*Commit {
 	if m != nil {
@@ -801,7 +871,7 @@ type ListBranchRequest struct {
 func (m *ListBranchRequest) Reset()                    { *m = ListBranchRequest{} }
 func (m *ListBranchRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListBranchRequest) ProtoMessage()               {}
-func (*ListBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*ListBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
 func (m *ListBranchRequest) GetRepo() *Repo {
 	if m != nil {
@@ -817,7 +887,7 @@ type DeleteCommitRequest struct {
 func (m *DeleteCommitRequest) Reset()                    { *m = DeleteCommitRequest{} }
 func (m *DeleteCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteCommitRequest) ProtoMessage()               {}
-func (*DeleteCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*DeleteCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 func (m *DeleteCommitRequest) GetCommit() *Commit {
 	if m != nil {
@@ -834,7 +904,7 @@ type FlushCommitRequest struct {
 func (m *FlushCommitRequest) Reset()                    { *m = FlushCommitRequest{} }
 func (m *FlushCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*FlushCommitRequest) ProtoMessage()               {}
-func (*FlushCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*FlushCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
 
 func (m *FlushCommitRequest) GetCommit() []*Commit {
 	if m != nil {
@@ -864,7 +934,7 @@ type DiffMethod struct {
 func (m *DiffMethod) Reset()                    { *m = DiffMethod{} }
 func (m *DiffMethod) String() string            { return proto.CompactTextString(m) }
 func (*DiffMethod) ProtoMessage()               {}
-func (*DiffMethod) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*DiffMethod) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
 
 func (m *DiffMethod) GetFromCommit() *Commit {
 	if m != nil {
@@ -884,7 +954,7 @@ type GetFileRequest struct {
 func (m *GetFileRequest) Reset()                    { *m = GetFileRequest{} }
 func (m *GetFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetFileRequest) ProtoMessage()               {}
-func (*GetFileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (*GetFileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
 
 func (m *GetFileRequest) GetFile() *File {
 	if m != nil {
@@ -918,7 +988,7 @@ type PutFileRequest struct {
 func (m *PutFileRequest) Reset()                    { *m = PutFileRequest{} }
 func (m *PutFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*PutFileRequest) ProtoMessage()               {}
-func (*PutFileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (*PutFileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
 func (m *PutFileRequest) GetFile() *File {
 	if m != nil {
@@ -936,7 +1006,7 @@ type InspectFileRequest struct {
 func (m *InspectFileRequest) Reset()                    { *m = InspectFileRequest{} }
 func (m *InspectFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectFileRequest) ProtoMessage()               {}
-func (*InspectFileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (*InspectFileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
 
 func (m *InspectFileRequest) GetFile() *File {
 	if m != nil {
@@ -969,7 +1039,7 @@ type ListFileRequest struct {
 func (m *ListFileRequest) Reset()                    { *m = ListFileRequest{} }
 func (m *ListFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListFileRequest) ProtoMessage()               {}
-func (*ListFileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+func (*ListFileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
 
 func (m *ListFileRequest) GetFile() *File {
 	if m != nil {
@@ -999,7 +1069,7 @@ type DeleteFileRequest struct {
 func (m *DeleteFileRequest) Reset()                    { *m = DeleteFileRequest{} }
 func (m *DeleteFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteFileRequest) ProtoMessage()               {}
-func (*DeleteFileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (*DeleteFileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
 func (m *DeleteFileRequest) GetFile() *File {
 	if m != nil {
@@ -1016,7 +1086,7 @@ type SquashCommitRequest struct {
 func (m *SquashCommitRequest) Reset()                    { *m = SquashCommitRequest{} }
 func (m *SquashCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*SquashCommitRequest) ProtoMessage()               {}
-func (*SquashCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+func (*SquashCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
 
 func (m *SquashCommitRequest) GetFromCommits() []*Commit {
 	if m != nil {
@@ -1040,7 +1110,7 @@ type ReplayCommitRequest struct {
 func (m *ReplayCommitRequest) Reset()                    { *m = ReplayCommitRequest{} }
 func (m *ReplayCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*ReplayCommitRequest) ProtoMessage()               {}
-func (*ReplayCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+func (*ReplayCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
 
 func (m *ReplayCommitRequest) GetFromCommits() []*Commit {
 	if m != nil {
@@ -1049,6 +1119,233 @@ func (m *ReplayCommitRequest) GetFromCommits() []*Commit {
 	return nil
 }
 
+// FileDiff is the change a commit made to a file.
+type FileDiff struct {
+	Path      string                      `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+	FileType  FileType                    `protobuf:"varint,2,opt,name=file_type,json=fileType,enum=pfs.FileType" json:"file_type,omitempty"`
+	BlockRefs []*BlockRef                 `protobuf:"bytes,3,rep,name=block_refs,json=blockRefs" json:"block_refs,omitempty"`
+	Delete    bool                        `protobuf:"varint,4,opt,name=delete" json:"delete,omitempty"`
+	Modified  *google_protobuf2.Timestamp `protobuf:"bytes,5,opt,name=modified" json:"modified,omitempty"`
+	Delimiter Delimiter                   `protobuf:"varint,6,opt,name=delimiter,enum=pfs.Delimiter" json:"delimiter,omitempty"`
+}
+
+func (m *FileDiff) Reset()                    { *m = FileDiff{} }
+func (m *FileDiff) String() string            { return proto.CompactTextString(m) }
+func (*FileDiff) ProtoMessage()               {}
+func (*FileDiff) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *FileDiff) GetBlockRefs() []*BlockRef {
+	if m != nil {
+		return m.BlockRefs
+	}
+	return nil
+}
+
+func (m *FileDiff) GetModified() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.Modified
+	}
+	return nil
+}
+
+// CommitReplica is everything needed to recreate a finished commit in
+// another cluster, except for the blocks its FileDiffs refer to.
+type CommitReplica struct {
+	CommitInfo *CommitInfo `protobuf:"bytes,1,opt,name=commit_info,json=commitInfo" json:"commit_info,omitempty"`
+	FileDiffs  []*FileDiff `protobuf:"bytes,2,rep,name=file_diffs,json=fileDiffs" json:"file_diffs,omitempty"`
+}
+
+func (m *CommitReplica) Reset()                    { *m = CommitReplica{} }
+func (m *CommitReplica) String() string            { return proto.CompactTextString(m) }
+func (*CommitReplica) ProtoMessage()               {}
+func (*CommitReplica) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *CommitReplica) GetCommitInfo() *CommitInfo {
+	if m != nil {
+		return m.CommitInfo
+	}
+	return nil
+}
+
+func (m *CommitReplica) GetFileDiffs() []*FileDiff {
+	if m != nil {
+		return m.FileDiffs
+	}
+	return nil
+}
+
+type PullCommitRequest struct {
+	Commit *Commit `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
+}
+
+func (m *PullCommitRequest) Reset()                    { *m = PullCommitRequest{} }
+func (m *PullCommitRequest) String() string            { return proto.CompactTextString(m) }
+func (*PullCommitRequest) ProtoMessage()               {}
+func (*PullCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *PullCommitRequest) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+type PushCommitRequest struct {
+	Replica *CommitReplica `protobuf:"bytes,1,opt,name=replica" json:"replica,omitempty"`
+}
+
+func (m *PushCommitRequest) Reset()                    { *m = PushCommitRequest{} }
+func (m *PushCommitRequest) String() string            { return proto.CompactTextString(m) }
+func (*PushCommitRequest) ProtoMessage()               {}
+func (*PushCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *PushCommitRequest) GetReplica() *CommitReplica {
+	if m != nil {
+		return m.Replica
+	}
+	return nil
+}
+
+// Upload identifies a resumable upload session.
+type Upload struct {
+	ID string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *Upload) Reset()                    { *m = Upload{} }
+func (m *Upload) String() string            { return proto.CompactTextString(m) }
+func (*Upload) ProtoMessage()               {}
+func (*Upload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+
+type BeginUploadRequest struct {
+	File      *File     `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	Delimiter Delimiter `protobuf:"varint,2,opt,name=delimiter,enum=pfs.Delimiter" json:"delimiter,omitempty"`
+}
+
+func (m *BeginUploadRequest) Reset()                    { *m = BeginUploadRequest{} }
+func (m *BeginUploadRequest) String() string            { return proto.CompactTextString(m) }
+func (*BeginUploadRequest) ProtoMessage()               {}
+func (*BeginUploadRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+func (m *BeginUploadRequest) GetFile() *File {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
+// UploadPartRequest carries part of an upload, only the first request in a
+// stream needs to set upload and offset_bytes.
+type UploadPartRequest struct {
+	Upload      *Upload `protobuf:"bytes,1,opt,name=upload" json:"upload,omitempty"`
+	OffsetBytes uint64  `protobuf:"varint,2,opt,name=offset_bytes,json=offsetBytes" json:"offset_bytes,omitempty"`
+	Value       []byte  `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *UploadPartRequest) Reset()                    { *m = UploadPartRequest{} }
+func (m *UploadPartRequest) String() string            { return proto.CompactTextString(m) }
+func (*UploadPartRequest) ProtoMessage()               {}
+func (*UploadPartRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+
+func (m *UploadPartRequest) GetUpload() *Upload {
+	if m != nil {
+		return m.Upload
+	}
+	return nil
+}
+
+// UploadPart is a part of an upload which has been written to block storage.
+type UploadPart struct {
+	OffsetBytes uint64      `protobuf:"varint,1,opt,name=offset_bytes,json=offsetBytes" json:"offset_bytes,omitempty"`
+	SizeBytes   uint64      `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes" json:"size_bytes,omitempty"`
+	BlockRefs   []*BlockRef `protobuf:"bytes,3,rep,name=block_refs,json=blockRefs" json:"block_refs,omitempty"`
+}
+
+func (m *UploadPart) Reset()                    { *m = UploadPart{} }
+func (m *UploadPart) String() string            { return proto.CompactTextString(m) }
+func (*UploadPart) ProtoMessage()               {}
+func (*UploadPart) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+
+func (m *UploadPart) GetBlockRefs() []*BlockRef {
+	if m != nil {
+		return m.BlockRefs
+	}
+	return nil
+}
+
+type UploadInfo struct {
+	Upload    *Upload                     `protobuf:"bytes,1,opt,name=upload" json:"upload,omitempty"`
+	File      *File                       `protobuf:"bytes,2,opt,name=file" json:"file,omitempty"`
+	Delimiter Delimiter                   `protobuf:"varint,3,opt,name=delimiter,enum=pfs.Delimiter" json:"delimiter,omitempty"`
+	Parts     []*UploadPart               `protobuf:"bytes,4,rep,name=parts" json:"parts,omitempty"`
+	Started   *google_protobuf2.Timestamp `protobuf:"bytes,5,opt,name=started" json:"started,omitempty"`
+}
+
+func (m *UploadInfo) Reset()                    { *m = UploadInfo{} }
+func (m *UploadInfo) String() string            { return proto.CompactTextString(m) }
+func (*UploadInfo) ProtoMessage()               {}
+func (*UploadInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+
+func (m *UploadInfo) GetUpload() *Upload {
+	if m != nil {
+		return m.Upload
+	}
+	return nil
+}
+
+func (m *UploadInfo) GetFile() *File {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
+func (m *UploadInfo) GetParts() []*UploadPart {
+	if m != nil {
+		return m.Parts
+	}
+	return nil
+}
+
+func (m *UploadInfo) GetStarted() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.Started
+	}
+	return nil
+}
+
+type InspectUploadRequest struct {
+	Upload *Upload `protobuf:"bytes,1,opt,name=upload" json:"upload,omitempty"`
+}
+
+func (m *InspectUploadRequest) Reset()                    { *m = InspectUploadRequest{} }
+func (m *InspectUploadRequest) String() string            { return proto.CompactTextString(m) }
+func (*InspectUploadRequest) ProtoMessage()               {}
+func (*InspectUploadRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+func (m *InspectUploadRequest) GetUpload() *Upload {
+	if m != nil {
+		return m.Upload
+	}
+	return nil
+}
+
+type CompleteUploadRequest struct {
+	Upload    *Upload `protobuf:"bytes,1,opt,name=upload" json:"upload,omitempty"`
+	SizeBytes uint64  `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes" json:"size_bytes,omitempty"`
+}
+
+func (m *CompleteUploadRequest) Reset()                    { *m = CompleteUploadRequest{} }
+func (m *CompleteUploadRequest) String() string            { return proto.CompactTextString(m) }
+func (*CompleteUploadRequest) ProtoMessage()               {}
+func (*CompleteUploadRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+
+func (m *CompleteUploadRequest) GetUpload() *Upload {
+	if m != nil {
+		return m.Upload
+	}
+	return nil
+}
+
 type PutBlockRequest struct {
 	Value     []byte    `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	Delimiter Delimiter `protobuf:"varint,2,opt,name=delimiter,enum=pfs.Delimiter" json:"delimiter,omitempty"`
@@ -1057,7 +1354,7 @@ type PutBlockRequest struct {
 func (m *PutBlockRequest) Reset()                    { *m = PutBlockRequest{} }
 func (m *PutBlockRequest) String() string            { return proto.CompactTextString(m) }
 func (*PutBlockRequest) ProtoMessage()               {}
-func (*PutBlockRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+func (*PutBlockRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
 
 type GetBlockRequest struct {
 	Block       *Block `protobuf:"bytes,1,opt,name=block" json:"block,omitempty"`
@@ -1068,7 +1365,7 @@ type GetBlockRequest struct {
 func (m *GetBlockRequest) Reset()                    { *m = GetBlockRequest{} }
 func (m *GetBlockRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetBlockRequest) ProtoMessage()               {}
-func (*GetBlockRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+func (*GetBlockRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
 
 func (m *GetBlockRequest) GetBlock() *Block {
 	if m != nil {
@@ -1084,7 +1381,7 @@ type DeleteBlockRequest struct {
 func (m *DeleteBlockRequest) Reset()                    { *m = DeleteBlockRequest{} }
 func (m *DeleteBlockRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteBlockRequest) ProtoMessage()               {}
-func (*DeleteBlockRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+func (*DeleteBlockRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
 
 func (m *DeleteBlockRequest) GetBlock() *Block {
 	if m != nil {
@@ -1100,7 +1397,7 @@ type InspectBlockRequest struct {
 func (m *InspectBlockRequest) Reset()                    { *m = InspectBlockRequest{} }
 func (m *InspectBlockRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectBlockRequest) ProtoMessage()               {}
-func (*InspectBlockRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+func (*InspectBlockRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
 
 func (m *InspectBlockRequest) GetBlock() *Block {
 	if m != nil {
@@ -1115,7 +1412,7 @@ type ListBlockRequest struct {
 func (m *ListBlockRequest) Reset()                    { *m = ListBlockRequest{} }
 func (m *ListBlockRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListBlockRequest) ProtoMessage()               {}
-func (*ListBlockRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+func (*ListBlockRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
 
 func init() {
 	proto.RegisterType((*Repo)(nil), "pfs.Repo")
@@ -1136,6 +1433,7 @@ func init() {
 	proto.RegisterType((*Append)(nil), "pfs.Append")
 	proto.RegisterType((*BlockInfo)(nil), "pfs.BlockInfo")
 	proto.RegisterType((*BlockInfos)(nil), "pfs.BlockInfos")
+	proto.RegisterType((*FileHash)(nil), "pfs.FileHash")
 	proto.RegisterType((*Shard)(nil), "pfs.Shard")
 	proto.RegisterType((*CreateRepoRequest)(nil), "pfs.CreateRepoRequest")
 	proto.RegisterType((*InspectRepoRequest)(nil), "pfs.InspectRepoRequest")
@@ -1158,6 +1456,17 @@ func init() {
 	proto.RegisterType((*DeleteFileRequest)(nil), "pfs.DeleteFileRequest")
 	proto.RegisterType((*SquashCommitRequest)(nil), "pfs.SquashCommitRequest")
 	proto.RegisterType((*ReplayCommitRequest)(nil), "pfs.ReplayCommitRequest")
+	proto.RegisterType((*FileDiff)(nil), "pfs.FileDiff")
+	proto.RegisterType((*CommitReplica)(nil), "pfs.CommitReplica")
+	proto.RegisterType((*PullCommitRequest)(nil), "pfs.PullCommitRequest")
+	proto.RegisterType((*PushCommitRequest)(nil), "pfs.PushCommitRequest")
+	proto.RegisterType((*Upload)(nil), "pfs.Upload")
+	proto.RegisterType((*BeginUploadRequest)(nil), "pfs.BeginUploadRequest")
+	proto.RegisterType((*UploadPartRequest)(nil), "pfs.UploadPartRequest")
+	proto.RegisterType((*UploadPart)(nil), "pfs.UploadPart")
+	proto.RegisterType((*UploadInfo)(nil), "pfs.UploadInfo")
+	proto.RegisterType((*InspectUploadRequest)(nil), "pfs.InspectUploadRequest")
+	proto.RegisterType((*CompleteUploadRequest)(nil), "pfs.CompleteUploadRequest")
 	proto.RegisterType((*PutBlockRequest)(nil), "pfs.PutBlockRequest")
 	proto.RegisterType((*GetBlockRequest)(nil), "pfs.GetBlockRequest")
 	proto.RegisterType((*DeleteBlockRequest)(nil), "pfs.DeleteBlockRequest")
@@ -1165,6 +1474,7 @@ func init() {
 	proto.RegisterType((*ListBlockRequest)(nil), "pfs.ListBlockRequest")
 	proto.RegisterEnum("pfs.CommitType", CommitType_name, CommitType_value)
 	proto.RegisterEnum("pfs.FileType", FileType_name, FileType_value)
+	proto.RegisterEnum("pfs.FileHashMethod", FileHashMethod_name, FileHashMethod_value)
 	proto.RegisterEnum("pfs.CommitStatus", CommitStatus_name, CommitStatus_value)
 	proto.RegisterEnum("pfs.Delimiter", Delimiter_name, Delimiter_value)
 	proto.RegisterEnum("pfs.ListFileMode", ListFileMode_name, ListFileMode_value)
@@ -1214,9 +1524,27 @@ type APIClient interface {
 	SquashCommit(ctx context.Context, in *SquashCommitRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 	// Replay returns the head of the commit of the merge
 	ReplayCommit(ctx context.Context, in *ReplayCommitRequest, opts ...grpc.CallOption) (*Commits, error)
+	// PullCommit returns a finished commit and the changes it made, so that
+	// it can be pushed to another cluster.
+	PullCommit(ctx context.Context, in *PullCommitRequest, opts ...grpc.CallOption) (*CommitReplica, error)
+	// PushCommit recreates a commit pulled from another cluster, with the same
+	// ID, branch, clock and provenance.  The blocks the commit refers to must
+	// already be in this cluster.
+	PushCommit(ctx context.Context, in *PushCommitRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 	// File rpcs
 	// PutFile writes the specified file to pfs.
 	PutFile(ctx context.Context, opts ...grpc.CallOption) (API_PutFileClient, error)
+	// BeginUpload starts a resumable upload to a file in an open commit.
+	// The upload is deleted when the commit finishes.
+	BeginUpload(ctx context.Context, in *BeginUploadRequest, opts ...grpc.CallOption) (*Upload, error)
+	// UploadPart writes the part of an upload that starts at offset_bytes.
+	// Uploading a part at the same offset again replaces it.
+	UploadPart(ctx context.Context, opts ...grpc.CallOption) (API_UploadPartClient, error)
+	// InspectUpload returns the parts of an upload that have been written.
+	InspectUpload(ctx context.Context, in *InspectUploadRequest, opts ...grpc.CallOption) (*UploadInfo, error)
+	// CompleteUpload appends the parts of an upload to its file, the parts
+	// must cover exactly size_bytes with no gaps.
+	CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 	// GetFile returns a byte stream of the contents of the file.
 	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error)
 	// InspectFile returns info about a file.
@@ -1374,6 +1702,24 @@ func (c *aPIClient) ReplayCommit(ctx context.Context, in *ReplayCommitRequest, o
 	return out, nil
 }
 
+func (c *aPIClient) PullCommit(ctx context.Context, in *PullCommitRequest, opts ...grpc.CallOption) (*CommitReplica, error) {
+	out := new(CommitReplica)
+	err := grpc.Invoke(ctx, "/pfs.API/PullCommit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) PushCommit(ctx context.Context, in *PushCommitRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/PushCommit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) PutFile(ctx context.Context, opts ...grpc.CallOption) (API_PutFileClient, error) {
 	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[0], c.cc, "/pfs.API/PutFile", opts...)
 	if err != nil {
@@ -1408,8 +1754,69 @@ func (x *aPIPutFileClient) CloseAndRecv() (*google_protobuf1.Empty, error) {
 	return m, nil
 }
 
+func (c *aPIClient) BeginUpload(ctx context.Context, in *BeginUploadRequest, opts ...grpc.CallOption) (*Upload, error) {
+	out := new(Upload)
+	err := grpc.Invoke(ctx, "/pfs.API/BeginUpload", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UploadPart(ctx context.Context, opts ...grpc.CallOption) (API_UploadPartClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[1], c.cc, "/pfs.API/UploadPart", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIUploadPartClient{stream}
+	return x, nil
+}
+
+type API_UploadPartClient interface {
+	Send(*UploadPartRequest) error
+	CloseAndRecv() (*google_protobuf1.Empty, error)
+	grpc.ClientStream
+}
+
+type aPIUploadPartClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIUploadPartClient) Send(m *UploadPartRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *aPIUploadPartClient) CloseAndRecv() (*google_protobuf1.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(google_protobuf1.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) InspectUpload(ctx context.Context, in *InspectUploadRequest, opts ...grpc.CallOption) (*UploadInfo, error) {
+	out := new(UploadInfo)
+	err := grpc.Invoke(ctx, "/pfs.API/InspectUpload", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/CompleteUpload", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[1], c.cc, "/pfs.API/GetFile", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[2], c.cc, "/pfs.API/GetFile", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1521,9 +1928,27 @@ type APIServer interface {
 	SquashCommit(context.Context, *SquashCommitRequest) (*google_protobuf1.Empty, error)
 	// Replay returns the head of the commit of the merge
 	ReplayCommit(context.Context, *ReplayCommitRequest) (*Commits, error)
+	// PullCommit returns a finished commit and the changes it made, so that
+	// it can be pushed to another cluster.
+	PullCommit(context.Context, *PullCommitRequest) (*CommitReplica, error)
+	// PushCommit recreates a commit pulled from another cluster, with the same
+	// ID, branch, clock and provenance.  The blocks the commit refers to must
+	// already be in this cluster.
+	PushCommit(context.Context, *PushCommitRequest) (*google_protobuf1.Empty, error)
 	// File rpcs
 	// PutFile writes the specified file to pfs.
 	PutFile(API_PutFileServer) error
+	// BeginUpload starts a resumable upload to a file in an open commit.
+	// The upload is deleted when the commit finishes.
+	BeginUpload(context.Context, *BeginUploadRequest) (*Upload, error)
+	// UploadPart writes the part of an upload that starts at offset_bytes.
+	// Uploading a part at the same offset again replaces it.
+	UploadPart(API_UploadPartServer) error
+	// InspectUpload returns the parts of an upload that have been written.
+	InspectUpload(context.Context, *InspectUploadRequest) (*UploadInfo, error)
+	// CompleteUpload appends the parts of an upload to its file, the parts
+	// must cover exactly size_bytes with no gaps.
+	CompleteUpload(context.Context, *CompleteUploadRequest) (*google_protobuf1.Empty, error)
 	// GetFile returns a byte stream of the contents of the file.
 	GetFile(*GetFileRequest, API_GetFileServer) error
 	// InspectFile returns info about a file.
@@ -1812,6 +2237,42 @@ func _API_ReplayCommit_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_PullCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).PullCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/PullCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).PullCommit(ctx, req.(*PullCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_PushCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).PushCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/PushCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).PushCommit(ctx, req.(*PushCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_PutFile_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(APIServer).PutFile(&aPIPutFileServer{stream})
 }
@@ -1838,6 +2299,86 @@ func (x *aPIPutFileServer) Recv() (*PutFileRequest, error) {
 	return m, nil
 }
 
+func _API_BeginUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).BeginUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/BeginUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).BeginUpload(ctx, req.(*BeginUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UploadPart_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).UploadPart(&aPIUploadPartServer{stream})
+}
+
+type API_UploadPartServer interface {
+	SendAndClose(*google_protobuf1.Empty) error
+	Recv() (*UploadPartRequest, error)
+	grpc.ServerStream
+}
+
+type aPIUploadPartServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIUploadPartServer) SendAndClose(m *google_protobuf1.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *aPIUploadPartServer) Recv() (*UploadPartRequest, error) {
+	m := new(UploadPartRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _API_InspectUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InspectUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/InspectUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectUpload(ctx, req.(*InspectUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CompleteUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CompleteUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/CompleteUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CompleteUpload(ctx, req.(*CompleteUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_GetFile_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetFileRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2013,6 +2554,26 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReplayCommit",
 			Handler:    _API_ReplayCommit_Handler,
 		},
+		{
+			MethodName: "PullCommit",
+			Handler:    _API_PullCommit_Handler,
+		},
+		{
+			MethodName: "PushCommit",
+			Handler:    _API_PushCommit_Handler,
+		},
+		{
+			MethodName: "BeginUpload",
+			Handler:    _API_BeginUpload_Handler,
+		},
+		{
+			MethodName: "InspectUpload",
+			Handler:    _API_InspectUpload_Handler,
+		},
+		{
+			MethodName: "CompleteUpload",
+			Handler:    _API_CompleteUpload_Handler,
+		},
 		{
 			MethodName: "InspectFile",
 			Handler:    _API_InspectFile_Handler,
@@ -2040,6 +2601,11 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			Handler:       _API_PutFile_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "UploadPart",
+			Handler:       _API_UploadPart_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "GetFile",
 			Handler:       _API_GetFile_Handler,
@@ -2310,136 +2876,191 @@ var _BlockAPI_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("client/pfs/pfs.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2095 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xc4, 0x58, 0xc9, 0x6f, 0xdb, 0xd8,
-	0x19, 0x37, 0xa9, 0x8d, 0xfa, 0xb4, 0x58, 0x7e, 0x76, 0x52, 0x55, 0xce, 0x74, 0x3c, 0x2f, 0xcd,
-	0xc0, 0x71, 0xa7, 0x76, 0xa0, 0x2c, 0x0e, 0x92, 0x66, 0x32, 0x8a, 0x2d, 0x27, 0x2a, 0x64, 0x3b,
-	0x78, 0x76, 0x66, 0xd0, 0xc3, 0x40, 0xa0, 0xa4, 0xc7, 0x88, 0x08, 0x45, 0x72, 0x48, 0x2a, 0x03,
-	0x17, 0xe8, 0xa5, 0x97, 0xa2, 0xe7, 0x02, 0xbd, 0xf7, 0x58, 0xf4, 0xd4, 0x5b, 0x6f, 0x3d, 0xf5,
-	0x2f, 0xe9, 0x3f, 0x52, 0xbc, 0x85, 0x14, 0x29, 0xca, 0x92, 0x95, 0xa2, 0xe8, 0x21, 0xf1, 0x5b,
-	0xbe, 0x7d, 0x79, 0xdf, 0x4f, 0x84, 0xad, 0x81, 0x65, 0x52, 0x3b, 0x38, 0x70, 0x0d, 0x9f, 0xfd,
-	0xdb, 0x77, 0x3d, 0x27, 0x70, 0x50, 0xc6, 0x35, 0xfc, 0xc6, 0x9d, 0xf7, 0x8e, 0xf3, 0xde, 0xa2,
-	0x07, 0xba, 0x6b, 0x1e, 0xe8, 0xb6, 0xed, 0x04, 0x7a, 0x60, 0x3a, 0xb6, 0x24, 0x69, 0x6c, 0xcb,
-	0x5b, 0xbe, 0xeb, 0x4f, 0x8c, 0x03, 0x3a, 0x76, 0x83, 0x2b, 0x79, 0xf9, 0xf9, 0xec, 0x65, 0x60,
-	0x8e, 0xa9, 0x1f, 0xe8, 0x63, 0x57, 0x12, 0xfc, 0x6c, 0x96, 0xe0, 0x47, 0x4f, 0x77, 0x5d, 0xea,
-	0x85, 0xd2, 0xef, 0x84, 0x66, 0x7d, 0x78, 0x7f, 0xe0, 0x8f, 0x74, 0x6f, 0x28, 0xfe, 0x17, 0xb7,
-	0xb8, 0x01, 0x59, 0x42, 0x5d, 0x07, 0x21, 0xc8, 0xda, 0xfa, 0x98, 0xd6, 0x95, 0x1d, 0x65, 0xb7,
-	0x48, 0xf8, 0x1a, 0x1f, 0x42, 0xfe, 0xc8, 0x19, 0x8f, 0xcd, 0x00, 0x7d, 0x06, 0x59, 0x8f, 0xba,
-	0x0e, 0xbf, 0x2d, 0x35, 0x8b, 0xfb, 0xcc, 0x3d, 0xc6, 0x46, 0xf8, 0x31, 0xaa, 0x82, 0x6a, 0x0e,
-	0xeb, 0x2a, 0x67, 0x55, 0xcd, 0x21, 0xde, 0x87, 0x82, 0x60, 0xf4, 0xd1, 0x5d, 0xc8, 0x0f, 0xf8,
-	0xb2, 0xae, 0xec, 0x64, 0x76, 0x4b, 0xcd, 0x12, 0xe7, 0x15, 0xb7, 0x44, 0x5e, 0xe1, 0x2f, 0x41,
-	0x7b, 0xe5, 0xe9, 0xf6, 0x60, 0x44, 0x7d, 0xd4, 0x00, 0xad, 0x2f, 0xd7, 0x9c, 0xa5, 0x48, 0xa2,
-	0x3d, 0x7e, 0x09, 0xd9, 0x13, 0xd3, 0xa2, 0x09, 0xa1, 0xca, 0x35, 0x42, 0x99, 0x47, 0xae, 0x1e,
-	0x8c, 0xa4, 0x59, 0x7c, 0x8d, 0xb7, 0x21, 0xf7, 0xca, 0x72, 0x06, 0x1f, 0xd8, 0xe5, 0x48, 0xf7,
-	0x47, 0xa1, 0xbb, 0x6c, 0x8d, 0xff, 0xaa, 0x80, 0xc6, 0x9c, 0xea, 0xd8, 0x86, 0xb3, 0xcc, 0xe3,
-	0x47, 0x50, 0x18, 0x78, 0x54, 0x0f, 0xa8, 0x70, 0xbb, 0xd4, 0x6c, 0xec, 0x8b, 0x34, 0xec, 0x87,
-	0x69, 0xd8, 0xbf, 0x0c, 0xf3, 0x44, 0x42, 0x52, 0xf4, 0x19, 0x80, 0x6f, 0xfe, 0x96, 0xf6, 0xfa,
-	0x57, 0x01, 0xf5, 0xeb, 0x99, 0x1d, 0x65, 0x37, 0x4b, 0x8a, 0xec, 0xe4, 0x15, 0x3b, 0x40, 0xf7,
-	0x01, 0x5c, 0xcf, 0xf9, 0x48, 0x6d, 0xdd, 0x1e, 0xd0, 0x7a, 0x96, 0xc7, 0x2b, 0xa6, 0x39, 0x76,
-	0x89, 0x0f, 0xa1, 0x18, 0x9a, 0xea, 0xa3, 0x3d, 0x28, 0x32, 0xa3, 0x7a, 0xa6, 0x6d, 0x38, 0x32,
-	0xcc, 0x95, 0x88, 0x8d, 0x91, 0x10, 0xcd, 0x93, 0x2b, 0xfc, 0x97, 0x0c, 0x80, 0x08, 0x14, 0x77,
-	0xf3, 0x46, 0x91, 0xbc, 0x0d, 0x79, 0x91, 0x02, 0x19, 0x4b, 0xb9, 0x43, 0x0f, 0xa0, 0x24, 0x28,
-	0x7a, 0xc1, 0x95, 0x4b, 0xb9, 0x3f, 0xd5, 0xe6, 0x7a, 0x4c, 0xc2, 0xe5, 0x95, 0x4b, 0x09, 0x0c,
-	0xa2, 0x35, 0x7a, 0x00, 0x15, 0x57, 0xf7, 0xa8, 0x1d, 0xf4, 0xa4, 0xd6, 0x6c, 0x5a, 0x6b, 0x59,
-	0x50, 0xc8, 0xca, 0x7b, 0x04, 0x05, 0x3f, 0xd0, 0x3d, 0x16, 0xe8, 0xdc, 0xf2, 0x40, 0x4b, 0x52,
-	0xf4, 0x04, 0x34, 0xc3, 0xb4, 0x4d, 0x7f, 0x44, 0x87, 0xf5, 0xfc, 0x52, 0xb6, 0x88, 0x76, 0x26,
-	0x41, 0x85, 0xd9, 0x04, 0xdd, 0x81, 0xe2, 0x80, 0x85, 0xdf, 0xb2, 0xe8, 0xb0, 0xae, 0xed, 0x28,
-	0xbb, 0x1a, 0x99, 0x1e, 0xb0, 0xca, 0xd5, 0xbd, 0xc1, 0xc8, 0xfc, 0x48, 0x87, 0xf5, 0x22, 0xbf,
-	0x8c, 0xf6, 0xe8, 0x17, 0x89, 0xd4, 0x42, 0xba, 0x15, 0xe2, 0xc9, 0x7d, 0x09, 0xa5, 0x69, 0x8a,
-	0xfc, 0x58, 0x98, 0x63, 0x09, 0x8e, 0x87, 0x99, 0xa7, 0x58, 0x86, 0x99, 0x27, 0xf9, 0x8f, 0x2a,
-	0x68, 0xac, 0x51, 0xc2, 0x4a, 0x36, 0x4c, 0x8b, 0x26, 0x2a, 0x99, 0x5d, 0x12, 0x7e, 0xcc, 0x8a,
-	0x87, 0xfd, 0x15, 0x29, 0x54, 0x79, 0x0a, 0x2b, 0x11, 0x0d, 0x4f, 0xa0, 0x66, 0xc8, 0xd5, 0xb2,
-	0xfa, 0x7d, 0x02, 0xda, 0xd8, 0x19, 0x9a, 0x86, 0x49, 0x87, 0x32, 0xb1, 0x0b, 0xa3, 0x1e, 0xd2,
-	0xa2, 0x47, 0xb0, 0x2e, 0x1d, 0x8c, 0xd8, 0x73, 0xe9, 0xba, 0xa8, 0x0a, 0x9a, 0xd3, 0x90, 0xeb,
-	0x1e, 0x68, 0x83, 0x91, 0x69, 0x0d, 0x3d, 0x6a, 0xd7, 0xf3, 0xb1, 0x5e, 0xe1, 0xbe, 0x45, 0x57,
-	0xac, 0x53, 0xc2, 0x50, 0xf8, 0x91, 0xb3, 0xa9, 0x4e, 0x09, 0x49, 0x84, 0xb3, 0x3c, 0x88, 0x87,
-	0x50, 0x64, 0x6e, 0x11, 0xdd, 0x7e, 0x4f, 0xd1, 0x16, 0xe4, 0x2c, 0xe7, 0x47, 0xea, 0xf1, 0x28,
-	0x66, 0x89, 0xd8, 0xb0, 0xd3, 0x09, 0x7b, 0x6a, 0x79, 0xdc, 0xb2, 0x44, 0x6c, 0x30, 0x01, 0x8d,
-	0x3f, 0x32, 0x84, 0x1a, 0x68, 0x07, 0x72, 0x7d, 0xb6, 0x96, 0xd1, 0x07, 0xae, 0x4c, 0xdc, 0x8a,
-	0x0b, 0xf4, 0x73, 0xc8, 0x79, 0x4c, 0x85, 0x7c, 0x47, 0xaa, 0x82, 0x22, 0x54, 0x4c, 0xc4, 0x25,
-	0x37, 0x46, 0xca, 0xe4, 0x5e, 0x70, 0xde, 0x9e, 0x47, 0x8d, 0x84, 0x17, 0x21, 0x09, 0xd1, 0xfa,
-	0x72, 0x85, 0xff, 0xac, 0x42, 0xbe, 0xe5, 0xba, 0xd4, 0x1e, 0xa2, 0xaf, 0x00, 0x22, 0x36, 0x7f,
-	0x3e, 0x5f, 0xb1, 0x1f, 0x29, 0x79, 0x1c, 0x0b, 0xaf, 0xca, 0x69, 0x7f, 0xca, 0x69, 0x85, 0xb0,
-	0xfd, 0x23, 0x79, 0xd7, 0xb6, 0x03, 0xef, 0x6a, 0x1a, 0x6e, 0xf4, 0x25, 0x68, 0x96, 0xee, 0x07,
-	0xdc, 0xb4, 0x4c, 0x3a, 0x89, 0x05, 0x76, 0xc9, 0x02, 0x73, 0x1b, 0xf2, 0x43, 0x6a, 0xd1, 0x80,
-	0xf2, 0x4a, 0xd1, 0x88, 0xdc, 0x25, 0xcb, 0x31, 0xb7, 0xb0, 0x1c, 0x1b, 0xcf, 0xa1, 0x92, 0x30,
-	0x03, 0xd5, 0x20, 0xf3, 0x81, 0x5e, 0xc9, 0x47, 0x9d, 0x2d, 0x59, 0x86, 0x3e, 0xea, 0xd6, 0x44,
-	0x44, 0x57, 0x23, 0x62, 0xf3, 0x4c, 0x7d, 0xaa, 0xe0, 0xdf, 0x2b, 0x32, 0xa4, 0xbc, 0x49, 0x96,
-	0xe7, 0xe9, 0x7f, 0xf1, 0xe2, 0xe3, 0xe7, 0x00, 0x91, 0x0d, 0x3e, 0xfa, 0x65, 0x98, 0xa0, 0x58,
-	0x79, 0x56, 0xa7, 0x96, 0xf0, 0xfa, 0x14, 0x19, 0xe2, 0x05, 0xfa, 0x27, 0x05, 0x72, 0x17, 0x6c,
-	0x94, 0xa3, 0xcf, 0xa1, 0xc4, 0x83, 0x66, 0x4f, 0xc6, 0xfd, 0xa8, 0x46, 0x81, 0x1d, 0x9d, 0xf1,
-	0x13, 0xf4, 0x05, 0x94, 0x39, 0xc1, 0xd8, 0x19, 0x4e, 0xac, 0x89, 0x2f, 0xeb, 0x95, 0x33, 0x9d,
-	0x8a, 0x23, 0x46, 0x22, 0x94, 0x4b, 0x21, 0xc2, 0xd6, 0x12, 0x3f, 0x93, 0x52, 0xee, 0x42, 0x45,
-	0x90, 0x84, 0x62, 0xb2, 0x9c, 0x46, 0xf0, 0x49, 0x39, 0xf8, 0x7b, 0xd8, 0x38, 0xe2, 0xce, 0xf3,
-	0x99, 0x45, 0x7f, 0x98, 0x50, 0x7f, 0x29, 0x7e, 0x48, 0x0e, 0x3e, 0x75, 0xd1, 0xe0, 0x7b, 0x08,
-	0xa8, 0x63, 0xfb, 0x2e, 0x1d, 0x04, 0x37, 0x97, 0x8f, 0x7f, 0x05, 0xeb, 0x5d, 0xd3, 0x4f, 0x70,
-	0x24, 0x55, 0x2a, 0x8b, 0x54, 0xbe, 0x81, 0x8d, 0x63, 0x5e, 0x9c, 0x2b, 0x78, 0xb4, 0x05, 0x39,
-	0xc3, 0xf1, 0x06, 0x51, 0xdd, 0xf1, 0x0d, 0x36, 0x00, 0x5d, 0xb0, 0x09, 0x25, 0x9b, 0x41, 0x8a,
-	0xba, 0x0b, 0x79, 0x31, 0xf2, 0xe6, 0xce, 0x60, 0x71, 0x35, 0x33, 0x40, 0xd4, 0xc5, 0x03, 0xe4,
-	0x77, 0xb0, 0x71, 0xe2, 0x78, 0x1f, 0x3e, 0x41, 0xcd, 0x75, 0xa3, 0x3e, 0xa9, 0x3e, 0xb3, 0x58,
-	0x3d, 0x81, 0xcd, 0x13, 0x3e, 0x51, 0x53, 0x06, 0xdc, 0x08, 0x6b, 0x88, 0x89, 0x2a, 0x23, 0x27,
-	0x77, 0xf8, 0x05, 0x6c, 0xb5, 0xc4, 0x30, 0x4d, 0x0a, 0xbd, 0x07, 0x05, 0xc1, 0xe9, 0xcf, 0x03,
-	0x98, 0xe1, 0x1d, 0x7e, 0x0e, 0x5b, 0xb2, 0x6c, 0x56, 0xb7, 0x09, 0xff, 0x5b, 0x81, 0x0d, 0x56,
-	0x3f, 0x49, 0xd6, 0x7d, 0x28, 0x1b, 0x9e, 0x33, 0xee, 0x2d, 0x50, 0x5f, 0x62, 0x04, 0x21, 0x12,
-	0x5e, 0x25, 0x83, 0x9f, 0x00, 0xad, 0xee, 0x43, 0xde, 0x0f, 0xf4, 0x40, 0x76, 0x65, 0xb5, 0xb9,
-	0x11, 0x23, 0xbe, 0xe0, 0x17, 0x44, 0x12, 0xb0, 0xe2, 0x14, 0x8f, 0x5d, 0x4e, 0x14, 0x27, 0xdf,
-	0xb0, 0xc6, 0x65, 0x4e, 0x0a, 0x20, 0x7e, 0xe3, 0xc6, 0x0d, 0x95, 0xaa, 0x4b, 0x94, 0xe2, 0x67,
-	0xb0, 0x29, 0xba, 0xe8, 0x13, 0x12, 0xf0, 0x3d, 0xa0, 0x13, 0x6b, 0xb2, 0xa8, 0x9e, 0xae, 0xfb,
-	0x69, 0x81, 0x30, 0x14, 0x02, 0xa7, 0xc7, 0x7d, 0x48, 0xbd, 0x2b, 0xf9, 0xc0, 0x61, 0x7f, 0xf1,
-	0x77, 0x00, 0xc7, 0xa6, 0x61, 0x9c, 0xd2, 0x60, 0xe4, 0xb0, 0x31, 0x59, 0x8a, 0xe5, 0x75, 0x9e,
-	0x59, 0x30, 0x4d, 0x2b, 0xda, 0x86, 0xa2, 0x31, 0xb1, 0xac, 0x1e, 0x87, 0x58, 0xa2, 0x64, 0x35,
-	0x76, 0xc0, 0xc6, 0x15, 0xfe, 0x97, 0x02, 0xd5, 0xd7, 0x34, 0xe0, 0x88, 0x64, 0x1a, 0xd0, 0x45,
-	0x68, 0xec, 0x0b, 0x28, 0x3b, 0x86, 0xe1, 0xd3, 0x40, 0x4e, 0x0c, 0x26, 0x31, 0x43, 0x4a, 0xe2,
-	0x4c, 0xa0, 0xac, 0xf4, 0x48, 0xc9, 0xc4, 0x41, 0xd8, 0x0e, 0xe4, 0xf8, 0xef, 0x3b, 0x89, 0xc0,
-	0xc4, 0x24, 0xe3, 0x63, 0x82, 0x88, 0x0b, 0x56, 0x5b, 0x43, 0xd3, 0x30, 0x7a, 0x63, 0xee, 0xaf,
-	0x84, 0x5a, 0xa2, 0xb6, 0xa6, 0x61, 0x20, 0x30, 0x8c, 0xd6, 0xf8, 0xef, 0x0a, 0x54, 0xdf, 0x4e,
-	0x56, 0xf1, 0x63, 0x15, 0x54, 0x19, 0xcd, 0x68, 0xe6, 0x4b, 0x59, 0xce, 0x68, 0xf4, 0x15, 0x14,
-	0x87, 0xd4, 0x32, 0xc7, 0x66, 0x40, 0x3d, 0x59, 0xd2, 0x62, 0x16, 0x1e, 0x87, 0xa7, 0x64, 0x4a,
-	0xc0, 0x26, 0xff, 0xc4, 0xb3, 0xb8, 0x2f, 0x45, 0xc2, 0x96, 0xf8, 0x0f, 0x4a, 0x34, 0x29, 0x56,
-	0xb0, 0x3b, 0x8a, 0x9e, 0x7a, 0xc3, 0xe8, 0x65, 0x96, 0x47, 0xef, 0x6f, 0x8a, 0x18, 0x3f, 0xff,
-	0x5f, 0x33, 0xd0, 0x3d, 0xc8, 0x8e, 0x9d, 0x21, 0x4d, 0x3c, 0x0f, 0xa1, 0x59, 0xa7, 0xce, 0x90,
-	0x12, 0x7e, 0x8d, 0x9b, 0xe1, 0xb4, 0xbb, 0xb9, 0xb9, 0xd8, 0x81, 0xcd, 0x8b, 0x1f, 0x26, 0xfa,
-	0x6c, 0x83, 0xae, 0xfa, 0x42, 0xee, 0x42, 0x31, 0x70, 0xc2, 0xbe, 0x53, 0xd3, 0x7d, 0xa7, 0x05,
-	0x8e, 0x58, 0xe1, 0x3e, 0x6c, 0x12, 0xea, 0x5a, 0xfa, 0xd5, 0x7f, 0xa7, 0x70, 0x9b, 0x2b, 0x4c,
-	0x0c, 0x3c, 0x2d, 0x70, 0xc4, 0x0b, 0x88, 0xdf, 0xc1, 0xfa, 0xdb, 0x49, 0x20, 0xa1, 0xb1, 0x90,
-	0x1f, 0x55, 0xaa, 0x72, 0x6d, 0xa5, 0xaa, 0x4b, 0x2a, 0x15, 0x4f, 0x60, 0xfd, 0x35, 0x4d, 0x8a,
-	0x5d, 0x0e, 0x3e, 0xe7, 0x3d, 0x0b, 0xd9, 0x65, 0xcf, 0x42, 0x02, 0x69, 0x3e, 0x01, 0x24, 0xd2,
-	0xba, 0x9a, 0x66, 0x7c, 0x08, 0x9b, 0xb2, 0x8b, 0x56, 0x64, 0x44, 0x50, 0xe3, 0xe3, 0x24, 0xc6,
-	0xb5, 0x77, 0x1e, 0x7e, 0x7b, 0x90, 0x7d, 0x5f, 0x3b, 0x3a, 0x3f, 0x3d, 0xed, 0x5c, 0xf6, 0x2e,
-	0x7f, 0xf3, 0xb6, 0xdd, 0x3b, 0x3b, 0x3f, 0x6b, 0xd7, 0xd6, 0x66, 0x4f, 0x49, 0xbb, 0x75, 0x5c,
-	0x53, 0xd0, 0x2d, 0xd8, 0x88, 0x9f, 0x7e, 0x47, 0x3a, 0x97, 0xed, 0x9a, 0xba, 0xf7, 0x46, 0xfc,
-	0xce, 0xe5, 0xe2, 0x10, 0x54, 0x4f, 0x3a, 0xdd, 0x76, 0x42, 0xd8, 0x2d, 0xd8, 0x98, 0x9e, 0x91,
-	0xf6, 0xeb, 0x77, 0xdd, 0x16, 0xa9, 0x29, 0x68, 0x03, 0x2a, 0xd3, 0xe3, 0xe3, 0x0e, 0xa9, 0xa9,
-	0x7b, 0xdf, 0x40, 0x39, 0x3e, 0xb6, 0x10, 0x40, 0xfe, 0xec, 0x9c, 0x9c, 0xb6, 0xba, 0xb5, 0x35,
-	0x54, 0x06, 0xad, 0x45, 0x8e, 0xde, 0x74, 0xbe, 0x6d, 0x33, 0x53, 0x2a, 0x50, 0x3c, 0x6a, 0x9d,
-	0x1d, 0xb5, 0xbb, 0xdd, 0xf6, 0x71, 0x4d, 0x45, 0x05, 0xc8, 0xb4, 0xba, 0xdd, 0x5a, 0x66, 0xef,
-	0x3e, 0x14, 0xa3, 0x84, 0x23, 0x0d, 0xb2, 0xd2, 0x04, 0x0d, 0xb2, 0xbf, 0xbe, 0x38, 0x3f, 0xab,
-	0x29, 0x6c, 0xd5, 0xed, 0x9c, 0x31, 0xb3, 0xbb, 0x50, 0x8e, 0x77, 0x1e, 0xda, 0x9c, 0x3e, 0x10,
-	0xbd, 0x48, 0xeb, 0x06, 0x54, 0xa2, 0xc3, 0x93, 0xd6, 0xc5, 0x65, 0x4d, 0x61, 0xb1, 0x89, 0x8e,
-	0x48, 0xfb, 0xe8, 0x1d, 0xb9, 0x68, 0xd7, 0xd4, 0xe6, 0x3f, 0x01, 0x32, 0xad, 0xb7, 0x1d, 0xf4,
-	0x35, 0xc0, 0x14, 0x79, 0xa3, 0xdb, 0xa2, 0xea, 0x67, 0xa1, 0x78, 0xe3, 0x76, 0xea, 0x67, 0x4b,
-	0x7b, 0xec, 0x06, 0x57, 0x78, 0x0d, 0x1d, 0x42, 0x29, 0x06, 0xad, 0xd1, 0x4f, 0xb8, 0x80, 0x34,
-	0xd8, 0x6e, 0x24, 0xbf, 0x2d, 0xe1, 0x35, 0xd4, 0x04, 0x2d, 0x84, 0xd7, 0x68, 0x2b, 0x7a, 0x57,
-	0xe2, 0x2c, 0xd5, 0x04, 0x8b, 0x8f, 0xd7, 0x98, 0xb1, 0x53, 0x50, 0x2d, 0x8d, 0x4d, 0xa1, 0xec,
-	0x05, 0xc6, 0x3e, 0x86, 0x52, 0x0c, 0x4a, 0x4b, 0x63, 0xd3, 0xe0, 0xba, 0x11, 0x6f, 0x7e, 0xbc,
-	0x86, 0x1e, 0x02, 0x4c, 0x91, 0xb1, 0x54, 0x9b, 0x82, 0xca, 0xb3, 0x4c, 0xaf, 0xa0, 0x1c, 0xc7,
-	0xb3, 0xa8, 0x2e, 0xdf, 0xbf, 0x14, 0xc4, 0x5d, 0x60, 0xef, 0x31, 0x54, 0x12, 0xf8, 0x15, 0xc9,
-	0x5f, 0xd3, 0x73, 0x30, 0xed, 0x02, 0x29, 0x2f, 0xa0, 0x92, 0x80, 0xb1, 0x52, 0xca, 0x3c, 0x68,
-	0xdb, 0x98, 0xfd, 0x42, 0x84, 0xd7, 0xd0, 0x53, 0x80, 0x29, 0x8e, 0x95, 0xde, 0xa7, 0x80, 0x6d,
-	0xa3, 0x36, 0xc3, 0xe8, 0x8b, 0x10, 0xc4, 0xd1, 0x9b, 0x0c, 0xc1, 0x1c, 0x40, 0xb7, 0xc0, 0xf8,
-	0x67, 0x50, 0x8a, 0xa1, 0x38, 0x99, 0xb2, 0x34, 0xae, 0x9b, 0xab, 0xff, 0xb1, 0xb0, 0x5c, 0x3c,
-	0xcd, 0x31, 0xcb, 0x13, 0x68, 0x55, 0x56, 0x66, 0xf8, 0x29, 0x59, 0x98, 0x1d, 0x1f, 0x4c, 0xd2,
-	0xec, 0x39, 0xb3, 0x6a, 0x81, 0xd9, 0x4f, 0xa1, 0x1c, 0x9f, 0x35, 0x52, 0xc6, 0x9c, 0xf1, 0xd3,
-	0x28, 0xc7, 0x0c, 0xf7, 0xb9, 0xc3, 0x05, 0x89, 0x9a, 0xd0, 0x26, 0xbf, 0x4a, 0x62, 0xa8, 0xeb,
-	0x75, 0xee, 0x2a, 0xe8, 0x25, 0x14, 0x24, 0x72, 0x94, 0xbc, 0x49, 0x1c, 0xd9, 0xd8, 0x4e, 0xf1,
-	0xf2, 0x77, 0xfe, 0x5b, 0x36, 0x91, 0xf0, 0xda, 0x03, 0x25, 0xd6, 0xcd, 0x5c, 0x48, 0xa2, 0x9b,
-	0xe3, 0x82, 0x92, 0xdf, 0xbf, 0xa6, 0xdd, 0xcc, 0xb9, 0xb6, 0x12, 0x28, 0x21, 0xd9, 0xcd, 0xd1,
-	0x57, 0xb5, 0x78, 0x37, 0x73, 0xae, 0x78, 0x37, 0xdf, 0xc8, 0x5f, 0xf4, 0x82, 0xbf, 0x9d, 0x34,
-	0xa0, 0x2d, 0xcb, 0x42, 0xd7, 0x90, 0x2d, 0x60, 0xff, 0x1a, 0x40, 0x36, 0xd2, 0x27, 0xf1, 0x37,
-	0xff, 0xa1, 0xca, 0x4f, 0x76, 0xec, 0x19, 0x7d, 0x04, 0x5a, 0x38, 0xf7, 0xa5, 0xff, 0x33, 0x30,
-	0xa0, 0x51, 0x4d, 0x7c, 0x34, 0xf3, 0x79, 0xbe, 0x5a, 0xa0, 0x85, 0x63, 0x5d, 0x72, 0xcd, 0x4c,
-	0xf9, 0xe5, 0x19, 0xfb, 0x06, 0x4a, 0xb1, 0x11, 0x2d, 0x33, 0x96, 0x1e, 0xda, 0x0b, 0x3b, 0xac,
-	0x1c, 0x1f, 0xd6, 0xb2, 0x54, 0xe7, 0xcc, 0xef, 0xc6, 0xcc, 0x67, 0x25, 0xde, 0x61, 0xc5, 0x68,
-	0x5e, 0xa3, 0x5b, 0xd3, 0x06, 0x8b, 0x73, 0xad, 0x27, 0xb9, 0x7c, 0xbc, 0xd6, 0xcf, 0x73, 0x23,
-	0x1e, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0x62, 0x6c, 0xc7, 0x7e, 0xfd, 0x1a, 0x00, 0x00,
+	// 2963 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3a, 0xcb, 0x6f, 0x1b, 0xc7,
+	0xf9, 0x5a, 0xbe, 0xb4, 0xfc, 0xf8, 0x10, 0x35, 0x92, 0xfc, 0x63, 0xa8, 0x3c, 0x94, 0x71, 0x1c,
+	0x28, 0x8c, 0x23, 0xfa, 0xa7, 0x38, 0x71, 0x2a, 0xd7, 0x49, 0x68, 0x89, 0x92, 0xd5, 0xea, 0x85,
+	0x95, 0x6c, 0xc7, 0x0a, 0x1c, 0x66, 0x45, 0x0e, 0xcd, 0x85, 0x97, 0x5c, 0x66, 0x77, 0xe9, 0x54,
+	0x4d, 0x0d, 0x14, 0xb9, 0x04, 0x3d, 0x17, 0xe8, 0xbd, 0xc7, 0xa2, 0x97, 0xf6, 0x50, 0x24, 0x28,
+	0xd0, 0xf6, 0x14, 0x14, 0xe8, 0x35, 0xc7, 0x1e, 0xdb, 0xbf, 0xa0, 0x87, 0xf4, 0x54, 0xa0, 0x98,
+	0xd7, 0x72, 0x97, 0xcb, 0x97, 0xe8, 0x04, 0x3d, 0x24, 0x9a, 0x9d, 0x6f, 0xbe, 0xf7, 0x37, 0xf3,
+	0x3d, 0x68, 0x58, 0xac, 0x99, 0x06, 0x69, 0xbb, 0xa5, 0x4e, 0xc3, 0xa1, 0xff, 0xad, 0x75, 0x6c,
+	0xcb, 0xb5, 0x50, 0xb4, 0xd3, 0x70, 0x0a, 0xcf, 0x3f, 0xb2, 0xac, 0x47, 0x26, 0x29, 0xe9, 0x1d,
+	0xa3, 0xa4, 0xb7, 0xdb, 0x96, 0xab, 0xbb, 0x86, 0xd5, 0x16, 0x47, 0x0a, 0xcb, 0x02, 0xca, 0xbe,
+	0xce, 0xba, 0x8d, 0x12, 0x69, 0x75, 0xdc, 0x73, 0x01, 0x7c, 0xa9, 0x1f, 0xe8, 0x1a, 0x2d, 0xe2,
+	0xb8, 0x7a, 0xab, 0x23, 0x0e, 0xbc, 0xd8, 0x7f, 0xe0, 0x53, 0x5b, 0xef, 0x74, 0x88, 0x2d, 0xa9,
+	0x3f, 0x2f, 0xc5, 0x7a, 0xfc, 0xa8, 0xe4, 0x34, 0x75, 0xbb, 0xce, 0xff, 0xcf, 0xa1, 0xb8, 0x00,
+	0x31, 0x8d, 0x74, 0x2c, 0x84, 0x20, 0xd6, 0xd6, 0x5b, 0x24, 0xaf, 0xac, 0x28, 0xab, 0x49, 0x8d,
+	0xad, 0xf1, 0x0d, 0x48, 0x6c, 0x5a, 0xad, 0x96, 0xe1, 0xa2, 0x17, 0x20, 0x66, 0x93, 0x8e, 0xc5,
+	0xa0, 0xa9, 0xf5, 0xe4, 0x1a, 0x55, 0x8f, 0xa2, 0x69, 0x6c, 0x1b, 0x65, 0x21, 0x62, 0xd4, 0xf3,
+	0x11, 0x86, 0x1a, 0x31, 0xea, 0x78, 0x0d, 0x66, 0x39, 0xa2, 0x83, 0x2e, 0x43, 0xa2, 0xc6, 0x96,
+	0x79, 0x65, 0x25, 0xba, 0x9a, 0x5a, 0x4f, 0x31, 0x5c, 0x0e, 0xd5, 0x04, 0x08, 0xbf, 0x0a, 0xea,
+	0x6d, 0x5b, 0x6f, 0xd7, 0x9a, 0xc4, 0x41, 0x05, 0x50, 0xcf, 0xc4, 0x9a, 0xa1, 0x24, 0x35, 0xef,
+	0x1b, 0xbf, 0x07, 0xb1, 0x6d, 0xc3, 0x24, 0x01, 0xa2, 0xca, 0x10, 0xa2, 0x54, 0xa3, 0x8e, 0xee,
+	0x36, 0x85, 0x58, 0x6c, 0x8d, 0x97, 0x21, 0x7e, 0xdb, 0xb4, 0x6a, 0x8f, 0x29, 0xb0, 0xa9, 0x3b,
+	0x4d, 0xa9, 0x2e, 0x5d, 0xe3, 0xdf, 0x28, 0xa0, 0x52, 0xa5, 0x76, 0xdb, 0x0d, 0x6b, 0x9c, 0xc6,
+	0xd7, 0x61, 0xb6, 0x66, 0x13, 0xdd, 0x25, 0x5c, 0xed, 0xd4, 0x7a, 0x61, 0x8d, 0xbb, 0x61, 0x4d,
+	0xba, 0x61, 0xed, 0x44, 0xfa, 0x49, 0x93, 0x47, 0xd1, 0x0b, 0x00, 0x8e, 0xf1, 0x53, 0x52, 0x3d,
+	0x3b, 0x77, 0x89, 0x93, 0x8f, 0xae, 0x28, 0xab, 0x31, 0x2d, 0x49, 0x77, 0x6e, 0xd3, 0x0d, 0xf4,
+	0x1a, 0x40, 0xc7, 0xb6, 0x9e, 0x90, 0xb6, 0xde, 0xae, 0x91, 0x7c, 0x6c, 0x25, 0x1a, 0xe4, 0xec,
+	0x03, 0xe2, 0x1b, 0x90, 0x94, 0xa2, 0x3a, 0xa8, 0x08, 0x49, 0x2a, 0x54, 0xd5, 0x68, 0x37, 0x2c,
+	0x61, 0xe6, 0x8c, 0x87, 0x46, 0x8f, 0x68, 0xaa, 0x2d, 0x56, 0xf8, 0xd7, 0x51, 0x00, 0x6e, 0x28,
+	0xa6, 0xe6, 0x44, 0x96, 0xbc, 0x04, 0x09, 0xee, 0x02, 0x61, 0x4b, 0xf1, 0x85, 0xae, 0x41, 0x8a,
+	0x9f, 0xa8, 0xba, 0xe7, 0x1d, 0xc2, 0xf4, 0xc9, 0xae, 0xcf, 0xf9, 0x28, 0x9c, 0x9c, 0x77, 0x88,
+	0x06, 0x35, 0x6f, 0x8d, 0xae, 0x41, 0xa6, 0xa3, 0xdb, 0xa4, 0xed, 0x56, 0x05, 0xd7, 0x58, 0x98,
+	0x6b, 0x9a, 0x9f, 0xe0, 0x5f, 0xd4, 0xd0, 0x8e, 0xab, 0xdb, 0xd4, 0xd0, 0xf1, 0xf1, 0x86, 0x16,
+	0x47, 0xd1, 0xdb, 0xa0, 0x36, 0x8c, 0xb6, 0xe1, 0x34, 0x49, 0x3d, 0x9f, 0x18, 0x8b, 0xe6, 0x9d,
+	0xed, 0x73, 0xd0, 0x6c, 0xbf, 0x83, 0x9e, 0x87, 0x64, 0x8d, 0x9a, 0xdf, 0x34, 0x49, 0x3d, 0xaf,
+	0xae, 0x28, 0xab, 0xaa, 0xd6, 0xdb, 0xa0, 0x91, 0xab, 0xdb, 0xb5, 0xa6, 0xf1, 0x84, 0xd4, 0xf3,
+	0x49, 0x06, 0xf4, 0xbe, 0xd1, 0xeb, 0x01, 0xd7, 0x42, 0xf8, 0x2a, 0xf8, 0x9d, 0xfb, 0x1e, 0xa4,
+	0x7a, 0x2e, 0x72, 0x7c, 0x66, 0xf6, 0x39, 0xd8, 0x6f, 0x66, 0xe6, 0x62, 0xa8, 0x79, 0x6b, 0xfc,
+	0x65, 0x04, 0x54, 0x7a, 0x51, 0x64, 0x24, 0x37, 0x0c, 0x93, 0x04, 0x22, 0x99, 0x02, 0x35, 0xb6,
+	0x4d, 0x83, 0x87, 0xfe, 0xe5, 0x2e, 0x8c, 0x30, 0x17, 0x66, 0xbc, 0x33, 0xcc, 0x81, 0x6a, 0x43,
+	0xac, 0xc6, 0xc5, 0xef, 0xdb, 0xa0, 0xb6, 0xac, 0xba, 0xd1, 0x30, 0x48, 0x3d, 0x1f, 0x1b, 0x6f,
+	0x75, 0x79, 0x16, 0x5d, 0x87, 0x39, 0xa1, 0xa0, 0x87, 0x1e, 0x0f, 0xc7, 0x45, 0x96, 0x9f, 0xd9,
+	0x97, 0x58, 0x57, 0x40, 0xad, 0x35, 0x0d, 0xb3, 0x6e, 0x93, 0x76, 0x3e, 0xe1, 0xbb, 0x2b, 0x4c,
+	0x37, 0x0f, 0x84, 0xae, 0x42, 0xb2, 0x4e, 0x4c, 0xa3, 0x65, 0xb8, 0xc4, 0x66, 0x1e, 0xcd, 0xae,
+	0x67, 0xd9, 0xb9, 0x2d, 0xb9, 0xab, 0xf5, 0x0e, 0xd0, 0x7b, 0x25, 0x0d, 0xe7, 0x78, 0xa6, 0x09,
+	0xdd, 0x2b, 0x79, 0x84, 0x9b, 0x86, 0x99, 0xfc, 0x06, 0x24, 0xa9, 0x11, 0x34, 0xbd, 0xfd, 0x88,
+	0xa0, 0x45, 0x88, 0x9b, 0xd6, 0xa7, 0xc4, 0x66, 0x36, 0x8f, 0x69, 0xfc, 0x83, 0xee, 0x76, 0xe9,
+	0xc3, 0xcc, 0xac, 0x1c, 0xd3, 0xf8, 0x07, 0xd6, 0x40, 0x65, 0x4f, 0x92, 0x46, 0x1a, 0x68, 0x05,
+	0xe2, 0x67, 0x74, 0x2d, 0x7c, 0x05, 0x8c, 0x19, 0x87, 0x72, 0x00, 0x7a, 0x05, 0xe2, 0x36, 0x65,
+	0x21, 0x5e, 0x1d, 0xae, 0x89, 0xc7, 0x58, 0xe3, 0x40, 0x26, 0x8c, 0xa0, 0xc9, 0xb4, 0x60, 0xb8,
+	0x55, 0x9b, 0x34, 0x02, 0x5a, 0xc8, 0x23, 0x9a, 0x7a, 0x26, 0x56, 0xf8, 0x57, 0x11, 0x48, 0x94,
+	0x3b, 0x1d, 0xd2, 0xae, 0xa3, 0xab, 0x00, 0x1e, 0x9a, 0x33, 0x18, 0x2f, 0x79, 0xe6, 0x31, 0x79,
+	0xcb, 0xe7, 0x8c, 0x08, 0x3b, 0xfb, 0x1c, 0x3b, 0xcb, 0x89, 0xad, 0x6d, 0x0a, 0x58, 0xa5, 0xed,
+	0xda, 0xe7, 0x3e, 0xe7, 0xbc, 0x0a, 0xaa, 0xa9, 0x3b, 0x2e, 0x13, 0x2d, 0x1a, 0x76, 0xf9, 0x2c,
+	0x05, 0x52, 0xc3, 0x5c, 0x82, 0x44, 0x9d, 0x98, 0xc4, 0x25, 0x2c, 0xae, 0x54, 0x4d, 0x7c, 0x05,
+	0x83, 0x37, 0x3e, 0x32, 0x78, 0x0b, 0x37, 0x21, 0x13, 0x10, 0x03, 0xe5, 0x20, 0xfa, 0x98, 0x9c,
+	0x8b, 0x14, 0x40, 0x97, 0xd4, 0x43, 0x4f, 0x74, 0xb3, 0xcb, 0xad, 0xab, 0x6a, 0xfc, 0x63, 0x23,
+	0xf2, 0x8e, 0x82, 0x3f, 0x57, 0x84, 0x49, 0xd9, 0x95, 0x1a, 0xef, 0xa7, 0xef, 0x23, 0x3f, 0xe0,
+	0x9b, 0x00, 0x9e, 0x0c, 0x0e, 0x7a, 0x43, 0x3a, 0xc8, 0x17, 0x9e, 0xd9, 0x9e, 0x24, 0x2c, 0x3e,
+	0x93, 0x67, 0x72, 0x89, 0x77, 0xf9, 0x93, 0x70, 0x47, 0x77, 0x9a, 0xe8, 0x75, 0x48, 0xb4, 0x88,
+	0xdb, 0xb4, 0xea, 0x4c, 0x81, 0xec, 0xfa, 0x82, 0x67, 0x33, 0x0a, 0xde, 0x67, 0x20, 0x4d, 0x1c,
+	0x91, 0x66, 0x8a, 0x78, 0x66, 0xc2, 0xdf, 0x28, 0x10, 0x3f, 0xa6, 0x35, 0x04, 0x7a, 0x09, 0x52,
+	0xcc, 0xfe, 0xed, 0x6e, 0xeb, 0xcc, 0x0b, 0x77, 0xa0, 0x5b, 0x07, 0x6c, 0x07, 0xbd, 0x0c, 0x69,
+	0x76, 0xa0, 0x65, 0xd5, 0xbb, 0x66, 0xd7, 0x11, 0xa1, 0xcf, 0x90, 0xf6, 0xf9, 0x16, 0x3d, 0xc2,
+	0xf5, 0x10, 0x44, 0xb8, 0xda, 0x29, 0xb6, 0x27, 0xa8, 0x5c, 0x86, 0x0c, 0x3f, 0x22, 0xc9, 0xc4,
+	0xd8, 0x19, 0x8e, 0x27, 0xe9, 0xc8, 0x58, 0x60, 0x79, 0x9d, 0xbf, 0x1f, 0x99, 0x80, 0x5e, 0x3c,
+	0x16, 0xe8, 0x8a, 0xa6, 0xff, 0x47, 0xa6, 0x75, 0xc6, 0x72, 0x43, 0x52, 0x63, 0x6b, 0xfc, 0x10,
+	0xe6, 0x37, 0x99, 0x1f, 0x58, 0xb2, 0x25, 0x9f, 0x74, 0x89, 0x33, 0xb6, 0xf0, 0x09, 0x66, 0xec,
+	0xc8, 0xa8, 0x8c, 0xfd, 0x26, 0xa0, 0xdd, 0xb6, 0xd3, 0x21, 0x35, 0x77, 0x72, 0xfa, 0xf8, 0x87,
+	0x30, 0xb7, 0x67, 0x38, 0x01, 0x8c, 0x20, 0x4b, 0x65, 0x14, 0xcb, 0x3b, 0x30, 0xbf, 0xc5, 0xee,
+	0xc9, 0x05, 0x34, 0x5a, 0x84, 0x78, 0xc3, 0xb2, 0x6b, 0xde, 0x15, 0x60, 0x1f, 0xb8, 0x01, 0xe8,
+	0x98, 0xa6, 0x56, 0x71, 0x2f, 0x05, 0xa9, 0xcb, 0x90, 0xe0, 0xb9, 0x7a, 0x60, 0xf1, 0xc0, 0x41,
+	0xe8, 0xf5, 0x01, 0x26, 0x1a, 0x9a, 0xf9, 0x9e, 0xc2, 0xfc, 0xb6, 0x65, 0x3f, 0x9e, 0x82, 0xcd,
+	0xb0, 0x1a, 0x25, 0xc8, 0x3e, 0x3a, 0x9a, 0xbd, 0x06, 0x0b, 0xdb, 0xac, 0x14, 0x08, 0x09, 0x30,
+	0x51, 0x91, 0xc4, 0x4b, 0x01, 0x61, 0x39, 0xf1, 0x85, 0x6f, 0xc1, 0x62, 0x99, 0x57, 0x01, 0x41,
+	0xa2, 0x57, 0x60, 0x96, 0x63, 0x3a, 0x83, 0x2a, 0x63, 0x09, 0xc3, 0x37, 0x61, 0x51, 0x84, 0xcd,
+	0xc5, 0x65, 0xc2, 0xff, 0x50, 0x60, 0x9e, 0xc6, 0x4f, 0x10, 0x75, 0x0d, 0xd2, 0x0d, 0xdb, 0x6a,
+	0x55, 0x47, 0xb0, 0x4f, 0xd1, 0x03, 0xb2, 0x84, 0xbf, 0x88, 0x07, 0xa7, 0xa8, 0x09, 0x5f, 0x83,
+	0x84, 0xe3, 0xea, 0xae, 0xb8, 0xd5, 0xd9, 0xf5, 0x79, 0xdf, 0xe1, 0x63, 0x06, 0xd0, 0xc4, 0x01,
+	0x1a, 0x9c, 0xfc, 0xdd, 0x8d, 0xf3, 0xe0, 0x64, 0x1f, 0xf8, 0x21, 0x57, 0x92, 0x77, 0x10, 0x13,
+	0x5f, 0x5c, 0xc9, 0x34, 0x32, 0x86, 0x29, 0xde, 0x80, 0x05, 0x7e, 0x8b, 0xa6, 0x70, 0xc0, 0x43,
+	0x40, 0xdb, 0x66, 0x77, 0x54, 0x3c, 0x0d, 0xeb, 0x89, 0x10, 0x86, 0x59, 0xd7, 0xaa, 0x32, 0x1d,
+	0x42, 0xef, 0x4a, 0xc2, 0xb5, 0xe8, 0x5f, 0x7c, 0x1f, 0x60, 0xcb, 0x68, 0x34, 0xf8, 0x83, 0x8d,
+	0xae, 0x42, 0xca, 0xe7, 0xd7, 0x41, 0x62, 0x41, 0xcf, 0xad, 0x68, 0x19, 0x92, 0x8d, 0xae, 0x69,
+	0x56, 0x59, 0x6d, 0xc8, 0x43, 0x56, 0xa5, 0x1b, 0xf4, 0xb5, 0xc4, 0x5f, 0x2b, 0x90, 0xdd, 0x21,
+	0x2e, 0x5d, 0xfb, 0x0c, 0x3a, 0xaa, 0x8c, 0x7c, 0x19, 0xd2, 0x56, 0xa3, 0xe1, 0x10, 0x57, 0x24,
+	0x2f, 0x4a, 0x31, 0xaa, 0xa5, 0xf8, 0x1e, 0x2f, 0x0f, 0xc3, 0xd9, 0x2d, 0xea, 0xaf, 0x1e, 0x57,
+	0x20, 0xce, 0x1a, 0xd3, 0x7c, 0xcc, 0x97, 0x54, 0x59, 0x9a, 0xd1, 0x38, 0x80, 0xc6, 0x56, 0xdd,
+	0x68, 0x34, 0xaa, 0x22, 0x77, 0xf1, 0x37, 0x9e, 0xc7, 0x56, 0xcf, 0x0c, 0x1a, 0xd4, 0xbd, 0x35,
+	0xfe, 0xbd, 0x02, 0xd9, 0xa3, 0xee, 0x45, 0xf4, 0xb8, 0x48, 0x39, 0xec, 0x95, 0x0b, 0x54, 0x97,
+	0xb4, 0x28, 0x17, 0x82, 0x05, 0x67, 0x6c, 0x4c, 0xc1, 0x49, 0xb3, 0x6b, 0xd7, 0x36, 0x99, 0x2e,
+	0x49, 0x8d, 0x2e, 0xf1, 0x17, 0x8a, 0x97, 0x29, 0x2e, 0x20, 0xb7, 0x67, 0xbd, 0xc8, 0x84, 0xd6,
+	0x8b, 0x8e, 0xb7, 0xde, 0x6f, 0x15, 0x9e, 0x7e, 0xfe, 0xb7, 0x62, 0xa0, 0x2b, 0x10, 0x6b, 0x59,
+	0x75, 0x12, 0x78, 0x1e, 0xa4, 0x58, 0xfb, 0x56, 0x9d, 0x68, 0x0c, 0x8c, 0xd7, 0x65, 0xb6, 0x9b,
+	0x5c, 0x5c, 0x6c, 0xc1, 0xc2, 0xf1, 0x27, 0x5d, 0xdd, 0x69, 0x3e, 0xdb, 0x0b, 0xb9, 0x0a, 0x49,
+	0xd7, 0x92, 0xf7, 0x2e, 0x12, 0xbe, 0x77, 0xaa, 0x6b, 0xf1, 0x15, 0x3e, 0x83, 0x05, 0x8d, 0x74,
+	0x4c, 0xfd, 0xfc, 0xd9, 0x18, 0x2e, 0x33, 0x86, 0x81, 0x84, 0xa7, 0xba, 0x16, 0x7f, 0x01, 0xf1,
+	0xb7, 0x0a, 0x2f, 0xf5, 0xa8, 0x39, 0xbd, 0x29, 0x88, 0xd2, 0x9b, 0x82, 0x5c, 0x28, 0xc6, 0x83,
+	0x6d, 0x40, 0x74, 0x4c, 0x1b, 0x30, 0xac, 0x4e, 0xf7, 0x77, 0x86, 0xf1, 0x0b, 0x74, 0x86, 0x81,
+	0xbb, 0x94, 0x18, 0xd7, 0xbc, 0x59, 0x90, 0x91, 0x66, 0xed, 0x98, 0x46, 0x4d, 0x0f, 0x77, 0xce,
+	0xca, 0x98, 0xce, 0x99, 0xaa, 0xcb, 0x4c, 0x43, 0xc3, 0xcf, 0xc9, 0x47, 0x7c, 0xea, 0x4a, 0x8b,
+	0x6a, 0xc9, 0x86, 0x58, 0x39, 0xf8, 0x1d, 0x98, 0x3f, 0xea, 0x9a, 0xe6, 0x14, 0x89, 0xa1, 0x4c,
+	0x31, 0xfb, 0xc3, 0xee, 0x2a, 0xcc, 0xda, 0x5c, 0x72, 0x81, 0x8a, 0xfc, 0xa8, 0x1c, 0xa2, 0xc9,
+	0x23, 0x38, 0x0f, 0x89, 0xbb, 0x1d, 0xd3, 0xd2, 0xeb, 0x62, 0xfc, 0xa6, 0x78, 0xe3, 0x37, 0x1d,
+	0xd0, 0x6d, 0xf2, 0xc8, 0x68, 0x73, 0xf0, 0x84, 0x37, 0x37, 0x60, 0xea, 0xc8, 0x38, 0x53, 0x7f,
+	0x02, 0xf3, 0x9c, 0xfa, 0x91, 0x6e, 0xfb, 0x35, 0xef, 0xb2, 0xcd, 0x80, 0xe6, 0x42, 0x0a, 0x01,
+	0x1a, 0x98, 0x28, 0x62, 0xc1, 0x44, 0x31, 0xf0, 0x5d, 0xc5, 0x3f, 0x03, 0xe8, 0xb1, 0x0c, 0x91,
+	0x51, 0xc2, 0x64, 0x82, 0xf9, 0x26, 0xd2, 0x3f, 0xad, 0xb8, 0x50, 0x64, 0xe3, 0xbf, 0x2b, 0x92,
+	0xbd, 0x9c, 0x9b, 0x8d, 0x57, 0x55, 0x5a, 0x3c, 0x32, 0x81, 0xc5, 0xa3, 0xe3, 0x12, 0xc5, 0x15,
+	0x88, 0x77, 0x74, 0xdb, 0x75, 0xc4, 0x5c, 0x70, 0xce, 0xc7, 0x90, 0xf9, 0x80, 0x43, 0xa7, 0x9b,
+	0x97, 0xf9, 0xaa, 0xcc, 0x60, 0xcc, 0x4c, 0xa2, 0x26, 0xfe, 0x10, 0x96, 0x36, 0xad, 0x56, 0x87,
+	0x5e, 0xf4, 0x8b, 0x63, 0x8f, 0xf1, 0x12, 0xbe, 0x0b, 0x73, 0x47, 0x5d, 0x57, 0x78, 0x84, 0x93,
+	0xf5, 0xc2, 0x43, 0x19, 0x9a, 0x76, 0xc7, 0xc6, 0x6f, 0x17, 0xe6, 0x76, 0x48, 0x90, 0xec, 0xf8,
+	0xa6, 0x7e, 0x82, 0xd0, 0x1d, 0xd3, 0xc1, 0xbf, 0x0d, 0x88, 0xe7, 0xa8, 0x8b, 0x71, 0xc6, 0x37,
+	0x60, 0x41, 0xf8, 0xe7, 0x82, 0x88, 0x08, 0x72, 0xac, 0x36, 0xf6, 0x61, 0x15, 0x0f, 0xe5, 0x04,
+	0x58, 0x14, 0x31, 0xb9, 0xcd, 0xc3, 0xfd, 0xfd, 0xdd, 0x93, 0xea, 0xc9, 0x83, 0xa3, 0x4a, 0xf5,
+	0xe0, 0xf0, 0xa0, 0x92, 0x9b, 0xe9, 0xdf, 0xd5, 0x2a, 0xe5, 0xad, 0x9c, 0x82, 0x96, 0x60, 0xde,
+	0xbf, 0x7b, 0x5f, 0xdb, 0x3d, 0xa9, 0xe4, 0x22, 0xc5, 0x3b, 0x3c, 0xdf, 0x30, 0x72, 0x08, 0xb2,
+	0xdb, 0xbb, 0x7b, 0x95, 0x00, 0xb1, 0x25, 0x98, 0xef, 0xed, 0x69, 0x95, 0x9d, 0xbb, 0x7b, 0x65,
+	0x2d, 0xa7, 0xa0, 0x79, 0xc8, 0xf4, 0xb6, 0xb7, 0x76, 0xb5, 0x5c, 0xa4, 0x78, 0x0c, 0xd9, 0xe0,
+	0x14, 0x02, 0x65, 0x20, 0x79, 0x72, 0x78, 0x54, 0xdd, 0xab, 0xdc, 0xab, 0xec, 0xe5, 0x66, 0x90,
+	0x0a, 0xb1, 0xa3, 0xf2, 0xc9, 0x9d, 0x9c, 0x42, 0x01, 0x5b, 0xbb, 0x5a, 0x65, 0xf3, 0xe4, 0x50,
+	0x7b, 0x90, 0x8b, 0xa0, 0x1c, 0xa4, 0x8f, 0xb4, 0xca, 0xf6, 0xee, 0x07, 0x55, 0xad, 0x7c, 0xb0,
+	0x53, 0xc9, 0x45, 0xd1, 0x2c, 0x44, 0x7f, 0x5c, 0x79, 0x90, 0x8b, 0x15, 0xdf, 0x87, 0xb4, 0xbf,
+	0xb0, 0x47, 0x00, 0x89, 0x83, 0x43, 0x6d, 0xbf, 0x4c, 0xe9, 0xa5, 0x41, 0x2d, 0x6b, 0x9b, 0x77,
+	0x76, 0xef, 0x55, 0xb6, 0x38, 0xcd, 0xcd, 0xf2, 0xc1, 0x66, 0x65, 0x6f, 0xaf, 0xb2, 0x95, 0x8b,
+	0x50, 0x0a, 0xe5, 0xbd, 0xbd, 0x5c, 0xb4, 0xf8, 0x1a, 0x24, 0xbd, 0x28, 0xa2, 0x22, 0x08, 0xbd,
+	0x54, 0x88, 0xfd, 0xe8, 0xf8, 0xf0, 0x20, 0xa7, 0xd0, 0xd5, 0xde, 0xee, 0x01, 0xb5, 0xc5, 0x1e,
+	0xa4, 0xfd, 0xb5, 0x09, 0x5a, 0xe8, 0x95, 0x50, 0x55, 0x8f, 0xeb, 0x3c, 0x64, 0xbc, 0xcd, 0xed,
+	0xf2, 0xf1, 0x49, 0x4e, 0xa1, 0x06, 0xf7, 0xb6, 0xb4, 0xca, 0xe6, 0x5d, 0xed, 0xb8, 0x92, 0x8b,
+	0xac, 0xff, 0x75, 0x19, 0xa2, 0xe5, 0xa3, 0x5d, 0x74, 0x0f, 0xa0, 0x37, 0x9b, 0x40, 0x97, 0x78,
+	0x5a, 0xe8, 0x1f, 0x56, 0x14, 0x2e, 0x85, 0xae, 0x7a, 0x85, 0xfe, 0x90, 0x84, 0xf3, 0x9f, 0x7f,
+	0xf3, 0xcf, 0x5f, 0x46, 0xd0, 0x86, 0x52, 0xc4, 0x99, 0xd2, 0x93, 0xff, 0x67, 0x3f, 0x50, 0xd1,
+	0xc6, 0xc2, 0x41, 0x1f, 0x40, 0xca, 0x37, 0x94, 0x40, 0xff, 0xc7, 0x08, 0x87, 0xc7, 0x14, 0x85,
+	0xe0, 0xcf, 0x09, 0xf8, 0x65, 0x46, 0x70, 0x19, 0x3d, 0x17, 0xa0, 0x56, 0xfa, 0x8c, 0xfe, 0x59,
+	0xa3, 0x3f, 0x1d, 0x3d, 0x45, 0x3b, 0xa0, 0xca, 0xc9, 0x05, 0x5a, 0xf4, 0x4a, 0x36, 0x3f, 0xcd,
+	0x6c, 0x80, 0xa6, 0x83, 0x97, 0x18, 0xd1, 0x39, 0xd4, 0x27, 0x62, 0x15, 0xa0, 0x37, 0xc4, 0x10,
+	0xaa, 0x87, 0xa6, 0x1a, 0x43, 0x55, 0x17, 0x92, 0x16, 0x47, 0x48, 0xda, 0x84, 0x94, 0x6f, 0xb6,
+	0x21, 0x6c, 0x10, 0x9e, 0x76, 0x14, 0xfc, 0x79, 0x1c, 0xbf, 0xc9, 0xe8, 0xbe, 0x41, 0x4d, 0xba,
+	0xda, 0x47, 0x9a, 0x0f, 0x24, 0xd6, 0x7a, 0x1c, 0x4a, 0xa2, 0xaa, 0x43, 0x7f, 0x52, 0x00, 0x7a,
+	0xe3, 0x0d, 0xa1, 0x4b, 0x68, 0xde, 0x11, 0x64, 0xf4, 0x0b, 0x85, 0x71, 0xfa, 0x5c, 0xd9, 0x50,
+	0x8a, 0xa7, 0xb7, 0x29, 0xbf, 0x5b, 0x93, 0xf2, 0xf3, 0x40, 0x46, 0xfd, 0x56, 0xb1, 0x54, 0x7c,
+	0x5a, 0x6a, 0x58, 0xf6, 0x63, 0xfc, 0x83, 0x29, 0xd0, 0x39, 0x2a, 0xfa, 0x9b, 0x02, 0x69, 0xff,
+	0x7c, 0x04, 0xe5, 0x45, 0x4a, 0x0b, 0x8d, 0x4c, 0x86, 0xfa, 0xe3, 0x0b, 0xae, 0xce, 0xcf, 0x95,
+	0xd3, 0x32, 0x7e, 0xaf, 0x4f, 0x12, 0xce, 0x77, 0xa0, 0x24, 0x02, 0xe4, 0x29, 0xc2, 0x38, 0xe2,
+	0x9b, 0x53, 0x10, 0x90, 0xc8, 0x88, 0x40, 0x26, 0x30, 0x97, 0x41, 0x62, 0x60, 0x3d, 0x60, 0x56,
+	0x33, 0x2e, 0xba, 0xa8, 0x57, 0x2e, 0x49, 0x59, 0xc4, 0x4f, 0x3e, 0xa2, 0x94, 0x47, 0x7f, 0x50,
+	0x20, 0x13, 0x18, 0xe0, 0x08, 0x3e, 0x83, 0x86, 0x3a, 0x85, 0xfe, 0xd2, 0x14, 0xff, 0x84, 0x31,
+	0xb0, 0x4f, 0x37, 0xd0, 0x3b, 0xd3, 0x1a, 0x0b, 0x5d, 0x9f, 0xc6, 0x4a, 0xf4, 0xc5, 0xe9, 0x4d,
+	0x8e, 0x44, 0xa8, 0x86, 0x46, 0x49, 0x85, 0x5c, 0x9f, 0xc0, 0x0e, 0x7e, 0x91, 0x49, 0x9c, 0xa7,
+	0x26, 0x59, 0x90, 0x8c, 0x4d, 0xc3, 0x91, 0x3f, 0xf8, 0xa1, 0x3f, 0x2a, 0x90, 0xf6, 0x8f, 0x53,
+	0x44, 0x0c, 0x0d, 0x98, 0xb0, 0x0c, 0xb5, 0xba, 0x67, 0x94, 0xe2, 0xd4, 0x46, 0x29, 0x4e, 0x67,
+	0x94, 0x07, 0x90, 0xf2, 0x8d, 0x73, 0xc4, 0x53, 0x11, 0x1e, 0xf0, 0x0c, 0x30, 0xcb, 0x4b, 0x4c,
+	0xe6, 0xe7, 0xa8, 0x59, 0x16, 0x25, 0xeb, 0x06, 0x45, 0x94, 0x76, 0xa9, 0x72, 0x7b, 0xf3, 0x16,
+	0xce, 0x67, 0xef, 0xc0, 0x54, 0x4b, 0xbc, 0xc3, 0xb7, 0xe5, 0xef, 0xe1, 0x45, 0x46, 0xf5, 0x15,
+	0x84, 0x87, 0xbe, 0x6e, 0x25, 0xf9, 0xdb, 0x39, 0x3a, 0x83, 0xb4, 0xbf, 0xd5, 0x15, 0x76, 0x1f,
+	0xd0, 0xfd, 0x0e, 0xb5, 0xfb, 0x0a, 0xe3, 0x56, 0xa0, 0x3a, 0x2c, 0x49, 0x86, 0x0e, 0xc3, 0x97,
+	0x4a, 0x7c, 0x00, 0x69, 0x7f, 0x77, 0x2b, 0x78, 0x0c, 0x68, 0x78, 0x0b, 0x69, 0x9f, 0x85, 0x9c,
+	0x81, 0x94, 0x6d, 0x86, 0x25, 0x29, 0x6f, 0x00, 0xf4, 0x3a, 0x2d, 0x61, 0x9e, 0x50, 0xeb, 0x55,
+	0x18, 0xd0, 0x2f, 0xe1, 0x19, 0xf4, 0x2e, 0xc5, 0x75, 0x9a, 0x7d, 0xb8, 0x93, 0x6a, 0x3d, 0x83,
+	0xee, 0xc2, 0xac, 0x98, 0x21, 0xa1, 0x05, 0x81, 0xec, 0x1f, 0x89, 0x0c, 0xc5, 0x5c, 0x66, 0x5a,
+	0x2d, 0x51, 0xad, 0x72, 0x52, 0xab, 0x4e, 0xd7, 0x65, 0x13, 0xb7, 0x55, 0x05, 0x1d, 0x42, 0xca,
+	0xd7, 0xa5, 0x89, 0x60, 0x0a, 0xf7, 0x6d, 0x05, 0x7f, 0xd5, 0x8c, 0x0b, 0x8c, 0xe6, 0x22, 0xa5,
+	0x39, 0x27, 0x69, 0xf2, 0x42, 0xda, 0x41, 0x1f, 0x05, 0x1a, 0xa4, 0x4b, 0xfd, 0x0d, 0xc2, 0x18,
+	0x69, 0x07, 0x5d, 0x5c, 0x4e, 0xb9, 0x4a, 0x3b, 0x8b, 0x55, 0x05, 0x7d, 0xec, 0xbd, 0x64, 0x42,
+	0xe4, 0xc0, 0x4b, 0x16, 0x14, 0xda, 0xdf, 0x9e, 0xb0, 0x97, 0xec, 0x32, 0x23, 0xff, 0x02, 0x5a,
+	0xee, 0x93, 0xba, 0xf4, 0x19, 0x5f, 0xb0, 0xfb, 0x65, 0x43, 0x36, 0xd8, 0x49, 0xa0, 0x82, 0xf4,
+	0x68, 0xb8, 0xbd, 0x18, 0xaa, 0xc9, 0x55, 0xc6, 0xea, 0x55, 0xfc, 0xca, 0x08, 0x56, 0xa5, 0x9a,
+	0x20, 0x89, 0xfe, 0xad, 0xc0, 0xec, 0x0e, 0xf1, 0xbb, 0x37, 0x38, 0xf8, 0x2c, 0x2c, 0x87, 0xd8,
+	0xb0, 0x5a, 0xfe, 0x1e, 0x6b, 0x4a, 0xbf, 0xe4, 0xf9, 0xec, 0x77, 0xca, 0xe9, 0x03, 0x74, 0xbf,
+	0xef, 0x16, 0x52, 0x3f, 0xaf, 0x8d, 0x78, 0x58, 0xfc, 0xf0, 0x5e, 0x66, 0x33, 0x89, 0x04, 0xd1,
+	0x59, 0xcd, 0xad, 0x62, 0xf1, 0x29, 0x3a, 0x7e, 0x26, 0xc2, 0x83, 0x89, 0x5e, 0x53, 0xd0, 0x7f,
+	0x14, 0xaf, 0xfa, 0x63, 0xda, 0x07, 0xaa, 0x3f, 0xbf, 0x05, 0x82, 0x3f, 0x7a, 0xe3, 0xaf, 0xb9,
+	0xce, 0x7f, 0x56, 0x4e, 0x3f, 0x46, 0x1f, 0x7d, 0x07, 0x3a, 0x1b, 0x9c, 0x23, 0xbb, 0x17, 0xfd,
+	0xaa, 0x9f, 0x3e, 0xa3, 0xea, 0xa3, 0x68, 0xff, 0x4b, 0xe1, 0x35, 0x2a, 0x53, 0x7e, 0x31, 0x30,
+	0x56, 0x0c, 0xd6, 0xa8, 0x52, 0x73, 0x07, 0xff, 0x85, 0xab, 0xfe, 0x95, 0x72, 0xfa, 0x10, 0x7d,
+	0xf8, 0x1d, 0xa8, 0xce, 0x32, 0xe3, 0x20, 0xd9, 0xee, 0x3f, 0xa3, 0xde, 0x43, 0x09, 0x7f, 0xab,
+	0xc8, 0x7a, 0x9a, 0xa9, 0xed, 0xaf, 0xa7, 0x27, 0x79, 0xd3, 0x7c, 0xf1, 0x5e, 0xfc, 0xbe, 0xe2,
+	0xbd, 0xf8, 0x7d, 0xc4, 0x3b, 0xba, 0xc5, 0x7a, 0x38, 0xe2, 0x92, 0xb2, 0x69, 0xa2, 0x21, 0xea,
+	0x8d, 0x48, 0x02, 0xef, 0x02, 0x88, 0xd2, 0x70, 0x2a, 0xfc, 0xf5, 0xaf, 0x22, 0xe2, 0xdf, 0x79,
+	0xd0, 0x76, 0xee, 0x3a, 0xa8, 0x72, 0xa8, 0x21, 0x02, 0xaf, 0x6f, 0xc6, 0x51, 0xc8, 0x06, 0x06,
+	0x51, 0x0e, 0x9e, 0x59, 0x55, 0x50, 0x19, 0xd4, 0x1d, 0x12, 0xc0, 0xea, 0x1b, 0x61, 0x8c, 0x7e,
+	0xaa, 0x66, 0xae, 0x29, 0xe8, 0x7d, 0x48, 0xf9, 0xe6, 0x0f, 0xe2, 0xc6, 0x87, 0x27, 0x12, 0x23,
+	0xec, 0xb0, 0x01, 0x69, 0xff, 0x24, 0x42, 0xa4, 0xf8, 0x01, 0xc3, 0x89, 0x42, 0xdf, 0xbf, 0x45,
+	0xc0, 0x33, 0xe8, 0x2d, 0x48, 0x7a, 0xc3, 0x08, 0xb4, 0xd4, 0x2b, 0x71, 0xfc, 0x58, 0x73, 0x41,
+	0x2c, 0x07, 0xcf, 0x9c, 0x25, 0x98, 0x10, 0x6f, 0xfe, 0x77, 0x00, 0xa0, 0xd5, 0x32, 0xe4, 0x60,
+	0x29, 0x00, 0x00,
 }
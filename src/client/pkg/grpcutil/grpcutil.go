@@ -1,6 +1,7 @@
 package grpcutil
 
 import (
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 )
 
@@ -14,3 +15,17 @@ type Dialer interface {
 func NewDialer(opts ...grpc.DialOption) Dialer {
 	return newDialer(opts...)
 }
+
+// ChainUnaryServer returns an interceptor which calls interceptors in
+// order, since a server only takes one.
+func ChainUnaryServer(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], handler
+			handler = func(ctx context.Context, request interface{}) (interface{}, error) {
+				return interceptor(ctx, request, info, next)
+			}
+		}
+		return handler(ctx, request)
+	}
+}
@@ -0,0 +1,115 @@
+package grpcutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Environment variables used to configure TLS for clients of pachd.
+const (
+	// TLSEnv enables TLS when set to "true".
+	TLSEnv = "PACH_TLS"
+	// TLSCAEnv is the path to a PEM bundle of CAs used to verify pachd's
+	// certificate. If it's unset the system roots are used.
+	TLSCAEnv = "PACH_TLS_CA"
+	// TLSCertEnv and TLSKeyEnv are the paths to a PEM certificate and key
+	// presented to pachd for mutual TLS.
+	TLSCertEnv = "PACH_TLS_CERT"
+	TLSKeyEnv  = "PACH_TLS_KEY"
+	// TLSServerNameEnv overrides the name used to verify pachd's
+	// certificate, which is otherwise taken from the address dialed.
+	TLSServerNameEnv = "PACH_TLS_SERVER_NAME"
+)
+
+// TLSOptions describe how a client connects to pachd over TLS.
+type TLSOptions struct {
+	CAFile     string
+	CertFile   string
+	KeyFile    string
+	ServerName string
+}
+
+// TLSOptionsFromEnv reads TLSOptions from the environment. It returns nil if
+// TLS isn't enabled.
+func TLSOptionsFromEnv() *TLSOptions {
+	if os.Getenv(TLSEnv) != "true" && os.Getenv(TLSCAEnv) == "" {
+		return nil
+	}
+	return &TLSOptions{
+		CAFile:     os.Getenv(TLSCAEnv),
+		CertFile:   os.Getenv(TLSCertEnv),
+		KeyFile:    os.Getenv(TLSKeyEnv),
+		ServerName: os.Getenv(TLSServerNameEnv),
+	}
+}
+
+// DialOption returns a grpc.DialOption which connects according to o. A nil
+// o connects without TLS.
+func (o *TLSOptions) DialOption() (grpc.DialOption, error) {
+	if o == nil {
+		return grpc.WithInsecure(), nil
+	}
+	config := &tls.Config{
+		ServerName: o.ServerName,
+	}
+	if o.CAFile != "" {
+		pool, err := certPoolFromFile(o.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = pool
+	}
+	if o.CertFile != "" || o.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(o.CertFile, o.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(config)), nil
+}
+
+// DialOptionFromEnv is TLSOptionsFromEnv followed by DialOption.
+func DialOptionFromEnv() (grpc.DialOption, error) {
+	return TLSOptionsFromEnv().DialOption()
+}
+
+// ServerTLSConfig creates a tls.Config for serving with the given
+// certificate and key. If clientCAFile is not empty, clients are required
+// to present a certificate signed by one of the CAs in it.
+func ServerTLSConfig(certFile string, keyFile string, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+	}
+	if clientCAFile != "" {
+		pool, err := certPoolFromFile(clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return config, nil
+}
+
+func certPoolFromFile(path string) (*x509.CertPool, error) {
+	pem, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in %s", path)
+	}
+	return pool, nil
+}
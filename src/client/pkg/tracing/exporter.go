@@ -0,0 +1,70 @@
+package tracing
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+// TraceEnv is the environment variable which enables tracing, see
+// SetTracerFromEnv.
+const TraceEnv = "PACH_TRACE"
+
+// Exporter sends the spans finished by a tracer from NewTracer somewhere,
+// e.g. a file.
+type Exporter interface {
+	Export(record *Record) error
+}
+
+// NewWriterExporter returns an Exporter which writes spans to w as JSON,
+// one per line.
+func NewWriterExporter(w io.Writer) Exporter {
+	return &writerExporter{encoder: json.NewEncoder(w)}
+}
+
+type writerExporter struct {
+	lock    sync.Mutex
+	encoder *json.Encoder
+}
+
+func (e *writerExporter) Export(record *Record) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.encoder.Encode(record)
+}
+
+// ExporterFromEnv returns the Exporter that TraceEnv selects: "stdout",
+// "stderr" or the path of a file to append spans to.  It returns nil if
+// TraceEnv isn't set.
+func ExporterFromEnv() (Exporter, error) {
+	switch value := os.Getenv(TraceEnv); value {
+	case "":
+		return nil, nil
+	case "stdout":
+		return NewWriterExporter(os.Stdout), nil
+	case "stderr":
+		return NewWriterExporter(os.Stderr), nil
+	default:
+		file, err := os.OpenFile(value, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0666)
+		if err != nil {
+			return nil, err
+		}
+		return NewWriterExporter(file), nil
+	}
+}
+
+// SetTracerFromEnv registers a tracer for process which sends spans to the
+// Exporter that TraceEnv selects, if any, as the global tracer.
+func SetTracerFromEnv(process string) error {
+	exporter, err := ExporterFromEnv()
+	if err != nil {
+		return err
+	}
+	if exporter != nil {
+		opentracing.SetGlobalTracer(NewTracer(process, exporter))
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package tracing
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// UnaryServerInterceptor starts a span for every unary RPC.
+func UnaryServerInterceptor(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	span, ctx := StartSpan(ctx, info.FullMethod)
+	response, err := handler(ctx, request)
+	FinishSpan(span, err)
+	return response, err
+}
+
+// StreamServerInterceptor starts a span for every streaming RPC.
+func StreamServerInterceptor(server interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	span, ctx := StartSpan(stream.Context(), info.FullMethod)
+	err := handler(server, &serverStream{ServerStream: stream, ctx: ctx})
+	FinishSpan(span, err)
+	return err
+}
+
+// serverStream is a grpc.ServerStream with the context of its span.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,296 @@
+package tracing
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sjezewski/pachyderm/src/client/pkg/uuid"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// The keys which carry a span context in a TextMap, such as gRPC metadata.
+const (
+	traceIDKey       = "pach-trace-id"
+	spanIDKey        = "pach-span-id"
+	baggageKeyPrefix = "pach-baggage-"
+)
+
+// Record is a finished span, as it's exported.
+type Record struct {
+	TraceID  string `json:"trace_id"`
+	SpanID   string `json:"span_id"`
+	ParentID string `json:"parent_id,omitempty"`
+	Name     string `json:"name"`
+	// Process is the name of the process which did the work, see
+	// NewTracer.
+	Process  string                 `json:"process,omitempty"`
+	Start    time.Time              `json:"start"`
+	Duration time.Duration          `json:"duration"`
+	Tags     map[string]interface{} `json:"tags,omitempty"`
+	Logs     []*LogRecord           `json:"logs,omitempty"`
+	Baggage  map[string]string      `json:"baggage,omitempty"`
+}
+
+// LogRecord is a set of fields logged on a span.
+type LogRecord struct {
+	Time   time.Time              `json:"time"`
+	Fields map[string]interface{} `json:"fields"`
+}
+
+// NewTracer returns an opentracing.Tracer which records the spans of
+// process and sends them to exporter as they finish.
+func NewTracer(process string, exporter Exporter) opentracing.Tracer {
+	return &tracer{
+		process:  process,
+		exporter: exporter,
+	}
+}
+
+type tracer struct {
+	process  string
+	exporter Exporter
+}
+
+type spanContext struct {
+	traceID string
+	spanID  string
+	baggage map[string]string
+}
+
+func (c *spanContext) ForeachBaggageItem(handler func(key string, value string) bool) {
+	for key, value := range c.baggage {
+		if !handler(key, value) {
+			return
+		}
+	}
+}
+
+type span struct {
+	tracer  *tracer
+	lock    sync.Mutex
+	context *spanContext
+	record  *Record
+}
+
+func (t *tracer) StartSpan(name string, options ...opentracing.StartSpanOption) opentracing.Span {
+	var startOptions opentracing.StartSpanOptions
+	for _, option := range options {
+		option.Apply(&startOptions)
+	}
+	if startOptions.StartTime.IsZero() {
+		startOptions.StartTime = time.Now()
+	}
+	s := &span{
+		tracer: t,
+		context: &spanContext{
+			spanID: uuid.NewWithoutDashes()[:16],
+		},
+		record: &Record{
+			Name:    name,
+			Process: t.process,
+			Start:   startOptions.StartTime,
+			Tags:    startOptions.Tags,
+		},
+	}
+	for _, reference := range startOptions.References {
+		parent, ok := reference.ReferencedContext.(*spanContext)
+		if !ok {
+			continue
+		}
+		s.context.traceID = parent.traceID
+		s.record.ParentID = parent.spanID
+		for key, value := range parent.baggage {
+			s.setBaggageItem(key, value)
+		}
+		break
+	}
+	if s.context.traceID == "" {
+		s.context.traceID = uuid.NewWithoutDashes()
+	}
+	s.record.TraceID = s.context.traceID
+	s.record.SpanID = s.context.spanID
+	return s
+}
+
+func (t *tracer) Inject(context opentracing.SpanContext, format interface{}, carrier interface{}) error {
+	c, ok := context.(*spanContext)
+	if !ok {
+		return opentracing.ErrInvalidSpanContext
+	}
+	switch format {
+	case opentracing.TextMap, opentracing.HTTPHeaders:
+	default:
+		return opentracing.ErrUnsupportedFormat
+	}
+	writer, ok := carrier.(opentracing.TextMapWriter)
+	if !ok {
+		return opentracing.ErrInvalidCarrier
+	}
+	writer.Set(traceIDKey, c.traceID)
+	writer.Set(spanIDKey, c.spanID)
+	for key, value := range c.baggage {
+		writer.Set(baggageKeyPrefix+key, value)
+	}
+	return nil
+}
+
+func (t *tracer) Extract(format interface{}, carrier interface{}) (opentracing.SpanContext, error) {
+	switch format {
+	case opentracing.TextMap, opentracing.HTTPHeaders:
+	default:
+		return nil, opentracing.ErrUnsupportedFormat
+	}
+	reader, ok := carrier.(opentracing.TextMapReader)
+	if !ok {
+		return nil, opentracing.ErrInvalidCarrier
+	}
+	c := &spanContext{}
+	if err := reader.ForeachKey(func(key string, value string) error {
+		switch key = strings.ToLower(key); {
+		case key == traceIDKey:
+			c.traceID = value
+		case key == spanIDKey:
+			c.spanID = value
+		case strings.HasPrefix(key, baggageKeyPrefix):
+			if c.baggage == nil {
+				c.baggage = make(map[string]string)
+			}
+			c.baggage[strings.TrimPrefix(key, baggageKeyPrefix)] = value
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if c.traceID == "" || c.spanID == "" {
+		return nil, opentracing.ErrSpanContextNotFound
+	}
+	return c, nil
+}
+
+func (s *span) Finish() {
+	s.FinishWithOptions(opentracing.FinishOptions{})
+}
+
+func (s *span) FinishWithOptions(options opentracing.FinishOptions) {
+	if options.FinishTime.IsZero() {
+		options.FinishTime = time.Now()
+	}
+	for _, logData := range options.BulkLogData {
+		options.LogRecords = append(options.LogRecords, logData.ToLogRecord())
+	}
+	s.lock.Lock()
+	for _, logRecord := range options.LogRecords {
+		s.log(logRecord.Timestamp, logRecord.Fields)
+	}
+	s.record.Duration = options.FinishTime.Sub(s.record.Start)
+	s.record.Baggage = s.context.baggage
+	record := s.record
+	s.lock.Unlock()
+	if s.tracer.exporter != nil {
+		s.tracer.exporter.Export(record)
+	}
+}
+
+func (s *span) Context() opentracing.SpanContext {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.context
+}
+
+func (s *span) SetOperationName(name string) opentracing.Span {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.record.Name = name
+	return s
+}
+
+func (s *span) SetTag(key string, value interface{}) opentracing.Span {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.record.Tags == nil {
+		s.record.Tags = make(map[string]interface{})
+	}
+	s.record.Tags[key] = value
+	return s
+}
+
+func (s *span) LogFields(fields ...log.Field) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.log(time.Now(), fields)
+}
+
+func (s *span) LogKV(keyValues ...interface{}) {
+	fields, err := log.InterleavedKVToFields(keyValues...)
+	if err != nil {
+		fields = []log.Field{log.Error(err), log.String("function", "LogKV")}
+	}
+	s.LogFields(fields...)
+}
+
+func (s *span) SetBaggageItem(key string, value string) opentracing.Span {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.setBaggageItem(key, value)
+	return s
+}
+
+func (s *span) BaggageItem(key string) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.context.baggage[key]
+}
+
+func (s *span) Tracer() opentracing.Tracer {
+	return s.tracer
+}
+
+func (s *span) LogEvent(event string) {
+	s.Log(opentracing.LogData{Event: event})
+}
+
+func (s *span) LogEventWithPayload(event string, payload interface{}) {
+	s.Log(opentracing.LogData{Event: event, Payload: payload})
+}
+
+func (s *span) Log(logData opentracing.LogData) {
+	logRecord := logData.ToLogRecord()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.log(logRecord.Timestamp, logRecord.Fields)
+}
+
+// log records fields, s.lock must be held.
+func (s *span) log(timestamp time.Time, fields []log.Field) {
+	logRecord := &LogRecord{
+		Time:   timestamp,
+		Fields: make(map[string]interface{}),
+	}
+	for _, field := range fields {
+		value := field.Value()
+		if err, ok := value.(error); ok {
+			// Errors don't marshal to JSON.
+			value = err.Error()
+		}
+		logRecord.Fields[field.Key()] = value
+	}
+	s.record.Logs = append(s.record.Logs, logRecord)
+}
+
+// setBaggageItem sets a baggage item, the span context is copied because
+// it's shared with the span's children, s.lock must be held.
+func (s *span) setBaggageItem(key string, value string) {
+	baggage := make(map[string]string)
+	for k, v := range s.context.baggage {
+		baggage[k] = v
+	}
+	baggage[key] = value
+	s.context = &spanContext{
+		traceID: s.context.traceID,
+		spanID:  s.context.spanID,
+		baggage: baggage,
+	}
+}
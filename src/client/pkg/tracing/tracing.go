@@ -0,0 +1,132 @@
+// Package tracing records OpenTracing spans of work, such as RPCs, which are
+// linked into traces across processes by injecting their span contexts into
+// gRPC metadata.
+//
+// Spans are started with opentracing.GlobalTracer(), so any OpenTracing
+// tracer can be plugged in with opentracing.SetGlobalTracer.  NewTracer
+// returns one which sends spans to an Exporter, such as a file, for use
+// offline.  A process without a tracer doesn't record spans.
+package tracing
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+)
+
+var (
+	rootLock sync.Mutex
+	root     opentracing.Span
+)
+
+// StartSpan starts a span named name.  Its parent is the span in ctx or, in
+// a gRPC server, the span of the client which made the call.  The returned
+// context carries the new span, to gRPC servers too.  If no tracer is
+// registered it returns a no-op span and ctx.
+func StartSpan(ctx context.Context, name string) (opentracing.Span, context.Context) {
+	if !opentracing.IsGlobalTracerRegistered() {
+		return opentracing.NoopTracer{}.StartSpan(name), ctx
+	}
+	tracer := opentracing.GlobalTracer()
+	var options []opentracing.StartSpanOption
+	if parent := opentracing.SpanFromContext(ctx); parent != nil {
+		options = append(options, opentracing.ChildOf(parent.Context()))
+	} else if md, ok := metadata.FromContext(ctx); ok {
+		if parent, err := tracer.Extract(opentracing.TextMap, metadataCarrier(md)); err == nil {
+			options = append(options, opentracing.ChildOf(parent))
+		}
+	}
+	span := tracer.StartSpan(name, options...)
+	return span, NewContext(ctx, span)
+}
+
+// NewContext returns a context carrying span, to gRPC servers too.  A nil
+// span returns ctx.
+func NewContext(ctx context.Context, span opentracing.Span) context.Context {
+	if span == nil {
+		return ctx
+	}
+	md, ok := metadata.FromContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	if err := span.Tracer().Inject(span.Context(), opentracing.TextMap, metadataCarrier(md)); err == nil {
+		ctx = metadata.NewContext(ctx, md)
+	}
+	return opentracing.ContextWithSpan(ctx, span)
+}
+
+// FromContext returns the span in ctx, if any.
+func FromContext(ctx context.Context) opentracing.Span {
+	return opentracing.SpanFromContext(ctx)
+}
+
+// FinishSpan finishes span, marking it as an error if err isn't nil.
+func FinishSpan(span opentracing.Span, err error) {
+	if err != nil {
+		ext.LogError(span, err)
+	}
+	span.Finish()
+}
+
+// StartRootSpan starts a span which covers the whole process, it's the
+// parent of the spans started from Background.  Processes which do a single
+// thing, like pachctl, use it so that all their RPCs are one trace.
+func StartRootSpan(name string) opentracing.Span {
+	span, _ := StartSpan(context.Background(), name)
+	rootLock.Lock()
+	defer rootLock.Unlock()
+	root = span
+	return span
+}
+
+// FinishRootSpan finishes the span started with StartRootSpan, if it hasn't
+// been finished already, see FinishSpan.  Processes call it on every path
+// to exit, so that the traces of failures are exported too.
+func FinishRootSpan(err error) {
+	rootLock.Lock()
+	span := root
+	root = nil
+	rootLock.Unlock()
+	if span != nil {
+		FinishSpan(span, err)
+	}
+}
+
+// Background returns a context carrying the span started with
+// StartRootSpan, or context.Background() if there isn't one.
+func Background() context.Context {
+	rootLock.Lock()
+	span := root
+	rootLock.Unlock()
+	return NewContext(context.Background(), span)
+}
+
+// metadataCarrier lets span contexts be injected into and extracted from
+// gRPC metadata.
+type metadataCarrier metadata.MD
+
+// Set implements opentracing.TextMapWriter, gRPC metadata keys are lower
+// case.
+func (c metadataCarrier) Set(key string, value string) {
+	c[strings.ToLower(key)] = []string{value}
+}
+
+// ForeachKey implements opentracing.TextMapReader.
+func (c metadataCarrier) ForeachKey(handler func(key string, value string) error) error {
+	for key, values := range c {
+		for _, value := range values {
+			if err := handler(key, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
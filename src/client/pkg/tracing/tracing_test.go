@@ -0,0 +1,84 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/sjezewski/pachyderm/src/client/pkg/require"
+
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// TestNoTracer has to run first, a global tracer can't be unregistered.
+func TestNoTracer(t *testing.T) {
+	span, ctx := StartSpan(context.Background(), "span")
+	require.Equal(t, context.Background(), ctx)
+	// The no-op span is safe to use.
+	span.SetTag("key", "value")
+	FinishSpan(span, nil)
+}
+
+func TestSpans(t *testing.T) {
+	var buf bytes.Buffer
+	opentracing.SetGlobalTracer(NewTracer("test", NewWriterExporter(&buf)))
+	defer opentracing.SetGlobalTracer(opentracing.NoopTracer{})
+
+	client := StartRootSpan("client")
+	client.SetTag("key", "value")
+	client.SetBaggageItem("job", "job-id")
+	// The server only gets the span's context, through the call's metadata.
+	md, ok := metadata.FromContext(Background())
+	require.True(t, ok)
+	serverCtx := metadata.NewContext(context.Background(), md)
+	info := &grpc.UnaryServerInfo{FullMethod: "/pfs.API/GetFile"}
+	var server opentracing.Span
+	_, err := UnaryServerInterceptor(serverCtx, nil, info, func(ctx context.Context, request interface{}) (interface{}, error) {
+		server = FromContext(ctx)
+		return nil, fmt.Errorf("error")
+	})
+	require.YesError(t, err)
+	require.Equal(t, "job-id", server.BaggageItem("job"))
+	FinishRootSpan(nil)
+	// The root span is only finished once.
+	FinishRootSpan(fmt.Errorf("error"))
+
+	// Spans are exported as they finish.
+	decoder := json.NewDecoder(&buf)
+	var records []*Record
+	for decoder.More() {
+		record := &Record{}
+		require.NoError(t, decoder.Decode(record))
+		records = append(records, record)
+	}
+	require.Equal(t, 2, len(records))
+	require.Equal(t, "/pfs.API/GetFile", records[0].Name)
+	require.Equal(t, "client", records[1].Name)
+	require.Equal(t, records[1].TraceID, records[0].TraceID)
+	require.Equal(t, records[1].SpanID, records[0].ParentID)
+	require.Equal(t, "test", records[0].Process)
+	require.Equal(t, true, records[0].Tags["error"])
+	require.Equal(t, "error", records[0].Logs[0].Fields["error.object"])
+	require.Equal(t, "value", records[1].Tags["key"])
+	require.Equal(t, 0, len(records[1].Logs))
+}
+
+func TestInjectExtract(t *testing.T) {
+	tracer := NewTracer("test", nil)
+	span := tracer.StartSpan("span")
+	span.SetBaggageItem("key", "value")
+	carrier := opentracing.TextMapCarrier{}
+	require.NoError(t, tracer.Inject(span.Context(), opentracing.TextMap, carrier))
+	context, err := tracer.Extract(opentracing.TextMap, carrier)
+	require.NoError(t, err)
+	child := tracer.StartSpan("child", opentracing.ChildOf(context))
+	require.Equal(t, "value", child.BaggageItem("key"))
+
+	_, err = tracer.Extract(opentracing.TextMap, opentracing.TextMapCarrier{})
+	require.Equal(t, opentracing.ErrSpanContextNotFound, err)
+	require.Equal(t, opentracing.ErrUnsupportedFormat, tracer.Inject(span.Context(), opentracing.Binary, carrier))
+}
@@ -86,6 +86,19 @@ func NewPipelineInput(repoName string, method *pps.Method) *pps.PipelineInput {
 	}
 }
 
+// NewCronInput returns a pipeline input which is triggered on a schedule,
+// see pps.CronInput.  repoName may be empty in which case the input's repo
+// is named after the pipeline.
+func NewCronInput(repoName string, spec string) *pps.PipelineInput {
+	result := &pps.PipelineInput{
+		Cron: &pps.CronInput{Spec: spec},
+	}
+	if repoName != "" {
+		result.Repo = NewRepo(repoName)
+	}
+	return result
+}
+
 // CreateJob creates and runs a job in PPS.
 // image is the Docker image to run the job in.
 // cmd is the command passed to the Docker run invocation.
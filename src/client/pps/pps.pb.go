@@ -11,6 +11,7 @@ It is generated from these files:
 It has these top-level messages:
 	Secret
 	Transform
+	RetryPolicy
 	Job
 	Method
 	JobInput
@@ -20,6 +21,7 @@ It has these top-level messages:
 	Pod
 	JobInfos
 	Pipeline
+	CronInput
 	PipelineInput
 	PipelineInfo
 	PipelineInfos
@@ -39,9 +41,10 @@ package pps
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
-import google_protobuf "go.pedge.io/pb/go/google/protobuf"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
 import google_protobuf1 "go.pedge.io/pb/go/google/protobuf"
 import google_protobuf2 "go.pedge.io/pb/go/google/protobuf"
+import google_protobuf3 "go.pedge.io/pb/go/google/protobuf"
 import pfs "github.com/sjezewski/pachyderm/src/client/pfs"
 
 import (
@@ -60,6 +63,31 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// PfsMode is how a job's pods access pfs.
+type PfsMode int32
+
+const (
+	// FUSE mounts the job's commits at /pfs, which requires privileged pods.
+	PfsMode_FUSE PfsMode = 0
+	// COPY downloads the job's inputs to /pfs before running the transform
+	// and uploads /pfs/out afterwards, pods don't need to be privileged.
+	PfsMode_COPY PfsMode = 1
+)
+
+var PfsMode_name = map[int32]string{
+	0: "FUSE",
+	1: "COPY",
+}
+var PfsMode_value = map[string]int32{
+	"FUSE": 0,
+	"COPY": 1,
+}
+
+func (x PfsMode) String() string {
+	return proto.EnumName(PfsMode_name, int32(x))
+}
+func (PfsMode) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
 type JobState int32
 
 const (
@@ -90,7 +118,7 @@ var JobState_value = map[string]int32{
 func (x JobState) String() string {
 	return proto.EnumName(JobState_name, int32(x))
 }
-func (JobState) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+func (JobState) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
 
 type Partition int32
 
@@ -114,7 +142,7 @@ var Partition_value = map[string]int32{
 func (x Partition) String() string {
 	return proto.EnumName(Partition_name, int32(x))
 }
-func (Partition) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (Partition) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
 
 type Incremental int32
 
@@ -138,7 +166,7 @@ var Incremental_value = map[string]int32{
 func (x Incremental) String() string {
 	return proto.EnumName(Incremental_name, int32(x))
 }
-func (Incremental) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (Incremental) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
 type ChunkState int32
 
@@ -165,7 +193,7 @@ var ChunkState_value = map[string]int32{
 func (x ChunkState) String() string {
 	return proto.EnumName(ChunkState_name, int32(x))
 }
-func (ChunkState) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (ChunkState) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 type PodState int32
 
@@ -189,7 +217,7 @@ var PodState_value = map[string]int32{
 func (x PodState) String() string {
 	return proto.EnumName(PodState_name, int32(x))
 }
-func (PodState) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (PodState) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 type PipelineState int32
 
@@ -229,7 +257,43 @@ var PipelineState_value = map[string]int32{
 func (x PipelineState) String() string {
 	return proto.EnumName(PipelineState_name, int32(x))
 }
-func (PipelineState) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (PipelineState) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+// Combinator says how the datums of the inputs of a pipeline are combined
+// into the work of its jobs.
+type Combinator int32
+
+const (
+	// Every datum of each input is processed with every datum of the other
+	// inputs.
+	Combinator_CROSS Combinator = 0
+	// The datums of each input are processed on their own, without the
+	// other inputs.
+	Combinator_UNION Combinator = 1
+	// Datums with the same path in different inputs are processed together.
+	Combinator_JOIN_ON_PATH Combinator = 2
+	// Datums whose globs capture the same string in different inputs are
+	// processed together.
+	Combinator_JOIN_ON_CAPTURE Combinator = 3
+)
+
+var Combinator_name = map[int32]string{
+	0: "CROSS",
+	1: "UNION",
+	2: "JOIN_ON_PATH",
+	3: "JOIN_ON_CAPTURE",
+}
+var Combinator_value = map[string]int32{
+	"CROSS":           0,
+	"UNION":           1,
+	"JOIN_ON_PATH":    2,
+	"JOIN_ON_CAPTURE": 3,
+}
+
+func (x Combinator) String() string {
+	return proto.EnumName(Combinator_name, int32(x))
+}
+func (Combinator) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 // Which Parallelism strategy to use. Depending on the value of
 // 'strategy', other messages in the spec will or will not be set.
@@ -252,7 +316,7 @@ var ParallelismSpec_Strategy_value = map[string]int32{
 func (x ParallelismSpec_Strategy) String() string {
 	return proto.EnumName(ParallelismSpec_Strategy_name, int32(x))
 }
-func (ParallelismSpec_Strategy) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{5, 0} }
+func (ParallelismSpec_Strategy) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{6, 0} }
 
 type Secret struct {
 	// Name must be the name of the secret in kubernetes.
@@ -273,6 +337,8 @@ type Transform struct {
 	Stdin            []string          `protobuf:"bytes,5,rep,name=stdin" json:"stdin,omitempty"`
 	AcceptReturnCode []int64           `protobuf:"varint,6,rep,packed,name=accept_return_code,json=acceptReturnCode" json:"accept_return_code,omitempty"`
 	Debug            bool              `protobuf:"varint,7,opt,name=debug" json:"debug,omitempty"`
+	PfsMode          PfsMode           `protobuf:"varint,8,opt,name=pfs_mode,json=pfsMode,enum=pps.PfsMode" json:"pfs_mode,omitempty"`
+	RetryPolicy      *RetryPolicy      `protobuf:"bytes,9,opt,name=retry_policy,json=retryPolicy" json:"retry_policy,omitempty"`
 }
 
 func (m *Transform) Reset()                    { *m = Transform{} }
@@ -294,6 +360,31 @@ func (m *Transform) GetSecrets() []*Secret {
 	return nil
 }
 
+func (m *Transform) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+// RetryPolicy says how many times, and when, a chunk whose pod failed is
+// retried before its job fails.
+type RetryPolicy struct {
+	// The number of times a chunk is attempted, 0 means the default of 3.
+	MaxAttempts uint64 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts" json:"max_attempts,omitempty"`
+	// How long to wait before retrying a chunk, it doubles with each attempt.
+	BackoffSeconds uint64 `protobuf:"varint,2,opt,name=backoff_seconds,json=backoffSeconds" json:"backoff_seconds,omitempty"`
+	// If set, only failures in which the transform exited with one of these
+	// codes are retried.  Failures which aren't the transform exiting, such as
+	// the pod being lost, are always retried.
+	RetryOnExitCode []int64 `protobuf:"varint,3,rep,packed,name=retry_on_exit_code,json=retryOnExitCode" json:"retry_on_exit_code,omitempty"`
+}
+
+func (m *RetryPolicy) Reset()                    { *m = RetryPolicy{} }
+func (m *RetryPolicy) String() string            { return proto.CompactTextString(m) }
+func (*RetryPolicy) ProtoMessage()               {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
 type Job struct {
 	ID string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 }
@@ -301,17 +392,26 @@ type Job struct {
 func (m *Job) Reset()                    { *m = Job{} }
 func (m *Job) String() string            { return proto.CompactTextString(m) }
 func (*Job) ProtoMessage()               {}
-func (*Job) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*Job) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
 type Method struct {
 	Partition   Partition   `protobuf:"varint,1,opt,name=partition,enum=pps.Partition" json:"partition,omitempty"`
 	Incremental Incremental `protobuf:"varint,2,opt,name=incremental,enum=pps.Incremental" json:"incremental,omitempty"`
+	// file_hash selects how files are assigned to shards by the FILE partition.
+	FileHash *pfs.FileHash `protobuf:"bytes,3,opt,name=file_hash,json=fileHash" json:"file_hash,omitempty"`
 }
 
 func (m *Method) Reset()                    { *m = Method{} }
 func (m *Method) String() string            { return proto.CompactTextString(m) }
 func (*Method) ProtoMessage()               {}
-func (*Method) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*Method) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *Method) GetFileHash() *pfs.FileHash {
+	if m != nil {
+		return m.FileHash
+	}
+	return nil
+}
 
 type JobInput struct {
 	Commit *pfs.Commit `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
@@ -319,12 +419,15 @@ type JobInput struct {
 	// This flag specifies whether the pipeline should be triggered
 	// when this input gets an empty commit.
 	RunEmpty bool `protobuf:"varint,3,opt,name=run_empty,json=runEmpty" json:"run_empty,omitempty"`
+	// glob, if set, defines the datums of this input, each path which
+	// matches it is a unit of work which is given to exactly one chunk.
+	Glob string `protobuf:"bytes,4,opt,name=glob" json:"glob,omitempty"`
 }
 
 func (m *JobInput) Reset()                    { *m = JobInput{} }
 func (m *JobInput) String() string            { return proto.CompactTextString(m) }
 func (*JobInput) ProtoMessage()               {}
-func (*JobInput) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*JobInput) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 func (m *JobInput) GetCommit() *pfs.Commit {
 	if m != nil {
@@ -365,7 +468,7 @@ type ParallelismSpec struct {
 func (m *ParallelismSpec) Reset()                    { *m = ParallelismSpec{} }
 func (m *ParallelismSpec) String() string            { return proto.CompactTextString(m) }
 func (*ParallelismSpec) ProtoMessage()               {}
-func (*ParallelismSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*ParallelismSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 type JobInfo struct {
 	Job             *Job                        `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
@@ -375,17 +478,18 @@ type JobInfo struct {
 	ParallelismSpec *ParallelismSpec            `protobuf:"bytes,12,opt,name=parallelism_spec,json=parallelismSpec" json:"parallelism_spec,omitempty"`
 	Inputs          []*JobInput                 `protobuf:"bytes,5,rep,name=inputs" json:"inputs,omitempty"`
 	ParentJob       *Job                        `protobuf:"bytes,6,opt,name=parent_job,json=parentJob" json:"parent_job,omitempty"`
-	Started         *google_protobuf1.Timestamp `protobuf:"bytes,7,opt,name=started" json:"started,omitempty"`
-	Finished        *google_protobuf1.Timestamp `protobuf:"bytes,8,opt,name=finished" json:"finished,omitempty"`
+	Started         *google_protobuf2.Timestamp `protobuf:"bytes,7,opt,name=started" json:"started,omitempty"`
+	Finished        *google_protobuf2.Timestamp `protobuf:"bytes,8,opt,name=finished" json:"finished,omitempty"`
 	OutputCommit    *pfs.Commit                 `protobuf:"bytes,9,opt,name=output_commit,json=outputCommit" json:"output_commit,omitempty"`
 	State           JobState                    `protobuf:"varint,10,opt,name=state,enum=pps.JobState" json:"state,omitempty"`
 	Chunks          []*Chunk                    `protobuf:"bytes,11,rep,name=chunks" json:"chunks,omitempty"`
+	Combinator      Combinator                  `protobuf:"varint,14,opt,name=combinator,enum=pps.Combinator" json:"combinator,omitempty"`
 }
 
 func (m *JobInfo) Reset()                    { *m = JobInfo{} }
 func (m *JobInfo) String() string            { return proto.CompactTextString(m) }
 func (*JobInfo) ProtoMessage()               {}
-func (*JobInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*JobInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 func (m *JobInfo) GetJob() *Job {
 	if m != nil {
@@ -429,14 +533,14 @@ func (m *JobInfo) GetParentJob() *Job {
 	return nil
 }
 
-func (m *JobInfo) GetStarted() *google_protobuf1.Timestamp {
+func (m *JobInfo) GetStarted() *google_protobuf2.Timestamp {
 	if m != nil {
 		return m.Started
 	}
 	return nil
 }
 
-func (m *JobInfo) GetFinished() *google_protobuf1.Timestamp {
+func (m *JobInfo) GetFinished() *google_protobuf2.Timestamp {
 	if m != nil {
 		return m.Finished
 	}
@@ -462,12 +566,14 @@ type Chunk struct {
 	// A series of pods, in chronological order, that have processed this shard
 	Pods  []*Pod     `protobuf:"bytes,2,rep,name=pods" json:"pods,omitempty"`
 	State ChunkState `protobuf:"varint,3,opt,name=state,enum=pps.ChunkState" json:"state,omitempty"`
+	// the number of times the chunk has been attempted
+	Attempts uint64 `protobuf:"varint,4,opt,name=attempts" json:"attempts,omitempty"`
 }
 
 func (m *Chunk) Reset()                    { *m = Chunk{} }
 func (m *Chunk) String() string            { return proto.CompactTextString(m) }
 func (*Chunk) ProtoMessage()               {}
-func (*Chunk) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*Chunk) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 func (m *Chunk) GetPods() []*Pod {
 	if m != nil {
@@ -480,12 +586,14 @@ type Pod struct {
 	Name         string      `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	OutputCommit *pfs.Commit `protobuf:"bytes,2,opt,name=output_commit,json=outputCommit" json:"output_commit,omitempty"`
 	State        PodState    `protobuf:"varint,3,opt,name=state,enum=pps.PodState" json:"state,omitempty"`
+	// why the pod failed, if it did
+	Error string `protobuf:"bytes,4,opt,name=error" json:"error,omitempty"`
 }
 
 func (m *Pod) Reset()                    { *m = Pod{} }
 func (m *Pod) String() string            { return proto.CompactTextString(m) }
 func (*Pod) ProtoMessage()               {}
-func (*Pod) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*Pod) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *Pod) GetOutputCommit() *pfs.Commit {
 	if m != nil {
@@ -501,7 +609,7 @@ type JobInfos struct {
 func (m *JobInfos) Reset()                    { *m = JobInfos{} }
 func (m *JobInfos) String() string            { return proto.CompactTextString(m) }
 func (*JobInfos) ProtoMessage()               {}
-func (*JobInfos) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*JobInfos) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *JobInfos) GetJobInfo() []*JobInfo {
 	if m != nil {
@@ -517,7 +625,20 @@ type Pipeline struct {
 func (m *Pipeline) Reset()                    { *m = Pipeline{} }
 func (m *Pipeline) String() string            { return proto.CompactTextString(m) }
 func (*Pipeline) ProtoMessage()               {}
-func (*Pipeline) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*Pipeline) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+// CronInput triggers a pipeline on a schedule, pps makes a commit in the
+// input's repo each time the schedule fires.
+type CronInput struct {
+	// spec is a cron spec, such as "0 2 * * *", or an interval, such as
+	// "@every 1h".
+	Spec string `protobuf:"bytes,1,opt,name=spec" json:"spec,omitempty"`
+}
+
+func (m *CronInput) Reset()                    { *m = CronInput{} }
+func (m *CronInput) String() string            { return proto.CompactTextString(m) }
+func (*CronInput) ProtoMessage()               {}
+func (*CronInput) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 type PipelineInput struct {
 	Repo   *pfs.Repo `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
@@ -525,12 +646,18 @@ type PipelineInput struct {
 	// This flag specifies whether the pipeline should be triggered
 	// when this input gets an empty commit.
 	RunEmpty bool `protobuf:"varint,3,opt,name=run_empty,json=runEmpty" json:"run_empty,omitempty"`
+	// cron, if set, makes this input a scheduled input, repo defaults to
+	// <pipeline>_cron and is created by pps.
+	Cron *CronInput `protobuf:"bytes,4,opt,name=cron" json:"cron,omitempty"`
+	// glob, if set, defines the datums of this input, each path which
+	// matches it is a unit of work which is given to exactly one chunk.
+	Glob string `protobuf:"bytes,5,opt,name=glob" json:"glob,omitempty"`
 }
 
 func (m *PipelineInput) Reset()                    { *m = PipelineInput{} }
 func (m *PipelineInput) String() string            { return proto.CompactTextString(m) }
 func (*PipelineInput) ProtoMessage()               {}
-func (*PipelineInput) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*PipelineInput) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 func (m *PipelineInput) GetRepo() *pfs.Repo {
 	if m != nil {
@@ -546,6 +673,13 @@ func (m *PipelineInput) GetMethod() *Method {
 	return nil
 }
 
+func (m *PipelineInput) GetCron() *CronInput {
+	if m != nil {
+		return m.Cron
+	}
+	return nil
+}
+
 type PipelineInfo struct {
 	Pipeline        *Pipeline                   `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
 	Version         uint64                      `protobuf:"varint,11,opt,name=version" json:"version,omitempty"`
@@ -553,16 +687,17 @@ type PipelineInfo struct {
 	ParallelismSpec *ParallelismSpec            `protobuf:"bytes,10,opt,name=parallelism_spec,json=parallelismSpec" json:"parallelism_spec,omitempty"`
 	Inputs          []*PipelineInput            `protobuf:"bytes,4,rep,name=inputs" json:"inputs,omitempty"`
 	OutputRepo      *pfs.Repo                   `protobuf:"bytes,5,opt,name=output_repo,json=outputRepo" json:"output_repo,omitempty"`
-	CreatedAt       *google_protobuf1.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	CreatedAt       *google_protobuf2.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
 	State           PipelineState               `protobuf:"varint,7,opt,name=state,enum=pps.PipelineState" json:"state,omitempty"`
 	RecentError     string                      `protobuf:"bytes,8,opt,name=recent_error,json=recentError" json:"recent_error,omitempty"`
 	JobCounts       map[int32]int32             `protobuf:"bytes,9,rep,name=job_counts,json=jobCounts" json:"job_counts,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Combinator      Combinator                  `protobuf:"varint,12,opt,name=combinator,enum=pps.Combinator" json:"combinator,omitempty"`
 }
 
 func (m *PipelineInfo) Reset()                    { *m = PipelineInfo{} }
 func (m *PipelineInfo) String() string            { return proto.CompactTextString(m) }
 func (*PipelineInfo) ProtoMessage()               {}
-func (*PipelineInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*PipelineInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *PipelineInfo) GetPipeline() *Pipeline {
 	if m != nil {
@@ -599,7 +734,7 @@ func (m *PipelineInfo) GetOutputRepo() *pfs.Repo {
 	return nil
 }
 
-func (m *PipelineInfo) GetCreatedAt() *google_protobuf1.Timestamp {
+func (m *PipelineInfo) GetCreatedAt() *google_protobuf2.Timestamp {
 	if m != nil {
 		return m.CreatedAt
 	}
@@ -620,7 +755,7 @@ type PipelineInfos struct {
 func (m *PipelineInfos) Reset()                    { *m = PipelineInfos{} }
 func (m *PipelineInfos) String() string            { return proto.CompactTextString(m) }
 func (*PipelineInfos) ProtoMessage()               {}
-func (*PipelineInfos) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*PipelineInfos) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *PipelineInfos) GetPipelineInfo() []*PipelineInfo {
 	if m != nil {
@@ -636,12 +771,13 @@ type CreateJobRequest struct {
 	Inputs          []*JobInput      `protobuf:"bytes,4,rep,name=inputs" json:"inputs,omitempty"`
 	ParentJob       *Job             `protobuf:"bytes,5,opt,name=parent_job,json=parentJob" json:"parent_job,omitempty"`
 	Force           bool             `protobuf:"varint,6,opt,name=force" json:"force,omitempty"`
+	Combinator      Combinator       `protobuf:"varint,8,opt,name=combinator,enum=pps.Combinator" json:"combinator,omitempty"`
 }
 
 func (m *CreateJobRequest) Reset()                    { *m = CreateJobRequest{} }
 func (m *CreateJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateJobRequest) ProtoMessage()               {}
-func (*CreateJobRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*CreateJobRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *CreateJobRequest) GetTransform() *Transform {
 	if m != nil {
@@ -686,7 +822,7 @@ type InspectJobRequest struct {
 func (m *InspectJobRequest) Reset()                    { *m = InspectJobRequest{} }
 func (m *InspectJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectJobRequest) ProtoMessage()               {}
-func (*InspectJobRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*InspectJobRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *InspectJobRequest) GetJob() *Job {
 	if m != nil {
@@ -703,7 +839,7 @@ type ListJobRequest struct {
 func (m *ListJobRequest) Reset()                    { *m = ListJobRequest{} }
 func (m *ListJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListJobRequest) ProtoMessage()               {}
-func (*ListJobRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*ListJobRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 func (m *ListJobRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -726,7 +862,7 @@ type GetLogsRequest struct {
 func (m *GetLogsRequest) Reset()                    { *m = GetLogsRequest{} }
 func (m *GetLogsRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetLogsRequest) ProtoMessage()               {}
-func (*GetLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*GetLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *GetLogsRequest) GetJob() *Job {
 	if m != nil {
@@ -742,12 +878,13 @@ type CreatePipelineRequest struct {
 	Inputs          []*PipelineInput `protobuf:"bytes,4,rep,name=inputs" json:"inputs,omitempty"`
 	Update          bool             `protobuf:"varint,5,opt,name=update" json:"update,omitempty"`
 	NoArchive       bool             `protobuf:"varint,6,opt,name=no_archive,json=noArchive" json:"no_archive,omitempty"`
+	Combinator      Combinator       `protobuf:"varint,8,opt,name=combinator,enum=pps.Combinator" json:"combinator,omitempty"`
 }
 
 func (m *CreatePipelineRequest) Reset()                    { *m = CreatePipelineRequest{} }
 func (m *CreatePipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreatePipelineRequest) ProtoMessage()               {}
-func (*CreatePipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*CreatePipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *CreatePipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -784,7 +921,7 @@ type InspectPipelineRequest struct {
 func (m *InspectPipelineRequest) Reset()                    { *m = InspectPipelineRequest{} }
 func (m *InspectPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectPipelineRequest) ProtoMessage()               {}
-func (*InspectPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*InspectPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *InspectPipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -799,7 +936,7 @@ type ListPipelineRequest struct {
 func (m *ListPipelineRequest) Reset()                    { *m = ListPipelineRequest{} }
 func (m *ListPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListPipelineRequest) ProtoMessage()               {}
-func (*ListPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*ListPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 type DeletePipelineRequest struct {
 	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
@@ -808,7 +945,7 @@ type DeletePipelineRequest struct {
 func (m *DeletePipelineRequest) Reset()                    { *m = DeletePipelineRequest{} }
 func (m *DeletePipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeletePipelineRequest) ProtoMessage()               {}
-func (*DeletePipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*DeletePipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *DeletePipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -824,7 +961,7 @@ type StartPipelineRequest struct {
 func (m *StartPipelineRequest) Reset()                    { *m = StartPipelineRequest{} }
 func (m *StartPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*StartPipelineRequest) ProtoMessage()               {}
-func (*StartPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*StartPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *StartPipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -840,7 +977,7 @@ type StopPipelineRequest struct {
 func (m *StopPipelineRequest) Reset()                    { *m = StopPipelineRequest{} }
 func (m *StopPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*StopPipelineRequest) ProtoMessage()               {}
-func (*StopPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*StopPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *StopPipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -852,6 +989,7 @@ func (m *StopPipelineRequest) GetPipeline() *Pipeline {
 func init() {
 	proto.RegisterType((*Secret)(nil), "pps.Secret")
 	proto.RegisterType((*Transform)(nil), "pps.Transform")
+	proto.RegisterType((*RetryPolicy)(nil), "pps.RetryPolicy")
 	proto.RegisterType((*Job)(nil), "pps.Job")
 	proto.RegisterType((*Method)(nil), "pps.Method")
 	proto.RegisterType((*JobInput)(nil), "pps.JobInput")
@@ -861,6 +999,7 @@ func init() {
 	proto.RegisterType((*Pod)(nil), "pps.Pod")
 	proto.RegisterType((*JobInfos)(nil), "pps.JobInfos")
 	proto.RegisterType((*Pipeline)(nil), "pps.Pipeline")
+	proto.RegisterType((*CronInput)(nil), "pps.CronInput")
 	proto.RegisterType((*PipelineInput)(nil), "pps.PipelineInput")
 	proto.RegisterType((*PipelineInfo)(nil), "pps.PipelineInfo")
 	proto.RegisterType((*PipelineInfos)(nil), "pps.PipelineInfos")
@@ -874,12 +1013,14 @@ func init() {
 	proto.RegisterType((*DeletePipelineRequest)(nil), "pps.DeletePipelineRequest")
 	proto.RegisterType((*StartPipelineRequest)(nil), "pps.StartPipelineRequest")
 	proto.RegisterType((*StopPipelineRequest)(nil), "pps.StopPipelineRequest")
+	proto.RegisterEnum("pps.PfsMode", PfsMode_name, PfsMode_value)
 	proto.RegisterEnum("pps.JobState", JobState_name, JobState_value)
 	proto.RegisterEnum("pps.Partition", Partition_name, Partition_value)
 	proto.RegisterEnum("pps.Incremental", Incremental_name, Incremental_value)
 	proto.RegisterEnum("pps.ChunkState", ChunkState_name, ChunkState_value)
 	proto.RegisterEnum("pps.PodState", PodState_name, PodState_value)
 	proto.RegisterEnum("pps.PipelineState", PipelineState_name, PipelineState_value)
+	proto.RegisterEnum("pps.Combinator", Combinator_name, Combinator_value)
 	proto.RegisterEnum("pps.ParallelismSpec_Strategy", ParallelismSpec_Strategy_name, ParallelismSpec_Strategy_value)
 }
 
@@ -898,14 +1039,14 @@ type APIClient interface {
 	InspectJob(ctx context.Context, in *InspectJobRequest, opts ...grpc.CallOption) (*JobInfo, error)
 	ListJob(ctx context.Context, in *ListJobRequest, opts ...grpc.CallOption) (*JobInfos, error)
 	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (API_GetLogsClient, error)
-	CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 	InspectPipeline(ctx context.Context, in *InspectPipelineRequest, opts ...grpc.CallOption) (*PipelineInfo, error)
 	ListPipeline(ctx context.Context, in *ListPipelineRequest, opts ...grpc.CallOption) (*PipelineInfos, error)
-	DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
-	StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
-	StopPipeline(ctx context.Context, in *StopPipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	StopPipeline(ctx context.Context, in *StopPipelineRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 	// DeleteAll deletes everything
-	DeleteAll(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	DeleteAll(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 }
 
 type aPIClient struct {
@@ -959,7 +1100,7 @@ func (c *aPIClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grp
 }
 
 type API_GetLogsClient interface {
-	Recv() (*google_protobuf2.BytesValue, error)
+	Recv() (*google_protobuf3.BytesValue, error)
 	grpc.ClientStream
 }
 
@@ -967,16 +1108,16 @@ type aPIGetLogsClient struct {
 	grpc.ClientStream
 }
 
-func (x *aPIGetLogsClient) Recv() (*google_protobuf2.BytesValue, error) {
-	m := new(google_protobuf2.BytesValue)
+func (x *aPIGetLogsClient) Recv() (*google_protobuf3.BytesValue, error) {
+	m := new(google_protobuf3.BytesValue)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *aPIClient) CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
+func (c *aPIClient) CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
 	err := grpc.Invoke(ctx, "/pps.API/CreatePipeline", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
@@ -1002,8 +1143,8 @@ func (c *aPIClient) ListPipeline(ctx context.Context, in *ListPipelineRequest, o
 	return out, nil
 }
 
-func (c *aPIClient) DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
+func (c *aPIClient) DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
 	err := grpc.Invoke(ctx, "/pps.API/DeletePipeline", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
@@ -1011,8 +1152,8 @@ func (c *aPIClient) DeletePipeline(ctx context.Context, in *DeletePipelineReques
 	return out, nil
 }
 
-func (c *aPIClient) StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
+func (c *aPIClient) StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
 	err := grpc.Invoke(ctx, "/pps.API/StartPipeline", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
@@ -1020,8 +1161,8 @@ func (c *aPIClient) StartPipeline(ctx context.Context, in *StartPipelineRequest,
 	return out, nil
 }
 
-func (c *aPIClient) StopPipeline(ctx context.Context, in *StopPipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
+func (c *aPIClient) StopPipeline(ctx context.Context, in *StopPipelineRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
 	err := grpc.Invoke(ctx, "/pps.API/StopPipeline", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
@@ -1029,8 +1170,8 @@ func (c *aPIClient) StopPipeline(ctx context.Context, in *StopPipelineRequest, o
 	return out, nil
 }
 
-func (c *aPIClient) DeleteAll(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
+func (c *aPIClient) DeleteAll(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
 	err := grpc.Invoke(ctx, "/pps.API/DeleteAll", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
@@ -1045,14 +1186,14 @@ type APIServer interface {
 	InspectJob(context.Context, *InspectJobRequest) (*JobInfo, error)
 	ListJob(context.Context, *ListJobRequest) (*JobInfos, error)
 	GetLogs(*GetLogsRequest, API_GetLogsServer) error
-	CreatePipeline(context.Context, *CreatePipelineRequest) (*google_protobuf.Empty, error)
+	CreatePipeline(context.Context, *CreatePipelineRequest) (*google_protobuf1.Empty, error)
 	InspectPipeline(context.Context, *InspectPipelineRequest) (*PipelineInfo, error)
 	ListPipeline(context.Context, *ListPipelineRequest) (*PipelineInfos, error)
-	DeletePipeline(context.Context, *DeletePipelineRequest) (*google_protobuf.Empty, error)
-	StartPipeline(context.Context, *StartPipelineRequest) (*google_protobuf.Empty, error)
-	StopPipeline(context.Context, *StopPipelineRequest) (*google_protobuf.Empty, error)
+	DeletePipeline(context.Context, *DeletePipelineRequest) (*google_protobuf1.Empty, error)
+	StartPipeline(context.Context, *StartPipelineRequest) (*google_protobuf1.Empty, error)
+	StopPipeline(context.Context, *StopPipelineRequest) (*google_protobuf1.Empty, error)
 	// DeleteAll deletes everything
-	DeleteAll(context.Context, *google_protobuf.Empty) (*google_protobuf.Empty, error)
+	DeleteAll(context.Context, *google_protobuf1.Empty) (*google_protobuf1.Empty, error)
 }
 
 func RegisterAPIServer(s *grpc.Server, srv APIServer) {
@@ -1122,7 +1263,7 @@ func _API_GetLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
 }
 
 type API_GetLogsServer interface {
-	Send(*google_protobuf2.BytesValue) error
+	Send(*google_protobuf3.BytesValue) error
 	grpc.ServerStream
 }
 
@@ -1130,7 +1271,7 @@ type aPIGetLogsServer struct {
 	grpc.ServerStream
 }
 
-func (x *aPIGetLogsServer) Send(m *google_protobuf2.BytesValue) error {
+func (x *aPIGetLogsServer) Send(m *google_protobuf3.BytesValue) error {
 	return x.ServerStream.SendMsg(m)
 }
 
@@ -1243,7 +1384,7 @@ func _API_StopPipeline_Handler(srv interface{}, ctx context.Context, dec func(in
 }
 
 func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(google_protobuf.Empty)
+	in := new(google_protobuf1.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1255,7 +1396,7 @@ func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(inter
 		FullMethod: "/pps.API/DeleteAll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteAll(ctx, req.(*google_protobuf.Empty))
+		return srv.(APIServer).DeleteAll(ctx, req.(*google_protobuf1.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1318,115 +1459,144 @@ var _API_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("client/pps/pps.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1748 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x58, 0x6d, 0x6f, 0xdb, 0xc8,
-	0x11, 0x96, 0x44, 0xbd, 0x90, 0xa3, 0x17, 0x33, 0x1b, 0x27, 0xc7, 0x2a, 0xcd, 0x9d, 0xcb, 0x22,
-	0xa8, 0xa3, 0x06, 0xca, 0xc1, 0x77, 0x08, 0x7a, 0xed, 0xb5, 0xa9, 0x2c, 0x53, 0xa9, 0x7c, 0x3a,
-	0x59, 0x58, 0xc9, 0x07, 0xb4, 0x38, 0x54, 0xa0, 0xa8, 0x95, 0x4d, 0x47, 0xe2, 0xf2, 0xc8, 0x95,
-	0x0b, 0x7f, 0xe8, 0xef, 0x68, 0xd1, 0x3f, 0x50, 0xf4, 0x07, 0xf4, 0x53, 0xff, 0x5c, 0xb1, 0xbb,
-	0x24, 0x45, 0xca, 0x8a, 0x1b, 0x27, 0xbd, 0x0f, 0x06, 0xb8, 0xcf, 0x0c, 0x67, 0x67, 0x86, 0xcf,
-	0x3c, 0xbb, 0x32, 0xec, 0x3b, 0x4b, 0x97, 0x78, 0xec, 0xa5, 0xef, 0x87, 0xfc, 0xaf, 0xed, 0x07,
-	0x94, 0x51, 0xa4, 0xf8, 0x7e, 0xd8, 0x7c, 0x72, 0x41, 0xe9, 0xc5, 0x92, 0xbc, 0x14, 0xd0, 0x6c,
-	0xbd, 0x78, 0x49, 0x56, 0x3e, 0xbb, 0x91, 0x1e, 0xcd, 0xcf, 0xb6, 0x8d, 0xcc, 0x5d, 0x91, 0x90,
-	0xd9, 0x2b, 0x3f, 0x72, 0xf8, 0x74, 0xdb, 0xe1, 0x2f, 0x81, 0xed, 0xfb, 0x24, 0x88, 0xb6, 0x68,
-	0x26, 0x1b, 0x2f, 0x42, 0xfe, 0x27, 0x51, 0xf3, 0x37, 0x50, 0x1e, 0x13, 0x27, 0x20, 0x0c, 0x21,
-	0x28, 0x7a, 0xf6, 0x8a, 0x18, 0xf9, 0x83, 0xfc, 0xa1, 0x86, 0xc5, 0x33, 0x7a, 0x0a, 0xb0, 0xa2,
-	0x6b, 0x8f, 0x4d, 0x7d, 0x9b, 0x5d, 0x1a, 0x05, 0x61, 0xd1, 0x04, 0x32, 0xb2, 0xd9, 0xa5, 0xf9,
-	0xb7, 0x02, 0x68, 0x93, 0xc0, 0xf6, 0xc2, 0x05, 0x0d, 0x56, 0x68, 0x1f, 0x4a, 0xee, 0xca, 0xbe,
-	0x88, 0x23, 0xc8, 0x05, 0xd2, 0x41, 0x71, 0x56, 0x73, 0xa3, 0x70, 0xa0, 0x1c, 0x6a, 0x98, 0x3f,
-	0xa2, 0xe7, 0xa0, 0x10, 0xef, 0xda, 0x50, 0x0e, 0x94, 0xc3, 0xea, 0xd1, 0x27, 0x6d, 0xde, 0x84,
-	0x24, 0x48, 0xdb, 0xf2, 0xae, 0x2d, 0x8f, 0x05, 0x37, 0x98, 0xfb, 0xa0, 0x67, 0x50, 0x09, 0x45,
-	0x76, 0xa1, 0x51, 0x14, 0xee, 0x55, 0xe1, 0x2e, 0x33, 0xc6, 0xb1, 0x8d, 0xef, 0x1c, 0xb2, 0xb9,
-	0xeb, 0x19, 0x25, 0xb1, 0x8b, 0x5c, 0xa0, 0x17, 0x80, 0x6c, 0xc7, 0x21, 0x3e, 0x9b, 0x06, 0x84,
-	0xad, 0x03, 0x6f, 0xea, 0xd0, 0x39, 0x31, 0xca, 0x07, 0xca, 0xa1, 0x82, 0x75, 0x69, 0xc1, 0xc2,
-	0xd0, 0xa5, 0x73, 0xc2, 0x63, 0xcc, 0xc9, 0x6c, 0x7d, 0x61, 0x54, 0x0e, 0xf2, 0x87, 0x2a, 0x96,
-	0x8b, 0xe6, 0x2b, 0x50, 0xe3, 0x8c, 0x78, 0x25, 0x6f, 0xc9, 0x4d, 0x54, 0x1d, 0x7f, 0xe4, 0xef,
-	0x5c, 0xdb, 0xcb, 0x35, 0x89, 0x3a, 0x23, 0x17, 0xbf, 0x2e, 0xfc, 0x2a, 0x6f, 0x3e, 0x02, 0xe5,
-	0x94, 0xce, 0x50, 0x03, 0x0a, 0xee, 0x3c, 0x7a, 0xa3, 0xe0, 0xce, 0xcd, 0x2b, 0x28, 0x7f, 0x4b,
-	0xd8, 0x25, 0x9d, 0xa3, 0x17, 0xa0, 0xf9, 0x76, 0xc0, 0x5c, 0xe6, 0x52, 0x4f, 0x38, 0x34, 0x8e,
-	0x1a, 0xa2, 0xb6, 0x51, 0x8c, 0xe2, 0x8d, 0x03, 0x3a, 0x82, 0xaa, 0xeb, 0x39, 0x01, 0x59, 0x11,
-	0x8f, 0xd9, 0x4b, 0xb1, 0x5d, 0xe3, 0x48, 0x17, 0xfe, 0xfd, 0x0d, 0x8e, 0xd3, 0x4e, 0xe6, 0x0f,
-	0xa0, 0x9e, 0xd2, 0x59, 0xdf, 0xf3, 0xd7, 0x0c, 0xfd, 0x1c, 0xca, 0x0e, 0x5d, 0xad, 0x5c, 0x26,
-	0xb6, 0x12, 0x6d, 0x5c, 0x84, 0xed, 0xae, 0x80, 0x70, 0x64, 0xe2, 0x4e, 0x2b, 0x91, 0x9c, 0x88,
-	0x1f, 0xf7, 0x5a, 0xe6, 0x8b, 0x23, 0x13, 0x7a, 0x02, 0x5a, 0xb0, 0xf6, 0xa6, 0x82, 0x99, 0x86,
-	0x22, 0x5a, 0xa5, 0x06, 0x6b, 0xcf, 0xe2, 0x6b, 0xf3, 0xdf, 0x79, 0xd8, 0x1b, 0xd9, 0x81, 0xbd,
-	0x5c, 0x92, 0xa5, 0x1b, 0xae, 0xc6, 0x3e, 0x71, 0xd0, 0x57, 0xa0, 0x86, 0x2c, 0xb0, 0x19, 0xb9,
-	0xb8, 0x89, 0xea, 0x7c, 0x1a, 0xd7, 0x99, 0xf6, 0x6b, 0x8f, 0x23, 0x27, 0x9c, 0xb8, 0xa3, 0x26,
-	0xa8, 0x0e, 0xf5, 0x42, 0x66, 0x7b, 0x4c, 0xa4, 0x54, 0xc4, 0xc9, 0x1a, 0x1d, 0x40, 0xd5, 0xa1,
-	0x64, 0xb1, 0x70, 0x1d, 0x4e, 0x6a, 0x91, 0x49, 0x1e, 0xa7, 0x21, 0xf3, 0x39, 0xa8, 0x71, 0x4c,
-	0x54, 0x03, 0xb5, 0x7b, 0x36, 0x1c, 0x4f, 0x3a, 0xc3, 0x89, 0x9e, 0x43, 0x7b, 0x50, 0xed, 0x9e,
-	0x59, 0xbd, 0x5e, 0xbf, 0xdb, 0xb7, 0x86, 0x13, 0x3d, 0x6f, 0xfe, 0xab, 0x08, 0x15, 0xd1, 0xab,
-	0x05, 0x45, 0x4d, 0x50, 0xae, 0xe8, 0x2c, 0xea, 0x93, 0x2a, 0x52, 0x3d, 0xa5, 0x33, 0xcc, 0x41,
-	0xfe, 0xd1, 0x58, 0xcc, 0xd4, 0xa8, 0x49, 0x8d, 0x2c, 0x7f, 0xf1, 0xc6, 0x01, 0x3d, 0x07, 0xd5,
-	0x77, 0x7d, 0xb2, 0x74, 0x3d, 0x22, 0xf2, 0xab, 0x1e, 0xd5, 0x65, 0xe5, 0x11, 0x88, 0x13, 0x33,
-	0x7a, 0x0e, 0x7a, 0xfc, 0x3c, 0xbd, 0x26, 0x41, 0xc8, 0x49, 0x51, 0x17, 0x15, 0xef, 0xc5, 0xf8,
-	0x77, 0x12, 0x46, 0xaf, 0x41, 0xf7, 0x37, 0xad, 0x9b, 0x86, 0x3e, 0x71, 0x8c, 0x9a, 0x88, 0xbe,
-	0xbf, 0xab, 0xaf, 0x78, 0xcf, 0xdf, 0xfa, 0x20, 0xcf, 0xa0, 0xec, 0x72, 0x52, 0x84, 0x62, 0x5a,
-	0xe2, 0xa4, 0x62, 0xaa, 0xe0, 0xc8, 0x88, 0x7e, 0x01, 0xe0, 0xdb, 0x01, 0xf1, 0xd8, 0x94, 0xb7,
-	0xa3, 0xbc, 0xd5, 0x0e, 0x4d, 0xda, 0x38, 0xc7, 0xbf, 0x84, 0x4a, 0xc8, 0xec, 0x80, 0x91, 0xb9,
-	0x18, 0x9d, 0xea, 0x51, 0xb3, 0x2d, 0x95, 0xa8, 0x1d, 0x2b, 0x51, 0x7b, 0x12, 0x4b, 0x15, 0x8e,
-	0x5d, 0xd1, 0x2b, 0x50, 0x17, 0xae, 0xe7, 0x86, 0x97, 0x64, 0x6e, 0xa8, 0xff, 0xf3, 0xb5, 0xc4,
-	0x17, 0x7d, 0x0e, 0x75, 0xba, 0x66, 0xfe, 0x9a, 0x4d, 0x23, 0x42, 0x6b, 0xb7, 0x09, 0x5d, 0x93,
-	0x1e, 0xdd, 0x98, 0xd6, 0xa5, 0x90, 0xd9, 0x8c, 0x18, 0x20, 0xd8, 0x97, 0x94, 0x3b, 0xe6, 0x20,
-	0x96, 0x36, 0x64, 0x42, 0xd9, 0xb9, 0x5c, 0x7b, 0x6f, 0x43, 0xa3, 0x2a, 0x9a, 0x02, 0xc2, 0xab,
-	0xcb, 0x21, 0x1c, 0x59, 0x4e, 0x8b, 0x6a, 0x51, 0x2f, 0x99, 0xdf, 0x43, 0x49, 0xc0, 0xdb, 0xb3,
-	0x8d, 0x7e, 0x0a, 0x45, 0x9f, 0xce, 0x43, 0xa1, 0x74, 0x71, 0xab, 0x46, 0x74, 0x8e, 0x05, 0x8a,
-	0x9e, 0xc5, 0x59, 0x28, 0x22, 0x8b, 0xbd, 0x4d, 0xfc, 0x74, 0x1e, 0xa6, 0x0f, 0xca, 0x88, 0xce,
-	0x77, 0x6a, 0xf1, 0xad, 0xca, 0x0b, 0xef, 0x5d, 0xb9, 0x92, 0xaa, 0x7c, 0x44, 0xe7, 0x99, 0x1d,
-	0xbf, 0x88, 0x64, 0x62, 0x41, 0xf9, 0x37, 0x57, 0xaf, 0xe8, 0x6c, 0xea, 0x7a, 0x0b, 0x6a, 0xe4,
-	0x45, 0x19, 0xb5, 0x0d, 0x39, 0x16, 0x14, 0x57, 0xae, 0xe4, 0x83, 0xf9, 0x29, 0xa8, 0x31, 0x8b,
-	0x77, 0xe5, 0x6a, 0xfa, 0x50, 0x8f, 0xed, 0x52, 0x80, 0x9e, 0x42, 0x31, 0x20, 0x3e, 0x8d, 0xc6,
-	0x4a, 0x13, 0x39, 0x63, 0xe2, 0x53, 0x2c, 0xe0, 0xff, 0x83, 0xf4, 0xfc, 0xb3, 0x08, 0xb5, 0xcd,
-	0x96, 0x0b, 0x9a, 0x99, 0xbe, 0xfc, 0xdd, 0xd3, 0x67, 0x40, 0x25, 0x1e, 0xba, 0xaa, 0x18, 0xba,
-	0x78, 0x79, 0xcf, 0x81, 0xdf, 0x35, 0x9a, 0x70, 0x9f, 0xd1, 0x6c, 0x25, 0xa3, 0x29, 0x4f, 0x3b,
-	0x94, 0xc9, 0x38, 0x3b, 0x9f, 0x2d, 0xa8, 0x46, 0x74, 0x10, 0x8d, 0x2d, 0x6d, 0x37, 0x16, 0xa4,
-	0x95, 0x3f, 0xa3, 0xaf, 0x00, 0x9c, 0x80, 0xd8, 0x8c, 0xcc, 0xa7, 0x36, 0x8b, 0x66, 0xf9, 0xae,
-	0x71, 0xd3, 0x22, 0xef, 0x0e, 0x43, 0x87, 0x31, 0x87, 0x2a, 0x82, 0x43, 0xd9, 0x8c, 0x32, 0x23,
-	0xf4, 0x33, 0xa8, 0x05, 0xc4, 0xe1, 0x82, 0x41, 0x82, 0x80, 0x06, 0x62, 0xaa, 0x35, 0x5c, 0x95,
-	0x98, 0xc5, 0x21, 0xf4, 0x1a, 0x80, 0xf3, 0xcb, 0xe1, 0x17, 0x88, 0xd0, 0xd0, 0x44, 0x8d, 0x07,
-	0x5b, 0x35, 0x2e, 0x28, 0xa7, 0x5b, 0x57, 0xb8, 0xc8, 0x9b, 0x80, 0x76, 0x15, 0xaf, 0x9b, 0x5f,
-	0x43, 0x23, 0x6b, 0x4c, 0x1f, 0xca, 0xa5, 0x1d, 0x87, 0x72, 0x29, 0x75, 0x28, 0x9f, 0x16, 0x55,
-	0x45, 0x2f, 0x9a, 0x6f, 0xd2, 0xdc, 0xe4, 0xac, 0x7f, 0x05, 0xf5, 0x44, 0x7c, 0x53, 0xd4, 0x7f,
-	0x70, 0x2b, 0x31, 0x5c, 0xf3, 0x53, 0x2b, 0xf3, 0xef, 0x05, 0xd0, 0xbb, 0xa2, 0x51, 0x5c, 0x11,
-	0xc9, 0x0f, 0x6b, 0x12, 0xb2, 0x2c, 0x63, 0xf2, 0xf7, 0x39, 0x22, 0x0a, 0x77, 0x93, 0x74, 0x17,
-	0xb9, 0x2a, 0x1f, 0xa6, 0xfb, 0xc5, 0xf7, 0xd7, 0xfd, 0xd2, 0xbb, 0x75, 0x7f, 0x1f, 0x4a, 0x0b,
-	0x1a, 0x38, 0x44, 0xf0, 0x49, 0xc5, 0x72, 0x11, 0xf5, 0x78, 0x04, 0x0f, 0xfa, 0x1e, 0x4f, 0x91,
-	0xa5, 0x5a, 0x73, 0xd7, 0xc9, 0xfa, 0x19, 0x54, 0x67, 0x4b, 0xea, 0xbc, 0x9d, 0x4a, 0xb2, 0x15,
-	0x44, 0x48, 0x10, 0x90, 0x20, 0x99, 0xf9, 0x16, 0x1a, 0x03, 0x37, 0x4c, 0x87, 0xbb, 0xc7, 0x80,
-	0xb7, 0xa1, 0x26, 0xaa, 0xdb, 0x28, 0xa7, 0xb2, 0xad, 0x9c, 0x55, 0xe1, 0x20, 0x17, 0xe6, 0x0b,
-	0x68, 0xbc, 0x21, 0x6c, 0x40, 0x2f, 0xc2, 0xf7, 0xc8, 0xdd, 0xfc, 0x47, 0x01, 0x1e, 0x49, 0x1e,
-	0x24, 0x5b, 0xdf, 0x3f, 0xc5, 0x8f, 0x57, 0x9a, 0xca, 0x8f, 0xa5, 0x34, 0x8f, 0xa1, 0xbc, 0xf6,
-	0xe7, 0xfc, 0xb3, 0x94, 0xc4, 0x67, 0x89, 0x56, 0xfc, 0xc7, 0x81, 0x47, 0xa7, 0x76, 0xe0, 0x5c,
-	0xba, 0xd7, 0x31, 0x0b, 0x34, 0x8f, 0x76, 0x24, 0x10, 0x31, 0xa1, 0x0b, 0x8f, 0x23, 0x26, 0x7c,
-	0x78, 0x73, 0xcc, 0x47, 0xf0, 0x90, 0x7f, 0xfc, 0xad, 0x08, 0xe6, 0x31, 0x3c, 0x3a, 0x21, 0x4b,
-	0xf2, 0x31, 0x7d, 0x37, 0x3b, 0xb0, 0x3f, 0xe6, 0x57, 0x92, 0x8f, 0x08, 0xf1, 0x7b, 0x78, 0x38,
-	0x66, 0xd4, 0xff, 0xf0, 0x08, 0xad, 0x3f, 0x8b, 0x33, 0x58, 0x10, 0x1d, 0xe9, 0x50, 0x3b, 0x3d,
-	0x3b, 0x9e, 0x76, 0xb1, 0xd5, 0x99, 0xf4, 0x87, 0x6f, 0xe4, 0x75, 0x95, 0x23, 0xf8, 0x7c, 0x38,
-	0xe4, 0x40, 0x3e, 0x06, 0x7a, 0x9d, 0xfe, 0xe0, 0x1c, 0x5b, 0x7a, 0x21, 0x06, 0xc6, 0xe7, 0xdd,
-	0xae, 0x35, 0x1e, 0xeb, 0x0a, 0xaa, 0x83, 0xc6, 0x01, 0xeb, 0xdb, 0xd1, 0xe4, 0x8f, 0x7a, 0xb1,
-	0xd5, 0x02, 0x2d, 0xf9, 0x59, 0x81, 0x34, 0x28, 0x1d, 0x0f, 0xce, 0xba, 0xdf, 0xe8, 0x39, 0xa4,
-	0x42, 0xb1, 0xd7, 0x1f, 0x58, 0x7a, 0x9e, 0x3f, 0x61, 0x6b, 0x74, 0xa6, 0x17, 0x5a, 0xbf, 0x84,
-	0x6a, 0xea, 0x27, 0x05, 0x37, 0x0c, 0xcf, 0x86, 0x96, 0x74, 0x3e, 0xe9, 0xf7, 0x7a, 0xd2, 0xb9,
-	0x77, 0x3e, 0x18, 0xe8, 0x85, 0xd6, 0xf7, 0x00, 0x9b, 0x3b, 0x0c, 0xda, 0x07, 0xbd, 0xfb, 0x87,
-	0xf3, 0xe1, 0x37, 0xd3, 0xf3, 0x61, 0x67, 0x3c, 0xee, 0xbf, 0x19, 0x5a, 0x27, 0x7a, 0x0e, 0x21,
-	0x68, 0x48, 0x34, 0xc1, 0xf2, 0xe8, 0x01, 0xd4, 0x25, 0x16, 0xa7, 0x5c, 0xd8, 0x40, 0x71, 0x59,
-	0x4a, 0xeb, 0x6b, 0x50, 0xe3, 0xdb, 0x0a, 0x2f, 0x71, 0x74, 0x76, 0x92, 0x34, 0x21, 0x17, 0x03,
-	0x71, 0x80, 0x3c, 0x6a, 0x00, 0x70, 0x80, 0xbf, 0x6e, 0x9d, 0xe8, 0x85, 0xd6, 0x5f, 0x37, 0x3a,
-	0x2f, 0x43, 0x3c, 0x80, 0xfa, 0xa8, 0x3f, 0xb2, 0x06, 0xfd, 0xa1, 0x35, 0xed, 0x9f, 0x0c, 0x78,
-	0x4d, 0xfb, 0xa0, 0x27, 0xd0, 0xa6, 0xbf, 0x9f, 0xc0, 0xc3, 0x0d, 0x6a, 0x8d, 0x27, 0x1d, 0x2c,
-	0xbe, 0x44, 0x21, 0xe3, 0x9e, 0xa4, 0x99, 0x41, 0xc7, 0x93, 0xb3, 0xd1, 0xc8, 0x3a, 0xd1, 0x8b,
-	0x47, 0xff, 0x29, 0x81, 0xd2, 0x19, 0xf5, 0x51, 0x1b, 0xb4, 0xe4, 0x90, 0x40, 0x8f, 0xe4, 0xb5,
-	0x6f, 0xeb, 0xd0, 0x68, 0x26, 0x82, 0x62, 0xe6, 0xd0, 0x97, 0x00, 0x1b, 0xe9, 0x44, 0x8f, 0xa3,
-	0xdf, 0x78, 0x5b, 0x5a, 0xda, 0xcc, 0xdc, 0xcb, 0xcc, 0x1c, 0x7a, 0x09, 0x95, 0x48, 0x1e, 0xd1,
-	0x43, 0x61, 0xca, 0x8a, 0x65, 0xb3, 0x9e, 0xf6, 0x0f, 0xcd, 0x1c, 0x7a, 0x0d, 0x95, 0x48, 0xe2,
-	0xa2, 0x17, 0xb2, 0x82, 0xd7, 0x7c, 0x72, 0xeb, 0x7a, 0x70, 0x7c, 0xc3, 0x48, 0xf8, 0x1d, 0x3f,
-	0x4c, 0xcd, 0xdc, 0xe7, 0x79, 0xd4, 0x83, 0x46, 0x56, 0xf4, 0x50, 0x33, 0x55, 0xdc, 0xd6, 0x30,
-	0x34, 0x1f, 0xdf, 0x0a, 0x27, 0xaf, 0x6d, 0x39, 0xd4, 0x85, 0xbd, 0x2d, 0x81, 0x40, 0x4f, 0xd2,
-	0x45, 0x6f, 0x47, 0xba, 0x7d, 0x2c, 0x9b, 0x39, 0xf4, 0x3b, 0xa8, 0xa5, 0x05, 0x02, 0x19, 0x49,
-	0x0f, 0xb6, 0x5f, 0x47, 0xb7, 0x5e, 0xe7, 0xdd, 0xe8, 0x41, 0x23, 0xab, 0x24, 0x51, 0x31, 0x3b,
-	0xe5, 0xe5, 0x8e, 0x62, 0x4e, 0xa0, 0x9e, 0x51, 0x13, 0xf4, 0x13, 0xf9, 0xff, 0x8a, 0x1d, 0x0a,
-	0x73, 0x47, 0x94, 0x63, 0xa8, 0xa5, 0x05, 0x25, 0xaa, 0x66, 0x87, 0xc6, 0xdc, 0x11, 0xe3, 0xb7,
-	0xa0, 0xc9, 0xe4, 0x3b, 0xcb, 0x25, 0x7a, 0x87, 0xdb, 0xbb, 0x5f, 0x3f, 0x2e, 0xfd, 0x49, 0xf1,
-	0xfd, 0x70, 0x56, 0x16, 0x86, 0x2f, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x03, 0x05, 0x40, 0xde,
-	0xb5, 0x12, 0x00, 0x00,
+	// 2209 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdd, 0x6e, 0xdb, 0xc8,
+	0x15, 0x36, 0xf5, 0x63, 0x51, 0x47, 0xb2, 0x4c, 0x8f, 0xed, 0x84, 0xab, 0x24, 0xbb, 0x0e, 0x17,
+	0x69, 0x6c, 0x6d, 0x20, 0x6d, 0x9d, 0x22, 0xe8, 0x6e, 0xb7, 0xd8, 0x2a, 0xb2, 0x9c, 0xc8, 0xeb,
+	0x48, 0xea, 0x48, 0xde, 0x62, 0x8b, 0xa2, 0x04, 0x45, 0x8d, 0x6c, 0x3a, 0x12, 0x87, 0x25, 0x47,
+	0x6e, 0x8c, 0x45, 0x6e, 0x0a, 0xb4, 0x40, 0xaf, 0x0b, 0xf4, 0x19, 0xfa, 0x02, 0x7d, 0x88, 0xa2,
+	0x97, 0xbd, 0x29, 0x7a, 0xdd, 0x57, 0xe8, 0x7d, 0x31, 0x33, 0x24, 0x45, 0xc9, 0x72, 0xe2, 0x24,
+	0xed, 0x85, 0x81, 0x99, 0x6f, 0x8e, 0xce, 0xcf, 0x37, 0x67, 0xbe, 0x19, 0x1a, 0xb6, 0xec, 0xb1,
+	0x43, 0x5c, 0x56, 0xf3, 0xbc, 0x80, 0xff, 0x55, 0x3d, 0x9f, 0x32, 0x8a, 0xd2, 0x9e, 0x17, 0x94,
+	0xef, 0x9e, 0x52, 0x7a, 0x3a, 0x26, 0x35, 0xcb, 0x73, 0x6a, 0x96, 0xeb, 0x52, 0x66, 0x31, 0x87,
+	0xba, 0xa1, 0x49, 0xf9, 0x4e, 0xb8, 0x2a, 0x66, 0x83, 0xe9, 0xa8, 0x46, 0x26, 0x1e, 0xbb, 0x0c,
+	0x17, 0x3f, 0x59, 0x5c, 0x64, 0xce, 0x84, 0x04, 0xcc, 0x9a, 0x78, 0xa1, 0xc1, 0xc7, 0x8b, 0x06,
+	0xbf, 0xf5, 0x2d, 0xcf, 0x23, 0x7e, 0xe4, 0x3d, 0x4e, 0x6b, 0x14, 0xf0, 0x3f, 0x89, 0x1a, 0x3f,
+	0x81, 0xd5, 0x1e, 0xb1, 0x7d, 0xc2, 0x10, 0x82, 0x8c, 0x6b, 0x4d, 0x88, 0xae, 0xec, 0x28, 0xbb,
+	0x79, 0x2c, 0xc6, 0xe8, 0x1e, 0xc0, 0x84, 0x4e, 0x5d, 0x66, 0x7a, 0x16, 0x3b, 0xd3, 0x53, 0x62,
+	0x25, 0x2f, 0x90, 0xae, 0xc5, 0xce, 0x8c, 0xff, 0xa4, 0x20, 0xdf, 0xf7, 0x2d, 0x37, 0x18, 0x51,
+	0x7f, 0x82, 0xb6, 0x20, 0xeb, 0x4c, 0xac, 0xd3, 0xc8, 0x83, 0x9c, 0x20, 0x0d, 0xd2, 0xf6, 0x64,
+	0xa8, 0xa7, 0x76, 0xd2, 0xbb, 0x79, 0xcc, 0x87, 0x68, 0x0f, 0xd2, 0xc4, 0xbd, 0xd0, 0xd3, 0x3b,
+	0xe9, 0xdd, 0xc2, 0xfe, 0xed, 0x2a, 0xa7, 0x28, 0x76, 0x52, 0x6d, 0xba, 0x17, 0x4d, 0x97, 0xf9,
+	0x97, 0x98, 0xdb, 0xa0, 0x07, 0x90, 0x0b, 0x44, 0x76, 0x81, 0x9e, 0x11, 0xe6, 0x05, 0x61, 0x2e,
+	0x33, 0xc6, 0xd1, 0x1a, 0x8f, 0x1c, 0xb0, 0xa1, 0xe3, 0xea, 0x59, 0x11, 0x45, 0x4e, 0xd0, 0x23,
+	0x40, 0x96, 0x6d, 0x13, 0x8f, 0x99, 0x3e, 0x61, 0x53, 0xdf, 0x35, 0x6d, 0x3a, 0x24, 0xfa, 0xea,
+	0x4e, 0x7a, 0x37, 0x8d, 0x35, 0xb9, 0x82, 0xc5, 0x42, 0x83, 0x0e, 0x09, 0xf7, 0x31, 0x24, 0x83,
+	0xe9, 0xa9, 0x9e, 0xdb, 0x51, 0x76, 0x55, 0x2c, 0x27, 0xe8, 0x21, 0xa8, 0xde, 0x28, 0x30, 0x27,
+	0xfc, 0x97, 0xea, 0x8e, 0xb2, 0x5b, 0xda, 0x2f, 0x8a, 0x0c, 0xba, 0xa3, 0xe0, 0x05, 0x1d, 0x12,
+	0x9c, 0xf3, 0xe4, 0x00, 0x3d, 0x86, 0xa2, 0x4f, 0x98, 0x7f, 0x69, 0x7a, 0x74, 0xec, 0xd8, 0x97,
+	0x7a, 0x7e, 0x47, 0xd9, 0x2d, 0xec, 0x6b, 0xc2, 0x18, 0xf3, 0x85, 0xae, 0xc0, 0x71, 0xc1, 0x9f,
+	0x4d, 0xca, 0x4f, 0x40, 0x8d, 0xea, 0xe5, 0x3c, 0xbd, 0x24, 0x97, 0x21, 0x77, 0x7c, 0xc8, 0x33,
+	0xba, 0xb0, 0xc6, 0x53, 0x12, 0xf2, 0x2e, 0x27, 0x5f, 0xa6, 0x7e, 0xac, 0x18, 0x7f, 0x50, 0xa0,
+	0x90, 0x70, 0x8a, 0xee, 0x43, 0x71, 0x62, 0xbd, 0x32, 0x2d, 0xc6, 0x78, 0xc7, 0x04, 0xc2, 0x49,
+	0x06, 0x17, 0x26, 0xd6, 0xab, 0x7a, 0x08, 0xa1, 0x87, 0xb0, 0x3e, 0xb0, 0xec, 0x97, 0x74, 0x34,
+	0x32, 0x03, 0x62, 0x53, 0x77, 0x18, 0x08, 0xb7, 0x19, 0x5c, 0x0a, 0xe1, 0x9e, 0x44, 0xd1, 0x67,
+	0x80, 0x64, 0x21, 0xd4, 0x35, 0xc9, 0x2b, 0x87, 0x49, 0xd6, 0xd2, 0x82, 0xb5, 0x75, 0xb1, 0xd2,
+	0x71, 0x9b, 0xaf, 0x1c, 0xc6, 0x49, 0x33, 0xb6, 0x21, 0x7d, 0x44, 0x07, 0xa8, 0x04, 0x29, 0x67,
+	0x18, 0xa6, 0x9e, 0x72, 0x86, 0xc6, 0x9f, 0x15, 0x58, 0x7d, 0x41, 0xd8, 0x19, 0x1d, 0xa2, 0x47,
+	0x90, 0xf7, 0x2c, 0x9f, 0x39, 0xbc, 0xcf, 0x85, 0x45, 0x69, 0xbf, 0x24, 0x19, 0x8c, 0x50, 0x3c,
+	0x33, 0x40, 0xfb, 0x50, 0x70, 0x5c, 0xdb, 0x27, 0x13, 0xe2, 0x32, 0x6b, 0x2c, 0x32, 0x2c, 0x85,
+	0x24, 0xb6, 0x66, 0x38, 0x4e, 0x1a, 0xa1, 0x0a, 0xe4, 0x47, 0xce, 0x98, 0x98, 0x67, 0x56, 0x70,
+	0xa6, 0xa7, 0x05, 0xed, 0x6b, 0x55, 0xde, 0xe0, 0x87, 0xce, 0x98, 0x3c, 0xb7, 0x82, 0x33, 0xac,
+	0x8e, 0xc2, 0x91, 0xf1, 0x7b, 0x05, 0xd4, 0x23, 0x3a, 0x68, 0xb9, 0xde, 0x94, 0xa1, 0x4f, 0x61,
+	0xd5, 0xa6, 0x93, 0x89, 0xc3, 0x44, 0x5e, 0xa2, 0xb7, 0x46, 0x41, 0xb5, 0x21, 0x20, 0x1c, 0x2e,
+	0x71, 0xa3, 0x89, 0xa8, 0x44, 0x4f, 0x45, 0x46, 0x5e, 0x50, 0x95, 0xc5, 0xe1, 0x70, 0x09, 0xdd,
+	0x81, 0xbc, 0x3f, 0x75, 0x4d, 0x71, 0x5c, 0x45, 0x0a, 0x2a, 0x56, 0xfd, 0xa9, 0xdb, 0xe4, 0x73,
+	0x7e, 0xae, 0x4e, 0xc7, 0x74, 0xa0, 0x67, 0xe4, 0xb9, 0xe2, 0x63, 0xe3, 0xaf, 0x0a, 0xac, 0x77,
+	0x2d, 0xdf, 0x1a, 0x8f, 0xc9, 0xd8, 0x09, 0x26, 0x3d, 0x8f, 0xd8, 0xe8, 0x0b, 0x50, 0x03, 0xe6,
+	0x5b, 0x8c, 0x9c, 0x5e, 0x86, 0x44, 0xdd, 0x8b, 0x88, 0x4a, 0xda, 0x55, 0x7b, 0xa1, 0x11, 0x8e,
+	0xcd, 0x51, 0x19, 0x54, 0x9b, 0xba, 0x01, 0xb3, 0x5c, 0x16, 0xee, 0x6a, 0x3c, 0x47, 0x3b, 0x50,
+	0xb0, 0x29, 0x19, 0x8d, 0x1c, 0x9b, 0x9f, 0x7e, 0x91, 0x9d, 0x82, 0x93, 0x90, 0xb1, 0x07, 0x6a,
+	0xe4, 0x13, 0x15, 0x41, 0x6d, 0x74, 0xda, 0xbd, 0x7e, 0xbd, 0xdd, 0xd7, 0x56, 0xd0, 0x3a, 0x14,
+	0x1a, 0x9d, 0xe6, 0xe1, 0x61, 0xab, 0xd1, 0x6a, 0xb6, 0xfb, 0x9a, 0x62, 0xfc, 0x2b, 0x03, 0x39,
+	0xc1, 0xdf, 0x88, 0xa2, 0x32, 0xa4, 0xcf, 0xe9, 0x20, 0xe4, 0x4e, 0x15, 0xa9, 0x1e, 0xd1, 0x01,
+	0xe6, 0x20, 0xdf, 0x75, 0x16, 0x1d, 0xe9, 0x90, 0xb8, 0xd2, 0xfc, 0x41, 0xc7, 0x33, 0x03, 0xb4,
+	0x07, 0xaa, 0xe7, 0x78, 0x64, 0xec, 0xb8, 0x64, 0xb6, 0x81, 0xbc, 0xf2, 0x10, 0xc4, 0xf1, 0x32,
+	0xda, 0x03, 0x2d, 0x1a, 0x9b, 0x17, 0xc4, 0x0f, 0x78, 0x57, 0xad, 0x89, 0x8a, 0xd7, 0x23, 0xfc,
+	0x5b, 0x09, 0xa3, 0xaf, 0x41, 0xf3, 0x66, 0xd4, 0x99, 0x81, 0x47, 0x6c, 0xbd, 0x28, 0xbc, 0x6f,
+	0x2d, 0xe3, 0x15, 0xaf, 0x7b, 0x0b, 0x1b, 0xf2, 0x00, 0x56, 0x1d, 0xde, 0x28, 0x81, 0x90, 0x95,
+	0x28, 0xa9, 0xa8, 0x7d, 0x70, 0xb8, 0x88, 0x1e, 0x02, 0x78, 0x96, 0x4f, 0x5c, 0x66, 0x72, 0x3a,
+	0x56, 0x17, 0xe8, 0xc8, 0xcb, 0x35, 0x7e, 0x4a, 0x7e, 0x04, 0xb9, 0x80, 0x59, 0x3e, 0x23, 0x43,
+	0xa1, 0x31, 0x85, 0xfd, 0x72, 0x55, 0x4a, 0x76, 0x35, 0x92, 0xec, 0x6a, 0x3f, 0xd2, 0x74, 0x1c,
+	0x99, 0xa2, 0x27, 0xa0, 0x8e, 0x1c, 0xd7, 0x09, 0xce, 0xc8, 0x50, 0x57, 0xdf, 0xfa, 0xb3, 0xd8,
+	0x16, 0x7d, 0x0e, 0x6b, 0x74, 0xca, 0xbc, 0x29, 0x33, 0x65, 0x27, 0xeb, 0xf9, 0xab, 0x4d, 0x5e,
+	0x94, 0x16, 0x8d, 0xa8, 0xd5, 0xb3, 0x01, 0xb3, 0x18, 0xd1, 0x41, 0x74, 0x5f, 0x5c, 0x6e, 0x8f,
+	0x83, 0x58, 0xae, 0x21, 0x03, 0x56, 0xed, 0xb3, 0xa9, 0xfb, 0x32, 0xd0, 0x0b, 0x82, 0x14, 0x10,
+	0x56, 0x0d, 0x0e, 0xe1, 0x70, 0x05, 0xd5, 0x00, 0x6c, 0x3a, 0x19, 0x38, 0xae, 0xc5, 0xa8, 0xaf,
+	0x97, 0x84, 0xb7, 0x75, 0x69, 0x17, 0xc3, 0x38, 0x61, 0x72, 0x94, 0x51, 0x33, 0x5a, 0xd6, 0x78,
+	0x05, 0x59, 0xe1, 0x67, 0x51, 0x4e, 0xd0, 0x5d, 0xc8, 0x78, 0x54, 0x08, 0x56, 0x3a, 0xe6, 0xb6,
+	0x4b, 0x87, 0x58, 0xa0, 0xe8, 0x41, 0x94, 0x76, 0x3a, 0x19, 0x88, 0x3b, 0x9a, 0x4b, 0xbc, 0x0c,
+	0x6a, 0xac, 0x8f, 0x19, 0x79, 0x46, 0xa2, 0x39, 0xd7, 0xd3, 0x74, 0x97, 0x0e, 0x97, 0x5e, 0x81,
+	0x57, 0x78, 0x4c, 0xdd, 0x98, 0xc7, 0x74, 0x82, 0xc7, 0x2e, 0x1d, 0xce, 0xa5, 0xb3, 0x05, 0x59,
+	0xe2, 0xfb, 0xd4, 0x0f, 0x65, 0x41, 0x4e, 0x8c, 0xc7, 0xa1, 0x3c, 0x8d, 0x28, 0xef, 0x2b, 0xf5,
+	0x9c, 0x0e, 0x4c, 0xc7, 0x1d, 0x51, 0x5d, 0x11, 0x95, 0x17, 0x67, 0x0d, 0x38, 0xa2, 0x38, 0x77,
+	0x2e, 0x07, 0xc6, 0xc7, 0xa0, 0x46, 0x27, 0x65, 0x59, 0x05, 0xc6, 0x27, 0x90, 0x6f, 0xf8, 0xd4,
+	0x95, 0xa2, 0x87, 0x20, 0x23, 0x4e, 0x42, 0x68, 0xc0, 0xc7, 0xc6, 0x5f, 0x14, 0x58, 0x8b, 0x3c,
+	0x48, 0xab, 0x7b, 0x90, 0xf1, 0x89, 0x47, 0xc3, 0xc3, 0x9d, 0x17, 0xb5, 0x62, 0xe2, 0x51, 0x2c,
+	0xe0, 0xff, 0x81, 0x28, 0x1a, 0x90, 0xb1, 0x7d, 0xea, 0xea, 0x99, 0x84, 0x36, 0xc4, 0x49, 0x62,
+	0xb1, 0x16, 0x0b, 0x67, 0x36, 0x21, 0x9c, 0xff, 0xcc, 0x40, 0x71, 0x96, 0xea, 0x88, 0xce, 0x69,
+	0x87, 0xf2, 0x66, 0xed, 0xd0, 0x21, 0x17, 0x49, 0x46, 0x41, 0x34, 0x40, 0x34, 0x7d, 0x47, 0xb9,
+	0x5a, 0x26, 0x2c, 0xf0, 0x2e, 0xc2, 0x52, 0x89, 0x85, 0x45, 0x3e, 0x6a, 0xd0, 0x5c, 0xc6, 0xf3,
+	0xea, 0x52, 0x81, 0x42, 0xd8, 0x7e, 0x62, 0x43, 0xb2, 0x8b, 0x1b, 0x02, 0x72, 0x95, 0x8f, 0xd1,
+	0x17, 0x00, 0xb6, 0x4f, 0x2c, 0x46, 0x86, 0xa6, 0xc5, 0xf4, 0xd5, 0xb7, 0x8a, 0x45, 0x3e, 0xb4,
+	0xae, 0x33, 0xb4, 0x1b, 0xf5, 0x6c, 0x4e, 0xf4, 0xec, 0x7c, 0x46, 0x73, 0x8d, 0x7b, 0x9f, 0x3f,
+	0x74, 0x6c, 0x2e, 0x77, 0xb2, 0x7f, 0x55, 0xb1, 0x3b, 0x05, 0x89, 0x35, 0x39, 0x84, 0xbe, 0x06,
+	0xe0, 0x9d, 0x6b, 0xf3, 0x77, 0x62, 0xa0, 0xe7, 0x45, 0x8d, 0x3b, 0x0b, 0x35, 0x8e, 0x28, 0x6f,
+	0xe4, 0x86, 0x30, 0x91, 0x0f, 0xbe, 0xfc, 0x79, 0x34, 0x5f, 0x10, 0x90, 0xe2, 0x5b, 0x05, 0xa4,
+	0xfc, 0x15, 0x94, 0xe6, 0xbd, 0x25, 0x9f, 0x53, 0xd9, 0x25, 0xcf, 0xa9, 0x6c, 0xe2, 0x39, 0x75,
+	0x94, 0x51, 0xd3, 0x5a, 0xc6, 0x78, 0x96, 0x3c, 0x04, 0xfc, 0x00, 0x3e, 0x81, 0xb5, 0xf8, 0xae,
+	0x49, 0x9c, 0xc2, 0x8d, 0x2b, 0x95, 0xe0, 0xa2, 0x97, 0x98, 0x19, 0x7f, 0x4b, 0x81, 0xd6, 0x10,
+	0xcc, 0xf2, 0x0b, 0x80, 0xfc, 0x66, 0x4a, 0x02, 0x36, 0xdf, 0x62, 0xca, 0xbb, 0xdc, 0x88, 0xa9,
+	0x37, 0x77, 0xf5, 0xb2, 0x6e, 0xcc, 0xbd, 0xdf, 0x35, 0x97, 0xb9, 0xf9, 0x35, 0x97, 0xbd, 0xfe,
+	0x9a, 0xdb, 0x82, 0xec, 0x88, 0xfa, 0x36, 0x11, 0x0d, 0xa8, 0x62, 0x39, 0x59, 0xd8, 0x52, 0xf5,
+	0x26, 0x77, 0x02, 0xdf, 0x94, 0x2e, 0x6c, 0xb4, 0x5c, 0x5e, 0x13, 0x4b, 0x70, 0xf9, 0xa6, 0x97,
+	0xc7, 0x27, 0x50, 0x18, 0x8c, 0xa9, 0xfd, 0xd2, 0x94, 0xed, 0x9c, 0x12, 0x39, 0x80, 0x80, 0x44,
+	0x1b, 0x1b, 0x2f, 0xa1, 0x74, 0xec, 0x04, 0x49, 0x77, 0xef, 0x20, 0x21, 0x55, 0x28, 0x3a, 0xee,
+	0xdc, 0x5d, 0x90, 0x5e, 0xbc, 0x0b, 0x0a, 0xc2, 0x40, 0x4e, 0x8c, 0x47, 0x50, 0x7a, 0x46, 0xd8,
+	0x31, 0x3d, 0x0d, 0x6e, 0x90, 0xbb, 0xf1, 0xf7, 0x14, 0x6c, 0xcb, 0xc6, 0x89, 0x43, 0xbf, 0x7b,
+	0x8a, 0x1f, 0xae, 0x65, 0xb9, 0xff, 0x97, 0x96, 0xdd, 0x82, 0xd5, 0xa9, 0x37, 0xe4, 0xdb, 0x92,
+	0x15, 0xdb, 0x12, 0xce, 0xf8, 0x57, 0xa6, 0x4b, 0x4d, 0xcb, 0xb7, 0xcf, 0x9c, 0x8b, 0xa8, 0x6d,
+	0xf2, 0x2e, 0xad, 0x4b, 0xe0, 0x7d, 0x5b, 0xa7, 0x01, 0xb7, 0xc2, 0xd6, 0x79, 0x7f, 0x36, 0x8d,
+	0x6d, 0xd8, 0xe4, 0xdd, 0xb2, 0xe0, 0xc1, 0x78, 0x0a, 0xdb, 0x07, 0x64, 0x4c, 0x3e, 0x64, 0xa3,
+	0x8c, 0x3a, 0x6c, 0xf5, 0xf8, 0x1b, 0xef, 0x03, 0x5c, 0xfc, 0x0c, 0x36, 0x7b, 0x8c, 0x7a, 0xef,
+	0xef, 0xa1, 0x72, 0x0f, 0x72, 0xe1, 0xa7, 0x2c, 0x52, 0x21, 0x73, 0x78, 0xd2, 0x6b, 0x6a, 0x2b,
+	0x7c, 0xd4, 0xe8, 0x74, 0xbf, 0xd3, 0x94, 0xca, 0xaf, 0xc5, 0x7b, 0x44, 0x1c, 0x1c, 0xa4, 0x41,
+	0xf1, 0xa8, 0xf3, 0xd4, 0x6c, 0xe0, 0x66, 0xbd, 0xdf, 0x6a, 0x3f, 0x93, 0x9f, 0x07, 0x1c, 0xc1,
+	0x27, 0xed, 0x36, 0x07, 0x94, 0x08, 0x38, 0xac, 0xb7, 0x8e, 0x4f, 0x70, 0x53, 0x4b, 0x45, 0x40,
+	0xef, 0xa4, 0xd1, 0x68, 0xf6, 0x7a, 0x5a, 0x1a, 0xad, 0x41, 0x9e, 0x03, 0xcd, 0x17, 0xdd, 0xfe,
+	0x77, 0x5a, 0xa6, 0x52, 0x81, 0x7c, 0xfc, 0x1d, 0x88, 0xf2, 0x90, 0x7d, 0x7a, 0xdc, 0x69, 0x7c,
+	0x23, 0x33, 0x38, 0x6c, 0x1d, 0x37, 0x35, 0x85, 0x8f, 0x70, 0xb3, 0xdb, 0xd1, 0x52, 0x95, 0xcf,
+	0xa0, 0x90, 0xf8, 0x06, 0xe4, 0x0b, 0xed, 0x4e, 0x3b, 0x4c, 0xf7, 0xa0, 0x75, 0x78, 0x28, 0x8d,
+	0x0f, 0x4f, 0x8e, 0x8f, 0xb5, 0x54, 0xe5, 0x57, 0x00, 0xb3, 0x27, 0x20, 0xda, 0x02, 0xad, 0xf1,
+	0xfc, 0xa4, 0xfd, 0x8d, 0x79, 0xd2, 0xae, 0xf7, 0x7a, 0xad, 0x67, 0xed, 0xe6, 0x81, 0xb6, 0x82,
+	0x10, 0x94, 0x24, 0x1a, 0x63, 0x0a, 0xda, 0x80, 0x35, 0x89, 0x45, 0x29, 0xa7, 0x66, 0x50, 0x54,
+	0x56, 0xba, 0xf2, 0x15, 0xa8, 0xd1, 0x7b, 0x8e, 0x97, 0xd8, 0xed, 0x1c, 0xc4, 0x24, 0xac, 0x44,
+	0x40, 0xe4, 0x40, 0x41, 0x25, 0x00, 0x0e, 0xf0, 0x9f, 0x37, 0x0f, 0xb4, 0x54, 0xe5, 0xf5, 0xec,
+	0xa2, 0x91, 0x2e, 0x36, 0x60, 0xad, 0xdb, 0xea, 0x36, 0x8f, 0x5b, 0xed, 0xa6, 0xd9, 0x3a, 0x38,
+	0xe6, 0x35, 0x6d, 0x81, 0x16, 0x43, 0x33, 0x7e, 0x6f, 0xc3, 0xe6, 0x0c, 0x6d, 0xf6, 0xfa, 0x75,
+	0x2c, 0x76, 0x22, 0x35, 0x67, 0x1e, 0xa7, 0x39, 0x87, 0xf6, 0xfa, 0x9d, 0x6e, 0xb7, 0x79, 0xa0,
+	0x65, 0x2a, 0x2d, 0x80, 0xd9, 0xb9, 0xe1, 0xa4, 0x37, 0x70, 0xa7, 0xd7, 0xd3, 0x56, 0xf8, 0xf0,
+	0xa4, 0xdd, 0xea, 0xb4, 0x35, 0x45, 0xee, 0x75, 0xab, 0x6d, 0x76, 0xda, 0x66, 0xb7, 0xde, 0x7f,
+	0xae, 0xa5, 0xd0, 0x26, 0xac, 0x47, 0x48, 0xa3, 0xde, 0xed, 0x8b, 0x00, 0xfb, 0x7f, 0x54, 0x21,
+	0x5d, 0xef, 0xb6, 0x50, 0x13, 0xf2, 0x52, 0xb7, 0xb8, 0xfe, 0x6f, 0x87, 0x8f, 0xb9, 0xf9, 0x0b,
+	0xb0, 0x1c, 0x6b, 0x9d, 0x71, 0xfb, 0x77, 0xff, 0xf8, 0xf7, 0x9f, 0x52, 0x1b, 0x5f, 0x2a, 0x15,
+	0xa3, 0x58, 0xbb, 0xf8, 0xa1, 0xf8, 0x3f, 0xd9, 0x39, 0x1d, 0x04, 0xe8, 0xe7, 0x00, 0x33, 0xb1,
+	0x47, 0xb7, 0xc2, 0xcf, 0xfe, 0x05, 0xf5, 0x2f, 0xcf, 0xbd, 0x82, 0x8d, 0x7b, 0xc2, 0xd9, 0x6d,
+	0xb4, 0x9d, 0xf4, 0x54, 0xfb, 0xfe, 0x9c, 0x0e, 0xaa, 0xce, 0xf0, 0x35, 0x6a, 0x40, 0x2e, 0x54,
+	0x7b, 0xb4, 0x29, 0x7e, 0x37, 0xaf, 0xfd, 0xe5, 0xb5, 0xa4, 0xb3, 0xc0, 0xd8, 0x12, 0xde, 0x4a,
+	0x68, 0x3e, 0x2f, 0x0b, 0x72, 0xa1, 0x8a, 0x87, 0x4e, 0xe6, 0x35, 0xbd, 0x7c, 0xe7, 0xca, 0x1b,
+	0xeb, 0xe9, 0x25, 0x23, 0xc1, 0xb7, 0xfc, 0x81, 0x61, 0x18, 0xc2, 0xe5, 0x5d, 0x54, 0x5e, 0x9a,
+	0x60, 0x6d, 0x4c, 0x4f, 0x83, 0xcf, 0x15, 0x34, 0x80, 0xd2, 0xbc, 0xf2, 0xa3, 0x72, 0x82, 0xc6,
+	0x85, 0x03, 0x5e, 0xbe, 0x75, 0x25, 0xa0, 0x78, 0x55, 0x1b, 0x77, 0x45, 0xac, 0x5b, 0x9c, 0xd9,
+	0x8d, 0x28, 0x5c, 0x74, 0xd4, 0x03, 0x34, 0x86, 0xf5, 0x05, 0x41, 0x44, 0x77, 0x92, 0x1c, 0x2f,
+	0x46, 0xb9, 0xfa, 0xd0, 0x31, 0xf6, 0x44, 0x80, 0x4f, 0xd1, 0xfd, 0x2b, 0xde, 0x6b, 0xdf, 0x47,
+	0xc3, 0x2a, 0xff, 0xe8, 0x78, 0x8d, 0x7e, 0x01, 0xc5, 0xa4, 0x72, 0x22, 0x3d, 0xa6, 0x7f, 0x31,
+	0x0e, 0xba, 0x12, 0x27, 0x30, 0x3e, 0x12, 0x81, 0x36, 0xd1, 0x92, 0x32, 0x28, 0x94, 0xe6, 0xb5,
+	0x37, 0xa4, 0x6a, 0xa9, 0x20, 0x5f, 0x4b, 0x55, 0x58, 0x49, 0xe5, 0x06, 0x95, 0x04, 0xb0, 0x36,
+	0x27, 0xd4, 0xe8, 0x23, 0xf9, 0x4f, 0xc8, 0x25, 0xe2, 0x7d, 0x6d, 0xb8, 0x9a, 0x08, 0xb7, 0x67,
+	0x3c, 0x7c, 0x6b, 0xb8, 0x9a, 0xf8, 0xf0, 0x47, 0x1e, 0x14, 0x93, 0xd2, 0x1e, 0xd2, 0xb7, 0x44,
+	0xed, 0xaf, 0x0d, 0x59, 0x15, 0x21, 0x77, 0x8d, 0x1f, 0xdc, 0x24, 0x24, 0xf5, 0xd0, 0x4f, 0x21,
+	0x2f, 0x29, 0xac, 0x8f, 0xc7, 0xe8, 0x1a, 0xa7, 0xd7, 0x06, 0x5b, 0x79, 0x9a, 0xfd, 0x25, 0xff,
+	0x0f, 0xf7, 0x60, 0x55, 0x2c, 0x3c, 0xfe, 0xef, 0x00, 0x3b, 0xed, 0xc6, 0xb7, 0x05, 0x17, 0x00,
+	0x00,
 }
@@ -0,0 +1,204 @@
+/*
+Package admin implements backup and restore of a whole Pachyderm cluster.
+
+Extract writes the state of a cluster as a stream of ops, each of which is a
+request to one of pachd's APIs.  Restore replays the ops, in order, against an
+empty cluster.  Since the stream is made of API requests rather than database
+rows it doesn't depend on how pachd stores its metadata, so a backup taken
+with one version of Pachyderm can be restored into a later one.
+
+The stream is newline-delimited JSON.  The first line is a Header, every
+subsequent line is an op of the form:
+
+	{"op": "<kind>", "request": <request>, "commit": <commit>}
+
+where request is the JSON encoding of the protobuf request for that kind of
+op and commit, which is only set for start_commit and fork_commit, is the
+commit that the request is expected to create.
+*/
+package admin
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/sjezewski/pachyderm/src/client/pfs"
+	"github.com/sjezewski/pachyderm/src/client/pps"
+	"github.com/sjezewski/pachyderm/src/server/pps/persist"
+)
+
+// Version is the version of the stream format written by Extract.  Restore
+// refuses streams with a newer version.
+const Version = 1
+
+// The kinds of ops in a stream, with the type of their request.
+const (
+	// OpCreateRepo is a pfs.CreateRepoRequest.
+	OpCreateRepo = "create_repo"
+	// OpStartCommit is a pfs.StartCommitRequest.
+	OpStartCommit = "start_commit"
+	// OpForkCommit is a pfs.ForkCommitRequest.
+	OpForkCommit = "fork_commit"
+	// OpPutFile is a pfs.PutFileRequest, either for a chunk of a regular
+	// file or for a directory.
+	OpPutFile = "put_file"
+	// OpDeleteFile is a pfs.DeleteFileRequest.
+	OpDeleteFile = "delete_file"
+	// OpFinishCommit is a pfs.FinishCommitRequest.
+	OpFinishCommit = "finish_commit"
+	// OpArchiveCommit is a pfs.ArchiveCommitRequest.
+	OpArchiveCommit = "archive_commit"
+	// OpCreateJob is a persist.JobInfo.
+	OpCreateJob = "create_job"
+	// OpCreatePipeline is a pps.CreatePipelineRequest, it's in place of
+	// the OpCreateRepo of the pipeline's output repo.
+	OpCreatePipeline = "create_pipeline"
+	// OpStartPipeline is a pps.StartPipelineRequest for a pipeline which
+	// wasn't stopped, pipelines are stopped until the end of the stream.
+	OpStartPipeline = "start_pipeline"
+)
+
+// Header is the first line of a stream.
+type Header struct {
+	Version int `json:"version"`
+}
+
+// Op is a single request in a stream.
+type Op struct {
+	Kind    string
+	Request proto.Message
+	// Commit is the commit created by an OpStartCommit or OpForkCommit.
+	Commit *pfs.Commit
+}
+
+func newRequest(kind string) (proto.Message, error) {
+	switch kind {
+	case OpCreateRepo:
+		return &pfs.CreateRepoRequest{}, nil
+	case OpStartCommit:
+		return &pfs.StartCommitRequest{}, nil
+	case OpForkCommit:
+		return &pfs.ForkCommitRequest{}, nil
+	case OpPutFile:
+		return &pfs.PutFileRequest{}, nil
+	case OpDeleteFile:
+		return &pfs.DeleteFileRequest{}, nil
+	case OpFinishCommit:
+		return &pfs.FinishCommitRequest{}, nil
+	case OpArchiveCommit:
+		return &pfs.ArchiveCommitRequest{}, nil
+	case OpCreateJob:
+		return &persist.JobInfo{}, nil
+	case OpCreatePipeline:
+		return &pps.CreatePipelineRequest{}, nil
+	case OpStartPipeline:
+		return &pps.StartPipelineRequest{}, nil
+	}
+	return nil, fmt.Errorf("unrecognized op %q", kind)
+}
+
+type rawOp struct {
+	Kind    string          `json:"op"`
+	Request json.RawMessage `json:"request"`
+	Commit  json.RawMessage `json:"commit,omitempty"`
+}
+
+// Writer writes a stream.
+type Writer struct {
+	w         io.Writer
+	marshaler *jsonpb.Marshaler
+}
+
+// NewWriter writes header to w and returns a Writer for the ops that follow
+// it.
+func NewWriter(w io.Writer, header *Header) (*Writer, error) {
+	if err := json.NewEncoder(w).Encode(header); err != nil {
+		return nil, err
+	}
+	return &Writer{
+		w:         w,
+		marshaler: &jsonpb.Marshaler{OrigName: true},
+	}, nil
+}
+
+// Write writes an op.
+func (w *Writer) Write(op *Op) error {
+	raw := &rawOp{Kind: op.Kind}
+	var err error
+	if raw.Request, err = w.marshal(op.Request); err != nil {
+		return err
+	}
+	if op.Commit != nil {
+		if raw.Commit, err = w.marshal(op.Commit); err != nil {
+			return err
+		}
+	}
+	return json.NewEncoder(w.w).Encode(raw)
+}
+
+func (w *Writer) marshal(message proto.Message) (json.RawMessage, error) {
+	var buffer bytes.Buffer
+	if err := w.marshaler.Marshal(&buffer, message); err != nil {
+		return nil, err
+	}
+	return json.RawMessage(buffer.Bytes()), nil
+}
+
+// Reader reads a stream.
+type Reader struct {
+	decoder *json.Decoder
+	header  *Header
+}
+
+// NewReader reads the header of the stream in r and returns a Reader for the
+// ops that follow it.
+func NewReader(r io.Reader) (*Reader, error) {
+	decoder := json.NewDecoder(bufio.NewReader(r))
+	header := &Header{}
+	if err := decoder.Decode(header); err != nil {
+		return nil, fmt.Errorf("error reading header: %v", err)
+	}
+	if header.Version < 1 || header.Version > Version {
+		return nil, fmt.Errorf("unsupported stream version %d, this version of pachctl supports up to %d", header.Version, Version)
+	}
+	return &Reader{
+		decoder: decoder,
+		header:  header,
+	}, nil
+}
+
+// Header returns the header of the stream.
+func (r *Reader) Header() *Header {
+	return r.header
+}
+
+// Read reads the next op, it returns io.EOF at the end of the stream.
+func (r *Reader) Read() (*Op, error) {
+	raw := &rawOp{}
+	if err := r.decoder.Decode(raw); err != nil {
+		return nil, err
+	}
+	request, err := newRequest(raw.Kind)
+	if err != nil {
+		return nil, err
+	}
+	if err := jsonpb.Unmarshal(bytes.NewReader(raw.Request), request); err != nil {
+		return nil, err
+	}
+	op := &Op{
+		Kind:    raw.Kind,
+		Request: request,
+	}
+	if len(raw.Commit) > 0 {
+		op.Commit = &pfs.Commit{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(raw.Commit), op.Commit); err != nil {
+			return nil, err
+		}
+	}
+	return op, nil
+}
@@ -0,0 +1,259 @@
+package admin
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pfs"
+	"github.com/sjezewski/pachyderm/src/client/pkg/require"
+	"github.com/sjezewski/pachyderm/src/client/pps"
+	pfsdb "github.com/sjezewski/pachyderm/src/server/pfs/db"
+	pfsserver "github.com/sjezewski/pachyderm/src/server/pfs/server"
+	"github.com/sjezewski/pachyderm/src/server/pps/persist"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func TestStreamRoundTrip(t *testing.T) {
+	ops := []*Op{
+		{
+			Kind:    OpCreateRepo,
+			Request: &pfs.CreateRepoRequest{Repo: client.NewRepo("in")},
+		},
+		{
+			Kind: OpCreatePipeline,
+			Request: &pps.CreatePipelineRequest{
+				Pipeline:  client.NewPipeline("out"),
+				Transform: &pps.Transform{Cmd: []string{"cp", "-r", "/pfs/in", "/pfs/out"}},
+				Inputs:    []*pps.PipelineInput{{Repo: client.NewRepo("in"), Glob: "*"}},
+			},
+		},
+		{
+			Kind:    OpStartCommit,
+			Request: &pfs.StartCommitRequest{Parent: client.NewCommit("out", "master")},
+			Commit:  client.NewCommit("out", "master/0"),
+		},
+		{
+			Kind: OpPutFile,
+			Request: &pfs.PutFileRequest{
+				File:      client.NewFile("out", "master/0", "/file"),
+				FileType:  pfs.FileType_FILE_TYPE_REGULAR,
+				Delimiter: pfs.Delimiter_LINE,
+				Value:     []byte("foo\n\x00bar\n"),
+			},
+		},
+		{
+			Kind:    OpFinishCommit,
+			Request: &pfs.FinishCommitRequest{Commit: client.NewCommit("out", "master/0"), Cancel: true},
+		},
+		{
+			Kind: OpCreateJob,
+			Request: &persist.JobInfo{
+				JobID:        "job",
+				PipelineName: "out",
+				OutputCommit: client.NewCommit("out", "master/0"),
+				State:        pps.JobState_JOB_SUCCESS,
+			},
+		},
+		{
+			Kind:    OpStartPipeline,
+			Request: &pps.StartPipelineRequest{Pipeline: client.NewPipeline("out")},
+		},
+	}
+	var buffer bytes.Buffer
+	writer, err := NewWriter(&buffer, &Header{Version: Version})
+	require.NoError(t, err)
+	for _, op := range ops {
+		require.NoError(t, writer.Write(op))
+	}
+
+	reader, err := NewReader(&buffer)
+	require.NoError(t, err)
+	require.Equal(t, &Header{Version: Version}, reader.Header())
+	for _, op := range ops {
+		readOp, err := reader.Read()
+		require.NoError(t, err)
+		require.Equal(t, op, readOp)
+	}
+	_, err = reader.Read()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestNewerVersion(t *testing.T) {
+	_, err := NewReader(strings.NewReader(`{"version": 1000}`))
+	require.YesError(t, err)
+}
+
+func TestPutFileWriter(t *testing.T) {
+	var buffer bytes.Buffer
+	writer, err := NewWriter(&buffer, &Header{Version: Version})
+	require.NoError(t, err)
+	line := strings.Repeat("a", 1023) + "\n"
+	content := strings.Repeat(line, 2*chunkSize/len(line)+1)
+	w := &putFileWriter{
+		writer:    writer,
+		file:      client.NewFile("repo", "master/0", "file"),
+		delimiter: pfs.Delimiter_LINE,
+	}
+	_, err = w.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	reader, err := NewReader(&buffer)
+	require.NoError(t, err)
+	var values []string
+	for {
+		op, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		value := op.Request.(*pfs.PutFileRequest).Value
+		// Chunks are split on newlines.
+		require.True(t, len(value) <= chunkSize)
+		require.Equal(t, byte('\n'), value[len(value)-1])
+		values = append(values, string(value))
+	}
+	require.Equal(t, 3, len(values))
+	require.Equal(t, content, strings.Join(values, ""))
+}
+
+func TestSplitValues(t *testing.T) {
+	value := `{"a":"` + strings.Repeat("a", 1000) + `"}`
+	data := []byte(strings.Repeat(value, 2*chunkSize/len(value)))
+	n := splitValues(pfs.Delimiter_JSON, data)
+	require.True(t, n <= chunkSize)
+	require.Equal(t, 0, n%len(value))
+	require.Equal(t, chunkSize, splitValues(pfs.Delimiter_NONE, data))
+
+	// A value which is longer than chunkSize isn't split.
+	value = `{"a":"` + strings.Repeat("a", chunkSize) + `"}`
+	require.Equal(t, len(value), splitValues(pfs.Delimiter_JSON, []byte(value+value)))
+	require.Equal(t, 0, splitValues(pfs.Delimiter_JSON, []byte(value[:chunkSize+1])))
+}
+
+func TestExtractRestore(t *testing.T) {
+	src, stopSrc := newCluster(t)
+	defer stopSrc()
+	_, err := src.PfsAPIClient.CreateRepo(context.Background(), &pfs.CreateRepoRequest{Repo: client.NewRepo("in")})
+	require.NoError(t, err)
+	_, err = src.PfsAPIClient.CreateRepo(context.Background(), &pfs.CreateRepoRequest{
+		Repo:       client.NewRepo("out"),
+		Provenance: []*pfs.Repo{client.NewRepo("in")},
+	})
+	require.NoError(t, err)
+
+	root, err := src.StartCommit("in", "master")
+	require.NoError(t, err)
+	_, err = src.PutFile("in", root.ID, "a", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	_, err = src.PutFileWithDelimiter("in", root.ID, "dir/b", pfs.Delimiter_JSON, strings.NewReader(`{"b":1}{"b":2}`))
+	require.NoError(t, err)
+	require.NoError(t, src.FinishCommit("in", root.ID))
+	child, err := src.StartCommit("in", "master")
+	require.NoError(t, err)
+	_, err = src.PutFile("in", child.ID, "a", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+	require.NoError(t, src.DeleteFile("in", child.ID, "dir/b"))
+	_, err = src.PutFileWithDelimiter("in", child.ID, "dir/b", pfs.Delimiter_NONE, strings.NewReader("baz"))
+	require.NoError(t, err)
+	require.NoError(t, src.FinishCommit("in", child.ID))
+	fork, err := src.ForkCommit("in", root.ID, "fork")
+	require.NoError(t, err)
+	_, err = src.PutFile("in", fork.ID, "c", strings.NewReader("fizz\n"))
+	require.NoError(t, err)
+	require.NoError(t, src.CancelCommit("in", fork.ID))
+	out, err := src.PfsAPIClient.StartCommit(context.Background(), &pfs.StartCommitRequest{
+		Parent:     client.NewCommit("out", "master"),
+		Provenance: []*pfs.Commit{child},
+	})
+	require.NoError(t, err)
+	_, err = src.PutFile("out", out.ID, "d", strings.NewReader("buzz\n"))
+	require.NoError(t, err)
+	require.NoError(t, src.FinishCommit("out", out.ID))
+	require.NoError(t, src.ArchiveCommit("out", out.ID))
+
+	var buffer bytes.Buffer
+	require.NoError(t, Extract(src, noPipelines{}, &buffer))
+	dst, stopDst := newCluster(t)
+	defer stopDst()
+	require.NoError(t, Restore(dst, noPipelines{}, &buffer))
+
+	for _, commit := range []*pfs.Commit{root, child, fork, out} {
+		srcInfo, err := src.InspectCommit(commit.Repo.Name, commit.ID)
+		require.NoError(t, err)
+		dstInfo, err := dst.InspectCommit(commit.Repo.Name, commit.ID)
+		require.NoError(t, err)
+		require.Equal(t, srcInfo.Branch, dstInfo.Branch)
+		require.Equal(t, srcInfo.ParentCommit, dstInfo.ParentCommit)
+		require.Equal(t, srcInfo.SizeBytes, dstInfo.SizeBytes)
+		require.Equal(t, srcInfo.Cancelled, dstInfo.Cancelled)
+		require.Equal(t, srcInfo.Archived, dstInfo.Archived)
+		require.Equal(t, srcInfo.Provenance, dstInfo.Provenance)
+	}
+	for _, file := range []*pfs.File{
+		client.NewFile("in", root.ID, "/a"),
+		client.NewFile("in", root.ID, "/dir/b"),
+		client.NewFile("in", child.ID, "/a"),
+		client.NewFile("in", child.ID, "/dir/b"),
+		client.NewFile("in", fork.ID, "/c"),
+		client.NewFile("out", out.ID, "/d"),
+	} {
+		repo, commitID, path := file.Commit.Repo.Name, file.Commit.ID, file.Path
+		srcInfo, err := src.InspectFile(repo, commitID, path, "", false, nil)
+		require.NoError(t, err)
+		dstInfo, err := dst.InspectFile(repo, commitID, path, "", false, nil)
+		require.NoError(t, err)
+		require.Equal(t, srcInfo.SizeBytes, dstInfo.SizeBytes)
+		require.Equal(t, srcInfo.Delimiter, dstInfo.Delimiter)
+		var srcContent, dstContent bytes.Buffer
+		require.NoError(t, src.GetFile(repo, commitID, path, 0, 0, "", false, nil, &srcContent))
+		require.NoError(t, dst.GetFile(repo, commitID, path, 0, 0, "", false, nil, &dstContent))
+		require.Equal(t, srcContent.String(), dstContent.String())
+	}
+}
+
+// noPipelines is the persist client of a cluster with no jobs or pipelines.
+type noPipelines struct {
+	persist.APIClient
+}
+
+func (noPipelines) ListJobInfos(ctx context.Context, request *pps.ListJobRequest, opts ...grpc.CallOption) (*persist.JobInfos, error) {
+	return &persist.JobInfos{}, nil
+}
+
+func (noPipelines) ListPipelineInfos(ctx context.Context, request *persist.ListPipelineInfosRequest, opts ...grpc.CallOption) (*persist.PipelineInfos, error) {
+	return &persist.PipelineInfos{}, nil
+}
+
+// newCluster serves pfs from a temporary directory and returns a client
+// for it and a function that stops the server.
+func newCluster(t *testing.T) (*client.APIClient, func()) {
+	dir, err := ioutil.TempDir("", "admin")
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	blockAPIServer, err := pfsserver.NewLocalBlockAPIServer(dir)
+	require.NoError(t, err)
+	driver, err := pfsdb.NewBoltDriver(address, filepath.Join(dir, "pfs.db"))
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	pfs.RegisterBlockAPIServer(server, blockAPIServer)
+	pfs.RegisterAPIServer(server, pfsserver.NewAPIServer(driver))
+	go server.Serve(listener)
+	c, err := client.NewFromAddress(address)
+	require.NoError(t, err)
+	return c, func() {
+		server.Stop()
+		os.RemoveAll(dir)
+	}
+}
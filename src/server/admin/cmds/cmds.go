@@ -0,0 +1,108 @@
+package cmds
+
+import (
+	"io"
+	"os"
+
+	"github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pkg/grpcutil"
+	"github.com/sjezewski/pachyderm/src/server/admin"
+	"github.com/sjezewski/pachyderm/src/server/pkg/cmd"
+	"github.com/sjezewski/pachyderm/src/server/pps/persist"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+// Cmds returns a slice containing admin commands.
+func Cmds(address string) []*cobra.Command {
+	var outputPath string
+	extract := &cobra.Command{
+		Use:   "extract",
+		Short: "Extract the state of the cluster.",
+		Long: `Extract the state of the cluster: every repo, commit, file, pipeline and job.
+
+The output is a stream which can be restored into an empty cluster with
+restore. It's written to stdout unless --output is set.
+
+Examples:
+
+	# back up a cluster
+	$ pachctl extract -o backup
+`,
+		Run: cmd.RunFixedArgs(0, func(args []string) (retErr error) {
+			c, persistClient, err := getClients(address)
+			if err != nil {
+				return err
+			}
+			var w io.Writer = os.Stdout
+			if outputPath != "" {
+				f, err := os.Create(outputPath)
+				if err != nil {
+					return err
+				}
+				defer func() {
+					if err := f.Close(); err != nil && retErr == nil {
+						retErr = err
+					}
+				}()
+				w = f
+			}
+			return admin.Extract(c, persistClient, w)
+		}),
+	}
+	extract.Flags().StringVarP(&outputPath, "output", "o", "", "The file to write the stream to.")
+
+	var inputPath string
+	restore := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore the state of a cluster.",
+		Long: `Restore the state of a cluster from a stream written by extract.
+
+The cluster should be empty. The stream is read from stdin unless --input is
+set.
+
+Examples:
+
+	# restore a backup
+	$ pachctl restore -i backup
+`,
+		Run: cmd.RunFixedArgs(0, func(args []string) error {
+			c, persistClient, err := getClients(address)
+			if err != nil {
+				return err
+			}
+			var r io.Reader = os.Stdin
+			if inputPath != "" {
+				f, err := os.Open(inputPath)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				r = f
+			}
+			return admin.Restore(c, persistClient, r)
+		}),
+	}
+	restore.Flags().StringVarP(&inputPath, "input", "i", "", "The file to read the stream from.")
+
+	return []*cobra.Command{extract, restore}
+}
+
+// getClients returns clients for the pfs and persist APIs served by pachd at
+// address.
+func getClients(address string) (*client.APIClient, persist.APIClient, error) {
+	c, err := client.NewFromAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+	dialOption, err := grpcutil.DialOptionFromEnv()
+	if err != nil {
+		return nil, nil, err
+	}
+	clientConn, err := grpc.Dial(address, dialOption)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c, persist.NewAPIClient(clientConn), nil
+}
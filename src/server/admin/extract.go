@@ -0,0 +1,437 @@
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"path"
+	"sort"
+
+	"github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pfs"
+	"github.com/sjezewski/pachyderm/src/client/pps"
+	"github.com/sjezewski/pachyderm/src/server/pps/persist"
+
+	"golang.org/x/net/context"
+)
+
+// chunkSize is the most file content put in a single OpPutFile.
+const chunkSize = 1024 * 1024
+
+// Extract writes the state of the cluster to w.
+func Extract(c *client.APIClient, persistClient persist.APIClient, w io.Writer) error {
+	writer, err := NewWriter(w, &Header{
+		Version: Version,
+	})
+	if err != nil {
+		return err
+	}
+	e := &extractor{
+		c:      c,
+		writer: writer,
+	}
+
+	repoInfos, err := c.ListRepo(nil)
+	if err != nil {
+		return err
+	}
+	// ListRepo doesn't include provenance.
+	for i, repoInfo := range repoInfos {
+		if repoInfos[i], err = c.InspectRepo(repoInfo.Repo.Name); err != nil {
+			return err
+		}
+	}
+	// A repo's provenance includes its provenance's provenance, so sorting
+	// by the amount of provenance creates repos after their provenance.
+	sort.Sort(byProvenance(repoInfos))
+	pipelineInfos, err := persistClient.ListPipelineInfos(context.Background(), &persist.ListPipelineInfosRequest{})
+	if err != nil {
+		return err
+	}
+	pipelines := make(map[string]*persist.PipelineInfo)
+	for _, pipelineInfo := range pipelineInfos.PipelineInfo {
+		pipelines[pipelineInfo.OutputRepo.Name] = pipelineInfo
+	}
+	for _, repoInfo := range repoInfos {
+		op := &Op{
+			Kind: OpCreateRepo,
+			Request: &pfs.CreateRepoRequest{
+				Repo:       repoInfo.Repo,
+				Provenance: repoInfo.Provenance,
+			},
+		}
+		// Pipelines create their output repos, so they're created in
+		// their place.
+		if pipelineInfo, ok := pipelines[repoInfo.Repo.Name]; ok {
+			op = &Op{
+				Kind: OpCreatePipeline,
+				Request: &pps.CreatePipelineRequest{
+					Pipeline:        &pps.Pipeline{Name: pipelineInfo.PipelineName},
+					Transform:       pipelineInfo.Transform,
+					ParallelismSpec: pipelineInfo.ParallelismSpec,
+					Inputs:          pipelineInfo.Inputs,
+					Combinator:      pipelineInfo.Combinator,
+				},
+			}
+		}
+		if err := writer.Write(op); err != nil {
+			return err
+		}
+	}
+
+	var archived []*pfs.Commit
+	for _, repoInfo := range repoInfos {
+		commitInfos, err := c.ListCommit([]*pfs.Commit{client.NewCommit(repoInfo.Repo.Name, "")}, nil, client.CommitTypeNone, client.CommitStatusAll, false)
+		if err != nil {
+			return err
+		}
+		byID := make(map[string]*pfs.CommitInfo)
+		for _, commitInfo := range commitInfos {
+			byID[commitInfo.Commit.ID] = commitInfo
+		}
+		done := make(map[string]bool)
+		var extractCommit func(commitInfo *pfs.CommitInfo) error
+		extractCommit = func(commitInfo *pfs.CommitInfo) error {
+			if done[commitInfo.Commit.ID] {
+				return nil
+			}
+			done[commitInfo.Commit.ID] = true
+			// Parents are extracted first so that they exist when the
+			// commit is restored.
+			if commitInfo.ParentCommit != nil {
+				if parentInfo, ok := byID[commitInfo.ParentCommit.ID]; ok {
+					if err := extractCommit(parentInfo); err != nil {
+						return err
+					}
+				}
+			}
+			return e.extractCommit(commitInfo)
+		}
+		for _, commitInfo := range commitInfos {
+			if err := extractCommit(commitInfo); err != nil {
+				return err
+			}
+			if commitInfo.Archived {
+				archived = append(archived, commitInfo.Commit)
+			}
+		}
+	}
+	if len(archived) > 0 {
+		if err := writer.Write(&Op{
+			Kind:    OpArchiveCommit,
+			Request: &pfs.ArchiveCommitRequest{Commits: archived},
+		}); err != nil {
+			return err
+		}
+	}
+
+	jobInfos, err := persistClient.ListJobInfos(context.Background(), &pps.ListJobRequest{})
+	if err != nil {
+		return err
+	}
+	for _, jobInfo := range jobInfos.JobInfo {
+		if err := writer.Write(&Op{
+			Kind:    OpCreateJob,
+			Request: jobInfo,
+		}); err != nil {
+			return err
+		}
+	}
+	// Pipelines are restarted once their jobs exist, so that they don't run
+	// the jobs again.
+	for _, pipelineInfo := range pipelineInfos.PipelineInfo {
+		if pipelineInfo.Stopped {
+			continue
+		}
+		if err := writer.Write(&Op{
+			Kind:    OpStartPipeline,
+			Request: &pps.StartPipelineRequest{Pipeline: &pps.Pipeline{Name: pipelineInfo.PipelineName}},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type byProvenance []*pfs.RepoInfo
+
+func (r byProvenance) Len() int      { return len(r) }
+func (r byProvenance) Swap(i, j int) { r[i], r[j] = r[j], r[i] }
+func (r byProvenance) Less(i, j int) bool {
+	if len(r[i].Provenance) != len(r[j].Provenance) {
+		return len(r[i].Provenance) < len(r[j].Provenance)
+	}
+	return r[i].Repo.Name < r[j].Repo.Name
+}
+
+type extractor struct {
+	c      *client.APIClient
+	writer *Writer
+	// files caches the files of the last commit extracted, since it's
+	// usually the parent of the next one.
+	files    map[string]*pfs.FileInfo
+	filesKey string
+}
+
+// extractCommit writes the ops to recreate a commit: starting it, the
+// changes to its files since its parent and finishing it.
+func (e *extractor) extractCommit(commitInfo *pfs.CommitInfo) error {
+	commit := commitInfo.Commit
+	repo := commit.Repo.Name
+	op := &Op{
+		Commit: commit,
+	}
+	switch {
+	case commitInfo.ParentCommit == nil:
+		op.Kind = OpStartCommit
+		op.Request = &pfs.StartCommitRequest{
+			Parent:     client.NewCommit(repo, commitInfo.Branch),
+			Provenance: commitInfo.Provenance,
+		}
+	case branch(commitInfo.ParentCommit.ID) != commitInfo.Branch:
+		op.Kind = OpForkCommit
+		op.Request = &pfs.ForkCommitRequest{
+			Parent:     commitInfo.ParentCommit,
+			Branch:     commitInfo.Branch,
+			Provenance: commitInfo.Provenance,
+		}
+	default:
+		op.Kind = OpStartCommit
+		op.Request = &pfs.StartCommitRequest{
+			Parent:     commitInfo.ParentCommit,
+			Provenance: commitInfo.Provenance,
+		}
+	}
+	if err := e.writer.Write(op); err != nil {
+		return err
+	}
+
+	var parentFiles map[string]*pfs.FileInfo
+	if commitInfo.ParentCommit != nil {
+		var err error
+		if parentFiles, err = e.listFiles(commitInfo.ParentCommit); err != nil {
+			return err
+		}
+	}
+	files, err := e.listFiles(commit)
+	if err != nil {
+		return err
+	}
+
+	// gone returns true if the file at p in the parent isn't in the commit,
+	// either because it was deleted or because it was replaced by a file of
+	// a different type.
+	gone := func(p string) bool {
+		return parentFiles[p] != nil && (files[p] == nil || files[p].FileType != parentFiles[p].FileType)
+	}
+	// Deletions come first, since a file may have been deleted and then
+	// written again in the same commit.  Only the top most deleted path is
+	// deleted, which deletes everything under it.
+	var deleted []string
+	for p := range parentFiles {
+		if gone(p) && !gone(path.Dir(p)) {
+			deleted = append(deleted, p)
+		}
+	}
+	sort.Strings(deleted)
+	for _, p := range deleted {
+		if err := e.writer.Write(&Op{
+			Kind:    OpDeleteFile,
+			Request: &pfs.DeleteFileRequest{File: client.NewFile(repo, commit.ID, p)},
+		}); err != nil {
+			return err
+		}
+	}
+
+	var paths []string
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		fileInfo := files[p]
+		parentInfo := parentFiles[p]
+		if gone(p) {
+			parentInfo = nil
+		}
+		if fileInfo.FileType == pfs.FileType_FILE_TYPE_DIR {
+			if parentInfo == nil {
+				if err := e.writer.Write(&Op{
+					Kind: OpPutFile,
+					Request: &pfs.PutFileRequest{
+						File:     client.NewFile(repo, commit.ID, p),
+						FileType: pfs.FileType_FILE_TYPE_DIR,
+					},
+				}); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if parentInfo != nil && (fileInfo.CommitModified == nil || fileInfo.CommitModified.ID != commit.ID) {
+			continue
+		}
+		if err := e.extractFile(commitInfo, fileInfo, parentInfo); err != nil {
+			return err
+		}
+	}
+
+	if commitInfo.Finished != nil {
+		return e.writer.Write(&Op{
+			Kind: OpFinishCommit,
+			Request: &pfs.FinishCommitRequest{
+				Commit: commit,
+				Cancel: commitInfo.Cancelled,
+			},
+		})
+	}
+	return nil
+}
+
+// extractFile writes the ops to recreate the changes made to a file in a
+// commit.  Changes are appends to the file unless the file was deleted and
+// written again, in which case the whole file is written.
+func (e *extractor) extractFile(commitInfo *pfs.CommitInfo, fileInfo *pfs.FileInfo, parentInfo *pfs.FileInfo) error {
+	commit := commitInfo.Commit
+	repo := commit.Repo.Name
+	p := fileInfo.File.Path
+	var fromCommitID string
+	if parentInfo != nil {
+		diffInfo, err := e.c.InspectFile(repo, commit.ID, p, commitInfo.ParentCommit.ID, false, nil)
+		if err != nil {
+			return err
+		}
+		if parentInfo.SizeBytes+diffInfo.SizeBytes == fileInfo.SizeBytes {
+			fromCommitID = commitInfo.ParentCommit.ID
+		} else if err := e.writer.Write(&Op{
+			Kind:    OpDeleteFile,
+			Request: &pfs.DeleteFileRequest{File: client.NewFile(repo, commit.ID, p)},
+		}); err != nil {
+			return err
+		}
+	}
+	w := &putFileWriter{
+		writer:    e.writer,
+		file:      client.NewFile(repo, commit.ID, p),
+		delimiter: fileInfo.Delimiter,
+	}
+	if err := e.c.GetFile(repo, commit.ID, p, 0, 0, fromCommitID, false, nil, w); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// listFiles returns every file and directory in a commit, keyed by path.
+func (e *extractor) listFiles(commit *pfs.Commit) (map[string]*pfs.FileInfo, error) {
+	key := commit.Repo.Name + "/" + commit.ID
+	if e.filesKey == key {
+		return e.files, nil
+	}
+	files := make(map[string]*pfs.FileInfo)
+	var list func(dir string) error
+	list = func(dir string) error {
+		fileInfos, err := e.c.ListFile(commit.Repo.Name, commit.ID, dir, "", false, nil, false)
+		if err != nil {
+			return err
+		}
+		for _, fileInfo := range fileInfos {
+			files[fileInfo.File.Path] = fileInfo
+			if fileInfo.FileType == pfs.FileType_FILE_TYPE_DIR {
+				if err := list(fileInfo.File.Path); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := list("/"); err != nil {
+		return nil, err
+	}
+	e.files = files
+	e.filesKey = key
+	return files, nil
+}
+
+// branch returns the branch of a commit ID of the form "branch/clock".
+func branch(commitID string) string {
+	return path.Dir(commitID)
+}
+
+// putFileWriter writes the content written to it as OpPutFiles with the
+// file's delimiter, each of which is at most chunkSize bytes and split
+// between values where it can.
+type putFileWriter struct {
+	writer    *Writer
+	file      *pfs.File
+	delimiter pfs.Delimiter
+	buffer    bytes.Buffer
+	sent      bool
+}
+
+func (w *putFileWriter) Write(p []byte) (int, error) {
+	w.buffer.Write(p)
+	for w.buffer.Len() >= chunkSize {
+		n := splitValues(w.delimiter, w.buffer.Bytes())
+		if n == 0 {
+			break
+		}
+		if err := w.put(w.buffer.Bytes()[:n]); err != nil {
+			return 0, err
+		}
+		w.buffer.Next(n)
+	}
+	return len(p), nil
+}
+
+// Close writes whatever is left in the buffer.  An OpPutFile is always
+// written, so that empty files are recreated.
+func (w *putFileWriter) Close() error {
+	if w.buffer.Len() > 0 || !w.sent {
+		return w.put(w.buffer.Bytes())
+	}
+	return nil
+}
+
+func (w *putFileWriter) put(value []byte) error {
+	w.sent = true
+	return w.writer.Write(&Op{
+		Kind: OpPutFile,
+		Request: &pfs.PutFileRequest{
+			File:      w.file,
+			FileType:  pfs.FileType_FILE_TYPE_REGULAR,
+			Delimiter: w.delimiter,
+			Value:     value,
+		},
+	})
+}
+
+// splitValues returns the length of the longest prefix of data which is at
+// most chunkSize bytes and ends between two of delimiter's values.  For
+// JSON, whose values can't be split, it's longer than chunkSize if the
+// first value is, and 0 if data doesn't hold a whole value yet.
+func splitValues(delimiter pfs.Delimiter, data []byte) int {
+	switch delimiter {
+	case pfs.Delimiter_LINE:
+		if i := bytes.LastIndexByte(data[:chunkSize], '\n'); i >= 0 {
+			return i + 1
+		}
+	case pfs.Delimiter_JSON:
+		reader := bytes.NewReader(data)
+		decoder := json.NewDecoder(reader)
+		n := 0
+		for {
+			var value json.RawMessage
+			if err := decoder.Decode(&value); err != nil {
+				return n
+			}
+			end := len(data) - reader.Len() - decoder.Buffered().(*bytes.Reader).Len()
+			// A value which ends with data, such as a number, might
+			// continue in what's written next.
+			if end == len(data) || (end > chunkSize && n > 0) {
+				return n
+			}
+			n = end
+		}
+	}
+	return chunkSize
+}
@@ -0,0 +1,103 @@
+package admin
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pfs"
+	"github.com/sjezewski/pachyderm/src/client/pps"
+	"github.com/sjezewski/pachyderm/src/server/pps/persist"
+
+	"golang.org/x/net/context"
+)
+
+// Restore replays the stream in r, which must have been written by Extract,
+// into the cluster.  The cluster should be empty, Restore returns an error
+// if the commits it creates don't have the same IDs as they had when they
+// were extracted.  Pipelines are created through pps, so they're validated
+// and get their output repos as they would for CreatePipeline, but they're
+// stopped until the stream's jobs have been restored.
+func Restore(c *client.APIClient, persistClient persist.APIClient, r io.Reader) error {
+	reader, err := NewReader(r)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	for {
+		op, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := restoreOp(ctx, c, persistClient, op); err != nil {
+			return fmt.Errorf("error restoring %s %v: %v", op.Kind, op.Request, err)
+		}
+	}
+}
+
+func restoreOp(ctx context.Context, c *client.APIClient, persistClient persist.APIClient, op *Op) error {
+	switch request := op.Request.(type) {
+	case *pfs.CreateRepoRequest:
+		_, err := c.PfsAPIClient.CreateRepo(ctx, request)
+		return err
+	case *pfs.StartCommitRequest:
+		commit, err := c.PfsAPIClient.StartCommit(ctx, request)
+		if err != nil {
+			return err
+		}
+		return checkCommit(op.Commit, commit)
+	case *pfs.ForkCommitRequest:
+		commit, err := c.PfsAPIClient.ForkCommit(ctx, request)
+		if err != nil {
+			return err
+		}
+		return checkCommit(op.Commit, commit)
+	case *pfs.PutFileRequest:
+		putFileClient, err := c.PfsAPIClient.PutFile(ctx)
+		if err != nil {
+			return err
+		}
+		if err := putFileClient.Send(request); err != nil {
+			return err
+		}
+		_, err = putFileClient.CloseAndRecv()
+		return err
+	case *pfs.DeleteFileRequest:
+		_, err := c.PfsAPIClient.DeleteFile(ctx, request)
+		return err
+	case *pfs.FinishCommitRequest:
+		_, err := c.PfsAPIClient.FinishCommit(ctx, request)
+		return err
+	case *pfs.ArchiveCommitRequest:
+		_, err := c.PfsAPIClient.ArchiveCommit(ctx, request)
+		return err
+	case *persist.JobInfo:
+		// These are set by the persist server.
+		request.Started = nil
+		request.CommitIndex = ""
+		_, err := persistClient.CreateJobInfo(ctx, request)
+		return err
+	case *pps.CreatePipelineRequest:
+		if _, err := c.PpsAPIClient.CreatePipeline(ctx, request); err != nil {
+			return err
+		}
+		// The pipeline mustn't process the commits which are restored
+		// after it, their jobs are restored too.
+		_, err := c.PpsAPIClient.StopPipeline(ctx, &pps.StopPipelineRequest{Pipeline: request.Pipeline})
+		return err
+	case *pps.StartPipelineRequest:
+		_, err := c.PpsAPIClient.StartPipeline(ctx, request)
+		return err
+	}
+	return fmt.Errorf("unrecognized op %q", op.Kind)
+}
+
+func checkCommit(expected *pfs.Commit, actual *pfs.Commit) error {
+	if expected != nil && expected.ID != actual.ID {
+		return fmt.Errorf("commit %s/%s was restored as %s, restore should be run against an empty cluster", expected.Repo.Name, expected.ID, actual.ID)
+	}
+	return nil
+}
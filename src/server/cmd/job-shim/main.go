@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pkg/tracing"
 	ppsclient "github.com/sjezewski/pachyderm/src/client/pps"
 	"github.com/sjezewski/pachyderm/src/server/pfs/fuse"
 	"github.com/sjezewski/pachyderm/src/server/pkg/cmd"
@@ -18,12 +19,15 @@ import (
 	"github.com/spf13/cobra"
 	"go.pedge.io/env"
 	"go.pedge.io/lion"
-	"golang.org/x/net/context"
 )
 
 type appEnv struct {
 	PachydermAddress string `env:"PACHD_PORT_650_TCP_ADDR,required"`
 	PodName          string `env:"PPS_POD_NAME,required"`
+	// RandomWrites lets user code write files in /pfs/out at any offset,
+	// pipelines enable it by setting PFS_FUSE_RANDOM_WRITES in their
+	// transform's env.
+	RandomWrites bool `env:"PFS_FUSE_RANDOM_WRITES"`
 }
 
 func main() {
@@ -37,16 +41,26 @@ func do(appEnvObj interface{}) error {
 		Short: `Pachyderm job-shim, coordinates with ppsd to create an output commit and run user work.`,
 		Long:  `Pachyderm job-shim, coordinates with ppsd to create an output commit and run user work.`,
 		Run: cmd.RunFixedArgs(1, func(args []string) (retErr error) {
+			// Tracing is enabled by setting PACH_TRACE in the transform's
+			// env, the shim's RPCs are all part of one trace.
+			if err := tracing.SetTracerFromEnv("job-shim"); err != nil {
+				return err
+			}
+			rootSpan := tracing.StartRootSpan("job-shim")
+			rootSpan.SetTag("job", args[0])
+			rootSpan.SetTag("pod", appEnv.PodName)
+			defer func() { tracing.FinishRootSpan(retErr) }()
 			ppsClient, err := ppsserver.NewInternalPodAPIClientFromAddress(fmt.Sprintf("%v:650", appEnv.PachydermAddress))
 			if err != nil {
 				return err
 			}
+			job := &ppsclient.Job{
+				ID: args[0],
+			}
 			response, err := ppsClient.StartPod(
-				context.Background(),
+				tracing.Background(),
 				&ppsserver.StartPodRequest{
-					Job: &ppsclient.Job{
-						ID: args[0],
-					},
+					Job:     job,
 					PodName: appEnv.PodName,
 				})
 			if err != nil {
@@ -66,11 +80,13 @@ func do(appEnvObj interface{}) error {
 				if r := recover(); r != nil && !finished {
 					fmt.Println("job shim crashed; this is like a bug in pachyderm")
 					if _, err := ppsClient.FinishPod(
-						context.Background(),
+						tracing.Background(),
 						&ppsserver.FinishPodRequest{
 							ChunkID: response.ChunkID,
 							PodName: appEnv.PodName,
 							Success: false,
+							Job:     job,
+							Error:   fmt.Sprintf("job shim crashed: %v", r),
 						},
 					); err != nil && retErr == nil {
 						retErr = err
@@ -83,31 +99,44 @@ func do(appEnvObj interface{}) error {
 				return err
 			}
 
-			mounter := fuse.NewMounter(appEnv.PachydermAddress, c)
-			ready := make(chan bool)
-			errCh := make(chan error)
-			go func() {
-				if err := mounter.MountAndCreate(
-					"/pfs",
-					nil,
-					response.CommitMounts,
-					ready,
-					response.Transform.Debug,
-					false,
-				); err != nil {
-					errCh <- err
+			copyMode := response.Transform.PfsMode == ppsclient.PfsMode_COPY
+			if copyMode {
+				span, _ := tracing.StartSpan(tracing.Background(), "copy-in")
+				err := fuse.CopyIn(c, "/pfs", response.CommitMounts)
+				tracing.FinishSpan(span, err)
+				if err != nil {
+					return err
 				}
-			}()
-			select {
-			case <-ready:
-			case err := <-errCh:
-				return err
-			}
-			defer func() {
-				if err := mounter.Unmount("/pfs"); err != nil && retErr == nil {
-					retErr = err
+			} else {
+				mounter := fuse.NewMounterWithOptions(appEnv.PachydermAddress, c, &fuse.Options{
+					RandomWrites: appEnv.RandomWrites,
+					JobID:        args[0],
+				})
+				ready := make(chan bool)
+				errCh := make(chan error)
+				go func() {
+					if err := mounter.MountAndCreate(
+						"/pfs",
+						nil,
+						response.CommitMounts,
+						ready,
+						response.Transform.Debug,
+						false,
+					); err != nil {
+						errCh <- err
+					}
+				}()
+				select {
+				case <-ready:
+				case err := <-errCh:
+					return err
 				}
-			}()
+				defer func() {
+					if err := mounter.Unmount("/pfs"); err != nil && retErr == nil {
+						retErr = err
+					}
+				}()
+			}
 			var readers []io.Reader
 			for _, line := range response.Transform.Stdin {
 				readers = append(readers, strings.NewReader(line+"\n"))
@@ -115,11 +144,13 @@ func do(appEnvObj interface{}) error {
 			if len(response.Transform.Cmd) == 0 {
 				fmt.Println("unable to run; a cmd needs to be provided")
 				if _, err := ppsClient.FinishPod(
-					context.Background(),
+					tracing.Background(),
 					&ppsserver.FinishPodRequest{
 						ChunkID: response.ChunkID,
 						PodName: appEnv.PodName,
 						Success: false,
+						Job:     job,
+						Error:   "no cmd provided",
 					},
 				); err != nil {
 					return err
@@ -128,43 +159,59 @@ func do(appEnvObj interface{}) error {
 				return
 			}
 
-			cmdCh := make(chan bool)
+			cmdCh := make(chan *ppsserver.FinishPodRequest)
 			go func() {
 				cmd := exec.Command(response.Transform.Cmd[0], response.Transform.Cmd[1:]...)
 				cmd.Stdin = io.MultiReader(readers...)
 				cmd.Stdout = os.Stdout
 				cmd.Stderr = os.Stderr
-				success := true
-				if err := cmd.Run(); err != nil {
-					success = false
+				finishRequest := &ppsserver.FinishPodRequest{
+					ChunkID: response.ChunkID,
+					PodName: appEnv.PodName,
+					Success: true,
+					Job:     job,
+				}
+				span, _ := tracing.StartSpan(tracing.Background(), "transform")
+				err := cmd.Run()
+				tracing.FinishSpan(span, err)
+				if err != nil {
+					finishRequest.Success = false
+					finishRequest.Error = err.Error()
 					if exiterr, ok := err.(*exec.ExitError); ok {
 						if status, ok := exiterr.Sys().(syscall.WaitStatus); ok {
 							for _, returnCode := range response.Transform.AcceptReturnCode {
 								if int(returnCode) == status.ExitStatus() {
-									success = true
+									finishRequest.Success = true
+									finishRequest.Error = ""
 								}
 							}
+							if !finishRequest.Success && status.Exited() {
+								finishRequest.ExitCode = int64(status.ExitStatus())
+							}
 						}
 					}
-					if !success {
+					if !finishRequest.Success {
 						fmt.Fprintf(os.Stderr, "Error from exec: %s\n", err.Error())
 					}
 				}
-				cmdCh <- success
+				if finishRequest.Success && copyMode {
+					span, _ := tracing.StartSpan(tracing.Background(), "copy-out")
+					err := fuse.CopyOut(c, "/pfs", response.CommitMounts)
+					tracing.FinishSpan(span, err)
+					if err != nil {
+						fmt.Fprintf(os.Stderr, "Error uploading /pfs/out: %s\n", err.Error())
+						finishRequest.Success = false
+						finishRequest.Error = fmt.Sprintf("error uploading /pfs/out: %s", err.Error())
+					}
+				}
+				cmdCh <- finishRequest
 			}()
 
 			tick := time.Tick(10 * time.Second)
 			for {
 				select {
-				case success := <-cmdCh:
-					res, err := ppsClient.FinishPod(
-						context.Background(),
-						&ppsserver.FinishPodRequest{
-							ChunkID: response.ChunkID,
-							PodName: appEnv.PodName,
-							Success: success,
-						},
-					)
+				case finishRequest := <-cmdCh:
+					res, err := ppsClient.FinishPod(tracing.Background(), finishRequest)
 					if err != nil {
 						return err
 					}
@@ -175,7 +222,7 @@ func do(appEnvObj interface{}) error {
 					return nil
 				case <-tick:
 					res, err := ppsClient.ContinuePod(
-						context.Background(),
+						tracing.Background(),
 						&ppsserver.ContinuePodRequest{
 							ChunkID: response.ChunkID,
 							PodName: appEnv.PodName,
@@ -16,14 +16,17 @@ import (
 	"google.golang.org/grpc/grpclog"
 
 	"github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pkg/grpcutil"
+	"github.com/sjezewski/pachyderm/src/client/pkg/tracing"
 	"github.com/sjezewski/pachyderm/src/client/version"
+	admincmds "github.com/sjezewski/pachyderm/src/server/admin/cmds"
 	pfscmds "github.com/sjezewski/pachyderm/src/server/pfs/cmds"
+	pkgcmd "github.com/sjezewski/pachyderm/src/server/pkg/cmd"
 	deploycmds "github.com/sjezewski/pachyderm/src/server/pkg/deploy/cmds"
 	ppscmds "github.com/sjezewski/pachyderm/src/server/pps/cmds"
 	"github.com/spf13/cobra"
 	"go.pedge.io/lion"
 	"go.pedge.io/pb/go/google/protobuf"
-	"go.pedge.io/pkg/cobra"
 	"go.pedge.io/pkg/exec"
 	"go.pedge.io/proto/version"
 	"golang.org/x/net/context"
@@ -33,12 +36,23 @@ import (
 // which may interact with the host.
 func PachctlCmd(address string) (*cobra.Command, error) {
 	var verbose bool
+	var useTLS bool
+	var tlsCA string
+	var tlsCert string
+	var tlsKey string
+	var tlsServerName string
 	rootCmd := &cobra.Command{
 		Use: os.Args[0],
 		Long: `Access the Pachyderm API.
 
 Environment variables:
   ADDRESS=<host>:<port>, the pachd server to connect to (e.g. 127.0.0.1:30650).
+  PACH_TLS=true, connect to pachd over TLS.
+  PACH_TLS_CA=<path>, a PEM bundle of CAs used to verify pachd's certificate (implies PACH_TLS).
+  PACH_TLS_CERT=<path>, PACH_TLS_KEY=<path>, a client certificate and key for mutual TLS.
+  PACH_TLS_SERVER_NAME=<name>, the name expected in pachd's certificate.
+  PACH_TRACE=stdout|stderr|<path>, write a trace of the command's RPCs there, as JSON.
+The --tls* flags take precedence over these variables.
 `,
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
 			if !verbose {
@@ -47,9 +61,34 @@ Environment variables:
 				// Silence our FUSE logs
 				lion.SetLevel(lion.LevelNone)
 			}
+			// The clients used by subcommands read their TLS config from
+			// the environment, so flags are applied there.
+			flags := cmd.Flags()
+			for flag, env := range map[string]string{
+				"tls":             grpcutil.TLSEnv,
+				"tls-ca":          grpcutil.TLSCAEnv,
+				"tls-cert":        grpcutil.TLSCertEnv,
+				"tls-key":         grpcutil.TLSKeyEnv,
+				"tls-server-name": grpcutil.TLSServerNameEnv,
+			} {
+				if flags.Changed(flag) {
+					os.Setenv(env, flags.Lookup(flag).Value.String())
+				}
+			}
+			if err := tracing.SetTracerFromEnv("pachctl"); err != nil {
+				fmt.Fprintf(os.Stderr, "error setting up tracing: %s\n", err.Error())
+			}
+			// Every RPC the command makes is part of this span's trace, it's
+			// finished by main and by cmd.ErrorAndExit.
+			tracing.StartRootSpan(cmd.CommandPath())
 		},
 	}
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Output verbose logs")
+	rootCmd.PersistentFlags().BoolVar(&useTLS, "tls", false, "Connect to pachd over TLS.")
+	rootCmd.PersistentFlags().StringVar(&tlsCA, "tls-ca", "", "A PEM bundle of CAs used to verify pachd's certificate, implies --tls.")
+	rootCmd.PersistentFlags().StringVar(&tlsCert, "tls-cert", "", "A PEM client certificate to present to pachd for mutual TLS.")
+	rootCmd.PersistentFlags().StringVar(&tlsKey, "tls-key", "", "The PEM key for --tls-cert.")
+	rootCmd.PersistentFlags().StringVar(&tlsServerName, "tls-server-name", "", "The name expected in pachd's certificate, defaults to the host in ADDRESS.")
 
 	pfsCmds := pfscmds.Cmds(address)
 	for _, cmd := range pfsCmds {
@@ -62,13 +101,16 @@ Environment variables:
 	for _, cmd := range ppsCmds {
 		rootCmd.AddCommand(cmd)
 	}
+	for _, cmd := range admincmds.Cmds(address) {
+		rootCmd.AddCommand(cmd)
+	}
 	rootCmd.AddCommand(deploycmds.DeployCmd())
 
 	version := &cobra.Command{
 		Use:   "version",
 		Short: "Return version information.",
 		Long:  "Return version information.",
-		Run: pkgcobra.RunFixedArgs(0, func(args []string) error {
+		Run: pkgcmd.RunFixedArgs(0, func(args []string) error {
 			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
 			printVersionHeader(writer)
 			printVersion(writer, "pachctl", version.Version)
@@ -95,7 +137,7 @@ Environment variables:
 		Short: "Delete everything.",
 		Long: `Delete all repos, commits, files, pipelines and jobs.
 This resets the cluster to its initial state.`,
-		Run: pkgcobra.RunFixedArgs(0, func(args []string) error {
+		Run: pkgcmd.RunFixedArgs(0, func(args []string) error {
 			client, err := client.NewFromAddress(address)
 			if err != nil {
 				return sanitizeErr(err)
@@ -117,7 +159,7 @@ This resets the cluster to its initial state.`,
 		Use:   "port-forward",
 		Short: "Forward a port on the local machine to pachd. This command blocks.",
 		Long:  "Forward a port on the local machine to pachd. This command blocks.",
-		Run: pkgcobra.RunFixedArgs(0, func(args []string) error {
+		Run: pkgcmd.RunFixedArgs(0, func(args []string) error {
 			stdin := strings.NewReader(fmt.Sprintf(`
 pod=$(kubectl get pod -l app=pachd | awk '{if (NR!=1) { print $1; exit 0 }}')
 kubectl port-forward "$pod" %d:650
@@ -137,7 +179,11 @@ kubectl port-forward "$pod" %d:650
 }
 
 func getVersionAPIClient(address string) (protoversion.APIClient, error) {
-	clientConn, err := grpc.Dial(address, grpc.WithInsecure())
+	dialOption, err := grpcutil.DialOptionFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	clientConn, err := grpc.Dial(address, dialOption)
 	if err != nil {
 		return nil, err
 	}
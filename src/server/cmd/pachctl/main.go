@@ -3,6 +3,7 @@ package main
 import (
 	"os"
 
+	"github.com/sjezewski/pachyderm/src/client/pkg/tracing"
 	"github.com/sjezewski/pachyderm/src/server/cmd/pachctl/cmd"
 	"github.com/spf13/pflag"
 	"go.pedge.io/env"
@@ -16,7 +17,10 @@ func main() {
 	env.Main(do, &appEnv{})
 }
 
-func do(appEnvObj interface{}) error {
+func do(appEnvObj interface{}) (retErr error) {
+	// Commands which fail exit through cmd.ErrorAndExit, which also finishes
+	// the root span.
+	defer func() { tracing.FinishRootSpan(retErr) }()
 	pflag.CommandLine = pflag.NewFlagSet(os.Args[0], pflag.ExitOnError)
 	appEnv := appEnvObj.(*appEnv)
 	rootCmd, err := cmd.PachctlCmd(appEnv.Address)
@@ -3,9 +3,13 @@ package main
 import (
 	"errors"
 	"fmt"
+	"math"
+	"net"
 	"net/http"
 	_ "net/http/pprof"
 	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/sjezewski/pachyderm/src/client"
 	healthclient "github.com/sjezewski/pachyderm/src/client/health"
@@ -13,12 +17,15 @@ import (
 	"github.com/sjezewski/pachyderm/src/client/pkg/discovery"
 	"github.com/sjezewski/pachyderm/src/client/pkg/grpcutil"
 	"github.com/sjezewski/pachyderm/src/client/pkg/shard"
+	"github.com/sjezewski/pachyderm/src/client/pkg/tracing"
 	"github.com/sjezewski/pachyderm/src/client/pkg/uuid"
 	ppsclient "github.com/sjezewski/pachyderm/src/client/pps"
 	"github.com/sjezewski/pachyderm/src/client/version"
+	"github.com/sjezewski/pachyderm/src/server/gateway"
 	"github.com/sjezewski/pachyderm/src/server/health"
 	pfs_persist "github.com/sjezewski/pachyderm/src/server/pfs/db"
 	"github.com/sjezewski/pachyderm/src/server/pfs/drive"
+	"github.com/sjezewski/pachyderm/src/server/pfs/s3"
 	pfs_server "github.com/sjezewski/pachyderm/src/server/pfs/server"
 	cache_pb "github.com/sjezewski/pachyderm/src/server/pkg/cache/groupcachepb"
 	cache_server "github.com/sjezewski/pachyderm/src/server/pkg/cache/server"
@@ -29,12 +36,16 @@ import (
 	persist_server "github.com/sjezewski/pachyderm/src/server/pps/persist/server"
 	pps_server "github.com/sjezewski/pachyderm/src/server/pps/server"
 
+	"github.com/prometheus/client_golang/prometheus"
 	flag "github.com/spf13/pflag"
 	"go.pedge.io/env"
 	"go.pedge.io/lion"
 	"go.pedge.io/lion/proto"
-	"go.pedge.io/proto/server"
+	"go.pedge.io/proto/rpclog"
+	"go.pedge.io/proto/version"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"k8s.io/kubernetes/pkg/api"
 	kube_client "k8s.io/kubernetes/pkg/client/restclient"
 	kube "k8s.io/kubernetes/pkg/client/unversioned"
@@ -42,28 +53,47 @@ import (
 
 var readinessCheck bool
 
+// The values of DATABASE_TYPE.  With bolt, pachd stores its metadata in
+// embedded databases under BOLT_ROOT (PACH_ROOT by default) rather than in
+// rethinkdb.  Since the databases can't be shared, this only works with a
+// single pachd.
+const (
+	rethinkDatabase = "rethink"
+	boltDatabase    = "bolt"
+)
+
 func init() {
 	flag.BoolVar(&readinessCheck, "readiness-check", false, "Set to true when checking if local pod is ready")
 	flag.Parse()
 }
 
 type appEnv struct {
-	Port               uint16 `env:"PORT,default=650"`
-	NumShards          uint64 `env:"NUM_SHARDS,default=32"`
-	StorageRoot        string `env:"PACH_ROOT,required"`
-	StorageBackend     string `env:"STORAGE_BACKEND,default="`
-	DatabaseAddress    string `env:"RETHINK_PORT_28015_TCP_ADDR,required"`
-	PPSDatabaseName    string `env:"DATABASE_NAME,default=pachyderm_pps"`
-	PFSDatabaseName    string `env:"DATABASE_NAME,default=pachyderm_pfs"`
-	KubeAddress        string `env:"KUBERNETES_PORT_443_TCP_ADDR,required"`
-	EtcdAddress        string `env:"ETCD_PORT_2379_TCP_ADDR,required"`
-	Namespace          string `env:"NAMESPACE,default=default"`
-	Metrics            bool   `env:"METRICS,default=true"`
-	Init               bool   `env:"INIT,default=false"`
-	BlockCacheBytes    int64  `env:"BLOCK_CACHE_BYTES,default=1073741824"` //default = 1 gigabyte
-	JobShimImage       string `env:"JOB_SHIM_IMAGE,default="`
-	JobImagePullPolicy string `env:"JOB_IMAGE_PULL_POLICY,default="`
-	LogLevel           string `env:"LOG_LEVEL,default=info"`
+	Port                uint16 `env:"PORT,default=650"`
+	HTTPPort            uint16 `env:"HTTP_PORT,default=652"`
+	S3Port              uint16 `env:"S3_PORT,default=653"`
+	S3Gateway           bool   `env:"S3_GATEWAY,default=false"`
+	NumShards           uint64 `env:"NUM_SHARDS,default=32"`
+	StorageRoot         string `env:"PACH_ROOT,required"`
+	StorageBackend      string `env:"STORAGE_BACKEND,default="`
+	DatabaseType        string `env:"DATABASE_TYPE,default=rethink"`
+	DatabaseAddress     string `env:"RETHINK_PORT_28015_TCP_ADDR,default="`
+	BoltRoot            string `env:"BOLT_ROOT,default="`
+	PPSDatabaseName     string `env:"DATABASE_NAME,default=pachyderm_pps"`
+	PFSDatabaseName     string `env:"DATABASE_NAME,default=pachyderm_pfs"`
+	KubeAddress         string `env:"KUBERNETES_PORT_443_TCP_ADDR,required"`
+	EtcdAddress         string `env:"ETCD_PORT_2379_TCP_ADDR,required"`
+	Namespace           string `env:"NAMESPACE,default=default"`
+	Metrics             bool   `env:"METRICS,default=true"`
+	Init                bool   `env:"INIT,default=false"`
+	BlockCacheBytes     int64  `env:"BLOCK_CACHE_BYTES,default=1073741824"`       //default = 1 gigabyte
+	BlockDiskCacheBytes int64  `env:"BLOCK_DISK_CACHE_BYTES,default=10737418240"` //default = 10 gigabytes
+	JobShimImage        string `env:"JOB_SHIM_IMAGE,default="`
+	JobImagePullPolicy  string `env:"JOB_IMAGE_PULL_POLICY,default="`
+	LogLevel            string `env:"LOG_LEVEL,default=info"`
+	TLSCertFile         string `env:"TLS_CERT_FILE,default="`
+	TLSKeyFile          string `env:"TLS_KEY_FILE,default="`
+	TLSClientCAFile     string `env:"TLS_CLIENT_CA_FILE,default="`
+	TLSSecret           string `env:"TLS_SECRET,default="`
 }
 
 func main() {
@@ -71,10 +101,16 @@ func main() {
 }
 
 func do(appEnvObj interface{}) error {
+	// The debug port also serves expvars under /debug/vars and Prometheus
+	// metrics under /metrics.
+	http.Handle("/metrics", prometheus.Handler())
 	go func() {
 		lion.Println(http.ListenAndServe(":651", nil))
 	}()
 	appEnv := appEnvObj.(*appEnv)
+	if err := tracing.SetTracerFromEnv("pachd"); err != nil {
+		return err
+	}
 	switch appEnv.LogLevel {
 	case "debug":
 		lion.SetLevel(lion.LevelDebug)
@@ -86,12 +122,25 @@ func do(appEnvObj interface{}) error {
 		lion.Errorf("Unrecognized log level %s, falling back to default of \"info\"", appEnv.LogLevel)
 		lion.SetLevel(lion.LevelInfo)
 	}
+	switch appEnv.DatabaseType {
+	case rethinkDatabase:
+		if appEnv.DatabaseAddress == "" {
+			return fmt.Errorf("RETHINK_PORT_28015_TCP_ADDR must be set when DATABASE_TYPE is %s", rethinkDatabase)
+		}
+	case boltDatabase:
+	default:
+		return fmt.Errorf("unrecognized DATABASE_TYPE %s, must be %s or %s", appEnv.DatabaseType, rethinkDatabase, boltDatabase)
+	}
 	etcdClient := getEtcdClient(appEnv)
 	rethinkAddress := fmt.Sprintf("%s:28015", appEnv.DatabaseAddress)
 	if appEnv.Init {
 		if err := setClusterID(etcdClient); err != nil {
 			return fmt.Errorf("error connecting to etcd, if this error persists it likely indicates that kubernetes services are not working correctly. See https://github.com/sjezewski/pachyderm/blob/master/SETUP.md#pachd-or-pachd-init-crash-loop-with-error-connecting-to-etcd for more info")
 		}
+		if appEnv.DatabaseType == boltDatabase {
+			// Bolt databases are created when they're opened.
+			return nil
+		}
 		if err := persist_server.InitDBs(rethinkAddress, appEnv.PPSDatabaseName); err != nil {
 			return err
 		}
@@ -129,10 +178,10 @@ func do(appEnvObj interface{}) error {
 	if err != nil {
 		return err
 	}
-	if appEnv.Metrics {
+	if appEnv.Metrics && appEnv.DatabaseType == rethinkDatabase {
 		go metrics.ReportMetrics(clusterID, kubeClient, rethinkAddress, appEnv.PFSDatabaseName, appEnv.PPSDatabaseName)
 	}
-	rethinkAPIServer, err := getRethinkAPIServer(appEnv)
+	persistAPIServer, err := getPersistAPIServer(appEnv)
 	if err != nil {
 		return err
 	}
@@ -156,14 +205,27 @@ func do(appEnvObj interface{}) error {
 	if err != nil {
 		return err
 	}
-	router := shard.NewRouter(
+	// pachd's own connections to pachd use the same PACH_TLS* environment
+	// variables as any other client.
+	tlsOptions := grpcutil.TLSOptionsFromEnv()
+	dialOption, err := tlsOptions.DialOption()
+	if err != nil {
+		return err
+	}
+	// Every pachd reports the commits and jobs in the whole cluster, not
+	// just its own, so they're only counted once a minute.
+	pachClient, err := client.NewFromAddressWithTLS(fmt.Sprintf("localhost:%d", appEnv.Port), tlsOptions)
+	if err != nil {
+		return err
+	}
+	prometheus.MustRegister(metrics.NewCollector(pachClient, time.Minute))
+	cacheServer := cache_server.NewCacheServer(
 		sharder,
 		grpcutil.NewDialer(
-			grpc.WithInsecure(),
+			dialOption,
 		),
 		address,
 	)
-	cacheServer := cache_server.NewCacheServer(router, appEnv.NumShards)
 	go func() {
 		if err := sharder.RegisterFrontends(nil, address, []shard.Frontend{cacheServer}); err != nil {
 			protolion.Printf("error from sharder.RegisterFrontend %s", sanitizeErr(err))
@@ -177,36 +239,115 @@ func do(appEnvObj interface{}) error {
 		getNamespace(),
 		appEnv.JobShimImage,
 		appEnv.JobImagePullPolicy,
+		appEnv.TLSSecret,
+		tlsOptions,
 	)
 	go func() {
-		if err := sharder.Register(nil, address, []shard.Server{ppsAPIServer, cacheServer}); err != nil {
+		if err := sharder.Register(nil, address, []shard.Server{ppsAPIServer}); err != nil {
 			protolion.Printf("error from sharder.Register %s", sanitizeErr(err))
 		}
 	}()
-	blockAPIServer, err := pfs_server.NewBlockAPIServer(appEnv.StorageRoot, appEnv.BlockCacheBytes, appEnv.StorageBackend)
+	blockAPIServer, err := pfs_server.NewBlockAPIServer(appEnv.StorageRoot, appEnv.BlockCacheBytes, appEnv.BlockDiskCacheBytes, appEnv.StorageBackend)
 	if err != nil {
 		return err
 	}
 	healthServer := health.NewHealthServer()
-	return protoserver.Serve(
+	go func() {
+		if err := serveHTTP(appEnv, tlsOptions); err != nil {
+			protolion.Printf("error from serveHTTP: %s", sanitizeErr(err))
+		}
+	}()
+	if appEnv.S3Gateway {
+		go func() {
+			if err := serveS3(appEnv, driver); err != nil {
+				protolion.Printf("error from serveS3: %s", sanitizeErr(err))
+			}
+		}()
+	}
+	return serve(
 		func(s *grpc.Server) {
 			pfsclient.RegisterAPIServer(s, apiServer)
 			pfsclient.RegisterBlockAPIServer(s, blockAPIServer)
 			ppsclient.RegisterAPIServer(s, ppsAPIServer)
 			ppsserver.RegisterInternalPodAPIServer(s, ppsAPIServer)
-			persist.RegisterAPIServer(s, rethinkAPIServer)
+			persist.RegisterAPIServer(s, persistAPIServer)
 			cache_pb.RegisterGroupCacheServer(s, cacheServer)
 			healthclient.RegisterHealthServer(s, healthServer)
 		},
-		protoserver.ServeOptions{
-			Version: version.Version,
-		},
-		protoserver.ServeEnv{
-			GRPCPort: appEnv.Port,
-		},
+		appEnv,
 	)
 }
 
+// serve serves the gRPC services registered by registerFunc, over TLS if a
+// TLS certificate is configured.  Every RPC is traced, see the tracing
+// package.
+func serve(registerFunc func(*grpc.Server), appEnv *appEnv) error {
+	options := []grpc.ServerOption{
+		grpc.MaxConcurrentStreams(math.MaxUint32),
+		grpc.UnaryInterceptor(grpcutil.ChainUnaryServer(
+			tracing.UnaryServerInterceptor,
+			protorpclog.LoggingUnaryServerInterceptor,
+		)),
+		grpc.StreamInterceptor(tracing.StreamServerInterceptor),
+	}
+	if appEnv.TLSCertFile != "" {
+		tlsConfig, err := grpcutil.ServerTLSConfig(appEnv.TLSCertFile, appEnv.TLSKeyFile, appEnv.TLSClientCAFile)
+		if err != nil {
+			return err
+		}
+		options = append(options, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	grpcServer := grpc.NewServer(options...)
+	registerFunc(grpcServer)
+	protoversion.RegisterAPIServer(grpcServer, protoversion.NewAPIServer(version.Version, protoversion.APIServerOptions{}))
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", appEnv.Port))
+	if err != nil {
+		return err
+	}
+	if appEnv.TLSCertFile != "" {
+		protolion.Printf("serving TLS on port %d, mutual TLS: %t", appEnv.Port, appEnv.TLSClientCAFile != "")
+	}
+	return grpcServer.Serve(listener)
+}
+
+// serveHTTP serves the REST/JSON gateway to the gRPC services on HTTPPort,
+// over TLS if a TLS certificate is configured.
+func serveHTTP(appEnv *appEnv, tlsOptions *grpcutil.TLSOptions) error {
+	handler, err := gateway.NewHandler(context.Background(), fmt.Sprintf("localhost:%d", appEnv.Port), tlsOptions)
+	if err != nil {
+		return err
+	}
+	return listenAndServe(appEnv, appEnv.HTTPPort, handler)
+}
+
+// listenAndServe serves handler on port with the same TLS config as the gRPC
+// services.  The gateways reach the gRPC services with pachd's own
+// credentials, so with mutual TLS they must verify client certificates
+// themselves.
+func listenAndServe(appEnv *appEnv, port uint16, handler http.Handler) error {
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", port),
+		Handler: handler,
+	}
+	if appEnv.TLSCertFile == "" {
+		return server.ListenAndServe()
+	}
+	tlsConfig, err := grpcutil.ServerTLSConfig(appEnv.TLSCertFile, appEnv.TLSKeyFile, appEnv.TLSClientCAFile)
+	if err != nil {
+		return err
+	}
+	server.TLSConfig = tlsConfig
+	// The certificate is in tlsConfig.
+	return server.ListenAndServeTLS("", "")
+}
+
+// serveS3 serves the S3 gateway to PFS on S3Port, over TLS if a certificate
+// is configured.  The gateway writes to PFS without authenticating requests,
+// so with mutual TLS it's only served to verified clients.
+func serveS3(appEnv *appEnv, driver drive.Driver) error {
+	return listenAndServe(appEnv, appEnv.S3Port, s3.NewHandler(driver))
+}
+
 func getEtcdClient(env *appEnv) discovery.Client {
 	return discovery.NewEtcdClient(fmt.Sprintf("http://%s:2379", env.EtcdAddress))
 }
@@ -242,12 +383,27 @@ func getKubeClient(env *appEnv) (*kube.Client, error) {
 	return kube.New(config)
 }
 
+// boltPath returns the path of the bolt database file with the given name.
+func boltPath(env *appEnv, name string) string {
+	root := env.BoltRoot
+	if root == "" {
+		root = env.StorageRoot
+	}
+	return filepath.Join(root, name)
+}
+
 func getPFSDriver(address string, env *appEnv) (drive.Driver, error) {
+	if env.DatabaseType == boltDatabase {
+		return pfs_persist.NewBoltDriver(address, boltPath(env, "pfs.db"))
+	}
 	rethinkAddress := fmt.Sprintf("%s:28015", env.DatabaseAddress)
 	return pfs_persist.NewDriver(address, rethinkAddress, env.PFSDatabaseName)
 }
 
-func getRethinkAPIServer(env *appEnv) (persist.APIServer, error) {
+func getPersistAPIServer(env *appEnv) (persist.APIServer, error) {
+	if env.DatabaseType == boltDatabase {
+		return persist_server.NewBoltAPIServer(boltPath(env, "pps.db"))
+	}
 	if err := persist_server.CheckDBs(fmt.Sprintf("%s:28015", env.DatabaseAddress), env.PPSDatabaseName); err != nil {
 		return nil, err
 	}
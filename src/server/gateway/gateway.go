@@ -0,0 +1,91 @@
+// Package gateway serves the pfs and pps APIs as REST/JSON over HTTP by
+// proxying requests to pachd's gRPC server.
+package gateway
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pfs"
+	"github.com/sjezewski/pachyderm/src/client/pkg/grpcutil"
+	"github.com/sjezewski/pachyderm/src/client/pps"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// putFilePatterns match PUT /v1/pfs/repos/{repo}/commits/{commit}/files/{path=**},
+// the same path that files are downloaded from, the second pattern matches
+// commit IDs of the form branch/clock.
+var putFilePatterns = []runtime.Pattern{
+	runtime.MustPattern(runtime.NewPattern(
+		1,
+		[]int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6, 3, 0, 4, 1, 5, 7},
+		[]string{"v1", "pfs", "repos", "repo", "commits", "commit", "files", "path"},
+		"",
+	)),
+	runtime.MustPattern(runtime.NewPattern(
+		1,
+		[]int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 1, 0, 4, 2, 5, 5, 2, 6, 3, 0, 4, 1, 5, 7},
+		[]string{"v1", "pfs", "repos", "repo", "commits", "commit", "files", "path"},
+		"",
+	)),
+}
+
+// NewHandler returns an http.Handler which serves the pfs and pps APIs by
+// forwarding requests to the gRPC server at address. The connections to
+// address are closed when ctx is done.
+func NewHandler(ctx context.Context, address string, tlsOptions *grpcutil.TLSOptions) (http.Handler, error) {
+	dialOption, err := tlsOptions.DialOption()
+	if err != nil {
+		return nil, err
+	}
+	c, err := client.NewFromAddressWithTLS(address, tlsOptions)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		<-ctx.Done()
+		c.Close()
+	}()
+	mux := runtime.NewServeMux()
+	// The raw upload handler is registered first, handlers are matched in
+	// the order they're registered.
+	for _, pattern := range putFilePatterns {
+		mux.Handle("PUT", pattern, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+			putFile(c, mux, w, req, pathParams)
+		})
+	}
+	opts := []grpc.DialOption{dialOption}
+	if err := pfs.RegisterAPIHandlerFromEndpoint(ctx, mux, address, opts); err != nil {
+		return nil, err
+	}
+	if err := pps.RegisterAPIHandlerFromEndpoint(ctx, mux, address, opts); err != nil {
+		return nil, err
+	}
+	return mux, nil
+}
+
+// putFile writes the body of req to a file. The delimiter query parameter
+// is the pfs.Delimiter used to split the file into blocks, it defaults to
+// LINE.
+func putFile(c *client.APIClient, mux *runtime.ServeMux, w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+	delimiter := pfs.Delimiter_LINE
+	if value := req.URL.Query().Get("delimiter"); value != "" {
+		d, ok := pfs.Delimiter_value[strings.ToUpper(value)]
+		if !ok {
+			runtime.OtherErrorHandler(w, req, fmt.Sprintf("unrecognized delimiter %s", value), http.StatusBadRequest)
+			return
+		}
+		delimiter = pfs.Delimiter(d)
+	}
+	if _, err := c.PutFileWithDelimiter(pathParams["repo"], pathParams["commit"], pathParams["path"], delimiter, req.Body); err != nil {
+		runtime.HTTPError(context.Background(), outboundMarshaler, w, req, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,116 @@
+package gateway
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pfs"
+	"github.com/sjezewski/pachyderm/src/client/pkg/require"
+	pfsdb "github.com/sjezewski/pachyderm/src/server/pfs/db"
+	pfsserver "github.com/sjezewski/pachyderm/src/server/pfs/server"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func TestGateway(t *testing.T) {
+	c, address, stop := newCluster(t)
+	defer stop()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	handler, err := NewHandler(ctx, address, nil)
+	require.NoError(t, err)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	resp, err := http.Post(server.URL+"/v1/pfs/repos", "application/json", strings.NewReader(`{"repo": {"name": "repo"}}`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	repoInfo, err := c.InspectRepo("repo")
+	require.NoError(t, err)
+	require.Equal(t, "repo", repoInfo.Repo.Name)
+
+	resp, err = http.Get(server.URL + "/v1/pfs/repos/repo")
+	require.NoError(t, err)
+	var repoJSON struct {
+		Repo struct {
+			Name string `json:"name"`
+		} `json:"repo"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&repoJSON))
+	resp.Body.Close()
+	require.Equal(t, "repo", repoJSON.Repo.Name)
+
+	resp, err = http.Get(server.URL + "/v1/pfs/repos/nonexistent")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.NotEqual(t, http.StatusOK, resp.StatusCode)
+
+	commit, err := c.StartCommit("repo", "master")
+	require.NoError(t, err)
+	fileURL := server.URL + "/v1/pfs/repos/repo/commits/" + commit.ID + "/files/dir/file"
+	req, err := http.NewRequest("PUT", fileURL, strings.NewReader("foo\nbar\n"))
+	require.NoError(t, err)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, err = http.Post(server.URL+"/v1/pfs/repos/repo/commits/"+commit.ID+"/finish", "application/json", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	commitInfo, err := c.InspectCommit("repo", commit.ID)
+	require.NoError(t, err)
+	require.Equal(t, pfs.CommitType_COMMIT_TYPE_READ, commitInfo.CommitType)
+
+	// Files are downloaded as their raw contents.
+	resp, err = http.Get(fileURL)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "application/octet-stream", resp.Header.Get("Content-Type"))
+	require.Equal(t, "foo\nbar\n", string(data))
+
+	resp, err = http.Get(fileURL + "?offset_bytes=4")
+	require.NoError(t, err)
+	data, err = ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, "bar\n", string(data))
+}
+
+// newCluster serves pfs from a temporary directory and returns a client
+// for it, its address and a function that stops the server.
+func newCluster(t *testing.T) (*client.APIClient, string, func()) {
+	dir, err := ioutil.TempDir("", "gateway")
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	blockAPIServer, err := pfsserver.NewLocalBlockAPIServer(dir)
+	require.NoError(t, err)
+	driver, err := pfsdb.NewBoltDriver(address, filepath.Join(dir, "pfs.db"))
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	pfs.RegisterBlockAPIServer(server, blockAPIServer)
+	pfs.RegisterAPIServer(server, pfsserver.NewAPIServer(driver))
+	go server.Serve(listener)
+	c, err := client.NewFromAddress(address)
+	require.NoError(t, err)
+	return c, address, func() {
+		server.Stop()
+		os.RemoveAll(dir)
+	}
+}
@@ -2521,6 +2521,58 @@ func TestAcceptReturnCode(t *testing.T) {
 	require.Equal(t, ppsclient.JobState_JOB_SUCCESS.String(), jobInfo.State.String())
 }
 
+func TestRetryPolicy(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+	c := getPachClient(t)
+	runJob := func(exitCode int) *ppsclient.JobInfo {
+		job, err := c.PpsAPIClient.CreateJob(
+			context.Background(),
+			&ppsclient.CreateJobRequest{
+				Transform: &ppsclient.Transform{
+					Cmd:   []string{"sh"},
+					Stdin: []string{fmt.Sprintf("exit %d", exitCode)},
+					RetryPolicy: &ppsclient.RetryPolicy{
+						MaxAttempts:     2,
+						BackoffSeconds:  1,
+						RetryOnExitCode: []int64{2},
+					},
+				},
+				ParallelismSpec: &ppsclient.ParallelismSpec{
+					Strategy: ppsclient.ParallelismSpec_CONSTANT,
+					Constant: 1,
+				},
+			},
+		)
+		require.NoError(t, err)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*60)
+		defer cancel() //cleanup resources
+		jobInfo, err := c.PpsAPIClient.InspectJob(ctx, &ppsclient.InspectJobRequest{
+			Job:        job,
+			BlockState: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, ppsclient.JobState_JOB_FAILURE.String(), jobInfo.State.String())
+		require.Equal(t, 1, len(jobInfo.Chunks))
+		return jobInfo
+	}
+
+	// Exit code 2 is retried until the chunk runs out of attempts.
+	chunk := runJob(2).Chunks[0]
+	require.Equal(t, uint64(2), chunk.Attempts)
+	require.Equal(t, 2, len(chunk.Pods))
+	for _, pod := range chunk.Pods {
+		require.True(t, pod.Error != "")
+	}
+
+	// Exit code 3 isn't retried.
+	chunk = runJob(3).Chunks[0]
+	require.Equal(t, uint64(1), chunk.Attempts)
+	require.Equal(t, 1, len(chunk.Pods))
+}
+
 func TestRestartAll(t *testing.T) {
 	t.Skip("this test is flaky")
 	if testing.Short() {
@@ -3534,6 +3586,309 @@ func TestParallelismSpec(t *testing.T) {
 	require.Equal(t, uint64(1), parellelism)
 }
 
+func TestCronInput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+
+	c := getPachClient(t)
+	pipelineName := uniqueString("pipeline")
+	cronRepo := pipelineName + "_cron"
+	outRepo := ppsserver.PipelineRepo(client.NewPipeline(pipelineName))
+	require.NoError(t, c.CreatePipeline(
+		pipelineName,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cp /pfs/%s/* /pfs/out/", cronRepo),
+		},
+		&ppsclient.ParallelismSpec{
+			Strategy: ppsclient.ParallelismSpec_CONSTANT,
+			Constant: 1,
+		},
+		[]*ppsclient.PipelineInput{client.NewCronInput("", "@every 10s")},
+		false,
+	))
+	// pps creates the cron input's repo.
+	_, err := c.InspectRepo(cronRepo)
+	require.NoError(t, err)
+
+	// Each tick is its own job, which sees only the new tick's file.
+	outCommits, err := c.ListCommit(
+		[]*pfsclient.Commit{{Repo: outRepo}},
+		nil,
+		client.CommitTypeRead,
+		pfsclient.CommitStatus_NORMAL,
+		true,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(outCommits))
+	outCommits, err = c.ListCommit(
+		[]*pfsclient.Commit{outCommits[0].Commit},
+		nil,
+		client.CommitTypeRead,
+		pfsclient.CommitStatus_NORMAL,
+		true,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(outCommits))
+	fileInfos, err := c.ListFile(outRepo.Name, outCommits[0].Commit.ID, "", "", false, nil, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(fileInfos))
+	// More ticks may have fired by now.
+	jobInfos, err := c.ListJob(pipelineName, nil)
+	require.NoError(t, err)
+	require.True(t, len(jobInfos) >= 2)
+
+	require.NoError(t, c.DeletePipeline(pipelineName))
+	// The cron input's repo goes with the pipeline, the output repo doesn't.
+	_, err = c.InspectRepo(cronRepo)
+	require.YesError(t, err)
+	require.NoError(t, c.DeleteRepo(outRepo.Name, false))
+
+	// A cron input can't use a repo that pps didn't create.
+	dataRepo := uniqueString("TestCronInput_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	require.YesError(t, c.CreatePipeline(
+		uniqueString("pipeline"),
+		"",
+		[]string{"true"},
+		nil,
+		&ppsclient.ParallelismSpec{
+			Strategy: ppsclient.ParallelismSpec_CONSTANT,
+			Constant: 1,
+		},
+		[]*ppsclient.PipelineInput{client.NewCronInput(dataRepo, "@every 10s")},
+		false,
+	))
+	_, err = c.InspectRepo(dataRepo)
+	require.NoError(t, err)
+
+	// Unnamed cron inputs would all get the same repo.
+	require.YesError(t, c.CreatePipeline(
+		uniqueString("pipeline"),
+		"",
+		[]string{"true"},
+		nil,
+		&ppsclient.ParallelismSpec{
+			Strategy: ppsclient.ParallelismSpec_CONSTANT,
+			Constant: 1,
+		},
+		[]*ppsclient.PipelineInput{
+			client.NewCronInput("", "@every 10s"),
+			client.NewCronInput("", "@daily"),
+		},
+		false,
+	))
+}
+
+func TestGlobInput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+
+	c := getPachClient(t)
+	dataRepo := uniqueString("TestGlobInput_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	for _, dir := range []string{"a/x", "a/y", "b/x", "b/y"} {
+		_, err = c.PutFile(dataRepo, commit.ID, dir+"/file", strings.NewReader(dir+"\n"))
+		require.NoError(t, err)
+	}
+	_, err = c.PutFile(dataRepo, commit.ID, "readme", strings.NewReader("readme\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, commit.ID))
+
+	pipelineName := uniqueString("pipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipelineName,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cat /pfs/%s/*/*/file >> /pfs/out/files", dataRepo),
+		},
+		&ppsclient.ParallelismSpec{
+			Strategy: ppsclient.ParallelismSpec_CONSTANT,
+			Constant: 2,
+		},
+		[]*ppsclient.PipelineInput{{
+			Repo: client.NewRepo(dataRepo),
+			Glob: "*/x",
+		}},
+		false,
+	))
+	commitInfos, err := c.FlushCommit([]*pfsclient.Commit{commit}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commitInfos))
+
+	// Only the datums, a/x and b/x, are seen, each by one container.
+	var buffer bytes.Buffer
+	require.NoError(t, c.GetFile(commitInfos[1].Commit.Repo.Name, commitInfos[1].Commit.ID, "files", 0, 0, "", false, nil, &buffer))
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	require.Equal(t, 2, len(lines))
+	require.EqualOneOf(t, []interface{}{"a/x", "b/x"}, lines[0])
+	require.EqualOneOf(t, []interface{}{"a/x", "b/x"}, lines[1])
+	require.NotEqual(t, lines[0], lines[1])
+
+	require.NoError(t, c.DeletePipeline(pipelineName))
+}
+
+func TestUnionInputs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+
+	c := getPachClient(t)
+	var repos []string
+	for _, name := range []string{"a", "b"} {
+		repo := uniqueString("TestUnionInputs_" + name)
+		require.NoError(t, c.CreateRepo(repo))
+		repos = append(repos, repo)
+	}
+
+	pipelineName := uniqueString("pipeline")
+	_, err := c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&ppsclient.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipelineName),
+			Transform: &ppsclient.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{fmt.Sprintf(`
+for repo in %s %s; do
+    if [ -d /pfs/$repo ]; then
+        cat /pfs/$repo/file >> /pfs/out/file
+        echo $repo >> /pfs/out/mounts
+    fi
+done
+`, repos[0], repos[1])},
+			},
+			ParallelismSpec: &ppsclient.ParallelismSpec{
+				Strategy: ppsclient.ParallelismSpec_CONSTANT,
+				Constant: 2,
+			},
+			Inputs: []*ppsclient.PipelineInput{
+				{Repo: client.NewRepo(repos[0])},
+				{Repo: client.NewRepo(repos[1])},
+			},
+			Combinator: ppsclient.Combinator_UNION,
+		})
+	require.NoError(t, err)
+	commitToInput := func(repo string, content string) *pfsclient.Commit {
+		commit, err := c.StartCommit(repo, "master")
+		require.NoError(t, err)
+		_, err = c.PutFile(repo, commit.ID, "file", strings.NewReader(content+"\n"))
+		require.NoError(t, err)
+		require.NoError(t, c.FinishCommit(repo, commit.ID))
+		return commit
+	}
+	getOutput := func(commit *pfsclient.Commit) ([]string, []string) {
+		commitInfos, err := c.FlushCommit([]*pfsclient.Commit{commit}, nil)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(commitInfos))
+		outCommit := commitInfos[1].Commit
+		var buffer bytes.Buffer
+		require.NoError(t, c.GetFile(outCommit.Repo.Name, outCommit.ID, "file", 0, 0, "", false, nil, &buffer))
+		lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+		buffer.Reset()
+		require.NoError(t, c.GetFile(outCommit.Repo.Name, outCommit.ID, "mounts", 0, 0, "", false, nil, &buffer))
+		return lines, strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	}
+
+	// The first input's commit is processed without waiting for the second.
+	lines, mounts := getOutput(commitToInput(repos[0], "a"))
+	require.Equal(t, []string{"a"}, lines)
+	require.Equal(t, []string{repos[0]}, mounts)
+
+	// Each input is processed by a container of its own.
+	lines, mounts = getOutput(commitToInput(repos[1], "b"))
+	require.Equal(t, 2, len(lines))
+	require.Equal(t, 2, len(mounts))
+	require.NotEqual(t, mounts[0], mounts[1])
+
+	require.NoError(t, c.DeletePipeline(pipelineName))
+}
+
+func TestJoinOnCapture(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+
+	c := getPachClient(t)
+	usersRepo := uniqueString("TestJoinOnCapture_users")
+	require.NoError(t, c.CreateRepo(usersRepo))
+	ordersRepo := uniqueString("TestJoinOnCapture_orders")
+	require.NoError(t, c.CreateRepo(ordersRepo))
+	usersCommit, err := c.StartCommit(usersRepo, "master")
+	require.NoError(t, err)
+	ordersCommit, err := c.StartCommit(ordersRepo, "master")
+	require.NoError(t, err)
+	numUsers := 8
+	for i := 0; i < numUsers; i++ {
+		_, err = c.PutFile(usersRepo, usersCommit.ID, fmt.Sprintf("user%d.json", i), strings.NewReader("user\n"))
+		require.NoError(t, err)
+		_, err = c.PutFile(ordersRepo, ordersCommit.ID, fmt.Sprintf("2016/user%d.csv", i), strings.NewReader("order\n"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, c.FinishCommit(usersRepo, usersCommit.ID))
+	require.NoError(t, c.FinishCommit(ordersRepo, ordersCommit.ID))
+
+	pipelineName := uniqueString("pipeline")
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&ppsclient.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipelineName),
+			Transform: &ppsclient.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{fmt.Sprintf(`
+for user in /pfs/%s/*.json; do
+    [ -e $user ] || continue
+    name=$(basename $user .json)
+    if [ -e /pfs/%s/2016/$name.csv ]; then
+        echo $name >> /pfs/out/joined
+    fi
+done
+`, usersRepo, ordersRepo)},
+			},
+			ParallelismSpec: &ppsclient.ParallelismSpec{
+				Strategy: ppsclient.ParallelismSpec_CONSTANT,
+				Constant: 4,
+			},
+			Inputs: []*ppsclient.PipelineInput{
+				{Repo: client.NewRepo(usersRepo), Glob: "(*).json"},
+				{Repo: client.NewRepo(ordersRepo), Glob: "*/(*).csv"},
+			},
+			Combinator: ppsclient.Combinator_JOIN_ON_CAPTURE,
+		})
+	require.NoError(t, err)
+	commitInfos, err := c.FlushCommit([]*pfsclient.Commit{usersCommit, ordersCommit}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(commitInfos))
+	outCommit := commitInfos[2].Commit
+
+	// Every user was seen along with their orders.
+	var buffer bytes.Buffer
+	require.NoError(t, c.GetFile(outCommit.Repo.Name, outCommit.ID, "joined", 0, 0, "", false, nil, &buffer))
+	require.Equal(t, numUsers, len(strings.Split(strings.TrimSpace(buffer.String()), "\n")))
+
+	// A join on a capture needs a glob with a capture.
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&ppsclient.CreatePipelineRequest{
+			Pipeline:   client.NewPipeline(uniqueString("pipeline")),
+			Transform:  &ppsclient.Transform{Cmd: []string{"true"}},
+			Inputs:     []*ppsclient.PipelineInput{{Repo: client.NewRepo(usersRepo), Glob: "*.json"}},
+			Combinator: ppsclient.Combinator_JOIN_ON_CAPTURE,
+		})
+	require.YesError(t, err)
+
+	require.NoError(t, c.DeletePipeline(pipelineName))
+}
+
 func getPachClient(t testing.TB) *client.APIClient {
 	client, err := client.NewFromAddress("0.0.0.0:30650")
 	require.NoError(t, err)
@@ -3,9 +3,11 @@ package cmds
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
@@ -21,10 +23,10 @@ import (
 	pfsclient "github.com/sjezewski/pachyderm/src/client/pfs"
 	"github.com/sjezewski/pachyderm/src/server/pfs/fuse"
 	"github.com/sjezewski/pachyderm/src/server/pfs/pretty"
+	"github.com/sjezewski/pachyderm/src/server/pfs/replica"
 	"github.com/sjezewski/pachyderm/src/server/pkg/cmd"
 
 	"github.com/spf13/cobra"
-	"go.pedge.io/pkg/cobra"
 	"go.pedge.io/pkg/exec"
 )
 
@@ -263,7 +265,7 @@ Examples:
 	$ pachctl list-commit foo -p bar/master/3 -p baz/master/5
 
 `,
-		Run: pkgcobra.Run(func(args []string) error {
+		Run: cmd.Run(func(args []string) error {
 			fromCommits, err := cmd.ParseCommits(args)
 			if err != nil {
 				return err
@@ -311,7 +313,7 @@ Examples:
 	# note that bar/1 needs to be an open commit
 	$ pachctl squash-commit test foo/2 foo/3 bar/1
 `,
-		Run: pkgcobra.Run(func(args []string) error {
+		Run: cmd.Run(func(args []string) error {
 			if len(args) < 3 {
 				fmt.Println("invalid arguments")
 				return nil
@@ -336,7 +338,7 @@ Examples:
 	# replay commits foo/2 and foo/3 onto branch "bar" in repo "test"
 	$ pachctl replay-commit test foo/2 foo/3 bar
 `,
-		Run: pkgcobra.Run(func(args []string) error {
+		Run: cmd.Run(func(args []string) error {
 			if len(args) < 3 {
 				fmt.Println("invalid arguments")
 				return nil
@@ -359,6 +361,42 @@ Examples:
 		}),
 	}
 
+	push := &cobra.Command{
+		Use:   "push repo-name commit-id remote-address",
+		Short: "Copy a commit to another cluster.",
+		Long: `Copy a finished commit to the cluster at remote-address.
+
+The commit keeps its ID, branch, clock and provenance.  Its ancestors and
+provenance are copied too if the other cluster doesn't have them, and repos
+are created as needed.  Only blocks the other cluster doesn't have are sent.
+
+Examples:
+
+	# copy the head of master in repo "test" to a production cluster
+	$ pachctl push test master production:650
+`,
+		Run: cmd.RunFixedArgs(3, func(args []string) error {
+			return replicate(address, args[2], args[0], args[1])
+		}),
+	}
+
+	pull := &cobra.Command{
+		Use:   "pull repo-name commit-id remote-address",
+		Short: "Copy a commit from another cluster.",
+		Long: `Copy a finished commit from the cluster at remote-address.
+
+This is the opposite of push.
+
+Examples:
+
+	# copy commit master/3 in repo "test" from a staging cluster
+	$ pachctl pull test master/3 staging:650
+`,
+		Run: cmd.RunFixedArgs(3, func(args []string) error {
+			return replicate(args[2], address, args[0], args[1])
+		}),
+	}
+
 	var repos cmd.RepeatedStringArg
 	flushCommit := &cobra.Command{
 		Use:   "flush-commit commit [commit ...]",
@@ -374,7 +412,7 @@ Examples:
 	$ pachctl flush-commit foo/master/1 -r bar -r baz
 
 `,
-		Run: pkgcobra.Run(func(args []string) error {
+		Run: cmd.Run(func(args []string) error {
 			commits, err := cmd.ParseCommits(args)
 			if err != nil {
 				return err
@@ -446,13 +484,15 @@ Files can be read from finished commits with get-file.`,
 	var recursive bool
 	var commitFlag bool
 	var inputFile string
+	var stateFile string
+	var delimiterName string
 	// putFilePath is a helper for putFile
-	putFilePath := func(client *client.APIClient, args []string, filePath string) error {
+	putFilePath := func(client *client.APIClient, args []string, filePath string, delimiter pfsclient.Delimiter) error {
 		if filePath == "-" {
 			if len(args) < 3 {
 				return errors.New("either a path or the -f flag needs to be provided")
 			}
-			_, err := client.PutFile(args[0], args[1], args[2], os.Stdin)
+			_, err := client.PutFileWithDelimiter(args[0], args[1], args[2], delimiter, os.Stdin)
 			return err
 		}
 		// try parsing the filename as a url, if it is one do a PutFileURL
@@ -464,9 +504,9 @@ Files can be read from finished commits with get-file.`,
 		}
 		if !recursive {
 			if len(args) == 3 {
-				return cpFile(client, args[0], args[1], args[2], filePath)
+				return cpFile(client, args[0], args[1], args[2], filePath, delimiter)
 			}
-			return cpFile(client, args[0], args[1], filePath, filePath)
+			return cpFile(client, args[0], args[1], filePath, filePath, delimiter)
 		}
 		var eg errgroup.Group
 		filepath.Walk(filePath, func(path string, info os.FileInfo, err error) error {
@@ -474,9 +514,9 @@ Files can be read from finished commits with get-file.`,
 				return nil
 			}
 			if len(args) == 3 {
-				eg.Go(func() error { return cpFile(client, args[0], args[1], filepath.Join(args[2], path), path) })
+				eg.Go(func() error { return cpFile(client, args[0], args[1], filepath.Join(args[2], path), path, delimiter) })
 			}
-			eg.Go(func() error { return cpFile(client, args[0], args[1], path, path) })
+			eg.Go(func() error { return cpFile(client, args[0], args[1], path, path, delimiter) })
 			return nil
 		})
 		return eg.Wait()
@@ -518,12 +558,36 @@ Put several files or URLs that are listed at URL.
 NOTE this URL can reference local files, so it could cause you to put sensitive
 files into your Pachyderm cluster.
 	pachctl put-file repo commit -i http://host/path
+
+Put a large file from the local filesystem in parts, if the upload is
+interrupted running the same command again resumes it from the last part
+that was written:
+	pachctl put-file repo commit path -f file --state-file file.upload
 `,
 		Run: cmd.RunBoundedArgs(2, 3, func(args []string) (retErr error) {
 			client, err := client.NewFromAddress(address)
 			if err != nil {
 				return err
 			}
+			d, ok := pfsclient.Delimiter_value[strings.ToUpper(delimiterName)]
+			if !ok {
+				return fmt.Errorf("unrecognized delimiter %s", delimiterName)
+			}
+			delimiter := pfsclient.Delimiter(d)
+			if stateFile != "" {
+				if commitFlag || recursive || inputFile != "" || len(filePaths) != 1 || filePaths[0] == "-" {
+					return errors.New("--state-file can only be used to put a single local file, without -c, -r or -i")
+				}
+				filePath := filePaths[0]
+				if url, err := url.Parse(filePath); err == nil && url.Scheme != "" {
+					return errors.New("--state-file can't be used to put a URL")
+				}
+				path := filePath
+				if len(args) == 3 {
+					path = args[2]
+				}
+				return cpFileResumable(client, args[0], args[1], path, filePath, delimiter, stateFile)
+			}
 			if commitFlag {
 				commit, err := client.StartCommit(args[0], args[1])
 				if err != nil {
@@ -574,12 +638,12 @@ files into your Pachyderm cluster.
 				scanner := bufio.NewScanner(r)
 				for scanner.Scan() {
 					if filePath := scanner.Text(); filePath != "" {
-						eg.Go(func() error { return putFilePath(client, args, filePath) })
+						eg.Go(func() error { return putFilePath(client, args, filePath, delimiter) })
 					}
 				}
 			} else {
 				for _, filePath := range filePaths {
-					eg.Go(func() error { return putFilePath(client, args, filePath) })
+					eg.Go(func() error { return putFilePath(client, args, filePath, delimiter) })
 				}
 			}
 			return eg.Wait()
@@ -589,6 +653,8 @@ files into your Pachyderm cluster.
 	putFile.Flags().StringVarP(&inputFile, "input-file", "i", "", "Read filepaths or URLs from a file.  If - is used, paths are read from the standard input.")
 	putFile.Flags().BoolVarP(&recursive, "recursive", "r", false, "Recursively put the files in a directory.")
 	putFile.Flags().BoolVarP(&commitFlag, "commit", "c", false, "Start and finish the commit in addition to putting data.")
+	putFile.Flags().StringVar(&delimiterName, "delimiter", "line", "How local files and standard input are split into blocks: \"line\", \"json\" or \"none\".")
+	putFile.Flags().StringVar(&stateFile, "state-file", "", "Put the file in resumable parts, recording the upload in this local file so that it can be resumed.")
 
 	var fromCommitID string
 	var fullFile bool
@@ -689,6 +755,7 @@ files into your Pachyderm cluster.
 
 	var debug bool
 	var allCommits bool
+	var mountOpts fuse.Options
 	mount := &cobra.Command{
 		Use:   "mount path/to/mount/point",
 		Short: "Mount pfs locally. This command blocks.",
@@ -699,7 +766,7 @@ files into your Pachyderm cluster.
 				return err
 			}
 			go func() { client.KeepConnected(nil) }()
-			mounter := fuse.NewMounter(address, client)
+			mounter := fuse.NewMounterWithOptions(address, client, &mountOpts)
 			mountPoint := args[0]
 			ready := make(chan bool)
 			go func() {
@@ -714,8 +781,94 @@ files into your Pachyderm cluster.
 		}),
 	}
 	addShardFlags(mount)
-	mount.Flags().BoolVarP(&debug, "debug", "d", false, "Turn on debug messages.")
-	mount.Flags().BoolVarP(&allCommits, "all-commits", "a", false, "Show archived and cancelled commits.")
+	addMountFlags(mount, &mountOpts, &debug, &allCommits)
+
+	fuseTrace := &cobra.Command{
+		Use:   "fuse-trace path/to/trace path/to/mount/point",
+		Short: "Mount pfs locally and record the operations on it. This command blocks.",
+		Long: `Mount pfs locally and record the operations on it to a trace file, this command blocks.
+The trace can be replayed against a fresh mount with fuse-replay.`,
+		Run: cmd.RunFixedArgs(2, func(args []string) (retErr error) {
+			client, err := client.NewFromAddress(address)
+			if err != nil {
+				return err
+			}
+			go func() { client.KeepConnected(nil) }()
+			trace, err := os.Create(args[0])
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := trace.Close(); err != nil && retErr == nil {
+					retErr = err
+				}
+			}()
+			opts := mountOpts
+			opts.Trace = trace
+			mounter := fuse.NewMounterWithOptions(address, client, &opts)
+			ready := make(chan bool)
+			go func() {
+				<-ready
+				fmt.Println("Filesystem mounted and being traced, CTRL-C to exit.")
+			}()
+			return mounter.Mount(args[1], shard(), nil, ready, debug, allCommits)
+		}),
+	}
+	addShardFlags(fuseTrace)
+	addMountFlags(fuseTrace, &mountOpts, &debug, &allCommits)
+
+	fuseReplay := &cobra.Command{
+		Use:   "fuse-replay path/to/trace path/to/mount/point",
+		Short: "Replay a trace recorded with fuse-trace against a fresh mount.",
+		Long: `Replay a trace recorded with fuse-trace against a fresh mount.
+Pfs is mounted at the mount point, the operations in the trace are performed
+on it and every operation whose result differs from the recorded one is
+printed. The cluster must have the repos and commits that the trace refers
+to, and the mount should use the same flags the trace was recorded with.`,
+		Run: cmd.RunFixedArgs(2, func(args []string) error {
+			client, err := client.NewFromAddress(address)
+			if err != nil {
+				return err
+			}
+			trace, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer trace.Close()
+			mountPoint := args[1]
+			mounter := fuse.NewMounterWithOptions(address, client, &mountOpts)
+			ready := make(chan bool)
+			mountErr := make(chan error, 1)
+			go func() {
+				mountErr <- mounter.MountAndCreate(mountPoint, shard(), nil, ready, debug, allCommits)
+			}()
+			<-ready
+			select {
+			case err := <-mountErr:
+				return err
+			default:
+			}
+			mismatches, replayErr := fuse.Replay(trace, mountPoint)
+			if err := mounter.Unmount(mountPoint); err != nil {
+				return err
+			}
+			if err := <-mountErr; err != nil {
+				return err
+			}
+			if replayErr != nil {
+				return replayErr
+			}
+			for _, mismatch := range mismatches {
+				fmt.Println(mismatch)
+			}
+			if len(mismatches) > 0 {
+				return fmt.Errorf("%d operations differ from the trace", len(mismatches))
+			}
+			return nil
+		}),
+	}
+	addShardFlags(fuseReplay)
+	addMountFlags(fuseReplay, &mountOpts, &debug, &allCommits)
 
 	unmount := &cobra.Command{
 		Use:   "unmount path/to/mount/point",
@@ -796,6 +949,8 @@ mount | grep pfs:// | cut -f 3 -d " "
 	result = append(result, listCommit)
 	result = append(result, squashCommit)
 	result = append(result, replayCommit)
+	result = append(result, push)
+	result = append(result, pull)
 	result = append(result, flushCommit)
 	result = append(result, listBranch)
 	result = append(result, file)
@@ -806,10 +961,42 @@ mount | grep pfs:// | cut -f 3 -d " "
 	result = append(result, deleteFile)
 	result = append(result, mount)
 	result = append(result, unmount)
+	result = append(result, fuseTrace)
+	result = append(result, fuseReplay)
 	result = append(result, archiveAll)
 	return result
 }
 
+// addMountFlags adds the flags which configure a mount to command.
+func addMountFlags(command *cobra.Command, opts *fuse.Options, debug *bool, allCommits *bool) {
+	command.Flags().BoolVarP(debug, "debug", "d", false, "Turn on debug messages.")
+	command.Flags().BoolVarP(allCommits, "all-commits", "a", false, "Show archived and cancelled commits.")
+	command.Flags().BoolVar(&opts.RandomWrites, "random-writes", false, "Allow files to be written at any offset and truncated, files being written are staged locally and replace the file's content in pfs when they're flushed.")
+	command.Flags().BoolVar(&opts.FollowBranches, "follow-branches", false, "Make each branch's directory follow the branch, switching to each new commit on the branch when it finishes.")
+	command.Flags().StringVar(&opts.SpillDir, "spill-dir", "", "The directory files being written are staged in with --random-writes, defaults to the system's temp dir.")
+}
+
+// replicate copies a commit from the cluster at fromAddress to the cluster
+// at toAddress and prints the commits that were copied.
+func replicate(fromAddress string, toAddress string, repoName string, commitID string) error {
+	from, err := client.NewFromAddress(fromAddress)
+	if err != nil {
+		return err
+	}
+	to, err := client.NewFromAddress(toAddress)
+	if err != nil {
+		return err
+	}
+	commits, err := replica.Replicate(from, to, repoName, commitID)
+	if err != nil {
+		return err
+	}
+	for _, commit := range commits {
+		fmt.Printf("%s/%s\n", commit.Repo.Name, commit.ID)
+	}
+	return nil
+}
+
 func parseCommitMounts(args []string) []*fuse.CommitMount {
 	var result []*fuse.CommitMount
 	for _, arg := range args {
@@ -828,7 +1015,7 @@ func parseCommitMounts(args []string) []*fuse.CommitMount {
 	return result
 }
 
-func cpFile(client *client.APIClient, repo string, commit string, path string, filePath string) (retErr error) {
+func cpFile(client *client.APIClient, repo string, commit string, path string, filePath string, delimiter pfsclient.Delimiter) (retErr error) {
 	f, err := os.Open(filePath)
 	if err != nil {
 		return err
@@ -838,6 +1025,161 @@ func cpFile(client *client.APIClient, repo string, commit string, path string, f
 			retErr = err
 		}
 	}()
-	_, err = client.PutFile(repo, commit, path, f)
+	_, err = client.PutFileWithDelimiter(repo, commit, path, delimiter, f)
 	return err
 }
+
+// uploadPartSize is the size of the parts that cpFileResumable uploads.
+const uploadPartSize = 64 * 1024 * 1024
+
+// uploadState is what cpFileResumable records in its state file, enough to
+// find the upload again and check that it's for the same file.
+type uploadState struct {
+	UploadID string `json:"upload_id"`
+	Repo     string `json:"repo"`
+	Commit   string `json:"commit"`
+	Path     string `json:"path"`
+	File     string `json:"file"`
+	Size     int64  `json:"size"`
+}
+
+// cpFileResumable is like cpFile except that it puts the file in parts.  The
+// upload is recorded in stateFile, if stateFile already exists the upload it
+// records is resumed after the last part that was written.  stateFile is
+// removed once the upload is complete.
+func cpFileResumable(client *client.APIClient, repo string, commit string, path string, filePath string, delimiter pfsclient.Delimiter, stateFile string) (retErr error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	fileInfo, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	state, err := readUploadState(stateFile)
+	if err != nil {
+		return err
+	}
+	var offset uint64
+	if state != nil {
+		if state.Repo != repo || state.Commit != commit || state.Path != path || state.File != filePath {
+			return fmt.Errorf("%s records an upload of %s to %s/%s:%s", stateFile, state.File, state.Repo, state.Commit, state.Path)
+		}
+		if state.Size != fileInfo.Size() {
+			return fmt.Errorf("%s has changed size since the upload began", filePath)
+		}
+		uploadInfo, err := client.InspectUpload(state.UploadID)
+		if err != nil {
+			return err
+		}
+		if uploadInfo.Delimiter != delimiter {
+			return fmt.Errorf("%s records an upload with delimiter %s", stateFile, uploadInfo.Delimiter)
+		}
+		// parts are written in order, so we resume after the contiguous
+		// parts at the start of the file
+		for _, part := range uploadInfo.Parts {
+			if part.OffsetBytes == offset {
+				offset += part.SizeBytes
+			}
+		}
+	} else {
+		upload, err := client.BeginUpload(repo, commit, path, delimiter)
+		if err != nil {
+			return err
+		}
+		state = &uploadState{
+			UploadID: upload.ID,
+			Repo:     repo,
+			Commit:   commit,
+			Path:     path,
+			File:     filePath,
+			Size:     fileInfo.Size(),
+		}
+		if err := writeUploadState(stateFile, state); err != nil {
+			return err
+		}
+	}
+	buf := make([]byte, uploadPartSize)
+	for offset < uint64(state.Size) {
+		n, err := f.ReadAt(buf, int64(offset))
+		if err != nil && err != io.EOF {
+			return err
+		}
+		part := buf[:n]
+		// end the part between two values, unless it's the last part
+		if offset+uint64(n) < uint64(state.Size) {
+			part = part[:splitValues(delimiter, part)]
+		}
+		if _, err := client.UploadPart(state.UploadID, offset, bytes.NewReader(part)); err != nil {
+			return err
+		}
+		offset += uint64(len(part))
+	}
+	if err := client.CompleteUpload(state.UploadID, uint64(state.Size)); err != nil {
+		return err
+	}
+	return os.Remove(stateFile)
+}
+
+// splitValues returns the length of the longest prefix of data which ends
+// between two of delimiter's values, or len(data) if a single value is
+// longer than data.
+func splitValues(delimiter pfsclient.Delimiter, data []byte) int {
+	switch delimiter {
+	case pfsclient.Delimiter_LINE:
+		if i := bytes.LastIndexByte(data, '\n'); i >= 0 {
+			return i + 1
+		}
+	case pfsclient.Delimiter_JSON:
+		reader := bytes.NewReader(data)
+		decoder := json.NewDecoder(reader)
+		n := 0
+		for {
+			var value json.RawMessage
+			if err := decoder.Decode(&value); err != nil {
+				break
+			}
+			end := len(data) - reader.Len() - decoder.Buffered().(*bytes.Reader).Len()
+			// A value which ends with data, such as a number, might
+			// continue after it.
+			if end == len(data) {
+				break
+			}
+			n = end
+		}
+		if n > 0 {
+			return n
+		}
+	}
+	return len(data)
+}
+
+// readUploadState reads the state file written by writeUploadState, it
+// returns nil if the file doesn't exist.
+func readUploadState(stateFile string) (*uploadState, error) {
+	data, err := ioutil.ReadFile(stateFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	state := &uploadState{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("error reading %s: %v", stateFile, err)
+	}
+	return state, nil
+}
+
+func writeUploadState(stateFile string, state *uploadState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(stateFile, data, 0644)
+}
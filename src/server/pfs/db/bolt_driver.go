@@ -0,0 +1,1936 @@
+package persist
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pfs"
+	"github.com/sjezewski/pachyderm/src/client/pkg/grpcutil"
+	"github.com/sjezewski/pachyderm/src/client/pkg/uuid"
+	pfsserver "github.com/sjezewski/pachyderm/src/server/pfs"
+	"github.com/sjezewski/pachyderm/src/server/pfs/db/persist"
+	"github.com/sjezewski/pachyderm/src/server/pfs/drive"
+
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// The bolt driver stores the same documents as the rethink driver, but in
+// BoltDB buckets:
+//
+// Repos: repo name -> persist.Repo
+// Commits: one nested bucket per repo, commit ID -> persist.Commit
+// Diffs: one nested bucket per repo, diff key -> persist.Diff
+// Uploads: upload ID -> persist.Upload
+//
+// Diff keys are of the form branch\x00clock path\x00ID, where clock is the
+// big-endian encoding of the diff's head clock.  This way the diffs of a
+// clock range are a contiguous run of keys, ordered by clock then path.
+var (
+	boltRepoBucket   = []byte("Repos")
+	boltCommitBucket = []byte("Commits")
+	boltDiffBucket   = []byte("Diffs")
+	boltUploadBucket = []byte("Uploads")
+
+	boltBuckets = [][]byte{
+		boltRepoBucket,
+		boltCommitBucket,
+		boltDiffBucket,
+		boltUploadBucket,
+	}
+)
+
+const boltOpenTimeout = 5 * time.Second
+
+type boltDriver struct {
+	blockClient pfs.BlockAPIClient
+	db          *bolt.DB
+	readAhead   *readAhead
+
+	// changed is closed and replaced every time the database is written to,
+	// which wakes up the calls that block waiting for commits.
+	changedLock sync.Mutex
+	changed     chan struct{}
+}
+
+// NewBoltDriver creates a Driver that stores its metadata in a BoltDB file
+// at the given path, rather than in rethinkdb.  Since the database is
+// embedded, only one pachd can use a given file.
+func NewBoltDriver(blockAddress string, path string) (drive.Driver, error) {
+	dialOption, err := grpcutil.DialOptionFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	clientConn, err := grpc.Dial(blockAddress, dialOption)
+	if err != nil {
+		return nil, err
+	}
+	return NewBoltDriverWithBlockClient(pfs.NewBlockAPIClient(clientConn), path)
+}
+
+// NewBoltDriverWithBlockClient is the same as NewBoltDriver except that it
+// takes an existing BlockAPIClient.
+func NewBoltDriverWithBlockClient(blockClient pfs.BlockAPIClient, path string) (drive.Driver, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: boltOpenTimeout})
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Update(createBoltBuckets); err != nil {
+		return nil, err
+	}
+	readAhead, err := readAheadFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return &boltDriver{
+		blockClient: blockClient,
+		db:          db,
+		readAhead:   readAhead,
+		changed:     make(chan struct{}),
+	}, nil
+}
+
+func createBoltBuckets(tx *bolt.Tx) error {
+	for _, bucket := range boltBuckets {
+		if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// update runs f in a read-write transaction and wakes up anyone waiting
+// for the database to change.
+func (d *boltDriver) update(f func(tx *bolt.Tx) error) error {
+	if err := d.db.Update(f); err != nil {
+		return err
+	}
+	d.changedLock.Lock()
+	defer d.changedLock.Unlock()
+	close(d.changed)
+	d.changed = make(chan struct{})
+	return nil
+}
+
+// waitFor calls f in a read-only transaction until it returns true or an
+// error, blocking until the database changes in between calls.
+func (d *boltDriver) waitFor(f func(tx *bolt.Tx) (bool, error)) error {
+	for {
+		d.changedLock.Lock()
+		changed := d.changed
+		d.changedLock.Unlock()
+		var done bool
+		if err := d.db.View(func(tx *bolt.Tx) error {
+			var err error
+			done, err = f(tx)
+			return err
+		}); err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		<-changed
+	}
+}
+
+func getBoltMessage(bucket *bolt.Bucket, key []byte, message proto.Message) (bool, error) {
+	value := bucket.Get(key)
+	if value == nil {
+		return false, nil
+	}
+	return true, proto.Unmarshal(value, message)
+}
+
+func putBoltMessage(bucket *bolt.Bucket, key []byte, message proto.Message) error {
+	value, err := proto.Marshal(message)
+	if err != nil {
+		return err
+	}
+	return bucket.Put(key, value)
+}
+
+// repoBucket returns the nested bucket that holds a repo's commits or diffs.
+func repoBucket(tx *bolt.Tx, bucket []byte, repo string) *bolt.Bucket {
+	return tx.Bucket(bucket).Bucket([]byte(repo))
+}
+
+func diffKeyPrefix(branch string, clock uint64) []byte {
+	key := make([]byte, len(branch)+9)
+	copy(key, branch)
+	binary.BigEndian.PutUint64(key[len(branch)+1:], clock)
+	return key
+}
+
+func diffKey(diff *persist.Diff) []byte {
+	head := persist.FullClockHead(diff.Clock)
+	key := diffKeyPrefix(head.Branch, head.Clock)
+	key = append(key, diff.Path...)
+	key = append(key, 0)
+	return append(key, diff.ID...)
+}
+
+func (d *boltDriver) CreateRepo(repo *pfs.Repo, provenance []*pfs.Repo) error {
+	if repo == nil {
+		return fmt.Errorf("repo cannot be nil")
+	}
+	if err := validateRepoName(repo.Name); err != nil {
+		return err
+	}
+
+	return d.update(func(tx *bolt.Tx) error {
+		repos := tx.Bucket(boltRepoBucket)
+		var provenantIDs []string
+		for _, r := range provenance {
+			if repos.Get([]byte(r.Name)) == nil {
+				return fmt.Errorf("could not create repo %v, not all provenance repos exist", repo.Name)
+			}
+			provenantIDs = append(provenantIDs, r.Name)
+		}
+		if repos.Get([]byte(repo.Name)) != nil {
+			return fmt.Errorf("repo %v exists", repo.Name)
+		}
+		if err := putBoltMessage(repos, []byte(repo.Name), &persist.Repo{
+			Name:       repo.Name,
+			Created:    now(),
+			Provenance: provenantIDs,
+		}); err != nil {
+			return err
+		}
+		if _, err := tx.Bucket(boltCommitBucket).CreateBucketIfNotExists([]byte(repo.Name)); err != nil {
+			return err
+		}
+		_, err := tx.Bucket(boltDiffBucket).CreateBucketIfNotExists([]byte(repo.Name))
+		return err
+	})
+}
+
+func (d *boltDriver) inspectRepo(tx *bolt.Tx, repo *pfs.Repo) (*persist.Repo, error) {
+	rawRepo := &persist.Repo{}
+	ok, err := getBoltMessage(tx.Bucket(boltRepoBucket), []byte(repo.Name), rawRepo)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, pfsserver.NewErrRepoNotFound(repo.Name)
+	}
+	return rawRepo, nil
+}
+
+func (d *boltDriver) InspectRepo(repo *pfs.Repo) (repoInfo *pfs.RepoInfo, retErr error) {
+	retErr = d.db.View(func(tx *bolt.Tx) error {
+		var err error
+		repoInfo, err = d.inspectRepoInfo(tx, repo)
+		return err
+	})
+	return repoInfo, retErr
+}
+
+func (d *boltDriver) inspectRepoInfo(tx *bolt.Tx, repo *pfs.Repo) (*pfs.RepoInfo, error) {
+	rawRepo, err := d.inspectRepo(tx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	// The full set of this repo's provenance, i.e. its immediate provenance
+	// plus the provenance of those repos.
+	fullProvenance := make(map[string]bool)
+	for _, repoName := range rawRepo.Provenance {
+		fullProvenance[repoName] = true
+		repoInfo, err := d.inspectRepoInfo(tx, &pfs.Repo{Name: repoName})
+		if err != nil {
+			return nil, err
+		}
+		for _, repo := range repoInfo.Provenance {
+			fullProvenance[repo.Name] = true
+		}
+	}
+
+	var provenance []*pfs.Repo
+	for repoName := range fullProvenance {
+		provenance = append(provenance, &pfs.Repo{
+			Name: repoName,
+		})
+	}
+	sort.Sort(byName(provenance))
+
+	return &pfs.RepoInfo{
+		Repo: &pfs.Repo{
+			Name: rawRepo.Name,
+		},
+		Created:    rawRepo.Created,
+		SizeBytes:  rawRepo.Size,
+		Provenance: provenance,
+	}, nil
+}
+
+func (d *boltDriver) ListRepo(provenance []*pfs.Repo) (repoInfos []*pfs.RepoInfo, retErr error) {
+	retErr = d.db.View(func(tx *bolt.Tx) error {
+		var err error
+		repoInfos, err = d.listRepo(tx, provenance)
+		return err
+	})
+	return repoInfos, retErr
+}
+
+func (d *boltDriver) listRepo(tx *bolt.Tx, provenance []*pfs.Repo) ([]*pfs.RepoInfo, error) {
+	var repoInfos []*pfs.RepoInfo
+	// Bolt keeps keys sorted, so the repos come out ordered by name.
+	c := tx.Bucket(boltRepoBucket).Cursor()
+nextRepo:
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		repoInfo, err := d.inspectRepoInfo(tx, &pfs.Repo{Name: string(k)})
+		if err != nil {
+			return nil, err
+		}
+		// Filter out the repos that don't have the given provenance
+		for _, p := range provenance {
+			var found bool
+			for _, r := range repoInfo.Provenance {
+				if p.Name == r.Name {
+					found = true
+					break
+				}
+			}
+			if !found {
+				continue nextRepo
+			}
+		}
+		repoInfo.Provenance = nil
+		repoInfos = append(repoInfos, repoInfo)
+	}
+	return repoInfos, nil
+}
+
+func (d *boltDriver) DeleteRepo(repo *pfs.Repo, force bool) error {
+	return d.update(func(tx *bolt.Tx) error {
+		if !force {
+			// Make sure that this repo is not the provenance of any other repo
+			repoInfos, err := d.listRepo(tx, []*pfs.Repo{repo})
+			if err != nil {
+				return err
+			}
+			if len(repoInfos) > 0 {
+				var repoNames []string
+				for _, repoInfo := range repoInfos {
+					repoNames = append(repoNames, repoInfo.Repo.Name)
+				}
+				return fmt.Errorf("cannot delete repo %v; it's the provenance of the following repos: %v", repo.Name, repoNames)
+			}
+		}
+
+		if err := tx.Bucket(boltRepoBucket).Delete([]byte(repo.Name)); err != nil {
+			return err
+		}
+		for _, bucket := range [][]byte{boltCommitBucket, boltDiffBucket} {
+			if err := tx.Bucket(bucket).DeleteBucket([]byte(repo.Name)); err != nil && err != bolt.ErrBucketNotFound {
+				return err
+			}
+		}
+		return d.deleteUploads(tx, func(upload *persist.Upload) bool {
+			return upload.Repo == repo.Name
+		})
+	})
+}
+
+func (d *boltDriver) getFullProvenance(tx *bolt.Tx, repo *pfs.Repo, provenance []*pfs.Commit) (fullProvenance []*persist.ProvenanceCommit, archived bool, err error) {
+	rawRepo, err := d.inspectRepo(tx, repo)
+	if err != nil {
+		return nil, false, err
+	}
+
+	repoSet := make(map[string]bool)
+	for _, repoName := range rawRepo.Provenance {
+		repoSet[repoName] = true
+	}
+
+	for _, c := range provenance {
+		if !repoSet[c.Repo.Name] {
+			return nil, false, fmt.Errorf("cannot use %s/%s as provenance, %s is not provenance of %s", c.Repo.Name, c.ID, c.Repo.Name, repo.Name)
+		}
+		fullProvenance = append(fullProvenance, &persist.ProvenanceCommit{
+			ID:   c.ID,
+			Repo: c.Repo.Name,
+		})
+	}
+	// The provenance of this commit includes the provenance of its
+	// immediate provenance, see the rethink driver.
+	provenanceSet := make(map[string]*persist.ProvenanceCommit)
+	for _, c := range provenance {
+		rawCommit, err := d.getRawCommit(tx, c)
+		if err != nil {
+			return nil, false, err
+		}
+		// If any of the commit's provenance is archived, the commit should be
+		// archived
+		archived = archived || rawCommit.Archived
+		for _, p := range rawCommit.Provenance {
+			provenanceSet[p.ID] = p
+		}
+	}
+
+	for _, c := range provenanceSet {
+		fullProvenance = append(fullProvenance, c)
+	}
+
+	return fullProvenance, archived, nil
+}
+
+func (d *boltDriver) ForkCommit(parent *pfs.Commit, branch string, provenance []*pfs.Commit) (*pfs.Commit, error) {
+	clock := persist.NewClock(branch)
+	if err := d.update(func(tx *bolt.Tx) error {
+		fullProvenance, archived, err := d.getFullProvenance(tx, parent.Repo, provenance)
+		if err != nil {
+			return err
+		}
+		parentCommit, err := d.getRawCommit(tx, parent)
+		if err != nil {
+			return err
+		}
+		return d.insertCommit(tx, &persist.Commit{
+			ID:         persist.NewCommitID(parent.Repo.Name, clock),
+			Repo:       parent.Repo.Name,
+			FullClock:  append(persist.CloneFullClock(parentCommit.FullClock), persist.NewClock(branch)),
+			Started:    now(),
+			Provenance: fullProvenance,
+			Archived:   archived,
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return &pfs.Commit{
+		Repo: parent.Repo,
+		ID:   clock.ReadableCommitID(),
+	}, nil
+}
+
+func (d *boltDriver) StartCommit(parent *pfs.Commit, provenance []*pfs.Commit) (*pfs.Commit, error) {
+	if parent.Repo.Name == "" || parent.ID == "" {
+		return nil, fmt.Errorf("Invalid parent commit: %s/%s", parent.Repo.Name, parent.ID)
+	}
+
+	var clock *persist.Clock
+	if err := d.update(func(tx *bolt.Tx) error {
+		fullProvenance, archived, err := d.getFullProvenance(tx, parent.Repo, provenance)
+		if err != nil {
+			return err
+		}
+
+		commit := &persist.Commit{
+			Repo:       parent.Repo.Name,
+			Started:    now(),
+			Provenance: fullProvenance,
+			Archived:   archived,
+		}
+
+		parentCommit, err := d.getRawCommit(tx, parent)
+		if _, ok := err.(*pfsserver.ErrCommitNotFound); ok && isBranchName(parent.ID) {
+			// Start a new branch
+			clock = persist.NewClock(parent.ID)
+			commit.FullClock = []*persist.Clock{clock}
+		} else if err != nil {
+			return err
+		} else {
+			commit.FullClock = persist.NewChild(persist.CloneFullClock(parentCommit.FullClock))
+			clock = persist.FullClockHead(commit.FullClock)
+		}
+		commit.ID = persist.NewCommitID(parent.Repo.Name, clock)
+
+		// Unlike in rethink there's no race between two StartCommits on the
+		// same branch, since bolt serializes read-write transactions.
+		return d.insertCommit(tx, commit)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &pfs.Commit{
+		Repo: parent.Repo,
+		ID:   clock.ReadableCommitID(),
+	}, nil
+}
+
+func (d *boltDriver) insertCommit(tx *bolt.Tx, commit *persist.Commit) error {
+	commits := repoBucket(tx, boltCommitBucket, commit.Repo)
+	if commits == nil {
+		return pfsserver.NewErrRepoNotFound(commit.Repo)
+	}
+	if commits.Get([]byte(commit.ID)) != nil {
+		return pfsserver.NewErrCommitExists(commit.Repo, commit.ID)
+	}
+	return putBoltMessage(commits, []byte(commit.ID), commit)
+}
+
+func (d *boltDriver) putCommit(tx *bolt.Tx, commit *persist.Commit) error {
+	commits := repoBucket(tx, boltCommitBucket, commit.Repo)
+	if commits == nil {
+		return pfsserver.NewErrRepoNotFound(commit.Repo)
+	}
+	return putBoltMessage(commits, []byte(commit.ID), commit)
+}
+
+// getRawCommit accepts a repo name and an ID, and returns a Commit object.
+// The ID can be of the form "branch/clock" or just "branch", in which case
+// the head of the branch is returned.
+func (d *boltDriver) getRawCommit(tx *bolt.Tx, commit *pfs.Commit) (*persist.Commit, error) {
+	commits := repoBucket(tx, boltCommitBucket, commit.Repo.Name)
+	if commits == nil {
+		return nil, pfsserver.NewErrCommitNotFound(commit.Repo.Name, commit.ID)
+	}
+	commitID, err := getRawCommitID(commit.Repo.Name, commit.ID)
+	if err != nil {
+		// We see if the commitID is a branch name
+		head, err := d.getHeadOfBranch(tx, commit.Repo.Name, commit.ID)
+		if err != nil {
+			return nil, err
+		}
+		if head == nil {
+			return nil, pfsserver.NewErrCommitNotFound(commit.Repo.Name, commit.ID)
+		}
+		return head, nil
+	}
+	rawCommit := &persist.Commit{}
+	ok, err := getBoltMessage(commits, []byte(commitID), rawCommit)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, pfsserver.NewErrCommitNotFound(commit.Repo.Name, commit.ID)
+	}
+	return rawCommit, nil
+}
+
+// getHeadOfBranch returns the commit with the highest clock on the given
+// branch, or nil if the branch doesn't exist.
+func (d *boltDriver) getHeadOfBranch(tx *bolt.Tx, repo string, branch string) (*persist.Commit, error) {
+	var head *persist.Commit
+	if err := d.forEachCommit(tx, repo, func(commit *persist.Commit) error {
+		clock := persist.FullClockHead(commit.FullClock)
+		if clock.Branch == branch && (head == nil || clock.Clock > persist.FullClockHead(head.FullClock).Clock) {
+			head = commit
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return head, nil
+}
+
+func (d *boltDriver) forEachCommit(tx *bolt.Tx, repo string, f func(*persist.Commit) error) error {
+	commits := repoBucket(tx, boltCommitBucket, repo)
+	if commits == nil {
+		return nil
+	}
+	return commits.ForEach(func(k, v []byte) error {
+		commit := &persist.Commit{}
+		if err := proto.Unmarshal(v, commit); err != nil {
+			return err
+		}
+		return f(commit)
+	})
+}
+
+// listCommits returns all commits of a repo, ordered by their full clocks.
+func (d *boltDriver) listCommits(tx *bolt.Tx, repo string) ([]*persist.Commit, error) {
+	var commits []*persist.Commit
+	if err := d.forEachCommit(tx, repo, func(commit *persist.Commit) error {
+		commits = append(commits, commit)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Sort(byFullClock(commits))
+	return commits, nil
+}
+
+// byFullClock sorts commits by their full clocks, in the same order as
+// rethink sorts the arrays in CommitFullClockIndex.
+type byFullClock []*persist.Commit
+
+func (c byFullClock) Len() int      { return len(c) }
+func (c byFullClock) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+func (c byFullClock) Less(i, j int) bool {
+	return compareFullClocks(c[i].FullClock, c[j].FullClock) < 0
+}
+
+func compareFullClocks(a persist.FullClock, b persist.FullClock) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i].Branch != b[i].Branch {
+			return strings.Compare(a[i].Branch, b[i].Branch)
+		}
+		if a[i].Clock != b[i].Clock {
+			if a[i].Clock < b[i].Clock {
+				return -1
+			}
+			return 1
+		}
+	}
+	return len(a) - len(b)
+}
+
+// clockDescendent is the same as persist.DBClockDescendent, but on
+// FullClocks rather than rethinkdb terms.
+func clockDescendent(child persist.FullClock, parent persist.FullClock) bool {
+	if len(child) < len(parent) || len(parent) == 0 {
+		return false
+	}
+	if len(child) == len(parent) {
+		childHead := persist.FullClockHead(child)
+		parentHead := persist.FullClockHead(parent)
+		return compareFullClocks(child[:len(child)-1], parent[:len(parent)-1]) == 0 &&
+			childHead.Branch == parentHead.Branch && childHead.Clock > parentHead.Clock
+	}
+	return compareFullClocks(child[:len(parent)], parent) == 0
+}
+
+// computeCommitSize computes the size of a commit using its diffs.
+func (d *boltDriver) computeCommitSize(tx *bolt.Tx, commit *persist.Commit) (uint64, error) {
+	head := persist.FullClockHead(commit.FullClock)
+	var size uint64
+	if err := d.forEachDiffInRange(tx, commit.Repo, &persist.ClockRange{
+		Branch: head.Branch,
+		Left:   head.Clock,
+		Right:  head.Clock,
+	}, func(diff *persist.Diff) error {
+		size += diff.Size
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+// FinishCommit blocks until its parent has been finished/cancelled
+func (d *boltDriver) FinishCommit(commit *pfs.Commit, cancel bool) error {
+	var rawCommit *persist.Commit
+	if err := d.db.View(func(tx *bolt.Tx) error {
+		if _, err := d.inspectRepo(tx, commit.Repo); err != nil {
+			return err
+		}
+		var err error
+		rawCommit, err = d.getRawCommit(tx, commit)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	parentClock := persist.FullClockParent(rawCommit.FullClock)
+	var parentCancelled bool
+	if parentClock != nil {
+		parentID := persist.NewCommitID(rawCommit.Repo, persist.FullClockHead(parentClock))
+		if err := d.waitFor(func(tx *bolt.Tx) (bool, error) {
+			commits := repoBucket(tx, boltCommitBucket, rawCommit.Repo)
+			if commits == nil {
+				return false, pfsserver.NewErrRepoNotFound(rawCommit.Repo)
+			}
+			parent := &persist.Commit{}
+			ok, err := getBoltMessage(commits, []byte(parentID), parent)
+			if err != nil || !ok {
+				return false, err
+			}
+			parentCancelled = parent.Cancelled
+			return parent.Finished != nil, nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return d.update(func(tx *bolt.Tx) error {
+		// Read the commit again, since it might have been modified while we
+		// were waiting for the parent.
+		rawCommit, err := d.getRawCommit(tx, &pfs.Commit{
+			Repo: commit.Repo,
+			ID:   persist.FullClockHead(rawCommit.FullClock).ReadableCommitID(),
+		})
+		if err != nil {
+			return err
+		}
+		rawCommit.Size, err = d.computeCommitSize(tx, rawCommit)
+		if err != nil {
+			return err
+		}
+		rawCommit.Finished = now()
+		rawCommit.Cancelled = parentCancelled || cancel
+		if err := d.putCommit(tx, rawCommit); err != nil {
+			return err
+		}
+
+		rawRepo, err := d.inspectRepo(tx, commit.Repo)
+		if err != nil {
+			return err
+		}
+		rawRepo.Size += rawCommit.Size
+		if err := putBoltMessage(tx.Bucket(boltRepoBucket), []byte(rawRepo.Name), rawRepo); err != nil {
+			return err
+		}
+
+		// Uploads to the commit can't be completed anymore.
+		commitID := persist.FullClockHead(rawCommit.FullClock).ReadableCommitID()
+		return d.deleteUploads(tx, func(upload *persist.Upload) bool {
+			return upload.Repo == rawCommit.Repo && upload.CommitID == commitID
+		})
+	})
+}
+
+// ArchiveCommit archives the given commits and all commits that have any of
+// the given commits as provenance
+func (d *boltDriver) ArchiveCommit(commits []*pfs.Commit) error {
+	provenanceIDs := make(map[string]bool)
+	rawIDs := make(map[string]bool)
+	for _, commit := range commits {
+		provenanceIDs[commit.ID] = true
+		rawID, err := getRawCommitID(commit.Repo.Name, commit.ID)
+		if err != nil {
+			return err
+		}
+		rawIDs[rawID] = true
+	}
+
+	return d.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltCommitBucket).ForEach(func(repo, _ []byte) error {
+			var toArchive []*persist.Commit
+			if err := d.forEachCommit(tx, string(repo), func(commit *persist.Commit) error {
+				archive := rawIDs[commit.ID]
+				for _, p := range commit.Provenance {
+					archive = archive || provenanceIDs[p.ID]
+				}
+				if archive {
+					commit.Archived = true
+					toArchive = append(toArchive, commit)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			// Bolt doesn't allow modifying a bucket while iterating over it.
+			for _, commit := range toArchive {
+				if err := d.putCommit(tx, commit); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
+
+func (d *boltDriver) InspectCommit(commit *pfs.Commit) (commitInfo *pfs.CommitInfo, retErr error) {
+	retErr = d.db.View(func(tx *bolt.Tx) error {
+		rawCommit, err := d.getRawCommit(tx, commit)
+		if err != nil {
+			return err
+		}
+		commitInfo = rawCommitToCommitInfo(rawCommit)
+		if commitInfo.Finished == nil {
+			commitInfo.SizeBytes, err = d.computeCommitSize(tx, rawCommit)
+		}
+		return err
+	})
+	return commitInfo, retErr
+}
+
+func (d *boltDriver) ListCommit(fromCommits []*pfs.Commit, provenance []*pfs.Commit, commitType pfs.CommitType, status pfs.CommitStatus, block bool) (commitInfos []*pfs.CommitInfo, retErr error) {
+	// The clocks are resolved up front so that a branch name refers to the
+	// head of the branch at the time of the call, even if we block.
+	repoToFromClock := make(map[string]persist.FullClock)
+	if err := d.db.View(func(tx *bolt.Tx) error {
+		for _, commit := range fromCommits {
+			// make sure that the repos exist
+			if _, err := d.inspectRepo(tx, commit.Repo); err != nil {
+				return err
+			}
+			repoToFromClock[commit.Repo.Name] = nil
+			if commit.ID != "" {
+				rawCommit, err := d.getRawCommit(tx, commit)
+				if err != nil {
+					return err
+				}
+				repoToFromClock[commit.Repo.Name] = rawCommit.FullClock
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	var repos []string
+	for repo := range repoToFromClock {
+		repos = append(repos, repo)
+	}
+	sort.Strings(repos)
+
+	match := func(commit *persist.Commit) bool {
+		if status != pfs.CommitStatus_ALL && status != pfs.CommitStatus_CANCELLED && commit.Cancelled {
+			return false
+		}
+		if status != pfs.CommitStatus_ALL && status != pfs.CommitStatus_ARCHIVED && commit.Archived {
+			return false
+		}
+		switch commitType {
+		case pfs.CommitType_COMMIT_TYPE_READ:
+			if commit.Finished == nil {
+				return false
+			}
+		case pfs.CommitType_COMMIT_TYPE_WRITE:
+			if commit.Finished != nil {
+				return false
+			}
+		}
+		for _, p := range provenance {
+			if !hasProvenance(commit, p.Repo.Name, p.ID) {
+				return false
+			}
+		}
+		return true
+	}
+
+	list := func(tx *bolt.Tx) (bool, error) {
+		commitInfos = nil
+		if len(repoToFromClock) == 0 {
+			repos = nil
+			if err := tx.Bucket(boltCommitBucket).ForEach(func(repo, _ []byte) error {
+				repos = append(repos, string(repo))
+				return nil
+			}); err != nil {
+				return false, err
+			}
+		}
+		for _, repo := range repos {
+			fromClock := repoToFromClock[repo]
+			commits, err := d.listCommits(tx, repo)
+			if err != nil {
+				return false, err
+			}
+			for _, commit := range commits {
+				if fromClock != nil && !clockDescendent(commit.FullClock, fromClock) {
+					continue
+				}
+				if match(commit) {
+					commitInfos = append(commitInfos, rawCommitToCommitInfo(commit))
+				}
+			}
+		}
+		return !block || len(commitInfos) > 0, nil
+	}
+
+	if err := d.waitFor(list); err != nil {
+		return nil, err
+	}
+	return commitInfos, nil
+}
+
+func hasProvenance(commit *persist.Commit, repo string, id string) bool {
+	for _, p := range commit.Provenance {
+		if p.Repo == repo && p.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *boltDriver) FlushCommit(fromCommits []*pfs.Commit, toRepos []*pfs.Repo) ([]*pfs.CommitInfo, error) {
+	var result []*pfs.CommitInfo
+	var provenanceIDs []*persist.ProvenanceCommit
+	repoSet := make(map[string]bool)
+	if err := d.db.View(func(tx *bolt.Tx) error {
+		repoSet1 := make(map[string]bool)
+		for _, commit := range fromCommits {
+			repoInfos, err := d.listRepo(tx, []*pfs.Repo{commit.Repo})
+			if err != nil {
+				return err
+			}
+			for _, repoInfo := range repoInfos {
+				repoSet1[repoInfo.Repo.Name] = true
+			}
+		}
+
+		repoSet2 := make(map[string]bool)
+		for _, repo := range toRepos {
+			repoInfo, err := d.inspectRepoInfo(tx, repo)
+			if err != nil {
+				return err
+			}
+			for _, repo := range repoInfo.Provenance {
+				repoSet2[repo.Name] = true
+			}
+			repoSet2[repo.Name] = true
+		}
+
+		// The repos that we care about.
+		for repoName := range repoSet1 {
+			if len(repoSet2) == 0 || repoSet2[repoName] {
+				repoSet[repoName] = true
+			}
+		}
+
+		for _, commit := range fromCommits {
+			rawCommit, err := d.getRawCommit(tx, commit)
+			if err != nil {
+				return err
+			}
+			result = append(result, rawCommitToCommitInfo(rawCommit))
+			provenanceIDs = append(provenanceIDs, &persist.ProvenanceCommit{
+				Repo: commit.Repo.Name,
+				// We can't just use commit.ID directly because it might be a
+				// branch name instead of a commitID
+				ID: persist.FullClockHead(rawCommit.FullClock).ReadableCommitID(),
+			})
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if len(provenanceIDs) == 0 {
+		return nil, nil
+	}
+
+	if err := d.waitFor(func(tx *bolt.Tx) (bool, error) {
+		var repos []string
+		for repo := range repoSet {
+			repos = append(repos, repo)
+		}
+		sort.Strings(repos)
+	nextRepo:
+		for _, repo := range repos {
+			commits, err := d.listCommits(tx, repo)
+			if err != nil {
+				return false, err
+			}
+			for _, commit := range commits {
+				if commit.Archived || commit.Finished == nil {
+					continue
+				}
+				for _, p := range provenanceIDs {
+					if hasProvenance(commit, p.Repo, p.ID) {
+						if commit.Cancelled {
+							return false, fmt.Errorf("commit %s/%s was cancelled", commit.Repo, commit.ID)
+						}
+						result = append(result, rawCommitToCommitInfo(commit))
+						delete(repoSet, repo)
+						continue nextRepo
+					}
+				}
+			}
+		}
+		return len(repoSet) == 0, nil
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (d *boltDriver) ListBranch(repo *pfs.Repo, status pfs.CommitStatus) (branches []string, retErr error) {
+	retErr = d.db.View(func(tx *bolt.Tx) error {
+		heads := make(map[string]*persist.Commit)
+		if err := d.forEachCommit(tx, repo.Name, func(commit *persist.Commit) error {
+			clock := persist.FullClockHead(commit.FullClock)
+			if head, ok := heads[clock.Branch]; !ok || clock.Clock > persist.FullClockHead(head.FullClock).Clock {
+				heads[clock.Branch] = commit
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for branch, head := range heads {
+			// Check if we should skip the branch based on status
+			if status != pfs.CommitStatus_ALL {
+				if head.Cancelled && status != pfs.CommitStatus_CANCELLED {
+					continue
+				}
+				if head.Archived && status != pfs.CommitStatus_ARCHIVED {
+					continue
+				}
+			}
+			branches = append(branches, branch)
+		}
+		sort.Strings(branches)
+		return nil
+	})
+	return branches, retErr
+}
+
+func (d *boltDriver) DeleteCommit(commit *pfs.Commit) error {
+	return grpc.Errorf(codes.Unimplemented, "DeleteCommit is not implemented by the bolt driver")
+}
+
+func (d *boltDriver) PutFile(ctx context.Context, file *pfs.File, delimiter pfs.Delimiter, reader io.Reader) error {
+	fixPath(file)
+	if err := checkPath(file.Path); err != nil {
+		return err
+	}
+
+	var commit *persist.Commit
+	if err := d.db.View(func(tx *bolt.Tx) error {
+		var err error
+		commit, err = d.getRawCommit(tx, file.Commit)
+		return err
+	}); err != nil {
+		return err
+	}
+	if commit.Finished != nil {
+		return pfsserver.NewErrCommitFinished(commit.Repo, commit.ID)
+	}
+	_client := client.APIClient{BlockAPIClient: d.blockClient}.WithCtx(ctx)
+	blockrefs, err := _client.PutBlock(delimiter, reader)
+	if err != nil {
+		return err
+	}
+	refs, size := persistBlockRefs(blockrefs.BlockRef)
+	// Unlike with rethink, the diffs are written in a single transaction so
+	// we never end up with "/foo/bar" but not "/foo".
+	return d.update(func(tx *bolt.Tx) error {
+		return d.putBlockRefs(tx, file, commit, delimiter, refs, size)
+	})
+}
+
+// putBlockRefs appends refs to a file in commit, creating the file and its
+// ancestor directories if they don't exist.
+func (d *boltDriver) putBlockRefs(tx *bolt.Tx, file *pfs.File, commit *persist.Commit, delimiter pfs.Delimiter, refs []*persist.BlockRef, size uint64) error {
+	for _, diff := range putFileDiffs(commit, file.Path, delimiter, refs, size) {
+		if err := d.checkFileType(tx, file.Commit, diff.Path, diff.FileType); err != nil {
+			return err
+		}
+		if err := d.mergeDiff(tx, diff); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *boltDriver) BeginUpload(file *pfs.File, delimiter pfs.Delimiter) (*pfs.Upload, error) {
+	fixPath(file)
+	if err := checkPath(file.Path); err != nil {
+		return nil, err
+	}
+	var upload *persist.Upload
+	if err := d.update(func(tx *bolt.Tx) error {
+		commit, err := d.getRawCommit(tx, file.Commit)
+		if err != nil {
+			return err
+		}
+		if commit.Finished != nil {
+			return pfsserver.NewErrCommitFinished(commit.Repo, commit.ID)
+		}
+		upload = newRawUpload(file, commit, delimiter)
+		return putBoltMessage(tx.Bucket(boltUploadBucket), []byte(upload.ID), upload)
+	}); err != nil {
+		return nil, err
+	}
+	return &pfs.Upload{ID: upload.ID}, nil
+}
+
+func (d *boltDriver) UploadPart(ctx context.Context, upload *pfs.Upload, offset uint64, reader io.Reader) error {
+	var rawUpload *persist.Upload
+	if err := d.db.View(func(tx *bolt.Tx) error {
+		var err error
+		rawUpload, err = d.getRawUpload(tx, upload)
+		return err
+	}); err != nil {
+		return err
+	}
+	_client := client.APIClient{BlockAPIClient: d.blockClient}.WithCtx(ctx)
+	blockrefs, err := _client.PutBlock(pfs.Delimiter(rawUpload.Delimiter), reader)
+	if err != nil {
+		return err
+	}
+	refs, size := persistBlockRefs(blockrefs.BlockRef)
+	part := &persist.UploadPart{
+		Offset:    offset,
+		Size:      size,
+		BlockRefs: refs,
+	}
+	// The upload is read again so that concurrent parts don't clobber each
+	// other.
+	return d.update(func(tx *bolt.Tx) error {
+		rawUpload, err := d.getRawUpload(tx, upload)
+		if err != nil {
+			return err
+		}
+		setUploadPart(rawUpload, part)
+		return putBoltMessage(tx.Bucket(boltUploadBucket), []byte(rawUpload.ID), rawUpload)
+	})
+}
+
+func (d *boltDriver) InspectUpload(upload *pfs.Upload) (*pfs.UploadInfo, error) {
+	var rawUpload *persist.Upload
+	if err := d.db.View(func(tx *bolt.Tx) error {
+		var err error
+		rawUpload, err = d.getRawUpload(tx, upload)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return rawUploadToUploadInfo(rawUpload), nil
+}
+
+func (d *boltDriver) CompleteUpload(upload *pfs.Upload, sizeBytes uint64) error {
+	return d.update(func(tx *bolt.Tx) error {
+		rawUpload, err := d.getRawUpload(tx, upload)
+		if err != nil {
+			return err
+		}
+		file := client.NewFile(rawUpload.Repo, rawUpload.CommitID, rawUpload.Path)
+		commit, err := d.getRawCommit(tx, file.Commit)
+		if err != nil {
+			return err
+		}
+		if commit.Finished != nil {
+			return pfsserver.NewErrCommitFinished(commit.Repo, commit.ID)
+		}
+		refs, err := uploadBlockRefs(rawUpload, sizeBytes)
+		if err != nil {
+			return err
+		}
+		if err := d.putBlockRefs(tx, file, commit, pfs.Delimiter(rawUpload.Delimiter), refs, sizeBytes); err != nil {
+			return err
+		}
+		return tx.Bucket(boltUploadBucket).Delete([]byte(upload.ID))
+	})
+}
+
+func (d *boltDriver) getRawUpload(tx *bolt.Tx, upload *pfs.Upload) (*persist.Upload, error) {
+	if upload == nil {
+		return nil, fmt.Errorf("upload cannot be nil")
+	}
+	rawUpload := &persist.Upload{}
+	ok, err := getBoltMessage(tx.Bucket(boltUploadBucket), []byte(upload.ID), rawUpload)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, pfsserver.NewErrUploadNotFound(upload.ID)
+	}
+	return rawUpload, nil
+}
+
+// deleteUploads deletes the uploads that match.
+func (d *boltDriver) deleteUploads(tx *bolt.Tx, match func(*persist.Upload) bool) error {
+	uploads := tx.Bucket(boltUploadBucket)
+	var keys [][]byte
+	if err := uploads.ForEach(func(key []byte, value []byte) error {
+		upload := &persist.Upload{}
+		if err := proto.Unmarshal(value, upload); err != nil {
+			return err
+		}
+		if match(upload) {
+			keys = append(keys, key)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	// Keys can't be deleted while iterating over the bucket.
+	for _, key := range keys {
+		if err := uploads.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeDiff inserts a diff, or merges it into the diff with the same ID if
+// there is one.
+func (d *boltDriver) mergeDiff(tx *bolt.Tx, diff *persist.Diff) error {
+	diffs := repoBucket(tx, boltDiffBucket, diff.Repo)
+	if diffs == nil {
+		return pfsserver.NewErrRepoNotFound(diff.Repo)
+	}
+	key := diffKey(diff)
+	oldDiff := &persist.Diff{}
+	ok, err := getBoltMessage(diffs, key, oldDiff)
+	if err != nil {
+		return err
+	}
+	if ok {
+		// We throw an error if the new diff is of a different file type
+		// than the old diff, unless the old diff is NONE
+		if oldDiff.FileType != persist.FileType_NONE && oldDiff.FileType != diff.FileType {
+			return errors.New(ErrConflictFileTypeMsg)
+		}
+		oldDiff.BlockRefs = append(oldDiff.BlockRefs, diff.BlockRefs...)
+		oldDiff.Size += diff.Size
+		oldDiff.FileType = diff.FileType
+		oldDiff.Modified = diff.Modified
+		oldDiff.Delimiter = diff.Delimiter
+		diff = oldDiff
+	}
+	return putBoltMessage(diffs, key, diff)
+}
+
+// putDiff inserts a diff, replacing the diff with the same ID if there is
+// one.
+func (d *boltDriver) putDiff(tx *bolt.Tx, diff *persist.Diff) error {
+	diffs := repoBucket(tx, boltDiffBucket, diff.Repo)
+	if diffs == nil {
+		return pfsserver.NewErrRepoNotFound(diff.Repo)
+	}
+	return putBoltMessage(diffs, diffKey(diff), diff)
+}
+
+// checkFileType returns an error if the given type conflicts with the preexisting
+// type.
+func (d *boltDriver) checkFileType(tx *bolt.Tx, commit *pfs.Commit, path string, typ persist.FileType) error {
+	diff, err := d.inspectFile(tx, &pfs.File{
+		Commit: commit,
+		Path:   path,
+	}, nil, nil)
+	if err != nil {
+		if _, ok := err.(*pfsserver.ErrFileNotFound); ok {
+			// If the file was not found, then there's no type conflict
+			return nil
+		}
+		return err
+	}
+	if diff.FileType != typ && diff.FileType != persist.FileType_NONE {
+		return errors.New(ErrConflictFileTypeMsg)
+	}
+	return nil
+}
+
+func (d *boltDriver) MakeDirectory(file *pfs.File) error {
+	fixPath(file)
+	return d.update(func(tx *bolt.Tx) error {
+		commit, err := d.getRawCommit(tx, file.Commit)
+		if err != nil {
+			return err
+		}
+		if commit.Finished != nil {
+			return pfsserver.NewErrCommitFinished(commit.Repo, commit.ID)
+		}
+		if err := d.checkFileType(tx, file.Commit, file.Path, persist.FileType_DIR); err != nil {
+			return err
+		}
+		return d.mergeDiff(tx, &persist.Diff{
+			ID:       getDiffID(commit.Repo, commit.ID, file.Path),
+			Repo:     commit.Repo,
+			Path:     file.Path,
+			Clock:    commit.FullClock,
+			FileType: persist.FileType_DIR,
+			Modified: now(),
+		})
+	})
+}
+
+func (d *boltDriver) GetFile(ctx context.Context, file *pfs.File, filterShard *pfs.Shard, offset int64, size int64, diffMethod *pfs.DiffMethod) (io.ReadCloser, error) {
+	fixPath(file)
+	var diff *persist.Diff
+	if err := d.db.View(func(tx *bolt.Tx) error {
+		var err error
+		diff, err = d.inspectFile(tx, file, filterShard, diffMethod)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	switch diff.FileType {
+	case persist.FileType_DIR:
+		return nil, fmt.Errorf("file %s/%s/%s is directory", file.Commit.Repo.Name, file.Commit.ID, file.Path)
+	case persist.FileType_NONE:
+		return nil, pfsserver.NewErrFileNotFound(file.Path, file.Commit.Repo.Name, file.Commit.ID)
+	}
+	return newFileReader(ctx, d.blockClient, d.readAhead, diff.BlockRefs, file, offset, size), nil
+}
+
+func (d *boltDriver) InspectFile(file *pfs.File, filterShard *pfs.Shard, diffMethod *pfs.DiffMethod) (fileInfo *pfs.FileInfo, retErr error) {
+	fixPath(file)
+	retErr = d.db.View(func(tx *bolt.Tx) error {
+		var err error
+		fileInfo, err = d.inspectFileInfo(tx, file, filterShard, diffMethod)
+		return err
+	})
+	return fileInfo, retErr
+}
+
+func (d *boltDriver) inspectFileInfo(tx *bolt.Tx, file *pfs.File, filterShard *pfs.Shard, diffMethod *pfs.DiffMethod) (*pfs.FileInfo, error) {
+	diff, err := d.inspectFile(tx, file, filterShard, diffMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pfs.FileInfo{
+		File: file,
+	}
+
+	switch diff.FileType {
+	case persist.FileType_FILE:
+		res.FileType = pfs.FileType_FILE_TYPE_REGULAR
+		res.Modified = diff.Modified
+		res.CommitModified = &pfs.Commit{
+			Repo: file.Commit.Repo,
+			ID:   persist.FullClockHead(diff.Clock).ReadableCommitID(),
+		}
+		res.SizeBytes = diff.Size
+		res.Delimiter = pfs.Delimiter(diff.Delimiter)
+	case persist.FileType_DIR:
+		res.FileType = pfs.FileType_FILE_TYPE_DIR
+		res.Modified = diff.Modified
+		childrenDiffs, err := d.getChildren(tx, file.Path, diffMethod, file.Commit)
+		if err != nil {
+			return nil, err
+		}
+		for _, diff := range childrenDiffs {
+			res.Children = append(res.Children, &pfs.File{
+				Commit: &pfs.Commit{
+					Repo: file.Commit.Repo,
+					ID:   diff.CommitID(),
+				},
+				Path: diff.Path,
+			})
+		}
+	case persist.FileType_NONE:
+		return nil, pfsserver.NewErrFileNotFound(file.Path, file.Commit.Repo.Name, file.Commit.ID)
+	default:
+		return nil, fmt.Errorf("unrecognized file type: %d; this is likely a bug", diff.FileType)
+	}
+	return res, nil
+}
+
+func (d *boltDriver) inspectFile(tx *bolt.Tx, file *pfs.File, filterShard *pfs.Shard, diffMethod *pfs.DiffMethod) (*persist.Diff, error) {
+	diffs, err := d.getDiffsInCommitRange(tx, diffMethod, file.Commit, func(p string) bool {
+		return p == file.Path
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(diffs) == 0 {
+		return nil, pfsserver.NewErrFileNotFound(file.Path, file.Commit.Repo.Name, file.Commit.ID)
+	}
+
+	diff, err := foldBoltDiffs(diffs, true)
+	if err != nil {
+		return nil, err
+	}
+	if !diffInShard(diff, filterShard, file) {
+		return nil, pfsserver.NewErrFileNotFound(file.Path, file.Commit.Repo.Name, file.Commit.ID)
+	}
+	return filterBlocks(diff, filterShard, file)
+}
+
+// foldBoltDiffs takes an ordered list of diffs for a given path, and returns
+// a single diff that represents the aggregation of these diffs.  It's the
+// same as foldDiffs, or foldDiffsWithoutDelete if withDelete is false.
+func foldBoltDiffs(diffs []*persist.Diff, withDelete bool) (*persist.Diff, error) {
+	acc := &persist.Diff{}
+	for _, diff := range diffs {
+		// If neither the acc nor the new diff has FileType_NONE, and they have
+		// different FileTypes, then it's a file type conflict.
+		if acc.FileType != persist.FileType_NONE && diff.FileType != persist.FileType_NONE && acc.FileType != diff.FileType {
+			return nil, errors.New(ErrConflictFileTypeMsg)
+		}
+		merged := proto.Clone(diff).(*persist.Diff)
+		merged.Delete = acc.Delete || diff.Delete
+		if !withDelete || !diff.Delete {
+			merged.BlockRefs = append(acc.BlockRefs, diff.BlockRefs...)
+			merged.Size = acc.Size + diff.Size
+		}
+		acc = merged
+	}
+	return acc, nil
+}
+
+// foldDiffsByPath groups an ordered list of diffs by path, folds each
+// group, and returns the results ordered by path.
+func foldDiffsByPath(diffs []*persist.Diff, withDelete bool) ([]*persist.Diff, error) {
+	paths := make(map[string][]*persist.Diff)
+	for _, diff := range diffs {
+		paths[diff.Path] = append(paths[diff.Path], diff)
+	}
+	var result []*persist.Diff
+	for _, pathDiffs := range paths {
+		diff, err := foldBoltDiffs(pathDiffs, withDelete)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, diff)
+	}
+	sort.Sort(diffsByPath(result))
+	return result, nil
+}
+
+// diffsByPath sorts diffs by path
+type diffsByPath []*persist.Diff
+
+func (p diffsByPath) Len() int           { return len(p) }
+func (p diffsByPath) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p diffsByPath) Less(i, j int) bool { return p[i].Path < p[j].Path }
+
+// getExistingFiles folds the diffs matched by filter, and returns the
+// ones that haven't been deleted.
+func (d *boltDriver) getExistingFiles(tx *bolt.Tx, diffMethod *pfs.DiffMethod, toCommit *pfs.Commit, filter func(string) bool) ([]*persist.Diff, error) {
+	diffs, err := d.getDiffsInCommitRange(tx, diffMethod, toCommit, filter)
+	if err != nil {
+		return nil, err
+	}
+	diffs, err = foldDiffsByPath(diffs, true)
+	if err != nil {
+		return nil, err
+	}
+	var result []*persist.Diff
+	for _, diff := range diffs {
+		if diff.FileType != persist.FileType_NONE {
+			result = append(result, diff)
+		}
+	}
+	return result, nil
+}
+
+func (d *boltDriver) getChildren(tx *bolt.Tx, parent string, diffMethod *pfs.DiffMethod, toCommit *pfs.Commit) ([]*persist.Diff, error) {
+	return d.getExistingFiles(tx, diffMethod, toCommit, func(p string) bool {
+		return p != parent && path.Dir(p) == parent
+	})
+}
+
+// getChildrenFast is the same as getChildren except that it doesn't return
+// the sizes and blockrefs of the children.
+func (d *boltDriver) getChildrenFast(tx *bolt.Tx, parent string, diffMethod *pfs.DiffMethod, toCommit *pfs.Commit) ([]*persist.Diff, error) {
+	diffs, err := d.getChildren(tx, parent, diffMethod, toCommit)
+	if err != nil {
+		return nil, err
+	}
+	for _, diff := range diffs {
+		diff.BlockRefs = nil
+		diff.Size = 0
+	}
+	return diffs, nil
+}
+
+// getChildrenRecursive returns the children of parent, where the size of
+// each child is the total size of the files under it.
+func (d *boltDriver) getChildrenRecursive(tx *bolt.Tx, parent string, diffMethod *pfs.DiffMethod, toCommit *pfs.Commit) ([]*persist.Diff, error) {
+	diffs, err := d.getExistingFiles(tx, diffMethod, toCommit, func(p string) bool {
+		return hasPathPrefix(p, parent)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Group the diffs by their first component after the parent prefix.
+	// Since the diffs are sorted by path, the first diff of each group is
+	// the direct child of parent.
+	children := make(map[string]*persist.Diff)
+	var result []*persist.Diff
+	for _, diff := range diffs {
+		child := strings.Split(strings.TrimPrefix(strings.TrimPrefix(diff.Path, parent), "/"), "/")[0]
+		if acc, ok := children[child]; ok {
+			acc.Size += diff.Size
+			continue
+		}
+		children[child] = diff
+		result = append(result, diff)
+	}
+	return result, nil
+}
+
+// hasPathPrefix returns whether prefix is a proper ancestor of p.
+func hasPathPrefix(p string, prefix string) bool {
+	if prefix == "/" {
+		return p != "/"
+	}
+	return strings.HasPrefix(p, prefix+"/")
+}
+
+// getDiffsInCommitRange returns an ordered list of the diffs that are in
+// the range specified by diffMethod and toCommit, and whose path matches
+// filter.
+func (d *boltDriver) getDiffsInCommitRange(tx *bolt.Tx, diffMethod *pfs.DiffMethod, toCommit *pfs.Commit, filter func(string) bool) ([]*persist.Diff, error) {
+	var from *pfs.Commit
+	if diffMethod != nil {
+		from = diffMethod.FromCommit
+	}
+	diffs, err := d._getDiffsInCommitRange(tx, from, toCommit, filter)
+	if err != nil {
+		return nil, err
+	}
+	if diffMethod != nil && diffMethod.FullFile && len(diffs) > 0 {
+		// If FullFile is set to true and anything has changed since
+		// FromCommit, we return the diffs since the beginning.
+		return d._getDiffsInCommitRange(tx, nil, toCommit, filter)
+	}
+	return diffs, nil
+}
+
+func (d *boltDriver) _getDiffsInCommitRange(tx *bolt.Tx, fromCommit *pfs.Commit, toCommit *pfs.Commit, filter func(string) bool) ([]*persist.Diff, error) {
+	var fromClock persist.FullClock
+	if fromCommit != nil {
+		from, err := d.getRawCommit(tx, fromCommit)
+		if err != nil {
+			return nil, err
+		}
+		fromClock = from.FullClock
+	}
+
+	to, err := d.getRawCommit(tx, toCommit)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []*persist.Diff
+	crl := persist.NewClockRangeList(fromClock, to.FullClock)
+	for _, r := range crl.Ranges() {
+		if err := d.forEachDiffInRange(tx, to.Repo, r, func(diff *persist.Diff) error {
+			if filter(diff.Path) {
+				diffs = append(diffs, diff)
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return diffs, nil
+}
+
+// forEachDiffInRange calls f on every diff whose head clock is in r, in
+// order of clock then path.
+func (d *boltDriver) forEachDiffInRange(tx *bolt.Tx, repo string, r *persist.ClockRange, f func(*persist.Diff) error) error {
+	diffs := repoBucket(tx, boltDiffBucket, repo)
+	if diffs == nil {
+		return pfsserver.NewErrRepoNotFound(repo)
+	}
+	branchPrefix := diffKeyPrefix(r.Branch, 0)[:len(r.Branch)+1]
+	end := diffKeyPrefix(r.Branch, r.Right)
+	c := diffs.Cursor()
+	for k, v := c.Seek(diffKeyPrefix(r.Branch, r.Left)); k != nil && bytes.HasPrefix(k, branchPrefix); k, v = c.Next() {
+		if bytes.Compare(k[:len(end)], end) > 0 {
+			break
+		}
+		diff := &persist.Diff{}
+		if err := proto.Unmarshal(v, diff); err != nil {
+			return err
+		}
+		if err := f(diff); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *boltDriver) ListFile(file *pfs.File, filterShard *pfs.Shard, diffMethod *pfs.DiffMethod, mode drive.ListFileMode) (fileInfos []*pfs.FileInfo, retErr error) {
+	fixPath(file)
+	retErr = d.db.View(func(tx *bolt.Tx) error {
+		// We treat the root directory specially: we know that it's a directory
+		if file.Path != "/" {
+			fileInfo, err := d.inspectFileInfo(tx, file, filterShard, diffMethod)
+			if err != nil {
+				return err
+			}
+			switch fileInfo.FileType {
+			case pfs.FileType_FILE_TYPE_REGULAR:
+				fileInfos = []*pfs.FileInfo{fileInfo}
+				return nil
+			case pfs.FileType_FILE_TYPE_DIR:
+				break
+			default:
+				return fmt.Errorf("unrecognized file type %d; this is likely a bug", fileInfo.FileType)
+			}
+		}
+
+		var diffs []*persist.Diff
+		var err error
+		switch mode {
+		case drive.ListFileNORMAL:
+			diffs, err = d.getChildren(tx, file.Path, diffMethod, file.Commit)
+		case drive.ListFileFAST:
+			diffs, err = d.getChildrenFast(tx, file.Path, diffMethod, file.Commit)
+		case drive.ListFileRECURSE:
+			diffs, err = d.getChildrenRecursive(tx, file.Path, diffMethod, file.Commit)
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, diff := range diffs {
+			fileInfo := &pfs.FileInfo{}
+			fileInfo.File = &pfs.File{
+				Commit: file.Commit,
+				Path:   diff.Path,
+			}
+			if !diffInShard(diff, filterShard, fileInfo.File) {
+				continue
+			}
+			diff, err := filterBlocks(diff, filterShard, fileInfo.File)
+			if err != nil {
+				if _, ok := err.(*pfsserver.ErrFileNotFound); ok {
+					continue
+				}
+				return err
+			}
+			fileInfo.SizeBytes = diff.Size
+			fileInfo.Modified = diff.Modified
+			fileInfo.Delimiter = pfs.Delimiter(diff.Delimiter)
+			switch diff.FileType {
+			case persist.FileType_FILE:
+				fileInfo.FileType = pfs.FileType_FILE_TYPE_REGULAR
+			case persist.FileType_DIR:
+				fileInfo.FileType = pfs.FileType_FILE_TYPE_DIR
+			default:
+				return fmt.Errorf("unrecognized file type %d; this is likely a bug", diff.FileType)
+			}
+			fileInfo.CommitModified = &pfs.Commit{
+				Repo: file.Commit.Repo,
+				ID:   persist.FullClockHead(diff.Clock).ReadableCommitID(),
+			}
+			fileInfos = append(fileInfos, fileInfo)
+		}
+		return nil
+	})
+	return fileInfos, retErr
+}
+
+func (d *boltDriver) DeleteFile(file *pfs.File) error {
+	fixPath(file)
+	return d.update(func(tx *bolt.Tx) error {
+		commit, err := d.getRawCommit(tx, file.Commit)
+		if err != nil {
+			return err
+		}
+
+		// Get all files under the directory
+		diffs, err := d.getExistingFiles(tx, nil, file.Commit, func(p string) bool {
+			return hasPathPrefix(p, file.Path)
+		})
+		if err != nil {
+			return err
+		}
+		paths := []string{file.Path}
+		for _, diff := range diffs {
+			paths = append(paths, diff.Path)
+		}
+
+		for _, path := range paths {
+			if err := d.putDiff(tx, &persist.Diff{
+				ID:       getDiffID(commit.Repo, commit.ID, path),
+				Repo:     commit.Repo,
+				Path:     path,
+				Delete:   true,
+				Clock:    commit.FullClock,
+				FileType: persist.FileType_NONE,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// getRangesToMerge returns the clock ranges of the given commits that are
+// not in to.
+func (d *boltDriver) getRangesToMerge(tx *bolt.Tx, commits []*pfs.Commit, to *pfs.Commit) (*persist.ClockRangeList, error) {
+	var ranges persist.ClockRangeList
+	for _, commit := range commits {
+		rawCommit, err := d.getRawCommit(tx, commit)
+		if err != nil {
+			return nil, err
+		}
+		ranges.AddFullClock(rawCommit.FullClock)
+	}
+	if commit, err := d.getRawCommit(tx, to); err == nil {
+		ranges.SubFullClock(commit.FullClock)
+	} else if _, ok := err.(*pfsserver.ErrCommitNotFound); !ok {
+		return nil, err
+	}
+	return &ranges, nil
+}
+
+// getCommitsToMerge returns the commits that need to be merged into to,
+// ordered by clock.
+func (d *boltDriver) getCommitsToMerge(tx *bolt.Tx, commits []*pfs.Commit, to *pfs.Commit) ([]*persist.Commit, error) {
+	ranges, err := d.getRangesToMerge(tx, commits, to)
+	if err != nil {
+		return nil, err
+	}
+	var rawCommits []*persist.Commit
+	for _, r := range ranges.Ranges() {
+		var rangeCommits []*persist.Commit
+		if err := d.forEachCommit(tx, to.Repo.Name, func(commit *persist.Commit) error {
+			head := persist.FullClockHead(commit.FullClock)
+			if head.Branch == r.Branch && head.Clock >= r.Left && head.Clock <= r.Right {
+				rangeCommits = append(rangeCommits, commit)
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		sort.Sort(byFullClock(rangeCommits))
+		rawCommits = append(rawCommits, rangeCommits...)
+	}
+	return rawCommits, nil
+}
+
+// getDiffsToMerge returns the diffs that need to be added to to in case of a
+// Squash.
+func (d *boltDriver) getDiffsToMerge(tx *bolt.Tx, commits []*pfs.Commit, to *pfs.Commit) ([]*persist.Diff, error) {
+	var allDiffs []*persist.Diff
+	for _, commit := range commits {
+		ranges, err := d.getRangesToMerge(tx, []*pfs.Commit{commit}, to)
+		if err != nil {
+			return nil, err
+		}
+		var diffs []*persist.Diff
+		for _, r := range ranges.Ranges() {
+			if err := d.forEachDiffInRange(tx, to.Repo.Name, r, func(diff *persist.Diff) error {
+				diffs = append(diffs, diff)
+				return nil
+			}); err != nil {
+				return nil, err
+			}
+		}
+		diffs, err = foldDiffsByPath(diffs, true)
+		if err != nil {
+			return nil, err
+		}
+		allDiffs = append(allDiffs, diffs...)
+	}
+	return foldDiffsByPath(allDiffs, false)
+}
+
+func (d *boltDriver) SquashCommit(fromCommits []*pfs.Commit, toCommit *pfs.Commit) error {
+	if len(fromCommits) == 0 || toCommit == nil {
+		return fmt.Errorf("Invalid arguments: fromCommits: %v; toCommit: %v", fromCommits, toCommit)
+	}
+
+	return d.update(func(tx *bolt.Tx) error {
+		// Make sure that the commit exists and is open
+		rawToCommit, err := d.getRawCommit(tx, toCommit)
+		if err != nil {
+			return err
+		}
+		if rawToCommit.Finished != nil {
+			return fmt.Errorf("commit %s/%s is not open", toCommit.Repo.Name, toCommit.ID)
+		}
+
+		// Make sure that all fromCommits are from the same repo
+		var repo string
+		for _, commit := range fromCommits {
+			if repo == "" {
+				repo = commit.Repo.Name
+			} else if repo != commit.Repo.Name {
+				return fmt.Errorf("you can only squash commits from the same repo; found %s and %s", repo, commit.Repo.Name)
+			}
+		}
+		if repo != toCommit.Repo.Name {
+			return fmt.Errorf("you can only squash commits into the same repo; found %s and %s", repo, toCommit.Repo.Name)
+		}
+
+		// The union of the input commits' provenance is the provenance of
+		// the merged commit.
+		commitsToMerge, err := d.getCommitsToMerge(tx, fromCommits, toCommit)
+		if err != nil {
+			return err
+		}
+		var provenanceUnion []*persist.ProvenanceCommit
+		seen := make(map[persist.ProvenanceCommit]bool)
+		for _, commit := range commitsToMerge {
+			for _, p := range commit.Provenance {
+				if !seen[*p] {
+					seen[*p] = true
+					provenanceUnion = append(provenanceUnion, p)
+				}
+			}
+		}
+		rawToCommit.Provenance = provenanceUnion
+		if err := d.putCommit(tx, rawToCommit); err != nil {
+			return err
+		}
+
+		diffs, err := d.getDiffsToMerge(tx, fromCommits, toCommit)
+		if err != nil {
+			return err
+		}
+		for _, diff := range diffs {
+			// The diffs are not supposed to be mutated anymore, so
+			// the ID doesn't need to be a hash of (repo+commit+path).
+			diff.ID = uuid.NewWithoutDashes()
+			diff.Clock = rawToCommit.FullClock
+			if err := d.putDiff(tx, diff); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (d *boltDriver) ReplayCommit(fromCommits []*pfs.Commit, toBranch string) ([]*pfs.Commit, error) {
+	if len(fromCommits) == 0 || toBranch == "" {
+		return nil, fmt.Errorf("Invalid arguments: fromCommits: %v; toBranch: %v", fromCommits, toBranch)
+	}
+
+	// Make sure that all fromCommits are from the same repo
+	var repo string
+	for _, commit := range fromCommits {
+		if repo == "" {
+			repo = commit.Repo.Name
+		} else if repo != commit.Repo.Name {
+			return nil, fmt.Errorf("you can only replay commits from the same repo; found %s and %s", repo, commit.Repo.Name)
+		}
+	}
+	to := &pfs.Commit{
+		Repo: &pfs.Repo{Name: repo},
+		ID:   toBranch,
+	}
+
+	var commits []*persist.Commit
+	if err := d.db.View(func(tx *bolt.Tx) error {
+		var err error
+		commits, err = d.getCommitsToMerge(tx, fromCommits, to)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	var retCommits []*pfs.Commit
+	for _, rawCommit := range commits {
+		// Copy each commit and their diffs
+		newCommit, err := d.StartCommit(to, nil)
+		if err != nil {
+			return nil, err
+		}
+		if err := d.update(func(tx *bolt.Tx) error {
+			newRawCommit, err := d.getRawCommit(tx, newCommit)
+			if err != nil {
+				return err
+			}
+			var diffs []*persist.Diff
+			oldClock := persist.FullClockHead(rawCommit.FullClock)
+			if err := d.forEachDiffInRange(tx, repo, &persist.ClockRange{
+				Branch: oldClock.Branch,
+				Left:   oldClock.Clock,
+				Right:  oldClock.Clock,
+			}, func(diff *persist.Diff) error {
+				diffs = append(diffs, diff)
+				return nil
+			}); err != nil {
+				return err
+			}
+			// TODO: conflict detection
+			for _, diff := range diffs {
+				diff.ID = uuid.NewWithoutDashes()
+				diff.Clock = newRawCommit.FullClock
+				if err := d.putDiff(tx, diff); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		if err := d.FinishCommit(newCommit, false); err != nil {
+			return nil, err
+		}
+		retCommits = append(retCommits, newCommit)
+	}
+
+	return retCommits, nil
+}
+
+func (d *boltDriver) PullCommit(commit *pfs.Commit) (replica *pfs.CommitReplica, retErr error) {
+	retErr = d.db.View(func(tx *bolt.Tx) error {
+		rawCommit, err := d.getRawCommit(tx, commit)
+		if err != nil {
+			return err
+		}
+		if rawCommit.Finished == nil {
+			return fmt.Errorf("commit %s/%s has not finished, only finished commits can be pulled", commit.Repo.Name, commit.ID)
+		}
+		var diffs []*persist.Diff
+		clock := persist.FullClockHead(rawCommit.FullClock)
+		if err := d.forEachDiffInRange(tx, rawCommit.Repo, &persist.ClockRange{
+			Branch: clock.Branch,
+			Left:   clock.Clock,
+			Right:  clock.Clock,
+		}, func(diff *persist.Diff) error {
+			diffs = append(diffs, diff)
+			return nil
+		}); err != nil {
+			return err
+		}
+		replica = newCommitReplica(rawCommit, diffs)
+		return nil
+	})
+	return replica, retErr
+}
+
+func (d *boltDriver) PushCommit(replica *pfs.CommitReplica) error {
+	commitInfo := replica.CommitInfo
+	if err := checkBlocks(d.blockClient, replica.FileDiffs); err != nil {
+		return err
+	}
+	return d.update(func(tx *bolt.Tx) error {
+		var parentFullClock persist.FullClock
+		if commitInfo.ParentCommit != nil {
+			parentCommit, err := d.getRawCommit(tx, commitInfo.ParentCommit)
+			if err != nil {
+				return err
+			}
+			parentFullClock = parentCommit.FullClock
+		}
+		for _, c := range commitInfo.Provenance {
+			if _, err := d.getRawCommit(tx, c); err != nil {
+				return err
+			}
+		}
+		commit, err := newReplicaCommit(commitInfo, parentFullClock)
+		if err != nil {
+			return err
+		}
+		if err := d.insertCommit(tx, commit); err != nil {
+			return err
+		}
+		for _, diff := range newReplicaDiffs(commit, replica.FileDiffs) {
+			if err := d.putDiff(tx, diff); err != nil {
+				return err
+			}
+		}
+
+		rawRepo, err := d.inspectRepo(tx, commitInfo.Commit.Repo)
+		if err != nil {
+			return err
+		}
+		rawRepo.Size += commit.Size
+		return putBoltMessage(tx.Bucket(boltRepoBucket), []byte(rawRepo.Name), rawRepo)
+	})
+}
+
+func (d *boltDriver) DeleteAll() error {
+	return d.update(func(tx *bolt.Tx) error {
+		for _, bucket := range boltBuckets {
+			if err := tx.DeleteBucket(bucket); err != nil && err != bolt.ErrBucketNotFound {
+				return err
+			}
+		}
+		return createBoltBuckets(tx)
+	})
+}
+
+func (d *boltDriver) ArchiveAll() error {
+	return d.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltCommitBucket).ForEach(func(repo, _ []byte) error {
+			var commits []*persist.Commit
+			if err := d.forEachCommit(tx, string(repo), func(commit *persist.Commit) error {
+				commit.Archived = true
+				commits = append(commits, commit)
+				return nil
+			}); err != nil {
+				return err
+			}
+			for _, commit := range commits {
+				if err := d.putCommit(tx, commit); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
+
+func (d *boltDriver) Dump() {
+}
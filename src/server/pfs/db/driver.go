@@ -1,6 +1,7 @@
 package persist
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
@@ -15,6 +16,8 @@ import (
 
 	"github.com/sjezewski/pachyderm/src/client"
 	"github.com/sjezewski/pachyderm/src/client/pfs"
+	"github.com/sjezewski/pachyderm/src/client/pkg/grpcutil"
+	"github.com/sjezewski/pachyderm/src/client/pkg/uuid"
 	pfsserver "github.com/sjezewski/pachyderm/src/server/pfs"
 	"github.com/sjezewski/pachyderm/src/server/pfs/db/persist"
 	"github.com/sjezewski/pachyderm/src/server/pfs/drive"
@@ -23,6 +26,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"go.pedge.io/pb/go/google/protobuf"
 	"go.pedge.io/proto/time"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 )
 
@@ -36,6 +40,7 @@ const (
 	repoTable   Table = "Repos"
 	diffTable   Table = "Diffs"
 	commitTable Table = "Commits"
+	uploadTable Table = "Uploads"
 
 	connectTimeoutSeconds = 5
 	maxIdle               = 5
@@ -52,6 +57,7 @@ var (
 		repoTable,
 		commitTable,
 		diffTable,
+		uploadTable,
 	}
 
 	tableToTableCreateOpts = map[Table][]gorethink.TableCreateOpts{
@@ -70,6 +76,11 @@ var (
 				PrimaryKey: "ID",
 			},
 		},
+		uploadTable: []gorethink.TableCreateOpts{
+			gorethink.TableCreateOpts{
+				PrimaryKey: "ID",
+			},
+		},
 	}
 )
 
@@ -77,11 +88,16 @@ type driver struct {
 	blockClient pfs.BlockAPIClient
 	dbName      string
 	dbClient    *gorethink.Session
+	readAhead   *readAhead
 }
 
 // NewDriver is used to create a new Driver instance
 func NewDriver(blockAddress string, dbAddress string, dbName string) (drive.Driver, error) {
-	clientConn, err := grpc.Dial(blockAddress, grpc.WithInsecure())
+	dialOption, err := grpcutil.DialOptionFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	clientConn, err := grpc.Dial(blockAddress, dialOption)
 	if err != nil {
 		return nil, err
 	}
@@ -91,10 +107,16 @@ func NewDriver(blockAddress string, dbAddress string, dbName string) (drive.Driv
 		return nil, err
 	}
 
+	readAhead, err := readAheadFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
 	return &driver{
 		blockClient: pfs.NewBlockAPIClient(clientConn),
 		dbName:      dbName,
 		dbClient:    dbClient,
+		readAhead:   readAhead,
 	}, nil
 }
 
@@ -376,6 +398,13 @@ func (d *driver) DeleteRepo(repo *pfs.Repo, force bool) error {
 	_, err = d.getTerm(diffTable).Filter(map[string]interface{}{
 		"Repo": repo.Name,
 	}).Delete().RunWrite(d.dbClient)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.getTerm(uploadTable).Filter(map[string]interface{}{
+		"Repo": repo.Name,
+	}).Delete().RunWrite(d.dbClient)
 	return err
 }
 
@@ -694,7 +723,15 @@ func (d *driver) FinishCommit(commit *pfs.Commit, cancel bool) error {
 	rawCommit.Finished = now()
 	rawCommit.Cancelled = parentCancelled || cancel
 	_, err = d.getTerm(commitTable).Get(rawCommit.ID).Update(rawCommit).RunWrite(d.dbClient)
+	if err != nil {
+		return err
+	}
 
+	// Uploads to the commit can't be completed anymore.
+	_, err = d.getTerm(uploadTable).Filter(map[string]interface{}{
+		"Repo":     rawCommit.Repo,
+		"CommitID": persist.FullClockHead(rawCommit.FullClock).ReadableCommitID(),
+	}).Delete().RunWrite(d.dbClient)
 	return err
 }
 
@@ -737,7 +774,7 @@ func (d *driver) InspectCommit(commit *pfs.Commit) (*pfs.CommitInfo, error) {
 		return nil, err
 	}
 
-	commitInfo := d.rawCommitToCommitInfo(rawCommit)
+	commitInfo := rawCommitToCommitInfo(rawCommit)
 	if commitInfo.Finished == nil {
 		commitInfo.SizeBytes, err = d.computeCommitSize(rawCommit)
 		if err != nil {
@@ -748,7 +785,7 @@ func (d *driver) InspectCommit(commit *pfs.Commit) (*pfs.CommitInfo, error) {
 	return commitInfo, nil
 }
 
-func (d *driver) rawCommitToCommitInfo(rawCommit *persist.Commit) *pfs.CommitInfo {
+func rawCommitToCommitInfo(rawCommit *persist.Commit) *pfs.CommitInfo {
 	commitType := pfs.CommitType_COMMIT_TYPE_READ
 	var branch string
 	if len(rawCommit.FullClock) > 0 {
@@ -891,7 +928,7 @@ func (d *driver) ListCommit(fromCommits []*pfs.Commit, provenance []*pfs.Commit,
 	var commitInfos []*pfs.CommitInfo
 	if len(commits) > 0 {
 		for _, commit := range commits {
-			commitInfos = append(commitInfos, d.rawCommitToCommitInfo(commit))
+			commitInfos = append(commitInfos, rawCommitToCommitInfo(commit))
 		}
 	} else if block {
 		query = query.Changes(gorethink.ChangesOpts{
@@ -906,7 +943,7 @@ func (d *driver) ListCommit(fromCommits []*pfs.Commit, provenance []*pfs.Commit,
 		if err := cursor.Err(); err != nil {
 			return nil, err
 		}
-		commitInfos = append(commitInfos, d.rawCommitToCommitInfo(&commit))
+		commitInfos = append(commitInfos, rawCommitToCommitInfo(&commit))
 	}
 
 	return commitInfos, nil
@@ -952,7 +989,7 @@ func (d *driver) FlushCommit(fromCommits []*pfs.Commit, toRepos []*pfs.Repo) ([]
 		if err != nil {
 			return nil, err
 		}
-		result = append(result, d.rawCommitToCommitInfo(rawCommit))
+		result = append(result, rawCommitToCommitInfo(rawCommit))
 		provenanceIDs = append(provenanceIDs, &persist.ProvenanceCommit{
 			Repo: commit.Repo.Name,
 			// We can't just use commit.ID directly because it might be a
@@ -995,7 +1032,7 @@ func (d *driver) FlushCommit(fromCommits []*pfs.Commit, toRepos []*pfs.Repo) ([]
 		if commit.Cancelled {
 			return nil, fmt.Errorf("commit %s/%s was cancelled", commit.Repo, commit.ID)
 		}
-		result = append(result, d.rawCommitToCommitInfo(commit))
+		result = append(result, rawCommitToCommitInfo(commit))
 		delete(repoSet, commit.Repo)
 	}
 	return result, nil
@@ -1077,7 +1114,7 @@ func checkPath(path string) error {
 	return nil
 }
 
-func (d *driver) PutFile(file *pfs.File, delimiter pfs.Delimiter, reader io.Reader) (retErr error) {
+func (d *driver) PutFile(ctx context.Context, file *pfs.File, delimiter pfs.Delimiter, reader io.Reader) (retErr error) {
 	fixPath(file)
 	if err := checkPath(file.Path); err != nil {
 		return err
@@ -1092,15 +1129,59 @@ func (d *driver) PutFile(file *pfs.File, delimiter pfs.Delimiter, reader io.Read
 	if commit.Finished != nil {
 		return pfsserver.NewErrCommitFinished(commit.Repo, commit.ID)
 	}
-	_client := client.APIClient{BlockAPIClient: d.blockClient}
+	_client := client.APIClient{BlockAPIClient: d.blockClient}.WithCtx(ctx)
 	blockrefs, err := _client.PutBlock(delimiter, reader)
 	if err != nil {
 		return err
 	}
+	refs, size := persistBlockRefs(blockrefs.BlockRef)
+	return d.putBlockRefs(file, commit, delimiter, refs, size)
+}
+
+// putBlockRefs appends refs to a file in commit, creating the file and its
+// ancestor directories if they don't exist.
+func (d *driver) putBlockRefs(file *pfs.File, commit *persist.Commit, delimiter pfs.Delimiter, refs []*persist.BlockRef, size uint64) error {
+	diffs := putFileDiffs(commit, file.Path, delimiter, refs, size)
+
+	// Make sure that there's no type conflict
+	for _, diff := range diffs {
+		if err := d.checkFileType(file.Commit.Repo.Name, file.Commit.ID, diff.Path, diff.FileType); err != nil {
+			return err
+		}
+	}
+
+	// Actually, we don't know if Rethink actually inserts these documents in
+	// order.  If it doesn't, then we might end up with "/foo/bar" but not
+	// "/foo", which is kinda problematic.
+	_, err := d.getTerm(diffTable).Insert(diffs, gorethink.InsertOpts{
+		Conflict: func(id gorethink.Term, oldDoc gorethink.Term, newDoc gorethink.Term) gorethink.Term {
+			return gorethink.Branch(
+				// We throw an error if the new diff is of a different file type
+				// than the old diff, unless the old diff is NONE
+				oldDoc.Field("FileType").Ne(persist.FileType_NONE).And(oldDoc.Field("FileType").Ne(newDoc.Field("FileType"))),
+				gorethink.Error(ErrConflictFileTypeMsg),
+				oldDoc.Merge(map[string]interface{}{
+					"BlockRefs": oldDoc.Field("BlockRefs").Add(newDoc.Field("BlockRefs")),
+					"Size":      oldDoc.Field("Size").Add(newDoc.Field("Size")),
+					// Overwrite the file type in case the old file type is NONE
+					"FileType": newDoc.Field("FileType"),
+					// Update modification time
+					"Modified": newDoc.Field("Modified"),
+					// Delimiter_NONE is omitted from the new diff
+					"Delimiter": newDoc.Field("Delimiter").Default(0),
+				}),
+			)
+		},
+	}).RunWrite(d.dbClient)
+	return err
+}
 
+// persistBlockRefs converts block refs returned by PutBlock, it also returns
+// their total size.
+func persistBlockRefs(blockRefs []*pfs.BlockRef) ([]*persist.BlockRef, uint64) {
 	var refs []*persist.BlockRef
 	var size uint64
-	for _, blockref := range blockrefs.BlockRef {
+	for _, blockref := range blockRefs {
 		ref := &persist.BlockRef{
 			Hash:  blockref.Block.Hash,
 			Upper: blockref.Range.Upper,
@@ -1109,10 +1190,15 @@ func (d *driver) PutFile(file *pfs.File, delimiter pfs.Delimiter, reader io.Read
 		refs = append(refs, ref)
 		size += ref.Size()
 	}
+	return refs, size
+}
 
+// putFileDiffs returns the diffs that append refs to the file at path: one
+// for each ancestor directory and one for the file itself.
+func putFileDiffs(commit *persist.Commit, path string, delimiter pfs.Delimiter, refs []*persist.BlockRef, size uint64) []*persist.Diff {
 	var diffs []*persist.Diff
 	// the ancestor directories
-	for _, prefix := range getPrefixes(file.Path) {
+	for _, prefix := range getPrefixes(path) {
 		diffs = append(diffs, &persist.Diff{
 			ID:       getDiffID(commit.Repo, commit.ID, prefix),
 			Repo:     commit.Repo,
@@ -1126,48 +1212,199 @@ func (d *driver) PutFile(file *pfs.File, delimiter pfs.Delimiter, reader io.Read
 
 	// the file itself
 	diffs = append(diffs, &persist.Diff{
-		ID:        getDiffID(commit.Repo, commit.ID, file.Path),
+		ID:        getDiffID(commit.Repo, commit.ID, path),
 		Repo:      commit.Repo,
 		Delete:    false,
-		Path:      file.Path,
+		Path:      path,
 		BlockRefs: refs,
 		Size:      size,
 		Clock:     commit.FullClock,
 		FileType:  persist.FileType_FILE,
 		Modified:  now(),
+		Delimiter: int32(delimiter),
 	})
+	return diffs
+}
 
-	// Make sure that there's no type conflict
-	for _, diff := range diffs {
-		if err := d.checkFileType(file.Commit.Repo.Name, file.Commit.ID, diff.Path, diff.FileType); err != nil {
-			return err
-		}
+func (d *driver) BeginUpload(file *pfs.File, delimiter pfs.Delimiter) (*pfs.Upload, error) {
+	fixPath(file)
+	if err := checkPath(file.Path); err != nil {
+		return nil, err
+	}
+	commit, err := d.getRawCommit(file.Commit)
+	if err != nil {
+		return nil, err
+	}
+	if commit.Finished != nil {
+		return nil, pfsserver.NewErrCommitFinished(commit.Repo, commit.ID)
+	}
+	upload := newRawUpload(file, commit, delimiter)
+	if err := d.insertMessage(uploadTable, upload); err != nil {
+		return nil, err
 	}
+	return &pfs.Upload{ID: upload.ID}, nil
+}
 
-	// Actually, we don't know if Rethink actually inserts these documents in
-	// order.  If it doesn't, then we might end up with "/foo/bar" but not
-	// "/foo", which is kinda problematic.
-	_, err = d.getTerm(diffTable).Insert(diffs, gorethink.InsertOpts{
-		Conflict: func(id gorethink.Term, oldDoc gorethink.Term, newDoc gorethink.Term) gorethink.Term {
-			return gorethink.Branch(
-				// We throw an error if the new diff is of a different file type
-				// than the old diff, unless the old diff is NONE
-				oldDoc.Field("FileType").Ne(persist.FileType_NONE).And(oldDoc.Field("FileType").Ne(newDoc.Field("FileType"))),
-				gorethink.Error(ErrConflictFileTypeMsg),
-				oldDoc.Merge(map[string]interface{}{
-					"BlockRefs": oldDoc.Field("BlockRefs").Add(newDoc.Field("BlockRefs")),
-					"Size":      oldDoc.Field("Size").Add(newDoc.Field("Size")),
-					// Overwrite the file type in case the old file type is NONE
-					"FileType": newDoc.Field("FileType"),
-					// Update modification time
-					"Modified": newDoc.Field("Modified"),
-				}),
-			)
-		},
+func (d *driver) UploadPart(ctx context.Context, upload *pfs.Upload, offset uint64, reader io.Reader) error {
+	rawUpload, err := d.getRawUpload(upload)
+	if err != nil {
+		return err
+	}
+	_client := client.APIClient{BlockAPIClient: d.blockClient}.WithCtx(ctx)
+	blockrefs, err := _client.PutBlock(pfs.Delimiter(rawUpload.Delimiter), reader)
+	if err != nil {
+		return err
+	}
+	refs, size := persistBlockRefs(blockrefs.BlockRef)
+	part := &persist.UploadPart{
+		Offset:    offset,
+		Size:      size,
+		BlockRefs: refs,
+	}
+	// Replace the part at the same offset, if there is one, in a single
+	// update so that concurrent parts don't clobber each other.
+	_, err = d.getTerm(uploadTable).Get(upload.ID).Update(func(doc gorethink.Term) interface{} {
+		return map[string]interface{}{
+			"Parts": doc.Field("Parts").Default([]interface{}{}).Filter(func(p gorethink.Term) gorethink.Term {
+				return p.Field("Offset").Ne(offset)
+			}).Append(part),
+		}
 	}).RunWrite(d.dbClient)
 	return err
 }
 
+func (d *driver) InspectUpload(upload *pfs.Upload) (*pfs.UploadInfo, error) {
+	rawUpload, err := d.getRawUpload(upload)
+	if err != nil {
+		return nil, err
+	}
+	return rawUploadToUploadInfo(rawUpload), nil
+}
+
+func (d *driver) CompleteUpload(upload *pfs.Upload, sizeBytes uint64) error {
+	rawUpload, err := d.getRawUpload(upload)
+	if err != nil {
+		return err
+	}
+	file := client.NewFile(rawUpload.Repo, rawUpload.CommitID, rawUpload.Path)
+	commit, err := d.getRawCommit(file.Commit)
+	if err != nil {
+		return err
+	}
+	if commit.Finished != nil {
+		return pfsserver.NewErrCommitFinished(commit.Repo, commit.ID)
+	}
+	refs, err := uploadBlockRefs(rawUpload, sizeBytes)
+	if err != nil {
+		return err
+	}
+	if err := d.putBlockRefs(file, commit, pfs.Delimiter(rawUpload.Delimiter), refs, sizeBytes); err != nil {
+		return err
+	}
+	return d.deleteMessageByPrimaryKey(uploadTable, upload.ID)
+}
+
+func (d *driver) getRawUpload(upload *pfs.Upload) (*persist.Upload, error) {
+	if upload == nil {
+		return nil, fmt.Errorf("upload cannot be nil")
+	}
+	rawUpload := &persist.Upload{}
+	cursor, err := d.getTerm(uploadTable).Get(upload.ID).Run(d.dbClient)
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.One(rawUpload); err != nil {
+		if err == gorethink.ErrEmptyResult {
+			return nil, pfsserver.NewErrUploadNotFound(upload.ID)
+		}
+		return nil, err
+	}
+	return rawUpload, nil
+}
+
+func newRawUpload(file *pfs.File, commit *persist.Commit, delimiter pfs.Delimiter) *persist.Upload {
+	return &persist.Upload{
+		ID:   uuid.NewWithoutDashes(),
+		Repo: commit.Repo,
+		// file.Commit.ID may be a branch, the upload should go to the
+		// commit that was its head when the upload began.
+		CommitID:  persist.FullClockHead(commit.FullClock).ReadableCommitID(),
+		Path:      file.Path,
+		Delimiter: int32(delimiter),
+		Started:   now(),
+	}
+}
+
+func rawUploadToUploadInfo(rawUpload *persist.Upload) *pfs.UploadInfo {
+	uploadInfo := &pfs.UploadInfo{
+		Upload:    &pfs.Upload{ID: rawUpload.ID},
+		File:      client.NewFile(rawUpload.Repo, rawUpload.CommitID, rawUpload.Path),
+		Delimiter: pfs.Delimiter(rawUpload.Delimiter),
+		Started:   rawUpload.Started,
+	}
+	for _, part := range sortedUploadParts(rawUpload) {
+		var blockRefs []*pfs.BlockRef
+		for _, ref := range part.BlockRefs {
+			blockRefs = append(blockRefs, &pfs.BlockRef{
+				Block: client.NewBlock(ref.Hash),
+				Range: &pfs.ByteRange{Lower: ref.Lower, Upper: ref.Upper},
+			})
+		}
+		uploadInfo.Parts = append(uploadInfo.Parts, &pfs.UploadPart{
+			OffsetBytes: part.Offset,
+			SizeBytes:   part.Size,
+			BlockRefs:   blockRefs,
+		})
+	}
+	return uploadInfo
+}
+
+// setUploadPart adds part to an upload, replacing the part at the same
+// offset if there is one.
+func setUploadPart(rawUpload *persist.Upload, part *persist.UploadPart) {
+	for i, oldPart := range rawUpload.Parts {
+		if oldPart.Offset == part.Offset {
+			rawUpload.Parts[i] = part
+			return
+		}
+	}
+	rawUpload.Parts = append(rawUpload.Parts, part)
+}
+
+func sortedUploadParts(rawUpload *persist.Upload) []*persist.UploadPart {
+	parts := make([]*persist.UploadPart, len(rawUpload.Parts))
+	copy(parts, rawUpload.Parts)
+	sort.Sort(uploadPartsByOffset(parts))
+	return parts
+}
+
+type uploadPartsByOffset []*persist.UploadPart
+
+func (p uploadPartsByOffset) Len() int           { return len(p) }
+func (p uploadPartsByOffset) Less(i, j int) bool { return p[i].Offset < p[j].Offset }
+func (p uploadPartsByOffset) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+
+// uploadBlockRefs returns the block refs of an upload's parts in order.  The
+// parts must cover exactly the first sizeBytes of the file.
+func uploadBlockRefs(rawUpload *persist.Upload, sizeBytes uint64) ([]*persist.BlockRef, error) {
+	var refs []*persist.BlockRef
+	var offset uint64
+	for _, part := range sortedUploadParts(rawUpload) {
+		if part.Offset > offset {
+			return nil, fmt.Errorf("upload %s is missing bytes %d to %d", rawUpload.ID, offset, part.Offset)
+		}
+		if part.Offset < offset {
+			return nil, fmt.Errorf("upload %s has overlapping parts at offset %d", rawUpload.ID, part.Offset)
+		}
+		refs = append(refs, part.BlockRefs...)
+		offset += part.Size
+	}
+	if offset != sizeBytes {
+		return nil, fmt.Errorf("upload %s has %d bytes, expected %d", rawUpload.ID, offset, sizeBytes)
+	}
+	return refs, nil
+}
+
 func now() *google_protobuf.Timestamp {
 	return prototime.TimeToTimestamp(time.Now())
 }
@@ -1236,7 +1473,7 @@ func fixPath(file *pfs.File) {
 	}
 }
 
-func (d *driver) GetFile(file *pfs.File, filterShard *pfs.Shard, offset int64,
+func (d *driver) GetFile(ctx context.Context, file *pfs.File, filterShard *pfs.Shard, offset int64,
 	size int64, diffMethod *pfs.DiffMethod) (io.ReadCloser, error) {
 	fixPath(file)
 	diff, err := d.inspectFile(file, filterShard, diffMethod)
@@ -1246,27 +1483,67 @@ func (d *driver) GetFile(file *pfs.File, filterShard *pfs.Shard, offset int64,
 	if diff.FileType == persist.FileType_DIR {
 		return nil, fmt.Errorf("file %s/%s/%s is directory", file.Commit.Repo.Name, file.Commit.ID, file.Path)
 	}
-	return d.newFileReader(diff.BlockRefs, file, offset, size), nil
+	return newFileReader(ctx, d.blockClient, d.readAhead, diff.BlockRefs, file, offset, size), nil
 }
 
 type fileReader struct {
+	ctx         context.Context
 	blockClient pfs.BlockAPIClient
 	reader      io.Reader
-	offset      int64
 	size        int64 // how much data to read
 	sizeRead    int64 // how much data has been read
-	blockRefs   []*persist.BlockRef
+	ranges      []*blockRange
 	file        *pfs.File
-}
-
-func (d *driver) newFileReader(blockRefs []*persist.BlockRef, file *pfs.File, offset int64, size int64) *fileReader {
+	readAhead   *readAhead
+	// current is the prefetched block that reader reads from, if any.
+	current *prefetch
+	// prefetches are the blocks after the current one which are being
+	// fetched, in order.
+	prefetches []*prefetch
+}
+
+// newFileReader creates a reader of size bytes, starting at offset, of the
+// file made up of blockRefs.  A size of 0 reads the rest of the file.
+func newFileReader(ctx context.Context, blockClient pfs.BlockAPIClient, readAhead *readAhead, blockRefs []*persist.BlockRef, file *pfs.File, offset int64, size int64) *fileReader {
+	var ranges []*blockRange
+	var sizePlanned int64
+	for _, blockRef := range blockRefs {
+		if size > 0 && sizePlanned >= size {
+			break
+		}
+		blockSize := int64(blockRef.Size())
+		if offset >= blockSize {
+			offset -= blockSize
+			continue
+		}
+		blockRange := &blockRange{
+			hash:   blockRef.Hash,
+			offset: offset,
+			size:   blockSize - offset,
+		}
+		if size > 0 && blockRange.size > size-sizePlanned {
+			blockRange.size = size - sizePlanned
+		}
+		offset = 0
+		sizePlanned += blockRange.size
+		ranges = append(ranges, blockRange)
+	}
 	return &fileReader{
-		blockClient: d.blockClient,
-		blockRefs:   blockRefs,
-		offset:      offset,
+		ctx:         ctx,
+		blockClient: blockClient,
 		size:        size,
+		ranges:      ranges,
 		file:        file,
+		readAhead:   readAhead,
+	}
+}
+
+// diffInShard checks if the file of diff belongs in a given shard.
+func diffInShard(diff *persist.Diff, filterShard *pfs.Shard, file *pfs.File) bool {
+	if diff.FileType == persist.FileType_DIR {
+		return pfsserver.DirectoryInShard(filterShard, file)
 	}
+	return pfsserver.FileInShard(filterShard, file)
 }
 
 // filterBlocks filters out blockrefs for a given diff, or return a FileNotFound
@@ -1303,33 +1580,27 @@ func filterBlocks(diff *persist.Diff, filterShard *pfs.Shard, file *pfs.File) (*
 }
 
 func (r *fileReader) Read(data []byte) (int, error) {
-	var err error
 	if r.reader == nil {
-		var blockRef *persist.BlockRef
-		for {
-			if len(r.blockRefs) == 0 {
+		if len(r.prefetches) > 0 {
+			r.current = r.prefetches[0]
+			r.prefetches = r.prefetches[1:]
+			<-r.current.done
+			if r.current.err != nil {
+				return 0, r.current.err
+			}
+			r.reader = bytes.NewReader(r.current.data)
+		} else {
+			if len(r.ranges) == 0 {
 				return 0, io.EOF
 			}
-			blockRef = r.blockRefs[0]
-			r.blockRefs = r.blockRefs[1:]
-			blockSize := int64(blockRef.Size())
-			if r.offset >= blockSize {
-				r.offset -= blockSize
-				continue
+			var err error
+			r.reader, err = r.ranges[0].reader(r.ctx, r.blockClient)
+			if err != nil {
+				return 0, err
 			}
-			break
-		}
-		client := client.APIClient{BlockAPIClient: r.blockClient}
-		sizeLeft := r.size
-		// e.g. sometimes a reader is constructed of size 0
-		if sizeLeft != 0 {
-			sizeLeft -= r.sizeRead
-		}
-		r.reader, err = client.GetBlock(blockRef.Hash, uint64(r.offset), uint64(sizeLeft))
-		if err != nil {
-			return 0, err
+			r.ranges = r.ranges[1:]
 		}
-		r.offset = 0
+		r.prefetch()
 	}
 	size, err := r.reader.Read(data)
 	if err != nil && err != io.EOF {
@@ -1337,6 +1608,8 @@ func (r *fileReader) Read(data []byte) (int, error) {
 	}
 	if err == io.EOF {
 		r.reader = nil
+		r.readAhead.release(r.current)
+		r.current = nil
 	}
 	r.sizeRead += int64(size)
 	if r.sizeRead == r.size {
@@ -1348,7 +1621,30 @@ func (r *fileReader) Read(data []byte) (int, error) {
 	return size, nil
 }
 
+// prefetch starts fetching the blocks after the current one, as far as the
+// read ahead allows.
+func (r *fileReader) prefetch() {
+	for len(r.ranges) > 0 && len(r.prefetches) < r.readAhead.blocks() {
+		if !r.readAhead.acquire(r.ranges[0].size) {
+			return
+		}
+		r.prefetches = append(r.prefetches, r.ranges[0].fetch(r.ctx, r.blockClient))
+		r.ranges = r.ranges[1:]
+	}
+}
+
 func (r *fileReader) Close() error {
+	r.readAhead.release(r.current)
+	r.current = nil
+	// Blocks which are still being fetched release their memory once
+	// they're done.
+	for _, p := range r.prefetches {
+		go func(p *prefetch) {
+			<-p.done
+			r.readAhead.release(p)
+		}(p)
+	}
+	r.prefetches = nil
 	return nil
 }
 
@@ -1373,6 +1669,7 @@ func (d *driver) InspectFile(file *pfs.File, filterShard *pfs.Shard, diffMethod
 			ID:   persist.FullClockHead(diff.Clock).ReadableCommitID(),
 		}
 		res.SizeBytes = diff.Size
+		res.Delimiter = pfs.Delimiter(diff.Delimiter)
 	case persist.FileType_DIR:
 		res.FileType = pfs.FileType_FILE_TYPE_DIR
 		res.Modified = diff.Modified
@@ -1657,6 +1954,202 @@ func (d *driver) ReplayCommit(fromCommits []*pfs.Commit, toBranch string) ([]*pf
 	return retCommits, nil
 }
 
+func (d *driver) PullCommit(commit *pfs.Commit) (*pfs.CommitReplica, error) {
+	rawCommit, err := d.getRawCommit(commit)
+	if err != nil {
+		return nil, err
+	}
+	if rawCommit.Finished == nil {
+		return nil, fmt.Errorf("commit %s/%s has not finished, only finished commits can be pulled", commit.Repo.Name, commit.ID)
+	}
+
+	clock := persist.FullClockHead(rawCommit.FullClock)
+	cursor, err := d.getTerm(diffTable).GetAllByIndex(DiffClockIndex.Name, diffClockIndexKey(rawCommit.Repo, clock.Branch, clock.Clock)).Run(d.dbClient)
+	if err != nil {
+		return nil, err
+	}
+	var diffs []*persist.Diff
+	if err := cursor.All(&diffs); err != nil {
+		return nil, err
+	}
+	return newCommitReplica(rawCommit, diffs), nil
+}
+
+func (d *driver) PushCommit(replica *pfs.CommitReplica) error {
+	commitInfo := replica.CommitInfo
+	if err := checkBlocks(d.blockClient, replica.FileDiffs); err != nil {
+		return err
+	}
+
+	var parentFullClock persist.FullClock
+	if commitInfo.ParentCommit != nil {
+		parentCommit, err := d.getRawCommit(commitInfo.ParentCommit)
+		if err != nil {
+			return err
+		}
+		parentFullClock = parentCommit.FullClock
+	}
+	for _, c := range commitInfo.Provenance {
+		if _, err := d.getRawCommit(c); err != nil {
+			return err
+		}
+	}
+	commit, err := newReplicaCommit(commitInfo, parentFullClock)
+	if err != nil {
+		return err
+	}
+
+	// As with StartCommit, PutFile and FinishCommit, a failure part way
+	// through leaves the commit without some of its diffs.
+	if err := d.insertMessage(commitTable, commit); err != nil {
+		if gorethink.IsConflictErr(err) {
+			return pfsserver.NewErrCommitExists(commit.Repo, commitInfo.Commit.ID)
+		}
+		return err
+	}
+	if diffs := newReplicaDiffs(commit, replica.FileDiffs); len(diffs) > 0 {
+		if _, err := d.getTerm(diffTable).Insert(diffs).RunWrite(d.dbClient); err != nil {
+			return err
+		}
+	}
+	_, err = d.getTerm(repoTable).Get(commit.Repo).Update(map[string]interface{}{
+		"Size": gorethink.Row.Field("Size").Add(commit.Size),
+	}).RunWrite(d.dbClient)
+	return err
+}
+
+// newCommitReplica returns the replica of a commit with the given diffs.
+func newCommitReplica(rawCommit *persist.Commit, diffs []*persist.Diff) *pfs.CommitReplica {
+	replica := &pfs.CommitReplica{
+		CommitInfo: rawCommitToCommitInfo(rawCommit),
+	}
+	for _, diff := range diffs {
+		fileDiff := &pfs.FileDiff{
+			Path:      diff.Path,
+			Delete:    diff.Delete,
+			Modified:  diff.Modified,
+			Delimiter: pfs.Delimiter(diff.Delimiter),
+		}
+		switch diff.FileType {
+		case persist.FileType_FILE:
+			fileDiff.FileType = pfs.FileType_FILE_TYPE_REGULAR
+		case persist.FileType_DIR:
+			fileDiff.FileType = pfs.FileType_FILE_TYPE_DIR
+		}
+		for _, blockRef := range diff.BlockRefs {
+			fileDiff.BlockRefs = append(fileDiff.BlockRefs, &pfs.BlockRef{
+				Block: &pfs.Block{Hash: blockRef.Hash},
+				Range: &pfs.ByteRange{
+					Lower: blockRef.Lower,
+					Upper: blockRef.Upper,
+				},
+			})
+		}
+		replica.FileDiffs = append(replica.FileDiffs, fileDiff)
+	}
+	return replica
+}
+
+// newReplicaCommit returns the commit that commitInfo was pulled from.
+// parentFullClock is the full clock of the commit's parent, or nil if it
+// doesn't have one.
+func newReplicaCommit(commitInfo *pfs.CommitInfo, parentFullClock persist.FullClock) (*persist.Commit, error) {
+	if commitInfo.Finished == nil {
+		return nil, fmt.Errorf("commit %s/%s has not finished, only finished commits can be pushed", commitInfo.Commit.Repo.Name, commitInfo.Commit.ID)
+	}
+	clock, err := parseClock(commitInfo.Commit.ID)
+	if err != nil {
+		return nil, err
+	}
+	// The commit's full clock is its parent's, with the head replaced if the
+	// commit is on the same branch (StartCommit) or extended if it isn't
+	// (ForkCommit).
+	fullClock := persist.FullClock{clock}
+	if parentFullClock != nil {
+		fullClock = persist.CloneFullClock(parentFullClock)
+		if head := persist.FullClockHead(fullClock); head.Branch == clock.Branch {
+			head.Clock = clock.Clock
+		} else {
+			fullClock = append(fullClock, clock)
+		}
+	}
+
+	var provenance []*persist.ProvenanceCommit
+	for _, c := range commitInfo.Provenance {
+		provenance = append(provenance, &persist.ProvenanceCommit{
+			ID:   c.ID,
+			Repo: c.Repo.Name,
+		})
+	}
+	return &persist.Commit{
+		ID:         persist.NewCommitID(commitInfo.Commit.Repo.Name, clock),
+		Repo:       commitInfo.Commit.Repo.Name,
+		FullClock:  fullClock,
+		Started:    commitInfo.Started,
+		Finished:   commitInfo.Finished,
+		Cancelled:  commitInfo.Cancelled,
+		Archived:   commitInfo.Archived,
+		Provenance: provenance,
+		Size:       commitInfo.SizeBytes,
+	}, nil
+}
+
+// newReplicaDiffs returns the diffs that fileDiffs were pulled from, as
+// diffs of commit.
+func newReplicaDiffs(commit *persist.Commit, fileDiffs []*pfs.FileDiff) []*persist.Diff {
+	var diffs []*persist.Diff
+	for _, fileDiff := range fileDiffs {
+		diff := &persist.Diff{
+			// There may be more than one diff for a path in a commit, see
+			// ReplayCommit, so we can't use getDiffID.
+			ID:        uuid.NewWithoutDashes(),
+			Repo:      commit.Repo,
+			Path:      fileDiff.Path,
+			Delete:    fileDiff.Delete,
+			Clock:     commit.FullClock,
+			Modified:  fileDiff.Modified,
+			Delimiter: int32(fileDiff.Delimiter),
+		}
+		switch fileDiff.FileType {
+		case pfs.FileType_FILE_TYPE_REGULAR:
+			diff.FileType = persist.FileType_FILE
+		case pfs.FileType_FILE_TYPE_DIR:
+			diff.FileType = persist.FileType_DIR
+		}
+		for _, blockRef := range fileDiff.BlockRefs {
+			ref := &persist.BlockRef{
+				Hash:  blockRef.Block.Hash,
+				Lower: blockRef.Range.Lower,
+				Upper: blockRef.Range.Upper,
+			}
+			diff.BlockRefs = append(diff.BlockRefs, ref)
+			diff.Size += ref.Size()
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs
+}
+
+// checkBlocks returns an error if any of the blocks that fileDiffs refer to
+// aren't in the block store.
+func checkBlocks(blockClient pfs.BlockAPIClient, fileDiffs []*pfs.FileDiff) error {
+	_client := client.APIClient{BlockAPIClient: blockClient}
+	checked := make(map[string]bool)
+	for _, fileDiff := range fileDiffs {
+		for _, blockRef := range fileDiff.BlockRefs {
+			hash := blockRef.Block.Hash
+			if checked[hash] {
+				continue
+			}
+			if _, err := _client.InspectBlock(hash); err != nil {
+				return fmt.Errorf("block %s of file %s is missing: %v", hash, fileDiff.Path, err)
+			}
+			checked[hash] = true
+		}
+	}
+	return nil
+}
+
 // foldDiffs takes an ordered stream of diffs for a given path, and return
 // a single diff that represents the aggregation of these diffs.
 func foldDiffs(diffs gorethink.Term) gorethink.Term {
@@ -1678,6 +2171,7 @@ func foldDiffs(diffs gorethink.Term) gorethink.Term {
 					"Delete":    acc.Field("Delete").Or(diff.Field("Delete")),
 					"BlockRefs": acc.Field("BlockRefs").Add(diff.Field("BlockRefs")),
 					"Size":      acc.Field("Size").Add(diff.Field("Size")),
+					"Delimiter": diff.Field("Delimiter").Default(0),
 				}),
 			),
 		)
@@ -1700,6 +2194,7 @@ func foldDiffsWithoutDelete(diffs gorethink.Term) gorethink.Term {
 				"Delete":    acc.Field("Delete").Or(diff.Field("Delete")),
 				"BlockRefs": acc.Field("BlockRefs").Add(diff.Field("BlockRefs")),
 				"Size":      acc.Field("Size").Add(diff.Field("Size")),
+				"Delimiter": diff.Field("Delimiter").Default(0),
 			}),
 		)
 	})
@@ -1892,10 +2387,6 @@ func (d *driver) getFullClock(to *pfs.Commit) (persist.FullClock, error) {
 }
 
 func (d *driver) inspectFile(file *pfs.File, filterShard *pfs.Shard, diffMethod *pfs.DiffMethod) (*persist.Diff, error) {
-	if !pfsserver.FileInShard(filterShard, file) {
-		return nil, pfsserver.NewErrFileNotFound(file.Path, file.Commit.Repo.Name, file.Commit.ID)
-	}
-
 	query, err := d.getDiffsInCommitRange(diffMethod, file.Commit, false, DiffPathIndex.Name, func(clock interface{}) interface{} {
 		return diffPathIndexKey(file.Commit.Repo.Name, file.Path, clock)
 	})
@@ -1916,6 +2407,9 @@ func (d *driver) inspectFile(file *pfs.File, filterShard *pfs.Shard, diffMethod
 		return nil, err
 	}
 
+	if !diffInShard(diff, filterShard, file) {
+		return nil, pfsserver.NewErrFileNotFound(file.Path, file.Commit.Repo.Name, file.Commit.ID)
+	}
 	return filterBlocks(diff, filterShard, file)
 }
 
@@ -1958,7 +2452,7 @@ func (d *driver) ListFile(file *pfs.File, filterShard *pfs.Shard, diffMethod *pf
 			Commit: file.Commit,
 			Path:   diff.Path,
 		}
-		if !pfsserver.FileInShard(filterShard, fileInfo.File) {
+		if !diffInShard(diff, filterShard, fileInfo.File) {
 			continue
 		}
 		diff, err := filterBlocks(diff, filterShard, fileInfo.File)
@@ -1970,6 +2464,7 @@ func (d *driver) ListFile(file *pfs.File, filterShard *pfs.Shard, diffMethod *pf
 		}
 		fileInfo.SizeBytes = diff.Size
 		fileInfo.Modified = diff.Modified
+		fileInfo.Delimiter = pfs.Delimiter(diff.Delimiter)
 		switch diff.FileType {
 		case persist.FileType_FILE:
 			fileInfo.FileType = pfs.FileType_FILE_TYPE_REGULAR
@@ -0,0 +1,61 @@
+package persist
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sjezewski/pachyderm/src/client/pfs"
+	"github.com/sjezewski/pachyderm/src/client/pkg/require"
+	"github.com/sjezewski/pachyderm/src/client/pkg/uuid"
+	"github.com/sjezewski/pachyderm/src/server/pfs/drive"
+	"github.com/sjezewski/pachyderm/src/server/pfs/drive/drivetest"
+	pfsserver "github.com/sjezewski/pachyderm/src/server/pfs/server"
+
+	"google.golang.org/grpc"
+)
+
+func TestBoltDriver(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pfs_bolt_driver")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	clientConn, err := grpc.Dial(serveBlockAPI(t, dir), grpc.WithInsecure())
+	require.NoError(t, err)
+	blockClient := pfs.NewBlockAPIClient(clientConn)
+	drivetest.RunTests(t, func() drive.Driver {
+		driver, err := NewBoltDriverWithBlockClient(blockClient, filepath.Join(dir, uuid.NewWithoutDashes()+".db"))
+		require.NoError(t, err)
+		return driver
+	})
+}
+
+func TestRethinkDriver(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping rethink driver tests in short mode")
+	}
+	dir, err := ioutil.TempDir("", "pfs_rethink_driver")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	blockAddress := serveBlockAPI(t, dir)
+	drivetest.RunTests(t, func() drive.Driver {
+		dbName := "pachyderm_test_" + uuid.NewWithoutDashes()[0:12]
+		require.NoError(t, InitDB(RethinkAddress, dbName))
+		driver, err := NewDriver(blockAddress, RethinkAddress, dbName)
+		require.NoError(t, err)
+		return driver
+	})
+}
+
+// serveBlockAPI serves a local block API from dir and returns its address.
+func serveBlockAPI(t *testing.T, dir string) string {
+	blockAPIServer, err := pfsserver.NewLocalBlockAPIServer(dir)
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	pfs.RegisterBlockAPIServer(server, blockAPIServer)
+	go server.Serve(listener)
+	return listener.Addr().String()
+}
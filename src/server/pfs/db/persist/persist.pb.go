@@ -16,6 +16,8 @@ It has these top-level messages:
 	Diff
 	Commit
 	ProvenanceCommit
+	Upload
+	UploadPart
 */
 package persist
 
@@ -125,6 +127,9 @@ type Diff struct {
 	Clock     []*Clock                   `protobuf:"bytes,7,rep,name=clock" json:"clock,omitempty"`
 	FileType  FileType                   `protobuf:"varint,8,opt,name=file_type,json=fileType,enum=FileType" json:"file_type,omitempty"`
 	Modified  *google_protobuf.Timestamp `protobuf:"bytes,9,opt,name=modified" json:"modified,omitempty"`
+	// delimiter is a pfs.Delimiter, it's the delimiter the file was last
+	// written with
+	Delimiter int32 `protobuf:"varint,10,opt,name=delimiter" json:"delimiter,omitempty"`
 }
 
 func (m *Diff) Reset()                    { *m = Diff{} }
@@ -211,6 +216,56 @@ func (m *ProvenanceCommit) String() string            { return proto.CompactText
 func (*ProvenanceCommit) ProtoMessage()               {}
 func (*ProvenanceCommit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
+// Upload is a resumable upload to a file, its parts are written to the file
+// when the upload is completed.
+type Upload struct {
+	ID       string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Repo     string `protobuf:"bytes,2,opt,name=repo" json:"repo,omitempty"`
+	CommitID string `protobuf:"bytes,3,opt,name=commit_id,json=commitId" json:"commit_id,omitempty"`
+	Path     string `protobuf:"bytes,4,opt,name=path" json:"path,omitempty"`
+	// delimiter is a pfs.Delimiter
+	Delimiter int32                      `protobuf:"varint,5,opt,name=delimiter" json:"delimiter,omitempty"`
+	Parts     []*UploadPart              `protobuf:"bytes,6,rep,name=parts" json:"parts,omitempty"`
+	Started   *google_protobuf.Timestamp `protobuf:"bytes,7,opt,name=started" json:"started,omitempty"`
+}
+
+func (m *Upload) Reset()                    { *m = Upload{} }
+func (m *Upload) String() string            { return proto.CompactTextString(m) }
+func (*Upload) ProtoMessage()               {}
+func (*Upload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *Upload) GetParts() []*UploadPart {
+	if m != nil {
+		return m.Parts
+	}
+	return nil
+}
+
+func (m *Upload) GetStarted() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Started
+	}
+	return nil
+}
+
+type UploadPart struct {
+	Offset    uint64      `protobuf:"varint,1,opt,name=offset" json:"offset,omitempty"`
+	Size      uint64      `protobuf:"varint,2,opt,name=size" json:"size,omitempty"`
+	BlockRefs []*BlockRef `protobuf:"bytes,3,rep,name=block_refs,json=blockRefs" json:"block_refs,omitempty"`
+}
+
+func (m *UploadPart) Reset()                    { *m = UploadPart{} }
+func (m *UploadPart) String() string            { return proto.CompactTextString(m) }
+func (*UploadPart) ProtoMessage()               {}
+func (*UploadPart) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *UploadPart) GetBlockRefs() []*BlockRef {
+	if m != nil {
+		return m.BlockRefs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Clock)(nil), "Clock")
 	proto.RegisterType((*ClockID)(nil), "ClockID")
@@ -219,46 +274,54 @@ func init() {
 	proto.RegisterType((*Diff)(nil), "Diff")
 	proto.RegisterType((*Commit)(nil), "Commit")
 	proto.RegisterType((*ProvenanceCommit)(nil), "ProvenanceCommit")
+	proto.RegisterType((*Upload)(nil), "Upload")
+	proto.RegisterType((*UploadPart)(nil), "UploadPart")
 	proto.RegisterEnum("FileType", FileType_name, FileType_value)
 }
 
 func init() { proto.RegisterFile("server/pfs/db/persist/persist.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 551 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x8c, 0x92, 0xcd, 0x6a, 0xdb, 0x40,
-	0x10, 0xc7, 0x2b, 0x59, 0xb6, 0xa4, 0x09, 0x04, 0x77, 0x29, 0x45, 0x98, 0x90, 0x1a, 0x95, 0xb6,
-	0xa2, 0x07, 0x99, 0xba, 0x69, 0x1e, 0xa0, 0x71, 0x02, 0x2e, 0x25, 0x2d, 0x4b, 0x6e, 0x3d, 0x18,
-	0x7d, 0xcc, 0xc6, 0x4b, 0x25, 0x4b, 0xec, 0xca, 0x0e, 0xe9, 0xb9, 0x4f, 0xd0, 0x47, 0xea, 0x93,
-	0x95, 0x5d, 0x69, 0x1d, 0x27, 0x14, 0xec, 0x93, 0x66, 0x46, 0xff, 0x99, 0x9d, 0xf9, 0xcd, 0xc0,
-	0x6b, 0x89, 0x62, 0x83, 0x62, 0x52, 0x33, 0x39, 0xc9, 0xd3, 0x49, 0x8d, 0x42, 0x72, 0xd9, 0x98,
-	0x6f, 0x5c, 0x8b, 0xaa, 0xa9, 0x46, 0xaf, 0x6e, 0xab, 0xea, 0xb6, 0xc0, 0x89, 0xf6, 0xd2, 0x35,
-	0x9b, 0x34, 0xbc, 0x44, 0xd9, 0x24, 0x65, 0xdd, 0x09, 0x4e, 0x9f, 0x0a, 0xee, 0x44, 0x52, 0xab,
-	0x1a, 0xed, 0xff, 0xf0, 0x13, 0xf4, 0x2f, 0x8a, 0x2a, 0xfb, 0x49, 0x5e, 0xc2, 0x20, 0x15, 0xc9,
-	0x2a, 0x5b, 0x06, 0xd6, 0xd8, 0x8a, 0x7c, 0xda, 0x79, 0xe4, 0x05, 0xf4, 0x33, 0x25, 0x08, 0xec,
-	0xb1, 0x15, 0x39, 0xb4, 0x75, 0xc2, 0x1f, 0xe0, 0xea, 0xb4, 0xf9, 0x8c, 0x1c, 0x83, 0xcd, 0xf3,
-	0x2e, 0xc9, 0xe6, 0x39, 0x21, 0xe0, 0x08, 0xac, 0x2b, 0xad, 0xf7, 0xa9, 0xb6, 0x77, 0x8a, 0xf7,
-	0xfe, 0x5f, 0xdc, 0xd9, 0x2d, 0xfe, 0xdb, 0x02, 0x87, 0xaa, 0x34, 0x02, 0xce, 0x2a, 0x29, 0xb1,
-	0x2b, 0xae, 0x6d, 0x72, 0x06, 0x6e, 0x26, 0x30, 0x69, 0x30, 0xd7, 0x2f, 0x1c, 0x4d, 0x47, 0x71,
-	0x3b, 0x62, 0x6c, 0x46, 0x8c, 0x6f, 0x0c, 0x03, 0x6a, 0xa4, 0xaa, 0x92, 0xe4, 0xbf, 0x50, 0x3f,
-	0xef, 0x50, 0x6d, 0x93, 0x53, 0x80, 0x5a, 0x54, 0x1b, 0x5c, 0x25, 0xab, 0x0c, 0x03, 0x67, 0xdc,
-	0x8b, 0x7c, 0xba, 0x13, 0x09, 0xbf, 0x80, 0xf7, 0x59, 0xf5, 0x43, 0x91, 0xa9, 0xfc, 0x65, 0x22,
-	0x0d, 0x1b, 0x6d, 0xab, 0xe6, 0x8b, 0xea, 0x0e, 0x85, 0x21, 0xa3, 0x1d, 0x15, 0x5d, 0x2b, 0xc0,
-	0xdd, 0x53, 0xad, 0x13, 0xfe, 0xb1, 0xc1, 0x99, 0x71, 0xc6, 0x0e, 0xa2, 0x45, 0xc0, 0xa9, 0x93,
-	0xc6, 0xb0, 0xd2, 0x36, 0x89, 0x00, 0x52, 0xd5, 0xcc, 0x42, 0x20, 0x93, 0xba, 0xd9, 0xa3, 0xa9,
-	0x1f, 0x9b, 0xfe, 0xa8, 0x9f, 0x76, 0x96, 0x54, 0xac, 0x73, 0x2c, 0xb0, 0xc1, 0xa0, 0x3f, 0xb6,
-	0x22, 0x8f, 0x76, 0xde, 0x16, 0xc1, 0x60, 0x07, 0xc1, 0x89, 0xe1, 0xef, 0xea, 0x82, 0x83, 0x58,
-	0x2f, 0xb5, 0xdb, 0x03, 0x79, 0x0b, 0x3e, 0xe3, 0x05, 0x2e, 0x9a, 0xfb, 0x1a, 0x03, 0x6f, 0x6c,
-	0x45, 0xc7, 0x53, 0x3f, 0xbe, 0xe2, 0x05, 0xde, 0xdc, 0xd7, 0x48, 0x3d, 0xd6, 0x59, 0xe4, 0x1c,
-	0xbc, 0xb2, 0xca, 0x39, 0xe3, 0x98, 0x07, 0xfe, 0xde, 0x9d, 0x6c, 0xb5, 0xe1, 0x5f, 0x1b, 0x06,
-	0x17, 0x55, 0x59, 0xf2, 0xe6, 0x20, 0x2c, 0x6f, 0x00, 0xd8, 0xba, 0x28, 0x16, 0x6d, 0xc7, 0xbd,
-	0x47, 0x1d, 0xfb, 0xea, 0x4f, 0x7b, 0xc8, 0x67, 0xe0, 0xca, 0x26, 0x11, 0xea, 0x40, 0x9c, 0xfd,
-	0x07, 0xd2, 0x49, 0xd5, 0x0c, 0x8c, 0xaf, 0xb8, 0x5c, 0x62, 0xae, 0xb9, 0xed, 0x99, 0xc1, 0x68,
-	0xc9, 0x09, 0xf8, 0x99, 0xba, 0x96, 0xa2, 0xc0, 0x5c, 0xa3, 0xf5, 0xe8, 0x43, 0x80, 0x8c, 0xc0,
-	0x4b, 0x44, 0xb6, 0xe4, 0x1b, 0xcc, 0x03, 0x57, 0xff, 0xdc, 0xfa, 0xe4, 0xc3, 0xa3, 0xf3, 0xf3,
-	0xf4, 0x38, 0xcf, 0xe3, 0xef, 0xdb, 0x50, 0x4b, 0x66, 0xf7, 0x22, 0xb7, 0x2b, 0xf4, 0x1f, 0x56,
-	0x18, 0x9e, 0xc3, 0xf0, 0x69, 0xce, 0x21, 0x34, 0xdf, 0xbf, 0x03, 0xcf, 0xac, 0x92, 0x78, 0xe0,
-	0x5c, 0x7f, 0xbb, 0xbe, 0x1c, 0x3e, 0x53, 0xd6, 0xd5, 0xfc, 0xeb, 0xe5, 0xd0, 0x22, 0x2e, 0xf4,
-	0x66, 0x73, 0x3a, 0xb4, 0xd3, 0x81, 0x9e, 0xff, 0xe3, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x57,
-	0x10, 0xc3, 0x77, 0x90, 0x04, 0x00, 0x00,
+	// 652 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xdd, 0x6e, 0xd3, 0x4c,
+	0x10, 0xfd, 0xec, 0xd8, 0x8e, 0x3d, 0x95, 0xaa, 0x7c, 0x2b, 0x84, 0xac, 0x52, 0x95, 0x60, 0x04,
+	0x58, 0x5c, 0x38, 0xa2, 0x40, 0x1f, 0x80, 0xfe, 0x48, 0x41, 0xa8, 0x54, 0xab, 0x72, 0xc5, 0x45,
+	0x64, 0x7b, 0xc7, 0xcd, 0x0a, 0x3b, 0xb6, 0xd6, 0xdb, 0x56, 0xe5, 0x9a, 0x07, 0xe2, 0x19, 0x78,
+	0x06, 0x1e, 0x08, 0xed, 0xfa, 0x27, 0x4e, 0x05, 0x6a, 0xae, 0x3c, 0x33, 0x3b, 0x33, 0x9e, 0x39,
+	0xe7, 0x0c, 0x3c, 0xaf, 0x51, 0xdc, 0xa0, 0x98, 0x55, 0x59, 0x3d, 0x63, 0xc9, 0xac, 0x42, 0x51,
+	0xf3, 0x5a, 0x76, 0xdf, 0xa8, 0x12, 0xa5, 0x2c, 0xf7, 0x9e, 0x5e, 0x95, 0xe5, 0x55, 0x8e, 0x33,
+	0xed, 0x25, 0xd7, 0xd9, 0x4c, 0xf2, 0x02, 0x6b, 0x19, 0x17, 0x55, 0x9b, 0x70, 0x70, 0x3f, 0xe1,
+	0x56, 0xc4, 0x95, 0xea, 0xd1, 0xbc, 0x07, 0xef, 0xc1, 0x3e, 0xce, 0xcb, 0xf4, 0x1b, 0x79, 0x0c,
+	0x4e, 0x22, 0xe2, 0x55, 0xba, 0xf4, 0x8d, 0xa9, 0x11, 0x7a, 0xb4, 0xf5, 0xc8, 0x23, 0xb0, 0x53,
+	0x95, 0xe0, 0x9b, 0x53, 0x23, 0xb4, 0x68, 0xe3, 0x04, 0x5f, 0x61, 0xac, 0xcb, 0xe6, 0x27, 0x64,
+	0x17, 0x4c, 0xce, 0xda, 0x22, 0x93, 0x33, 0x42, 0xc0, 0x12, 0x58, 0x95, 0x3a, 0xdf, 0xa3, 0xda,
+	0x1e, 0x34, 0x1f, 0xfd, 0xbd, 0xb9, 0x35, 0x6c, 0xfe, 0xc3, 0x00, 0x8b, 0xaa, 0x32, 0x02, 0xd6,
+	0x2a, 0x2e, 0xb0, 0x6d, 0xae, 0x6d, 0xf2, 0x0e, 0xc6, 0xa9, 0xc0, 0x58, 0x22, 0xd3, 0x7f, 0xd8,
+	0x39, 0xdc, 0x8b, 0x9a, 0x15, 0xa3, 0x6e, 0xc5, 0xe8, 0xb2, 0xc3, 0x80, 0x76, 0xa9, 0xaa, 0x53,
+	0xcd, 0xbf, 0xa3, 0xfe, 0xbd, 0x45, 0xb5, 0x4d, 0x0e, 0x00, 0x2a, 0x51, 0xde, 0xe0, 0x2a, 0x5e,
+	0xa5, 0xe8, 0x5b, 0xd3, 0x51, 0xe8, 0xd1, 0x41, 0x24, 0xf8, 0x08, 0xee, 0x07, 0x35, 0x0f, 0xc5,
+	0x4c, 0xd5, 0x2f, 0xe3, 0xba, 0xc3, 0x46, 0xdb, 0x6a, 0xf8, 0xbc, 0xbc, 0x45, 0xd1, 0x21, 0xa3,
+	0x1d, 0x15, 0xbd, 0x56, 0x00, 0xb7, 0xbf, 0x6a, 0x9c, 0xe0, 0xa7, 0x09, 0xd6, 0x09, 0xcf, 0xb2,
+	0xad, 0xd0, 0x22, 0x60, 0x55, 0xb1, 0xec, 0xb0, 0xd2, 0x36, 0x09, 0x01, 0x12, 0x35, 0xcc, 0x42,
+	0x60, 0x56, 0xeb, 0x61, 0x77, 0x0e, 0xbd, 0xa8, 0x9b, 0x8f, 0x7a, 0x49, 0x6b, 0xd5, 0x0a, 0x6b,
+	0x86, 0x39, 0x4a, 0xf4, 0xed, 0xa9, 0x11, 0xba, 0xb4, 0xf5, 0x7a, 0x08, 0x9c, 0x01, 0x04, 0xfb,
+	0x1d, 0xfe, 0x63, 0xdd, 0xd0, 0x89, 0x34, 0xa9, 0x2d, 0x0f, 0xe4, 0x25, 0x78, 0x19, 0xcf, 0x71,
+	0x21, 0xef, 0x2a, 0xf4, 0xdd, 0xa9, 0x11, 0xee, 0x1e, 0x7a, 0xd1, 0x19, 0xcf, 0xf1, 0xf2, 0xae,
+	0x42, 0xea, 0x66, 0xad, 0x45, 0x8e, 0xc0, 0x2d, 0x4a, 0xc6, 0x33, 0x8e, 0xcc, 0xf7, 0x1e, 0xe4,
+	0xa4, 0xcf, 0x25, 0xfb, 0xe0, 0x31, 0xcc, 0x79, 0xc1, 0x25, 0x0a, 0x1f, 0xa6, 0x46, 0x68, 0xd3,
+	0x75, 0x20, 0xf8, 0x65, 0x82, 0x73, 0x5c, 0x16, 0x05, 0x97, 0x5b, 0x81, 0xf6, 0x02, 0x20, 0xbb,
+	0xce, 0xf3, 0x45, 0xb3, 0xcf, 0x68, 0x63, 0x1f, 0x4f, 0xbd, 0x68, 0x53, 0xc9, 0xa7, 0x96, 0xb1,
+	0x50, 0xf2, 0xb1, 0x1e, 0x96, 0x4f, 0x9b, 0xaa, 0x36, 0xcc, 0xf8, 0x8a, 0xd7, 0x4b, 0x64, 0xbe,
+	0xfd, 0x60, 0x59, 0x9f, 0xab, 0x36, 0x4c, 0x95, 0x96, 0xf2, 0x1c, 0x99, 0x06, 0xde, 0xa5, 0xeb,
+	0x00, 0xd9, 0x03, 0x37, 0x16, 0xe9, 0x92, 0xdf, 0x20, 0xf3, 0xc7, 0xfa, 0xb1, 0xf7, 0xc9, 0x9b,
+	0x0d, 0x71, 0xba, 0x7a, 0x9d, 0xff, 0xa3, 0x8b, 0x3e, 0xd4, 0x20, 0x33, 0xd4, 0x6b, 0x4f, 0xb0,
+	0xb7, 0x26, 0x38, 0x38, 0x82, 0xc9, 0xfd, 0x9a, 0x6d, 0xd0, 0x0c, 0x7e, 0x1b, 0xe0, 0x7c, 0xa9,
+	0xf2, 0x32, 0x66, 0x5b, 0x81, 0xff, 0x04, 0xbc, 0x54, 0x37, 0x5f, 0x70, 0xd6, 0xca, 0xd6, 0x6d,
+	0x02, 0x73, 0xd6, 0xcb, 0xd9, 0x1a, 0xc8, 0x79, 0x83, 0x7a, 0xfb, 0x1e, 0xf5, 0xe4, 0x19, 0xd8,
+	0x55, 0x2c, 0x64, 0xed, 0x3b, 0x7a, 0xef, 0x9d, 0xa8, 0x19, 0xe5, 0x22, 0x16, 0x92, 0x36, 0x2f,
+	0x43, 0x1e, 0xc7, 0x5b, 0xf3, 0x18, 0x24, 0x00, 0xeb, 0x56, 0xea, 0x52, 0xca, 0x2c, 0xab, 0x51,
+	0xea, 0xed, 0x2c, 0xda, 0x7a, 0x3d, 0x90, 0xe6, 0xe0, 0x52, 0x36, 0xef, 0x6f, 0xf4, 0xef, 0xfb,
+	0x7b, 0xfd, 0x0a, 0xdc, 0xee, 0x46, 0x88, 0x0b, 0xd6, 0xf9, 0xe7, 0xf3, 0xd3, 0xc9, 0x7f, 0xca,
+	0x3a, 0x9b, 0x7f, 0x3a, 0x9d, 0x18, 0x64, 0x0c, 0xa3, 0x93, 0x39, 0x9d, 0x98, 0x89, 0xa3, 0x27,
+	0x7d, 0xfb, 0x67, 0x00, 0x93, 0x30, 0xbc, 0xd2, 0xe9, 0x05, 0x00, 0x00,
 }
@@ -0,0 +1,140 @@
+package persist
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pfs"
+	"go.pedge.io/proto/stream"
+	"golang.org/x/net/context"
+)
+
+const (
+	// ReadAheadBlocksEnv is the environment variable for the number of
+	// blocks each file reader fetches ahead of the one being read.  0
+	// disables read ahead.
+	ReadAheadBlocksEnv = "PFS_READ_AHEAD_BLOCKS"
+	// ReadAheadBytesEnv is the environment variable for the most memory, in
+	// bytes, that all the file readers of a driver use for blocks fetched
+	// ahead.
+	ReadAheadBytesEnv = "PFS_READ_AHEAD_BYTES"
+
+	defaultReadAheadBlocks = 4
+	defaultReadAheadBytes  = 256 * 1024 * 1024
+)
+
+// readAhead limits the blocks that file readers fetch ahead of the block
+// being read.  A nil *readAhead doesn't allow any.
+type readAhead struct {
+	maxBlocks int
+	maxBytes  int64
+
+	lock  sync.Mutex
+	bytes int64
+}
+
+func newReadAhead(maxBlocks int, maxBytes int64) *readAhead {
+	return &readAhead{
+		maxBlocks: maxBlocks,
+		maxBytes:  maxBytes,
+	}
+}
+
+func readAheadFromEnv() (*readAhead, error) {
+	maxBlocks := defaultReadAheadBlocks
+	if value := os.Getenv(ReadAheadBlocksEnv); value != "" {
+		var err error
+		if maxBlocks, err = strconv.Atoi(value); err != nil {
+			return nil, fmt.Errorf("invalid %s: %s", ReadAheadBlocksEnv, value)
+		}
+	}
+	maxBytes := int64(defaultReadAheadBytes)
+	if value := os.Getenv(ReadAheadBytesEnv); value != "" {
+		var err error
+		if maxBytes, err = strconv.ParseInt(value, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid %s: %s", ReadAheadBytesEnv, value)
+		}
+	}
+	return newReadAhead(maxBlocks, maxBytes), nil
+}
+
+// blocks returns the number of blocks a reader may fetch ahead.
+func (r *readAhead) blocks() int {
+	if r == nil {
+		return 0
+	}
+	return r.maxBlocks
+}
+
+// acquire reserves size bytes for a block fetched ahead, it returns false
+// if there isn't enough memory left.
+func (r *readAhead) acquire(size int64) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.bytes+size > r.maxBytes {
+		return false
+	}
+	r.bytes += size
+	return true
+}
+
+// release returns the memory of a block fetched ahead, p may be nil.
+func (r *readAhead) release(p *prefetch) {
+	if p == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.bytes -= p.size
+}
+
+// blockRange is a range of a block which is part of a file.
+type blockRange struct {
+	hash   string
+	offset int64
+	size   int64
+}
+
+func (b *blockRange) reader(ctx context.Context, blockClient pfs.BlockAPIClient) (io.Reader, error) {
+	getBlockClient, err := blockClient.GetBlock(ctx, &pfs.GetBlockRequest{
+		Block:       client.NewBlock(b.hash),
+		OffsetBytes: uint64(b.offset),
+		SizeBytes:   uint64(b.size),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return protostream.NewStreamingBytesReader(getBlockClient), nil
+}
+
+// fetch starts reading the range into memory.
+func (b *blockRange) fetch(ctx context.Context, blockClient pfs.BlockAPIClient) *prefetch {
+	p := &prefetch{
+		size: b.size,
+		done: make(chan struct{}),
+	}
+	go func() {
+		defer close(p.done)
+		reader, err := b.reader(ctx, blockClient)
+		if err != nil {
+			p.err = err
+			return
+		}
+		p.data, p.err = ioutil.ReadAll(reader)
+	}()
+	return p
+}
+
+// prefetch is a block range being read into memory, data and err are set
+// once done is closed.
+type prefetch struct {
+	size int64
+	done chan struct{}
+	data []byte
+	err  error
+}
@@ -0,0 +1,83 @@
+package persist
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pfs"
+	"github.com/sjezewski/pachyderm/src/client/pkg/require"
+	"github.com/sjezewski/pachyderm/src/server/pfs/db/persist"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func TestReadAhead(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pfs_read_ahead")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	clientConn, err := grpc.Dial(serveBlockAPI(t, dir), grpc.WithInsecure())
+	require.NoError(t, err)
+	blockClient := pfs.NewBlockAPIClient(clientConn)
+	c := client.APIClient{BlockAPIClient: blockClient}
+
+	var blockRefs []*persist.BlockRef
+	var content string
+	for _, data := range []string{"foo", "barbaz", "buzz", "a", "qux"} {
+		refs, err := c.PutBlock(pfs.Delimiter_NONE, strings.NewReader(data))
+		require.NoError(t, err)
+		for _, ref := range refs.BlockRef {
+			blockRefs = append(blockRefs, &persist.BlockRef{
+				Hash:  ref.Block.Hash,
+				Lower: ref.Range.Lower,
+				Upper: ref.Range.Upper,
+			})
+		}
+		content += data
+	}
+
+	for _, readAhead := range []*readAhead{
+		nil,
+		newReadAhead(0, 1024),
+		newReadAhead(2, 1024),
+		newReadAhead(10, 1024),
+		// Only room for some of the blocks.
+		newReadAhead(10, 8),
+	} {
+		for offset := 0; offset <= len(content); offset++ {
+			for size := 0; offset+size <= len(content); size++ {
+				reader := newFileReader(context.Background(), blockClient, readAhead, blockRefs, nil, int64(offset), int64(size))
+				data, err := ioutil.ReadAll(reader)
+				require.NoError(t, err)
+				expected := content[offset:]
+				if size > 0 {
+					expected = content[offset : offset+size]
+				}
+				require.Equal(t, expected, string(data))
+				require.NoError(t, reader.Close())
+			}
+		}
+	}
+
+	// Readers which are closed early give back what they fetched ahead.
+	readAhead := newReadAhead(10, 1024)
+	reader := newFileReader(context.Background(), blockClient, readAhead, blockRefs, nil, 0, 0)
+	_, err = reader.Read(make([]byte, 1))
+	require.NoError(t, err)
+	require.Equal(t, int64(len(content)-len("foo")), readAheadBytes(readAhead))
+	require.NoError(t, reader.Close())
+	for i := 0; readAheadBytes(readAhead) != 0; i++ {
+		require.True(t, i < 100, "read ahead wasn't released")
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func readAheadBytes(r *readAhead) int64 {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.bytes
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/sjezewski/pachyderm/src/client/pfs"
+	"golang.org/x/net/context"
 )
 
 // ListFileMode specifies how ListFile executes.
@@ -41,6 +42,12 @@ type Driver interface {
 	SquashCommit(fromCommits []*pfs.Commit, toCommit *pfs.Commit) error
 	// Replay replays fromCommits onto toBranch
 	ReplayCommit(fromCommits []*pfs.Commit, toBranch string) ([]*pfs.Commit, error)
+	// PullCommit returns a finished commit and its diffs, so that it can be
+	// replicated into another cluster with PushCommit.
+	PullCommit(commit *pfs.Commit) (*pfs.CommitReplica, error)
+	// PushCommit recreates a commit returned by PullCommit.  The commit's
+	// parent and provenance must already exist.
+	PushCommit(replica *pfs.CommitReplica) error
 	ArchiveCommit(commit []*pfs.Commit) error
 	InspectCommit(commit *pfs.Commit) (*pfs.CommitInfo, error)
 	ListCommit(fromCommits []*pfs.Commit, provenance []*pfs.Commit, commitType pfs.CommitType, status pfs.CommitStatus, block bool) ([]*pfs.CommitInfo, error)
@@ -48,9 +55,23 @@ type Driver interface {
 	ListBranch(repo *pfs.Repo, status pfs.CommitStatus) ([]string, error)
 	DeleteCommit(commit *pfs.Commit) error
 
-	PutFile(file *pfs.File, delimiter pfs.Delimiter, reader io.Reader) error
+	// PutFile appends reader to file, its blocks are written with ctx.
+	PutFile(ctx context.Context, file *pfs.File, delimiter pfs.Delimiter, reader io.Reader) error
+	// BeginUpload starts a resumable upload to file, which must be in an
+	// open commit.  The upload is deleted when the commit finishes.
+	BeginUpload(file *pfs.File, delimiter pfs.Delimiter) (*pfs.Upload, error)
+	// UploadPart writes the part of an upload that starts at offset,
+	// replacing the part that was previously written there.  Its blocks
+	// are written with ctx.
+	UploadPart(ctx context.Context, upload *pfs.Upload, offset uint64, reader io.Reader) error
+	InspectUpload(upload *pfs.Upload) (*pfs.UploadInfo, error)
+	// CompleteUpload appends the parts of an upload to its file.  The parts
+	// must cover exactly the first sizeBytes of the file.
+	CompleteUpload(upload *pfs.Upload, sizeBytes uint64) error
 	MakeDirectory(file *pfs.File) error
-	GetFile(file *pfs.File, filterShard *pfs.Shard, offset int64,
+	// GetFile returns a reader of the file's content, its block reads are
+	// made with ctx.
+	GetFile(ctx context.Context, file *pfs.File, filterShard *pfs.Shard, offset int64,
 		size int64, diffMethod *pfs.DiffMethod) (io.ReadCloser, error)
 	InspectFile(file *pfs.File, filterShard *pfs.Shard, diffMethod *pfs.DiffMethod) (*pfs.FileInfo, error)
 	ListFile(file *pfs.File, filterShard *pfs.Shard, diffMethod *pfs.DiffMethod, mode ListFileMode) ([]*pfs.FileInfo, error)
@@ -0,0 +1,1256 @@
+/*
+Package drivetest provides a conformance test suite for implementations of
+drive.Driver, so that a driver can be validated without a running cluster.
+*/
+package drivetest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pclient "github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pfs"
+	"github.com/sjezewski/pachyderm/src/client/pkg/require"
+	"github.com/sjezewski/pachyderm/src/server/pfs/drive"
+	"golang.org/x/net/context"
+)
+
+// RunTests runs the conformance suite against the drivers returned by
+// newDriver. newDriver is called once per test, or more for tests which
+// need more than one driver, and must return a driver with no repos in it.
+// Drivers returned by newDriver should share a block store.
+func RunTests(t *testing.T, newDriver func() drive.Driver) {
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			test.f(t, newDriver())
+		})
+	}
+	for _, test := range multiDriverTests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			test.f(t, newDriver)
+		})
+	}
+}
+
+var tests = []struct {
+	name string
+	f    func(*testing.T, drive.Driver)
+}{
+	{"Repo", testRepo},
+	{"DeleteRepoWithProvenance", testDeleteRepoWithProvenance},
+	{"CommitClocks", testCommitClocks},
+	{"StartCommitErrors", testStartCommitErrors},
+	{"FinishCommit", testFinishCommit},
+	{"FinishCommitBlocksOnParent", testFinishCommitBlocksOnParent},
+	{"CancelCommit", testCancelCommit},
+	{"ForkCommit", testForkCommit},
+	{"ListBranch", testListBranch},
+	{"ListCommit", testListCommit},
+	{"ListCommitBlock", testListCommitBlock},
+	{"FlushCommit", testFlushCommit},
+	{"SquashCommit", testSquashCommit},
+	{"ReplayCommit", testReplayCommit},
+	{"DeleteCommit", testDeleteCommit},
+	{"PutFile", testPutFile},
+	{"PutFileConcurrent", testPutFileConcurrent},
+	{"PutFileTypeConflict", testPutFileTypeConflict},
+	{"Upload", testUpload},
+	{"GetFileOffset", testGetFileOffset},
+	{"MakeDirectory", testMakeDirectory},
+	{"InspectFile", testInspectFile},
+	{"ListFile", testListFile},
+	{"DeleteFile", testDeleteFile},
+	{"DiffMethod", testDiffMethod},
+	{"ShardFiltering", testShardFiltering},
+	{"FileHash", testFileHash},
+	{"Glob", testGlob},
+	{"ArchiveCommit", testArchiveCommit},
+	{"ArchiveAll", testArchiveAll},
+	{"DeleteAll", testDeleteAll},
+}
+
+var multiDriverTests = []struct {
+	name string
+	f    func(*testing.T, func() drive.Driver)
+}{
+	{"PullPushCommit", testPullPushCommit},
+}
+
+func testRepo(t *testing.T, d drive.Driver) {
+	require.NoError(t, d.CreateRepo(pclient.NewRepo("A"), nil))
+	require.YesError(t, d.CreateRepo(pclient.NewRepo("A"), nil))
+	require.YesError(t, d.CreateRepo(pclient.NewRepo("B"), []*pfs.Repo{pclient.NewRepo("nonexistent")}))
+	require.NoError(t, d.CreateRepo(pclient.NewRepo("B"), []*pfs.Repo{pclient.NewRepo("A")}))
+	require.NoError(t, d.CreateRepo(pclient.NewRepo("C"), []*pfs.Repo{pclient.NewRepo("B")}))
+
+	repoInfo, err := d.InspectRepo(pclient.NewRepo("C"))
+	require.NoError(t, err)
+	require.Equal(t, "C", repoInfo.Repo.Name)
+	require.NotNil(t, repoInfo.Created)
+	// Provenance is transitive.
+	require.Equal(t, 2, len(repoInfo.Provenance))
+	_, err = d.InspectRepo(pclient.NewRepo("nonexistent"))
+	require.YesError(t, err)
+
+	repoInfos, err := d.ListRepo(nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(repoInfos))
+	repoInfos, err = d.ListRepo([]*pfs.Repo{pclient.NewRepo("A")})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(repoInfos))
+	repoInfos, err = d.ListRepo([]*pfs.Repo{pclient.NewRepo("B")})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(repoInfos))
+	require.Equal(t, "C", repoInfos[0].Repo.Name)
+
+	require.NoError(t, d.DeleteRepo(pclient.NewRepo("C"), false))
+	_, err = d.InspectRepo(pclient.NewRepo("C"))
+	require.YesError(t, err)
+	repoInfos, err = d.ListRepo(nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(repoInfos))
+
+	// A deleted repo can be created again.
+	require.NoError(t, d.CreateRepo(pclient.NewRepo("C"), nil))
+}
+
+func testDeleteRepoWithProvenance(t *testing.T, d drive.Driver) {
+	require.NoError(t, d.CreateRepo(pclient.NewRepo("A"), nil))
+	require.NoError(t, d.CreateRepo(pclient.NewRepo("B"), []*pfs.Repo{pclient.NewRepo("A")}))
+	// A is the provenance of B, so it can only be deleted with force.
+	require.YesError(t, d.DeleteRepo(pclient.NewRepo("A"), false))
+	require.NoError(t, d.DeleteRepo(pclient.NewRepo("A"), true))
+	_, err := d.InspectRepo(pclient.NewRepo("A"))
+	require.YesError(t, err)
+}
+
+func testCommitClocks(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	commit1 := startCommit(t, d, repo, "master")
+	require.Equal(t, "master/0", commit1.ID)
+	require.NoError(t, d.FinishCommit(commit1, false))
+	commit2 := startCommit(t, d, repo, "master")
+	require.Equal(t, "master/1", commit2.ID)
+	require.NoError(t, d.FinishCommit(commit2, false))
+	// Starting a commit from a commit ID is the same as from its branch.
+	commit3 := startCommit(t, d, repo, commit2.ID)
+	require.Equal(t, "master/2", commit3.ID)
+
+	commitInfo, err := d.InspectCommit(commit1)
+	require.NoError(t, err)
+	require.Equal(t, commit1, commitInfo.Commit)
+	require.Equal(t, "master", commitInfo.Branch)
+	require.Nil(t, commitInfo.ParentCommit)
+	require.Equal(t, pfs.CommitType_COMMIT_TYPE_READ, commitInfo.CommitType)
+	require.NotNil(t, commitInfo.Started)
+	require.NotNil(t, commitInfo.Finished)
+
+	commitInfo, err = d.InspectCommit(commit3)
+	require.NoError(t, err)
+	require.Equal(t, commit2, commitInfo.ParentCommit)
+	require.Equal(t, pfs.CommitType_COMMIT_TYPE_WRITE, commitInfo.CommitType)
+	require.Nil(t, commitInfo.Finished)
+
+	// A branch name resolves to the head of the branch.
+	commitInfo, err = d.InspectCommit(pclient.NewCommit(repo, "master"))
+	require.NoError(t, err)
+	require.Equal(t, commit3, commitInfo.Commit)
+
+	_, err = d.InspectCommit(pclient.NewCommit(repo, "master/3"))
+	require.YesError(t, err)
+}
+
+func testStartCommitErrors(t *testing.T, d drive.Driver) {
+	repo := "test"
+	_, err := d.StartCommit(pclient.NewCommit(repo, "master"), nil)
+	require.YesError(t, err)
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+	_, err = d.StartCommit(pclient.NewCommit(repo, "master/3"), nil)
+	require.YesError(t, err)
+	_, err = d.StartCommit(pclient.NewCommit(repo, ""), nil)
+	require.YesError(t, err)
+}
+
+func testFinishCommit(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	commit := startCommit(t, d, repo, "master")
+	putFile(t, d, repo, commit.ID, "foo", "foo\n")
+	commitInfo, err := d.InspectCommit(commit)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), commitInfo.SizeBytes)
+	require.NoError(t, d.FinishCommit(commit, false))
+
+	// Finished commits can't be written to.
+	require.YesError(t, d.PutFile(context.Background(), pclient.NewFile(repo, commit.ID, "bar"), pfs.Delimiter_LINE, strings.NewReader("bar\n")))
+	require.YesError(t, d.MakeDirectory(pclient.NewFile(repo, commit.ID, "dir")))
+
+	commitInfo, err = d.InspectCommit(commit)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), commitInfo.SizeBytes)
+	require.False(t, commitInfo.Cancelled)
+	repoInfo, err := d.InspectRepo(pclient.NewRepo(repo))
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), repoInfo.SizeBytes)
+}
+
+func testFinishCommitBlocksOnParent(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	commit1 := startCommit(t, d, repo, "master")
+	commit2 := startCommit(t, d, repo, commit1.ID)
+	finished := make(chan error)
+	go func() {
+		finished <- d.FinishCommit(commit2, false)
+	}()
+	select {
+	case err := <-finished:
+		t.Fatalf("FinishCommit should block until the parent is finished, returned %v", err)
+	case <-time.After(time.Second):
+	}
+	require.NoError(t, d.FinishCommit(commit1, false))
+	select {
+	case err := <-finished:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("FinishCommit should have returned after the parent finished")
+	}
+}
+
+func testCancelCommit(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	commit1 := startCommit(t, d, repo, "master")
+	require.NoError(t, d.FinishCommit(commit1, true))
+	commitInfo, err := d.InspectCommit(commit1)
+	require.NoError(t, err)
+	require.True(t, commitInfo.Cancelled)
+
+	// The children of a cancelled commit are cancelled too.
+	commit2 := startCommit(t, d, repo, commit1.ID)
+	require.NoError(t, d.FinishCommit(commit2, false))
+	commitInfo, err = d.InspectCommit(commit2)
+	require.NoError(t, err)
+	require.True(t, commitInfo.Cancelled)
+}
+
+func testForkCommit(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	commit1 := startCommit(t, d, repo, "master")
+	putFile(t, d, repo, commit1.ID, "foo", "foo\n")
+	require.NoError(t, d.FinishCommit(commit1, false))
+
+	fork, err := d.ForkCommit(commit1, "fork", nil)
+	require.NoError(t, err)
+	require.Equal(t, "fork/0", fork.ID)
+	commitInfo, err := d.InspectCommit(fork)
+	require.NoError(t, err)
+	require.Equal(t, "fork", commitInfo.Branch)
+	require.Equal(t, commit1, commitInfo.ParentCommit)
+
+	// The fork sees its parent's files, but the parent's branch doesn't see
+	// the fork's.
+	require.Equal(t, "foo\n", getFile(t, d, repo, fork.ID, "foo", nil))
+	putFile(t, d, repo, "fork", "bar", "bar\n")
+	require.NoError(t, d.FinishCommit(fork, false))
+	require.Equal(t, "bar\n", getFile(t, d, repo, "fork", "bar", nil))
+	_, err = d.InspectFile(pclient.NewFile(repo, "master", "bar"), nil, nil)
+	require.YesError(t, err)
+
+	commit2 := startCommit(t, d, repo, "fork")
+	require.Equal(t, "fork/1", commit2.ID)
+
+	_, err = d.ForkCommit(pclient.NewCommit(repo, "nonexistent/0"), "fork2", nil)
+	require.YesError(t, err)
+}
+
+func testListBranch(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	commit1 := startCommit(t, d, repo, "A")
+	require.NoError(t, d.FinishCommit(commit1, false))
+	commit2 := startCommit(t, d, repo, commit1.ID)
+	require.NoError(t, d.FinishCommit(commit2, false))
+	commit3, err := d.ForkCommit(commit1, "B", nil)
+	require.NoError(t, err)
+	require.NoError(t, d.FinishCommit(commit3, false))
+	commit4 := startCommit(t, d, repo, "C")
+	require.NoError(t, d.FinishCommit(commit4, true))
+
+	branches, err := d.ListBranch(pclient.NewRepo(repo), pfs.CommitStatus_NORMAL)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(branches))
+	require.EqualOneOf(t, []interface{}{branches[0], branches[1]}, "A")
+	require.EqualOneOf(t, []interface{}{branches[0], branches[1]}, "B")
+	branches, err = d.ListBranch(pclient.NewRepo(repo), pfs.CommitStatus_ALL)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(branches))
+}
+
+func testListCommit(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	var commits []*pfs.Commit
+	for i := 0; i < 5; i++ {
+		commit := startCommit(t, d, repo, "master")
+		require.NoError(t, d.FinishCommit(commit, false))
+		commits = append(commits, commit)
+	}
+	open := startCommit(t, d, repo, "master")
+
+	commitInfos, err := d.ListCommit([]*pfs.Commit{pclient.NewCommit(repo, "")}, nil, pfs.CommitType_COMMIT_TYPE_NONE, pfs.CommitStatus_NORMAL, false)
+	require.NoError(t, err)
+	require.Equal(t, 6, len(commitInfos))
+	// Commits are listed in clock order.
+	for i, commit := range commits {
+		require.Equal(t, commit, commitInfos[i].Commit)
+	}
+	require.Equal(t, open, commitInfos[5].Commit)
+
+	commitInfos, err = d.ListCommit([]*pfs.Commit{pclient.NewCommit(repo, "")}, nil, pfs.CommitType_COMMIT_TYPE_READ, pfs.CommitStatus_NORMAL, false)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(commitInfos))
+	commitInfos, err = d.ListCommit([]*pfs.Commit{pclient.NewCommit(repo, "")}, nil, pfs.CommitType_COMMIT_TYPE_WRITE, pfs.CommitStatus_NORMAL, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(commitInfos))
+	require.Equal(t, open, commitInfos[0].Commit)
+
+	// Listing from a commit only returns its descendents.
+	commitInfos, err = d.ListCommit([]*pfs.Commit{commits[2]}, nil, pfs.CommitType_COMMIT_TYPE_NONE, pfs.CommitStatus_NORMAL, false)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(commitInfos))
+	require.Equal(t, commits[3], commitInfos[0].Commit)
+
+	// Cancelled commits are only listed with CommitStatus_CANCELLED or
+	// CommitStatus_ALL.
+	require.NoError(t, d.FinishCommit(open, true))
+	commitInfos, err = d.ListCommit([]*pfs.Commit{pclient.NewCommit(repo, "")}, nil, pfs.CommitType_COMMIT_TYPE_NONE, pfs.CommitStatus_NORMAL, false)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(commitInfos))
+	commitInfos, err = d.ListCommit([]*pfs.Commit{pclient.NewCommit(repo, "")}, nil, pfs.CommitType_COMMIT_TYPE_NONE, pfs.CommitStatus_CANCELLED, false)
+	require.NoError(t, err)
+	require.Equal(t, 6, len(commitInfos))
+	commitInfos, err = d.ListCommit([]*pfs.Commit{pclient.NewCommit(repo, "")}, nil, pfs.CommitType_COMMIT_TYPE_NONE, pfs.CommitStatus_ALL, false)
+	require.NoError(t, err)
+	require.Equal(t, 6, len(commitInfos))
+}
+
+func testListCommitBlock(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	commit1 := startCommit(t, d, repo, "master")
+	require.NoError(t, d.FinishCommit(commit1, false))
+
+	type result struct {
+		commitInfos []*pfs.CommitInfo
+		err         error
+	}
+	listed := make(chan result)
+	go func() {
+		commitInfos, err := d.ListCommit([]*pfs.Commit{commit1}, nil, pfs.CommitType_COMMIT_TYPE_NONE, pfs.CommitStatus_NORMAL, true)
+		listed <- result{commitInfos, err}
+	}()
+	select {
+	case r := <-listed:
+		t.Fatalf("ListCommit should block until there's a new commit, returned %v, %v", r.commitInfos, r.err)
+	case <-time.After(time.Second):
+	}
+
+	commit2 := startCommit(t, d, repo, commit1.ID)
+	require.NoError(t, d.FinishCommit(commit2, false))
+	select {
+	case r := <-listed:
+		require.NoError(t, r.err)
+		require.Equal(t, 1, len(r.commitInfos))
+		require.Equal(t, commit2, r.commitInfos[0].Commit)
+	case <-time.After(10 * time.Second):
+		t.Fatal("ListCommit should have returned after a new commit")
+	}
+}
+
+func testFlushCommit(t *testing.T, d drive.Driver) {
+	require.NoError(t, d.CreateRepo(pclient.NewRepo("A"), nil))
+	require.NoError(t, d.CreateRepo(pclient.NewRepo("B"), []*pfs.Repo{pclient.NewRepo("A")}))
+	require.NoError(t, d.CreateRepo(pclient.NewRepo("C"), []*pfs.Repo{pclient.NewRepo("B")}))
+
+	commitA := startCommit(t, d, "A", "master")
+	require.NoError(t, d.FinishCommit(commitA, false))
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- func() error {
+			commitB, err := d.StartCommit(pclient.NewCommit("B", "master"), []*pfs.Commit{commitA})
+			if err != nil {
+				return err
+			}
+			if err := d.FinishCommit(commitB, false); err != nil {
+				return err
+			}
+			commitC, err := d.StartCommit(pclient.NewCommit("C", "master"), []*pfs.Commit{commitB})
+			if err != nil {
+				return err
+			}
+			return d.FinishCommit(commitC, false)
+		}()
+	}()
+
+	commitInfos, err := d.FlushCommit([]*pfs.Commit{commitA}, nil)
+	require.NoError(t, err)
+	require.NoError(t, <-errCh)
+	require.Equal(t, 3, len(commitInfos))
+	commitInfos, err = d.FlushCommit([]*pfs.Commit{commitA}, []*pfs.Repo{pclient.NewRepo("B")})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commitInfos))
+
+	// The provenance of a commit includes its provenance's provenance.
+	commitInfo, err := d.InspectCommit(pclient.NewCommit("C", "master"))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commitInfo.Provenance))
+
+	// Flushing a commit whose downstream commits are cancelled is an error.
+	commitA2 := startCommit(t, d, "A", "master")
+	require.NoError(t, d.FinishCommit(commitA2, false))
+	go func() {
+		errCh <- func() error {
+			commitB, err := d.StartCommit(pclient.NewCommit("B", "master"), []*pfs.Commit{commitA2})
+			if err != nil {
+				return err
+			}
+			return d.FinishCommit(commitB, true)
+		}()
+	}()
+	_, err = d.FlushCommit([]*pfs.Commit{commitA2}, []*pfs.Repo{pclient.NewRepo("C")})
+	require.YesError(t, err)
+	require.NoError(t, <-errCh)
+}
+
+// makeBranches creates a root commit on master and two branches, A and B,
+// forked from it with two commits each that append to "file".
+func makeBranches(t *testing.T, d drive.Driver, repo string) (contentA string, contentB string) {
+	root := startCommit(t, d, repo, "master")
+	require.NoError(t, d.FinishCommit(root, false))
+	for _, branch := range []string{"A", "B"} {
+		var content string
+		for i := 0; i < 2; i++ {
+			var commit *pfs.Commit
+			var err error
+			if i == 0 {
+				commit, err = d.ForkCommit(root, branch, nil)
+			} else {
+				commit, err = d.StartCommit(pclient.NewCommit(repo, branch), nil)
+			}
+			require.NoError(t, err)
+			line := fmt.Sprintf("%s%d\n", branch, i)
+			putFile(t, d, repo, commit.ID, "file", line)
+			putFile(t, d, repo, commit.ID, fmt.Sprintf("%s%d", branch, i), line)
+			require.NoError(t, d.FinishCommit(commit, false))
+			content += line
+		}
+		if branch == "A" {
+			contentA = content
+		} else {
+			contentB = content
+		}
+	}
+	return contentA, contentB
+}
+
+func testSquashCommit(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+	contentA, contentB := makeBranches(t, d, repo)
+
+	commit := startCommit(t, d, repo, "master")
+	require.NoError(t, d.SquashCommit([]*pfs.Commit{pclient.NewCommit(repo, "A"), pclient.NewCommit(repo, "B")}, commit))
+	require.NoError(t, d.FinishCommit(commit, false))
+
+	// The ordering of commits within the same branch is preserved.
+	require.EqualOneOf(t, []interface{}{contentA + contentB, contentB + contentA}, getFile(t, d, repo, commit.ID, "file", nil))
+	fileInfos, err := d.ListFile(pclient.NewFile(repo, commit.ID, ""), nil, nil, drive.ListFileNORMAL)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(fileInfos))
+
+	// Squashing into a finished commit is an error.
+	require.YesError(t, d.SquashCommit([]*pfs.Commit{pclient.NewCommit(repo, "A")}, commit))
+}
+
+func testReplayCommit(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+	contentA, contentB := makeBranches(t, d, repo)
+
+	commits, err := d.ReplayCommit([]*pfs.Commit{pclient.NewCommit(repo, "A"), pclient.NewCommit(repo, "B")}, "master")
+	require.NoError(t, err)
+	require.Equal(t, 4, len(commits))
+	for i, commit := range commits {
+		require.Equal(t, fmt.Sprintf("master/%d", i+1), commit.ID)
+		commitInfo, err := d.InspectCommit(commit)
+		require.NoError(t, err)
+		require.Equal(t, pfs.CommitType_COMMIT_TYPE_READ, commitInfo.CommitType)
+	}
+	require.EqualOneOf(t, []interface{}{contentA + contentB, contentB + contentA}, getFile(t, d, repo, commits[3].ID, "file", nil))
+	fileInfos, err := d.ListFile(pclient.NewFile(repo, "master", ""), nil, nil, drive.ListFileNORMAL)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(fileInfos))
+
+	_, err = d.ReplayCommit(nil, "master")
+	require.YesError(t, err)
+}
+
+func testPullPushCommit(t *testing.T, newDriver func() drive.Driver) {
+	src := newDriver()
+	dst := newDriver()
+	for _, d := range []drive.Driver{src, dst} {
+		require.NoError(t, d.CreateRepo(pclient.NewRepo("in"), nil))
+		require.NoError(t, d.CreateRepo(pclient.NewRepo("out"), []*pfs.Repo{pclient.NewRepo("in")}))
+	}
+
+	root := startCommit(t, src, "in", "master")
+	putFile(t, src, "in", root.ID, "a", "foo\n")
+	putFile(t, src, "in", root.ID, "dir/b", "bar\n")
+	require.NoError(t, src.FinishCommit(root, false))
+	child := startCommit(t, src, "in", "master")
+	require.NoError(t, src.DeleteFile(pclient.NewFile("in", child.ID, "a")))
+	putFile(t, src, "in", child.ID, "a", "baz\n")
+	putFile(t, src, "in", child.ID, "c", "buzz\n")
+	require.NoError(t, src.FinishCommit(child, false))
+	fork, err := src.ForkCommit(root, "A", nil)
+	require.NoError(t, err)
+	putFile(t, src, "in", fork.ID, "d", "fizz\n")
+	require.NoError(t, src.FinishCommit(fork, true))
+	out, err := src.StartCommit(pclient.NewCommit("out", "master"), []*pfs.Commit{child})
+	require.NoError(t, err)
+	putFile(t, src, "out", out.ID, "e", "foo\nbaz\n")
+	require.NoError(t, src.FinishCommit(out, false))
+	open := startCommit(t, src, "in", "master")
+
+	// Parents and provenance are pushed first.
+	for _, commit := range []*pfs.Commit{root, child, fork, out} {
+		replica, err := src.PullCommit(commit)
+		require.NoError(t, err)
+		require.NoError(t, dst.PushCommit(replica))
+	}
+	for _, commit := range []*pfs.Commit{root, child, fork, out} {
+		srcInfo, err := src.InspectCommit(commit)
+		require.NoError(t, err)
+		dstInfo, err := dst.InspectCommit(commit)
+		require.NoError(t, err)
+		require.Equal(t, srcInfo, dstInfo)
+	}
+	require.Equal(t, "baz\n", getFile(t, dst, "in", child.ID, "a", nil))
+	require.Equal(t, "bar\n", getFile(t, dst, "in", child.ID, "dir/b", nil))
+	require.Equal(t, "buzz\n", getFile(t, dst, "in", child.ID, "c", nil))
+	require.Equal(t, "foo\n", getFile(t, dst, "in", fork.ID, "a", nil))
+	require.Equal(t, "fizz\n", getFile(t, dst, "in", fork.ID, "d", nil))
+	require.Equal(t, "foo\nbaz\n", getFile(t, dst, "out", out.ID, "e", nil))
+	srcFile, err := src.InspectFile(pclient.NewFile("in", child.ID, "a"), nil, nil)
+	require.NoError(t, err)
+	dstFile, err := dst.InspectFile(pclient.NewFile("in", child.ID, "a"), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, srcFile, dstFile)
+	srcRepo, err := src.InspectRepo(pclient.NewRepo("in"))
+	require.NoError(t, err)
+	dstRepo, err := dst.InspectRepo(pclient.NewRepo("in"))
+	require.NoError(t, err)
+	require.Equal(t, srcRepo.SizeBytes, dstRepo.SizeBytes)
+
+	// The pushed commits' clocks carry on as they would have in src.
+	commit := startCommit(t, dst, "in", "master")
+	require.Equal(t, open.ID, commit.ID)
+	commit, err = dst.StartCommit(pclient.NewCommit("in", "A"), nil)
+	require.NoError(t, err)
+	require.Equal(t, "A/1", commit.ID)
+
+	// Only finished commits can be pulled.
+	_, err = src.PullCommit(open)
+	require.YesError(t, err)
+	// A commit can only be pushed once.
+	replica, err := src.PullCommit(root)
+	require.NoError(t, err)
+	require.YesError(t, dst.PushCommit(replica))
+	// The parent must have been pushed first.
+	replica, err = src.PullCommit(child)
+	require.NoError(t, err)
+	empty := newDriver()
+	require.NoError(t, empty.CreateRepo(pclient.NewRepo("in"), nil))
+	require.YesError(t, empty.PushCommit(replica))
+	// So must the blocks.
+	replica, err = src.PullCommit(root)
+	require.NoError(t, err)
+	for _, fileDiff := range replica.FileDiffs {
+		for _, blockRef := range fileDiff.BlockRefs {
+			blockRef.Block.Hash = "missing"
+		}
+	}
+	require.YesError(t, empty.PushCommit(replica))
+	_, err = empty.InspectCommit(root)
+	require.YesError(t, err)
+}
+
+func testDeleteCommit(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+	commit := startCommit(t, d, repo, "master")
+	// No driver supports deleting commits yet.
+	require.YesError(t, d.DeleteCommit(commit))
+}
+
+func testPutFile(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	commit1 := startCommit(t, d, repo, "master")
+	putFile(t, d, repo, commit1.ID, "foo", "foo\n")
+	putFile(t, d, repo, commit1.ID, "foo", "foo\n")
+	putFile(t, d, repo, commit1.ID, "dir/bar", "bar\n")
+	require.Equal(t, "foo\nfoo\n", getFile(t, d, repo, commit1.ID, "foo", nil))
+	require.NoError(t, d.FinishCommit(commit1, false))
+
+	// Writing to a file in a new commit appends to it.
+	commit2 := startCommit(t, d, repo, "master")
+	putFile(t, d, repo, commit2.ID, "foo", "foo\n")
+	require.NoError(t, d.FinishCommit(commit2, false))
+	require.Equal(t, "foo\nfoo\n", getFile(t, d, repo, commit1.ID, "foo", nil))
+	require.Equal(t, "foo\nfoo\nfoo\n", getFile(t, d, repo, commit2.ID, "foo", nil))
+	require.Equal(t, "bar\n", getFile(t, d, repo, commit2.ID, "/dir/bar", nil))
+
+	// Reading a directory is an error.
+	_, err := d.GetFile(context.Background(), pclient.NewFile(repo, commit2.ID, "dir"), nil, 0, 0, nil)
+	require.YesError(t, err)
+	_, err = d.GetFile(context.Background(), pclient.NewFile(repo, commit2.ID, "nonexistent"), nil, 0, 0, nil)
+	require.YesError(t, err)
+}
+
+func testPutFileConcurrent(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	commit := startCommit(t, d, repo, "master")
+	numWorkers := 10
+	numPuts := 10
+	line := "foo\n"
+	var wg sync.WaitGroup
+	errCh := make(chan error, 2*numWorkers*numPuts)
+	for i := 0; i < numWorkers; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < numPuts; j++ {
+				errCh <- d.PutFile(context.Background(), pclient.NewFile(repo, commit.ID, "shared"), pfs.Delimiter_LINE, strings.NewReader(line))
+				errCh <- d.PutFile(context.Background(), pclient.NewFile(repo, commit.ID, fmt.Sprintf("dir%d/file%d", i, j)), pfs.Delimiter_LINE, strings.NewReader(line))
+			}
+		}()
+	}
+	wg.Wait()
+	close(errCh)
+	for err := range errCh {
+		require.NoError(t, err)
+	}
+	require.NoError(t, d.FinishCommit(commit, false))
+
+	require.Equal(t, strings.Repeat(line, numWorkers*numPuts), getFile(t, d, repo, commit.ID, "shared", nil))
+	fileInfos, err := d.ListFile(pclient.NewFile(repo, commit.ID, ""), nil, nil, drive.ListFileNORMAL)
+	require.NoError(t, err)
+	require.Equal(t, numWorkers+1, len(fileInfos))
+	for i := 0; i < numWorkers; i++ {
+		fileInfos, err := d.ListFile(pclient.NewFile(repo, commit.ID, fmt.Sprintf("dir%d", i)), nil, nil, drive.ListFileNORMAL)
+		require.NoError(t, err)
+		require.Equal(t, numPuts, len(fileInfos))
+	}
+	commitInfo, err := d.InspectCommit(commit)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2*numWorkers*numPuts*len(line)), commitInfo.SizeBytes)
+}
+
+func testPutFileTypeConflict(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	commit1 := startCommit(t, d, repo, "master")
+	putFile(t, d, repo, commit1.ID, "dir/file", "foo\n")
+	require.YesError(t, d.PutFile(context.Background(), pclient.NewFile(repo, commit1.ID, "dir"), pfs.Delimiter_LINE, strings.NewReader("foo\n")))
+	require.NoError(t, d.FinishCommit(commit1, false))
+
+	// The conflict persists across commits.
+	commit2 := startCommit(t, d, repo, "master")
+	require.YesError(t, d.PutFile(context.Background(), pclient.NewFile(repo, commit2.ID, "dir"), pfs.Delimiter_LINE, strings.NewReader("foo\n")))
+	require.YesError(t, d.MakeDirectory(pclient.NewFile(repo, commit2.ID, "dir/file")))
+}
+
+func testUpload(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	commit := startCommit(t, d, repo, "master")
+	putFile(t, d, repo, commit.ID, "foo", "foo\n")
+	upload, err := d.BeginUpload(pclient.NewFile(repo, "master", "foo"), pfs.Delimiter_LINE)
+	require.NoError(t, err)
+
+	// Parts can be written out of order, and writing a part again replaces
+	// it.
+	require.NoError(t, d.UploadPart(context.Background(), upload, 8, strings.NewReader("baz\n")))
+	require.NoError(t, d.UploadPart(context.Background(), upload, 0, strings.NewReader("xxx\n")))
+	require.NoError(t, d.UploadPart(context.Background(), upload, 0, strings.NewReader("bar\n")))
+	uploadInfo, err := d.InspectUpload(upload)
+	require.NoError(t, err)
+	require.Equal(t, commit.ID, uploadInfo.File.Commit.ID)
+	require.Equal(t, 2, len(uploadInfo.Parts))
+	require.Equal(t, uint64(0), uploadInfo.Parts[0].OffsetBytes)
+	require.Equal(t, uint64(8), uploadInfo.Parts[1].OffsetBytes)
+
+	// The parts must cover the file with no gaps.
+	require.YesError(t, d.CompleteUpload(upload, 12))
+	require.NoError(t, d.UploadPart(context.Background(), upload, 4, strings.NewReader("bar\n")))
+	require.YesError(t, d.CompleteUpload(upload, 16))
+	require.NoError(t, d.CompleteUpload(upload, 12))
+	require.Equal(t, "foo\nbar\nbar\nbaz\n", getFile(t, d, repo, commit.ID, "foo", nil))
+
+	// A completed upload is gone.
+	_, err = d.InspectUpload(upload)
+	require.YesError(t, err)
+	require.YesError(t, d.CompleteUpload(upload, 12))
+	_, err = d.InspectUpload(nil)
+	require.YesError(t, err)
+
+	// Uploads can't be completed after their commit has finished, so
+	// they're deleted.
+	upload, err = d.BeginUpload(pclient.NewFile(repo, commit.ID, "bar"), pfs.Delimiter_LINE)
+	require.NoError(t, err)
+	require.NoError(t, d.UploadPart(context.Background(), upload, 0, strings.NewReader("bar\n")))
+	require.NoError(t, d.FinishCommit(commit, false))
+	_, err = d.InspectUpload(upload)
+	require.YesError(t, err)
+	require.YesError(t, d.CompleteUpload(upload, 4))
+	_, err = d.BeginUpload(pclient.NewFile(repo, commit.ID, "baz"), pfs.Delimiter_LINE)
+	require.YesError(t, err)
+
+	// Uploads are deleted with their repo.
+	commit = startCommit(t, d, repo, "master")
+	upload, err = d.BeginUpload(pclient.NewFile(repo, commit.ID, "bar"), pfs.Delimiter_LINE)
+	require.NoError(t, err)
+	require.NoError(t, d.DeleteRepo(pclient.NewRepo(repo), false))
+	_, err = d.InspectUpload(upload)
+	require.YesError(t, err)
+}
+
+func testGetFileOffset(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	commit := startCommit(t, d, repo, "master")
+	putFile(t, d, repo, commit.ID, "file", "foo\n")
+	putFile(t, d, repo, commit.ID, "file", "bar\nbaz\n")
+	require.NoError(t, d.FinishCommit(commit, false))
+
+	for _, c := range []struct {
+		offset   int64
+		size     int64
+		expected string
+	}{
+		{0, 0, "foo\nbar\nbaz\n"},
+		{2, 0, "o\nbar\nbaz\n"},
+		{4, 4, "bar\n"},
+		{3, 3, "\nba"},
+		{12, 0, ""},
+	} {
+		reader, err := d.GetFile(context.Background(), pclient.NewFile(repo, commit.ID, "file"), nil, c.offset, c.size, nil)
+		require.NoError(t, err)
+		value, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		require.Equal(t, c.expected, string(value))
+	}
+}
+
+func testMakeDirectory(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	commit := startCommit(t, d, repo, "master")
+	require.NoError(t, d.MakeDirectory(pclient.NewFile(repo, commit.ID, "dir")))
+	fileInfo, err := d.InspectFile(pclient.NewFile(repo, commit.ID, "dir"), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, pfs.FileType_FILE_TYPE_DIR, fileInfo.FileType)
+	putFile(t, d, repo, commit.ID, "dir/file", "foo\n")
+	require.NoError(t, d.FinishCommit(commit, false))
+
+	fileInfos, err := d.ListFile(pclient.NewFile(repo, commit.ID, "dir"), nil, nil, drive.ListFileNORMAL)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(fileInfos))
+	require.Equal(t, "/dir/file", fileInfos[0].File.Path)
+}
+
+func testInspectFile(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	commit1 := startCommit(t, d, repo, "master")
+	putFile(t, d, repo, commit1.ID, "dir/foo", "foo\n")
+	require.NoError(t, d.FinishCommit(commit1, false))
+	commit2 := startCommit(t, d, repo, "master")
+	putFile(t, d, repo, commit2.ID, "dir/foo", "foo\n")
+	putFile(t, d, repo, commit2.ID, "dir/bar", "bar\n")
+	require.NoError(t, d.FinishCommit(commit2, false))
+
+	fileInfo, err := d.InspectFile(pclient.NewFile(repo, commit2.ID, "dir/foo"), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, pfs.FileType_FILE_TYPE_REGULAR, fileInfo.FileType)
+	require.Equal(t, uint64(8), fileInfo.SizeBytes)
+	require.Equal(t, commit2, fileInfo.CommitModified)
+	require.NotNil(t, fileInfo.Modified)
+	require.Equal(t, pfs.Delimiter_LINE, fileInfo.Delimiter)
+
+	fileInfo, err = d.InspectFile(pclient.NewFile(repo, commit2.ID, "dir"), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, pfs.FileType_FILE_TYPE_DIR, fileInfo.FileType)
+	require.Equal(t, 2, len(fileInfo.Children))
+
+	// Files have the delimiter they were last written with.
+	commit3 := startCommit(t, d, repo, "master")
+	require.NoError(t, d.PutFile(context.Background(), pclient.NewFile(repo, commit3.ID, "dir/foo"), pfs.Delimiter_JSON, strings.NewReader(`{"foo":1}`)))
+	require.NoError(t, d.FinishCommit(commit3, false))
+	fileInfo, err = d.InspectFile(pclient.NewFile(repo, commit3.ID, "dir/foo"), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, pfs.Delimiter_JSON, fileInfo.Delimiter)
+	fileInfos, err := d.ListFile(pclient.NewFile(repo, commit3.ID, "dir"), nil, nil, drive.ListFileNORMAL)
+	require.NoError(t, err)
+	for _, fileInfo := range fileInfos {
+		if fileInfo.File.Path == "/dir/foo" {
+			require.Equal(t, pfs.Delimiter_JSON, fileInfo.Delimiter)
+		} else {
+			require.Equal(t, pfs.Delimiter_LINE, fileInfo.Delimiter)
+		}
+	}
+
+	fileInfo, err = d.InspectFile(pclient.NewFile(repo, commit1.ID, "dir/foo"), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), fileInfo.SizeBytes)
+	_, err = d.InspectFile(pclient.NewFile(repo, commit1.ID, "dir/bar"), nil, nil)
+	require.YesError(t, err)
+}
+
+func testListFile(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	commit := startCommit(t, d, repo, "master")
+	putFile(t, d, repo, commit.ID, "foo", "foo\n")
+	putFile(t, d, repo, commit.ID, "dir/bar", "bar\n")
+	putFile(t, d, repo, commit.ID, "dir/subdir/baz", "baz\n")
+	require.NoError(t, d.FinishCommit(commit, false))
+
+	fileInfos, err := d.ListFile(pclient.NewFile(repo, commit.ID, ""), nil, nil, drive.ListFileNORMAL)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(fileInfos))
+	sizes := make(map[string]uint64)
+	for _, fileInfo := range fileInfos {
+		sizes[fileInfo.File.Path] = fileInfo.SizeBytes
+	}
+	require.Equal(t, uint64(4), sizes["/foo"])
+
+	fileInfos, err = d.ListFile(pclient.NewFile(repo, commit.ID, ""), nil, nil, drive.ListFileRECURSE)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(fileInfos))
+	for _, fileInfo := range fileInfos {
+		if fileInfo.File.Path == "/dir" {
+			require.Equal(t, uint64(8), fileInfo.SizeBytes)
+		}
+	}
+
+	fileInfos, err = d.ListFile(pclient.NewFile(repo, commit.ID, ""), nil, nil, drive.ListFileFAST)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(fileInfos))
+	for _, fileInfo := range fileInfos {
+		require.Equal(t, uint64(0), fileInfo.SizeBytes)
+	}
+
+	fileInfos, err = d.ListFile(pclient.NewFile(repo, commit.ID, "dir"), nil, nil, drive.ListFileNORMAL)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(fileInfos))
+
+	// Listing a file returns just that file.
+	fileInfos, err = d.ListFile(pclient.NewFile(repo, commit.ID, "foo"), nil, nil, drive.ListFileNORMAL)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(fileInfos))
+	require.Equal(t, "/foo", fileInfos[0].File.Path)
+
+	_, err = d.ListFile(pclient.NewFile(repo, commit.ID, "nonexistent"), nil, nil, drive.ListFileNORMAL)
+	require.YesError(t, err)
+}
+
+func testDeleteFile(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	commit1 := startCommit(t, d, repo, "master")
+	putFile(t, d, repo, commit1.ID, "foo", "foo\n")
+	putFile(t, d, repo, commit1.ID, "dir/bar", "bar\n")
+	putFile(t, d, repo, commit1.ID, "dir/baz", "baz\n")
+	require.NoError(t, d.FinishCommit(commit1, false))
+
+	commit2 := startCommit(t, d, repo, "master")
+	require.NoError(t, d.DeleteFile(pclient.NewFile(repo, commit2.ID, "foo")))
+	require.NoError(t, d.DeleteFile(pclient.NewFile(repo, commit2.ID, "dir")))
+	// A deleted file can be written again in the same commit.
+	putFile(t, d, repo, commit2.ID, "dir/baz", "new\n")
+	require.NoError(t, d.FinishCommit(commit2, false))
+
+	_, err := d.InspectFile(pclient.NewFile(repo, commit2.ID, "foo"), nil, nil)
+	require.YesError(t, err)
+	_, err = d.InspectFile(pclient.NewFile(repo, commit2.ID, "dir/bar"), nil, nil)
+	require.YesError(t, err)
+	require.Equal(t, "new\n", getFile(t, d, repo, commit2.ID, "dir/baz", nil))
+	fileInfos, err := d.ListFile(pclient.NewFile(repo, commit2.ID, ""), nil, nil, drive.ListFileNORMAL)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(fileInfos))
+
+	// The files are still in the parent commit.
+	require.Equal(t, "foo\n", getFile(t, d, repo, commit1.ID, "foo", nil))
+	require.Equal(t, "baz\n", getFile(t, d, repo, commit1.ID, "dir/baz", nil))
+}
+
+func testDiffMethod(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	commit1 := startCommit(t, d, repo, "master")
+	putFile(t, d, repo, commit1.ID, "file", "foo\n")
+	putFile(t, d, repo, commit1.ID, "old", "old\n")
+	require.NoError(t, d.FinishCommit(commit1, false))
+	commit2 := startCommit(t, d, repo, "master")
+	putFile(t, d, repo, commit2.ID, "file", "bar\n")
+	putFile(t, d, repo, commit2.ID, "new", "new\n")
+	require.NoError(t, d.FinishCommit(commit2, false))
+
+	since := &pfs.DiffMethod{FromCommit: commit1}
+	full := &pfs.DiffMethod{FromCommit: commit1, FullFile: true}
+
+	// Without FullFile only the changes since FromCommit are returned.
+	require.Equal(t, "bar\n", getFile(t, d, repo, commit2.ID, "file", since))
+	require.Equal(t, "foo\nbar\n", getFile(t, d, repo, commit2.ID, "file", full))
+	_, err := d.GetFile(context.Background(), pclient.NewFile(repo, commit2.ID, "old"), nil, 0, 0, since)
+	require.YesError(t, err)
+
+	fileInfo, err := d.InspectFile(pclient.NewFile(repo, commit2.ID, "file"), nil, since)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), fileInfo.SizeBytes)
+	fileInfo, err = d.InspectFile(pclient.NewFile(repo, commit2.ID, "file"), nil, full)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), fileInfo.SizeBytes)
+
+	fileInfos, err := d.ListFile(pclient.NewFile(repo, commit2.ID, ""), nil, since, drive.ListFileNORMAL)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(fileInfos))
+	fileInfos, err = d.ListFile(pclient.NewFile(repo, commit2.ID, ""), nil, full, drive.ListFileNORMAL)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(fileInfos))
+}
+
+func testShardFiltering(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	commit := startCommit(t, d, repo, "master")
+	numFiles := 100
+	content := "foo\n"
+	for i := 0; i < numFiles; i++ {
+		putFile(t, d, repo, commit.ID, fmt.Sprintf("file%d", i), content)
+	}
+	require.NoError(t, d.FinishCommit(commit, false))
+
+	totalSize := func(fileInfos []*pfs.FileInfo) int {
+		var size int
+		for _, fileInfo := range fileInfos {
+			size += int(fileInfo.SizeBytes)
+		}
+		return size
+	}
+	for _, shards := range [][]*pfs.Shard{
+		{{FileNumber: 0, FileModulus: 2}, {FileNumber: 1, FileModulus: 2}},
+		{{BlockNumber: 0, BlockModulus: 2}, {BlockNumber: 1, BlockModulus: 2}},
+	} {
+		var total int
+		var count int
+		for _, shard := range shards {
+			fileInfos, err := d.ListFile(pclient.NewFile(repo, commit.ID, ""), shard, nil, drive.ListFileNORMAL)
+			require.NoError(t, err)
+			require.True(t, len(fileInfos) > 0)
+			total += totalSize(fileInfos)
+			count += len(fileInfos)
+		}
+		require.Equal(t, numFiles*len(content), total)
+		require.Equal(t, numFiles, count)
+	}
+
+	// Each file is in exactly one shard.
+	var found int
+	for i := uint64(0); i < 2; i++ {
+		if _, err := d.GetFile(context.Background(), pclient.NewFile(repo, commit.ID, "file0"), &pfs.Shard{FileNumber: i, FileModulus: 2}, 0, 0, nil); err == nil {
+			found++
+		}
+	}
+	require.Equal(t, 1, found)
+
+	// A zero modulus means no filtering.
+	fileInfos, err := d.ListFile(pclient.NewFile(repo, commit.ID, ""), &pfs.Shard{}, nil, drive.ListFileNORMAL)
+	require.NoError(t, err)
+	require.Equal(t, numFiles, len(fileInfos))
+
+	// Files are sharded by their top level directory, so every file in a
+	// directory is in the same file shard.
+	commit = startCommit(t, d, repo, "master")
+	var paths []string
+	for i := 0; i < 10; i++ {
+		paths = append(paths, fmt.Sprintf("dir/file%d", i))
+		putFile(t, d, repo, commit.ID, paths[i], content)
+	}
+	require.NoError(t, d.FinishCommit(commit, false))
+	for i := uint64(0); i < 2; i++ {
+		shard := &pfs.Shard{FileNumber: i, FileModulus: 2}
+		_, dirErr := d.InspectFile(pclient.NewFile(repo, commit.ID, "dir"), shard, nil)
+		for _, path := range paths {
+			_, err := d.InspectFile(pclient.NewFile(repo, commit.ID, path), shard, nil)
+			require.Equal(t, dirErr == nil, err == nil)
+		}
+	}
+}
+
+func testFileHash(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	commit := startCommit(t, d, repo, "master")
+	dirs := []string{"a/x", "a/y", "b/x", "b/y"}
+	var paths []string
+	for _, dir := range dirs {
+		for i := 0; i < 5; i++ {
+			paths = append(paths, fmt.Sprintf("%s/file%d", dir, i))
+			putFile(t, d, repo, commit.ID, paths[len(paths)-1], "foo\n")
+		}
+	}
+	require.NoError(t, d.FinishCommit(commit, false))
+
+	modulus := uint64(3)
+	// shardOf returns the only shard the file at p is in.
+	shardOf := func(fileHash *pfs.FileHash, p string) uint64 {
+		var shards []uint64
+		for i := uint64(0); i < modulus; i++ {
+			shard := &pfs.Shard{FileNumber: i, FileModulus: modulus, FileHash: fileHash}
+			if _, err := d.InspectFile(pclient.NewFile(repo, commit.ID, p), shard, nil); err == nil {
+				shards = append(shards, i)
+			}
+		}
+		require.Equal(t, 1, len(shards))
+		return shards[0]
+	}
+	for _, fileHash := range []*pfs.FileHash{
+		{Method: pfs.FileHashMethod_PATH},
+		{Method: pfs.FileHashMethod_DIRECTORY},
+		{Method: pfs.FileHashMethod_PREFIX_RANGE},
+		{Method: pfs.FileHashMethod_KEY, Key: "^[^/]*/([^/]*)/"},
+	} {
+		var count int
+		for i := uint64(0); i < modulus; i++ {
+			shard := &pfs.Shard{FileNumber: i, FileModulus: modulus, FileHash: fileHash}
+			// Directories are in every shard.
+			_, err := d.InspectFile(pclient.NewFile(repo, commit.ID, "a/x"), shard, nil)
+			require.NoError(t, err)
+			for _, dir := range dirs {
+				fileInfos, err := d.ListFile(pclient.NewFile(repo, commit.ID, dir), shard, nil, drive.ListFileNORMAL)
+				require.NoError(t, err)
+				count += len(fileInfos)
+			}
+		}
+		require.Equal(t, len(paths), count)
+
+		shards := make(map[string]uint64)
+		for _, p := range paths {
+			shards[p] = shardOf(fileHash, p)
+		}
+		switch fileHash.Method {
+		case pfs.FileHashMethod_DIRECTORY:
+			for _, p := range paths {
+				require.Equal(t, shards[path.Dir(p)+"/file0"], shards[p])
+			}
+		case pfs.FileHashMethod_PREFIX_RANGE:
+			// paths is sorted, so the shards are too.
+			for i := 1; i < len(paths); i++ {
+				require.True(t, shards[paths[i-1]] <= shards[paths[i]])
+			}
+		case pfs.FileHashMethod_KEY:
+			// Files with the same second path component share a shard.
+			for _, p := range paths {
+				require.Equal(t, shards["a/"+strings.Split(p, "/")[1]+"/file0"], shards[p])
+			}
+		}
+	}
+}
+
+func testGlob(t *testing.T, d drive.Driver) {
+	repo := "test"
+	require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+
+	commit := startCommit(t, d, repo, "master")
+	var paths []string
+	for _, dir := range []string{"a/x", "a/y", "b/x", "b/y"} {
+		for i := 0; i < 5; i++ {
+			paths = append(paths, fmt.Sprintf("%s/file%d", dir, i))
+			putFile(t, d, repo, commit.ID, paths[len(paths)-1], "foo\n")
+		}
+	}
+	paths = append(paths, "readme")
+	putFile(t, d, repo, commit.ID, "readme", "foo\n")
+	require.NoError(t, d.FinishCommit(commit, false))
+
+	modulus := uint64(3)
+	// shardsOf returns the shards the file at p is in.
+	shardsOf := func(p string) []uint64 {
+		var shards []uint64
+		for i := uint64(0); i < modulus; i++ {
+			shard := &pfs.Shard{FileNumber: i, FileModulus: modulus, Glob: "*/x"}
+			if _, err := d.InspectFile(pclient.NewFile(repo, commit.ID, p), shard, nil); err == nil {
+				shards = append(shards, i)
+			}
+		}
+		return shards
+	}
+	// Directories which can contain datums are in every shard.
+	require.Equal(t, int(modulus), len(shardsOf("a")))
+	for _, p := range paths {
+		shards := shardsOf(p)
+		if !strings.HasPrefix(p, "a/x/") && !strings.HasPrefix(p, "b/x/") {
+			// Files outside of the datums are in no shard.
+			require.Equal(t, 0, len(shards), p)
+			continue
+		}
+		// The files of a datum are all in the same shard.
+		require.Equal(t, 1, len(shards), p)
+		require.Equal(t, shardsOf(path.Dir(p)+"/file0"), shards)
+	}
+}
+
+func testArchiveCommit(t *testing.T, d drive.Driver) {
+	require.NoError(t, d.CreateRepo(pclient.NewRepo("A"), nil))
+	require.NoError(t, d.CreateRepo(pclient.NewRepo("B"), []*pfs.Repo{pclient.NewRepo("A")}))
+	fromCommits := []*pfs.Commit{pclient.NewCommit("A", ""), pclient.NewCommit("B", "")}
+
+	commitA := startCommit(t, d, "A", "master")
+	require.NoError(t, d.FinishCommit(commitA, false))
+	commitB, err := d.StartCommit(pclient.NewCommit("B", "master"), []*pfs.Commit{commitA})
+	require.NoError(t, err)
+	require.NoError(t, d.FinishCommit(commitB, false))
+
+	commitInfos, err := d.ListCommit(fromCommits, nil, pfs.CommitType_COMMIT_TYPE_NONE, pfs.CommitStatus_NORMAL, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commitInfos))
+
+	// Archiving a commit archives the commits it's the provenance of.
+	require.NoError(t, d.ArchiveCommit([]*pfs.Commit{commitA}))
+	commitInfos, err = d.ListCommit(fromCommits, nil, pfs.CommitType_COMMIT_TYPE_NONE, pfs.CommitStatus_NORMAL, false)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(commitInfos))
+	commitInfos, err = d.ListCommit(fromCommits, nil, pfs.CommitType_COMMIT_TYPE_NONE, pfs.CommitStatus_ARCHIVED, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commitInfos))
+	commitInfo, err := d.InspectCommit(commitB)
+	require.NoError(t, err)
+	require.True(t, commitInfo.Archived)
+
+	// Commits whose provenance is archived are archived on creation.
+	commitB2, err := d.StartCommit(pclient.NewCommit("B", "master"), []*pfs.Commit{commitA})
+	require.NoError(t, err)
+	require.NoError(t, d.FinishCommit(commitB2, false))
+	commitInfo, err = d.InspectCommit(commitB2)
+	require.NoError(t, err)
+	require.True(t, commitInfo.Archived)
+	commitInfos, err = d.ListCommit(fromCommits, nil, pfs.CommitType_COMMIT_TYPE_NONE, pfs.CommitStatus_ALL, false)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(commitInfos))
+	branches, err := d.ListBranch(pclient.NewRepo("B"), pfs.CommitStatus_NORMAL)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(branches))
+}
+
+func testArchiveAll(t *testing.T, d drive.Driver) {
+	for _, repo := range []string{"A", "B"} {
+		require.NoError(t, d.CreateRepo(pclient.NewRepo(repo), nil))
+		commit := startCommit(t, d, repo, "master")
+		putFile(t, d, repo, commit.ID, "foo", "foo\n")
+		require.NoError(t, d.FinishCommit(commit, false))
+	}
+	require.NoError(t, d.ArchiveAll())
+
+	commitInfos, err := d.ListCommit([]*pfs.Commit{pclient.NewCommit("A", ""), pclient.NewCommit("B", "")}, nil, pfs.CommitType_COMMIT_TYPE_NONE, pfs.CommitStatus_NORMAL, false)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(commitInfos))
+	// Archived commits are still readable, and new commits can be made on
+	// top of them.
+	require.Equal(t, "foo\n", getFile(t, d, "A", "master/0", "foo", nil))
+	commit := startCommit(t, d, "A", "master")
+	require.Equal(t, "master/1", commit.ID)
+	require.NoError(t, d.FinishCommit(commit, false))
+	commitInfos, err = d.ListCommit([]*pfs.Commit{pclient.NewCommit("A", "")}, nil, pfs.CommitType_COMMIT_TYPE_NONE, pfs.CommitStatus_NORMAL, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(commitInfos))
+}
+
+func testDeleteAll(t *testing.T, d drive.Driver) {
+	require.NoError(t, d.CreateRepo(pclient.NewRepo("A"), nil))
+	commit := startCommit(t, d, "A", "master")
+	putFile(t, d, "A", commit.ID, "foo", "foo\n")
+	require.NoError(t, d.FinishCommit(commit, false))
+	require.NoError(t, d.DeleteAll())
+
+	repoInfos, err := d.ListRepo(nil)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(repoInfos))
+	_, err = d.InspectCommit(commit)
+	require.YesError(t, err)
+
+	// The driver is still usable afterwards.
+	require.NoError(t, d.CreateRepo(pclient.NewRepo("A"), nil))
+	commit = startCommit(t, d, "A", "master")
+	require.Equal(t, "master/0", commit.ID)
+	_, err = d.InspectFile(pclient.NewFile("A", commit.ID, "foo"), nil, nil)
+	require.YesError(t, err)
+	d.Dump()
+}
+
+func startCommit(t *testing.T, d drive.Driver, repo string, parentID string) *pfs.Commit {
+	commit, err := d.StartCommit(pclient.NewCommit(repo, parentID), nil)
+	require.NoError(t, err)
+	return commit
+}
+
+func putFile(t *testing.T, d drive.Driver, repo string, commitID string, path string, content string) {
+	require.NoError(t, d.PutFile(context.Background(), pclient.NewFile(repo, commitID, path), pfs.Delimiter_LINE, strings.NewReader(content)))
+}
+
+func getFile(t *testing.T, d drive.Driver, repo string, commitID string, path string, diffMethod *pfs.DiffMethod) string {
+	reader, err := d.GetFile(context.Background(), pclient.NewFile(repo, commitID, path), nil, 0, 0, diffMethod)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, reader.Close())
+	}()
+	value, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	return string(value)
+}
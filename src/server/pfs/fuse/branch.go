@@ -0,0 +1,178 @@
+package fuse
+
+import (
+	"time"
+
+	"bazil.org/fuse"
+	"github.com/sjezewski/pachyderm/src/client"
+	pfsclient "github.com/sjezewski/pachyderm/src/client/pfs"
+	"go.pedge.io/lion/proto"
+)
+
+// followBranchBackoff is how long a branch follower waits before asking
+// pfs for new commits again, after an error or after being woken up by
+// commits on other branches.
+const followBranchBackoff = time.Second
+
+type branchKey struct {
+	repo   string
+	branch string
+}
+
+// branchHead returns the newest finished commit on a branch.  The first
+// call for a branch starts following it, so that later calls return the
+// new head as soon as a commit finishes on the branch.
+func (f *filesystem) branchHead(repo string, branch string) (string, error) {
+	key := branchKey{repo, branch}
+	f.branchLock.Lock()
+	head, ok := f.branchHeads[key]
+	f.branchLock.Unlock()
+	if ok {
+		return head, nil
+	}
+	head, err := f.lastFinishedCommit(repo, branch)
+	if err != nil {
+		return "", err
+	}
+	f.branchLock.Lock()
+	defer f.branchLock.Unlock()
+	if head, ok := f.branchHeads[key]; ok {
+		return head, nil
+	}
+	f.branchHeads[key] = head
+	go f.followBranch(repo, branch, head)
+	return head, nil
+}
+
+// isBranch returns true if name is one of repo's branches, rather than a
+// commit.
+func (f *filesystem) isBranch(repo string, name string) (bool, error) {
+	f.branchLock.Lock()
+	_, ok := f.branchHeads[branchKey{repo, name}]
+	f.branchLock.Unlock()
+	if ok {
+		return true, nil
+	}
+	branches, err := f.apiClient.ListBranch(repo, pfsclient.CommitStatus_NORMAL)
+	if err != nil {
+		return false, err
+	}
+	for _, branch := range branches {
+		if branch == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// lastFinishedCommit returns the ID of the newest finished commit on branch,
+// walking back from the branch's head, which may still be open.
+func (f *filesystem) lastFinishedCommit(repo string, branch string) (string, error) {
+	commitInfo, err := f.apiClient.InspectCommit(repo, branch)
+	if err != nil {
+		return "", err
+	}
+	for commitInfo.CommitType != pfsclient.CommitType_COMMIT_TYPE_READ || commitInfo.Cancelled {
+		if commitInfo.ParentCommit == nil {
+			return "", fuse.ENOENT
+		}
+		commitInfo, err = f.apiClient.InspectCommit(repo, commitInfo.ParentCommit.ID)
+		if err != nil {
+			return "", err
+		}
+	}
+	return commitInfo.Commit.ID, nil
+}
+
+// followBranch waits for commits to finish on branch and switches the
+// branch's directory to them, until the filesystem is unmounted.  The
+// blocking ListCommit uses the filesystem's context, so that unmounting
+// cancels it.
+func (f *filesystem) followBranch(repo string, branch string, head string) {
+	for {
+		commitInfos, err := f.apiClient.PfsAPIClient.ListCommit(
+			f.ctx,
+			&pfsclient.ListCommitRequest{
+				FromCommits: []*pfsclient.Commit{client.NewCommit(repo, head)},
+				CommitType:  client.CommitTypeRead,
+				Status:      pfsclient.CommitStatus_NORMAL,
+				Block:       true,
+			},
+		)
+		if err != nil {
+			if f.ctx.Err() != nil {
+				return
+			}
+			protolion.Errorf("error following branch %s/%s: %s", repo, branch, err.Error())
+			if !f.backOff() {
+				return
+			}
+			continue
+		}
+		// ListCommit returns the commits that descend from head, which
+		// includes commits on branches started from this one.
+		newHead := ""
+		for _, commitInfo := range commitInfos.CommitInfo {
+			if commitInfo.Branch == branch {
+				newHead = commitInfo.Commit.ID
+			}
+		}
+		if newHead == "" {
+			if !f.backOff() {
+				return
+			}
+			continue
+		}
+		head = newHead
+		f.setBranchHead(repo, branch, head)
+	}
+}
+
+// backOff waits for followBranchBackoff, it returns false if the filesystem
+// is unmounted first.
+func (f *filesystem) backOff() bool {
+	select {
+	case <-f.ctx.Done():
+		return false
+	case <-time.After(followBranchBackoff):
+		return true
+	}
+}
+
+// setBranchHead switches a branch to a new head and makes the kernel forget
+// the branch's directory, so that it's looked up again.  Nodes which were
+// looked up under the old head, and their open handles, keep reading it.
+func (f *filesystem) setBranchHead(repo string, branch string, head string) {
+	f.branchLock.Lock()
+	f.branchHeads[branchKey{repo, branch}] = head
+	repoDir := f.repoDirs[repo]
+	f.branchLock.Unlock()
+	if f.server == nil || repoDir == nil {
+		return
+	}
+	if err := f.server.InvalidateEntry(repoDir, branch); err != nil && err != fuse.ErrNotCached {
+		protolion.Errorf("error invalidating branch %s/%s: %s", repo, branch, err.Error())
+	}
+}
+
+// repoDir returns the directory for a repo, the same node is used for every
+// lookup of the repo so that its entries can be invalidated.
+func (f *filesystem) repoDir(repo string, newDir func() (*directory, error)) (*directory, error) {
+	f.branchLock.Lock()
+	dir, ok := f.repoDirs[repo]
+	f.branchLock.Unlock()
+	if ok {
+		return dir, nil
+	}
+	dir, err := newDir()
+	if err != nil {
+		return nil, err
+	}
+	f.branchLock.Lock()
+	defer f.branchLock.Unlock()
+	if existing, ok := f.repoDirs[repo]; ok {
+		return existing, nil
+	}
+	f.repoDirs[repo] = dir
+	return dir, nil
+}
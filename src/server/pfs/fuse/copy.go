@@ -0,0 +1,185 @@
+package fuse
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/sjezewski/pachyderm/src/client"
+	pfsclient "github.com/sjezewski/pachyderm/src/client/pfs"
+)
+
+// CopyIn and CopyOut are an alternative to mounting commits for environments
+// where FUSE isn't available.  CopyIn downloads the commits to a local
+// directory, laid out the way Mount presents them, and CopyOut uploads what
+// was written to it.
+
+// CopyIn downloads the files in commitMounts to dir/<alias or repo>,
+// respecting each commit mount's shard and diff method.  The directories of
+// open commits are created empty, files written to them are appended to the
+// commits by CopyOut.  Anything already in the directories, such as what was
+// copied in or written before a container restarted, is removed first.
+func CopyIn(apiClient *client.APIClient, dir string, commitMounts []*CommitMount) error {
+	for _, commitMount := range commitMounts {
+		commitDir := filepath.Join(dir, commitMountName(commitMount))
+		if err := os.RemoveAll(commitDir); err != nil {
+			return err
+		}
+		if err := os.MkdirAll(commitDir, 0777); err != nil {
+			return err
+		}
+		write, err := isWriteCommit(apiClient, commitMount.Commit)
+		if err != nil {
+			return err
+		}
+		if write {
+			continue
+		}
+		if err := copyInDir(apiClient, commitMount, "", commitDir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CopyOut uploads the files and directories under the directories of the
+// open commits in commitMounts, which CopyIn created.  Files are appended to
+// any content they already have in the commit.
+func CopyOut(apiClient *client.APIClient, dir string, commitMounts []*CommitMount) error {
+	for _, commitMount := range commitMounts {
+		write, err := isWriteCommit(apiClient, commitMount.Commit)
+		if err != nil {
+			return err
+		}
+		if !write {
+			continue
+		}
+		commitDir := filepath.Join(dir, commitMountName(commitMount))
+		if err := filepath.Walk(commitDir, func(localPath string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if localPath == commitDir {
+				return nil
+			}
+			relPath, err := filepath.Rel(commitDir, localPath)
+			if err != nil {
+				return err
+			}
+			filePath := filepath.ToSlash(relPath)
+			if info.IsDir() {
+				return apiClient.MakeDirectory(commitMount.Commit.Repo.Name, commitMount.Commit.ID, filePath)
+			}
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+			file, err := os.Open(localPath)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			_, err = apiClient.PutFileWithDelimiter(
+				commitMount.Commit.Repo.Name,
+				commitMount.Commit.ID,
+				filePath,
+				pathDelimiter(filePath),
+				file,
+			)
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyInDir downloads the directory at filePath in commitMount's commit, and
+// everything under it, to localDir.
+func copyInDir(apiClient *client.APIClient, commitMount *CommitMount, filePath string, localDir string) error {
+	fromCommitID, fullFile := commitMountDiff(commitMount)
+	fileInfos, err := apiClient.ListFile(
+		commitMount.Commit.Repo.Name,
+		commitMount.Commit.ID,
+		filePath,
+		fromCommitID,
+		fullFile,
+		commitMount.Shard,
+		false,
+	)
+	if err != nil {
+		return err
+	}
+	for _, fileInfo := range fileInfos {
+		childPath := strings.TrimPrefix(fileInfo.File.Path, "/")
+		localPath := filepath.Join(localDir, path.Base(childPath))
+		switch fileInfo.FileType {
+		case pfsclient.FileType_FILE_TYPE_REGULAR:
+			if err := copyInFile(apiClient, commitMount, childPath, localPath); err != nil {
+				return err
+			}
+		case pfsclient.FileType_FILE_TYPE_DIR:
+			if err := os.MkdirAll(localPath, 0777); err != nil {
+				return err
+			}
+			if err := copyInDir(apiClient, commitMount, childPath, localPath); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func copyInFile(apiClient *client.APIClient, commitMount *CommitMount, filePath string, localPath string) (retErr error) {
+	file, err := os.Create(localPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := file.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	fromCommitID, fullFile := commitMountDiff(commitMount)
+	return apiClient.GetFile(
+		commitMount.Commit.Repo.Name,
+		commitMount.Commit.ID,
+		filePath,
+		0,
+		0,
+		fromCommitID,
+		fullFile,
+		commitMount.Shard,
+		file,
+	)
+}
+
+// commitMountName returns the name of the directory a commit mount appears
+// as.
+func commitMountName(commitMount *CommitMount) string {
+	if commitMount.Alias != "" {
+		return commitMount.Alias
+	}
+	return commitMount.Commit.Repo.Name
+}
+
+// commitMountDiff returns the from commit and full file arguments for
+// reading commitMount's files, like filesystem.getFromCommitID and
+// filesystem.getFullFile.
+func commitMountDiff(commitMount *CommitMount) (string, bool) {
+	if commitMount.DiffMethod == nil {
+		return "", false
+	}
+	if commitMount.DiffMethod.FromCommit == nil {
+		return "", commitMount.DiffMethod.FullFile
+	}
+	return commitMount.DiffMethod.FromCommit.ID, commitMount.DiffMethod.FullFile
+}
+
+func isWriteCommit(apiClient *client.APIClient, commit *pfsclient.Commit) (bool, error) {
+	commitInfo, err := apiClient.InspectCommit(commit.Repo.Name, commit.ID)
+	if err != nil {
+		return false, err
+	}
+	return commitInfo.CommitType != pfsclient.CommitType_COMMIT_TYPE_READ, nil
+}
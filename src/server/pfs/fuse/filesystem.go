@@ -17,7 +17,6 @@ import (
 	"github.com/sjezewski/pachyderm/src/client"
 	pfsclient "github.com/sjezewski/pachyderm/src/client/pfs"
 	"github.com/sjezewski/pachyderm/src/server/pfs/drive"
-	"go.pedge.io/lion/proto"
 	"go.pedge.io/proto/time"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -30,36 +29,71 @@ type filesystem struct {
 	inodes     map[string]uint64
 	lock       sync.RWMutex
 	allCommits bool
+	opts       *Options
+	readCache  *readCache
+	recorder   *recorder
+	// server is used to invalidate the kernel's caches, it's nil in tests
+	// which don't mount the filesystem.
+	server *fs.Server
+	// ctx is cancelled when the filesystem is unmounted, it stops RPCs
+	// made in the background.
+	ctx    context.Context
+	cancel func()
+
+	// branchLock protects branchHeads and repoDirs, which are only used
+	// with Options.FollowBranches.
+	branchLock  sync.Mutex
+	branchHeads map[branchKey]string
+	repoDirs    map[string]*directory
 }
 
+// finishedCommitTTL is how long the kernel caches the attributes and
+// directory entries of finished commits, they never change.
+const finishedCommitTTL = time.Hour
+
 func newFilesystem(
 	apiClient *client.APIClient,
 	shard *pfsclient.Shard,
 	commitMounts []*CommitMount,
 	allCommits bool,
+	opts *Options,
 ) *filesystem {
+	readCacheBytes := opts.ReadCacheBytes
+	if readCacheBytes == 0 {
+		readCacheBytes = defaultReadCacheBytes
+	}
+	ctx, cancel := context.WithCancel(context.Background())
 	return &filesystem{
 		apiClient: apiClient,
 		Filesystem: Filesystem{
 			shard,
 			commitMounts,
 		},
-		inodes:     make(map[string]uint64),
-		allCommits: allCommits,
+		inodes:      make(map[string]uint64),
+		allCommits:  allCommits,
+		opts:        opts,
+		readCache:   newReadCache(readCacheBlockSize, readCacheBytes),
+		recorder:    newRecorder(opts.Trace),
+		ctx:         ctx,
+		cancel:      cancel,
+		branchHeads: make(map[branchKey]string),
+		repoDirs:    make(map[string]*directory),
 	}
 }
 
+// close stops the filesystem's background work, it's called once the
+// filesystem is unmounted.
+func (f *filesystem) close() {
+	f.cancel()
+}
+
 func (f *filesystem) Root() (result fs.Node, retErr error) {
 	defer func() {
-		if retErr == nil {
-			protolion.Debug(&Root{&f.Filesystem, getNode(result), errorToString(retErr)})
-		} else {
-			protolion.Error(&Root{&f.Filesystem, getNode(result), errorToString(retErr)})
-		}
+		f.logOp(&Root{&f.Filesystem, getNode(result), errorToString(retErr)}, retErr)
 	}()
 	return &directory{
-		f,
-		Node{
+		fs: f,
+		Node: Node{
 			File: &pfsclient.File{
 				Commit: &pfsclient.Commit{
 					Repo: &pfsclient.Repo{},
@@ -72,18 +106,24 @@ func (f *filesystem) Root() (result fs.Node, retErr error) {
 type directory struct {
 	fs *filesystem
 	Node
+	// finished is true if the directory is in a finished commit, and so
+	// never changes.  It's false for commits which are referred to by
+	// branch name, since the branch can move.
+	finished bool
+	// followed is true if the directory is a branch which is followed, see
+	// Options.FollowBranches.
+	followed bool
 }
 
 func (d *directory) Attr(ctx context.Context, a *fuse.Attr) (retErr error) {
 	defer func() {
-		if retErr == nil {
-			protolion.Debug(&DirectoryAttr{&d.Node, &Attr{uint32(a.Mode)}, errorToString(retErr)})
-		} else {
-			protolion.Error(&DirectoryAttr{&d.Node, &Attr{uint32(a.Mode)}, errorToString(retErr)})
-		}
+		d.fs.logOp(&DirectoryAttr{&d.Node, &Attr{uint32(a.Mode)}, errorToString(retErr)}, retErr)
 	}()
 
 	a.Valid = time.Nanosecond
+	if d.finished {
+		a.Valid = finishedCommitTTL
+	}
 	if d.Write {
 		a.Mode = os.ModeDir | 0775
 	} else {
@@ -94,21 +134,25 @@ func (d *directory) Attr(ctx context.Context, a *fuse.Attr) (retErr error) {
 	return nil
 }
 
-func (d *directory) Lookup(ctx context.Context, name string) (result fs.Node, retErr error) {
+func (d *directory) Lookup(ctx context.Context, request *fuse.LookupRequest, response *fuse.LookupResponse) (result fs.Node, retErr error) {
+	name := request.Name
 	defer func() {
-		if retErr == nil || retErr == fuse.ENOENT {
-			protolion.Debug(&DirectoryLookup{&d.Node, name, getNode(result), errorToString(retErr)})
-		} else {
-			protolion.Error(&DirectoryLookup{&d.Node, name, getNode(result), errorToString(retErr)})
-		}
+		d.fs.logOp(&DirectoryLookup{&d.Node, name, getNode(result), errorToString(retErr)}, retErr)
 	}()
-	if d.File.Commit.Repo.Name == "" {
-		return d.lookUpRepo(ctx, name)
+	switch {
+	case d.File.Commit.Repo.Name == "":
+		result, retErr = d.lookUpRepo(ctx, name)
+	case d.File.Commit.ID == "":
+		result, retErr = d.lookUpCommit(ctx, name)
+	default:
+		result, retErr = d.lookUpFile(ctx, name)
 	}
-	if d.File.Commit.ID == "" {
-		return d.lookUpCommit(ctx, name)
+	// A followed branch's entry changes when a commit finishes on the
+	// branch, even though the commit it points to doesn't.
+	if retErr == nil && isFinished(result) && !isFollowedBranch(result) {
+		response.EntryValid = finishedCommitTTL
 	}
-	return d.lookUpFile(ctx, name)
+	return result, retErr
 }
 
 func (d *directory) ReadDirAll(ctx context.Context) (result []fuse.Dirent, retErr error) {
@@ -117,11 +161,7 @@ func (d *directory) ReadDirAll(ctx context.Context) (result []fuse.Dirent, retEr
 		for _, dirent := range result {
 			dirents = append(dirents, &Dirent{dirent.Inode, dirent.Name})
 		}
-		if retErr == nil {
-			protolion.Debug(&DirectoryReadDirAll{&d.Node, dirents, errorToString(retErr)})
-		} else {
-			protolion.Error(&DirectoryReadDirAll{&d.Node, dirents, errorToString(retErr)})
-		}
+		d.fs.logOp(&DirectoryReadDirAll{&d.Node, dirents, errorToString(retErr)}, retErr)
 	}()
 	if d.File.Commit.Repo.Name == "" {
 		return d.readRepos(ctx)
@@ -140,11 +180,7 @@ func (d *directory) ReadDirAll(ctx context.Context) (result []fuse.Dirent, retEr
 
 func (d *directory) Create(ctx context.Context, request *fuse.CreateRequest, response *fuse.CreateResponse) (result fs.Node, _ fs.Handle, retErr error) {
 	defer func() {
-		if retErr == nil {
-			protolion.Debug(&DirectoryCreate{&d.Node, getNode(result), errorToString(retErr)})
-		} else {
-			protolion.Error(&DirectoryCreate{&d.Node, getNode(result), errorToString(retErr)})
-		}
+		d.fs.logOp(&DirectoryCreate{&d.Node, getNode(result), errorToString(retErr)}, retErr)
 	}()
 	if d.File.Commit.ID == "" {
 		return nil, 0, fuse.EPERM
@@ -162,6 +198,16 @@ func (d *directory) Create(ctx context.Context, request *fuse.CreateRequest, res
 		}
 		return nil, 0, err
 	}
+	if d.fs.opts.RandomWrites {
+		response.Flags |= fuse.OpenDirectIO
+		spill, err := newSpill(d.fs.opts.SpillDir)
+		if err != nil {
+			return nil, 0, err
+		}
+		handle := localResult.newHandle(0)
+		handle.spill = spill
+		return localResult, handle, nil
+	}
 	response.Flags |= fuse.OpenDirectIO | fuse.OpenNonSeekable
 	handle := localResult.newHandle(0)
 	return localResult, handle, nil
@@ -169,11 +215,7 @@ func (d *directory) Create(ctx context.Context, request *fuse.CreateRequest, res
 
 func (d *directory) Mkdir(ctx context.Context, request *fuse.MkdirRequest) (result fs.Node, retErr error) {
 	defer func() {
-		if retErr == nil {
-			protolion.Debug(&DirectoryMkdir{&d.Node, getNode(result), errorToString(retErr)})
-		} else {
-			protolion.Error(&DirectoryMkdir{&d.Node, getNode(result), errorToString(retErr)})
-		}
+		d.fs.logOp(&DirectoryMkdir{&d.Node, getNode(result), errorToString(retErr)}, retErr)
 	}()
 	if d.File.Commit.ID == "" {
 		return nil, fuse.EPERM
@@ -188,11 +230,7 @@ func (d *directory) Mkdir(ctx context.Context, request *fuse.MkdirRequest) (resu
 
 func (d *directory) Remove(ctx context.Context, req *fuse.RemoveRequest) (retErr error) {
 	defer func() {
-		if retErr == nil {
-			protolion.Debug(&FileRemove{&d.Node, req.Name, req.Dir, errorToString(retErr)})
-		} else {
-			protolion.Error(&FileRemove{&d.Node, req.Name, req.Dir, errorToString(retErr)})
-		}
+		d.fs.logOp(&FileRemove{&d.Node, req.Name, req.Dir, errorToString(retErr)}, retErr)
 	}()
 	return d.fs.apiClient.DeleteFile(d.Node.File.Commit.Repo.Name,
 		d.Node.File.Commit.ID, filepath.Join(d.Node.File.Path, req.Name))
@@ -202,16 +240,14 @@ type file struct {
 	directory
 	size    int64
 	handles []*handle
-	lock    sync.Mutex
+	// hash is the file's content hash, see contentHash.
+	hash string
+	lock sync.Mutex
 }
 
 func (f *file) Attr(ctx context.Context, a *fuse.Attr) (retErr error) {
 	defer func() {
-		if retErr == nil {
-			protolion.Debug(&FileAttr{&f.Node, &Attr{uint32(a.Mode)}, errorToString(retErr)})
-		} else {
-			protolion.Error(&FileAttr{&f.Node, &Attr{uint32(a.Mode)}, errorToString(retErr)})
-		}
+		f.fs.logOp(&FileAttr{&f.Node, &Attr{uint32(a.Mode)}, errorToString(retErr)}, retErr)
 	}()
 	fileInfo, err := f.fs.apiClient.InspectFile(
 		f.File.Commit.Repo.Name,
@@ -228,19 +264,29 @@ func (f *file) Attr(ctx context.Context, a *fuse.Attr) (retErr error) {
 		a.Size = fileInfo.SizeBytes
 		a.Mtime = prototime.TimestampToTime(fileInfo.Modified)
 	}
+	// Writes which haven't been uploaded yet aren't reflected in pfs.
+	size, ok, err := f.spillSize()
+	if err != nil {
+		return err
+	}
+	if ok {
+		a.Size = uint64(size)
+	}
 	a.Mode = 0666
 	a.Inode = f.fs.inode(f.File)
+	if f.finished {
+		a.Valid = finishedCommitTTL
+	}
 	return nil
 }
 
 func (f *file) Setattr(ctx context.Context, req *fuse.SetattrRequest, resp *fuse.SetattrResponse) (retErr error) {
 	defer func() {
-		if retErr == nil {
-			protolion.Debug(&FileSetAttr{&f.Node, errorToString(retErr)})
-		} else {
-			protolion.Error(&FileSetAttr{&f.Node, errorToString(retErr)})
-		}
+		f.fs.logOp(&FileSetAttr{&f.Node, errorToString(retErr), req.Size, req.Valid.Size()}, retErr)
 	}()
+	if f.fs.opts.RandomWrites && (req.Valid&fuse.SetattrSize) > 0 {
+		return f.truncate(int64(req.Size))
+	}
 	if req.Size == 0 && (req.Valid&fuse.SetattrSize) > 0 {
 		err := f.fs.apiClient.DeleteFile(f.Node.File.Commit.Repo.Name,
 			f.Node.File.Commit.ID, f.Node.File.Path)
@@ -261,12 +307,29 @@ func (f *file) Setattr(ctx context.Context, req *fuse.SetattrRequest, resp *fuse
 
 func (f *file) Open(ctx context.Context, request *fuse.OpenRequest, response *fuse.OpenResponse) (_ fs.Handle, retErr error) {
 	defer func() {
-		if retErr == nil {
-			protolion.Debug(&FileOpen{&f.Node, errorToString(retErr)})
-		} else {
-			protolion.Error(&FileOpen{&f.Node, errorToString(retErr)})
-		}
+		f.fs.logOp(&FileOpen{&f.Node, errorToString(retErr), uint32(request.Flags)}, retErr)
 	}()
+	if f.finished {
+		// The kernel can cache the file's pages and seek in it, since it
+		// never changes.
+		response.Flags |= fuse.OpenKeepCache
+		return f.newHandle(0), nil
+	}
+	if f.fs.opts.RandomWrites {
+		response.Flags |= fuse.OpenDirectIO
+		if request.Flags.IsReadOnly() {
+			return f.newHandle(0), nil
+		}
+		// O_TRUNC opens would throw away the file's content anyway, so
+		// there's no need to download it.
+		spill, err := f.newSpill(request.Flags&fuse.OpenTruncate == 0)
+		if err != nil {
+			return nil, err
+		}
+		handle := f.newHandle(0)
+		handle.spill = spill
+		return handle, nil
+	}
 	response.Flags |= fuse.OpenDirectIO | fuse.OpenNonSeekable
 	fileInfo, err := f.fs.apiClient.InspectFile(
 		f.File.Commit.Repo.Name,
@@ -293,15 +356,27 @@ func (f *file) Fsync(ctx context.Context, req *fuse.FsyncRequest) error {
 				return err
 			}
 		}
+		h.lock.Lock()
+		err := h.upload()
+		h.lock.Unlock()
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
 func (f *file) delimiter() pfsclient.Delimiter {
-	if strings.HasSuffix(f.File.Path, ".json") {
+	return pathDelimiter(f.File.Path)
+}
+
+// pathDelimiter returns the delimiter that files written at path are split
+// into blocks with.
+func pathDelimiter(path string) pfsclient.Delimiter {
+	if strings.HasSuffix(path, ".json") {
 		return pfsclient.Delimiter_JSON
 	}
-	if strings.HasSuffix(f.File.Path, ".bin") {
+	if strings.HasSuffix(path, ".bin") {
 		return pfsclient.Delimiter_NONE
 	}
 	return pfsclient.Delimiter_LINE
@@ -323,6 +398,120 @@ func (f *file) touch() error {
 	return nil
 }
 
+// newSpill creates a spill for f, if load is true it starts with f's
+// current content.
+func (f *file) newSpill(load bool) (*spill, error) {
+	spill, err := newSpill(f.fs.opts.SpillDir)
+	if err != nil {
+		return nil, err
+	}
+	if !load {
+		return spill, nil
+	}
+	if err := f.fs.apiClient.GetFile(
+		f.File.Commit.Repo.Name,
+		f.File.Commit.ID,
+		f.File.Path,
+		0,
+		0,
+		f.fs.getFromCommitID(f.getRepoOrAliasName()),
+		f.fs.getFullFile(f.getRepoOrAliasName()),
+		f.Shard,
+		spill.file,
+	); err != nil && grpc.Code(err) != codes.NotFound {
+		spill.close()
+		return nil, err
+	}
+	return spill, nil
+}
+
+// upload replaces f's content in pfs with the content of spill.  The new
+// content is written to block storage, as an upload, before the old content
+// is deleted, so f keeps its old content if writing the new content fails.
+func (f *file) upload(spill *spill) error {
+	size, err := spill.size()
+	if err != nil {
+		return err
+	}
+	reader, err := spill.reader()
+	if err != nil {
+		return err
+	}
+	upload, err := f.fs.apiClient.BeginUpload(f.File.Commit.Repo.Name, f.File.Commit.ID, f.File.Path, f.delimiter())
+	if err != nil {
+		return err
+	}
+	if size > 0 {
+		if _, err := f.fs.apiClient.UploadPart(upload.ID, 0, reader); err != nil {
+			return err
+		}
+	}
+	if err := f.fs.apiClient.DeleteFile(f.File.Commit.Repo.Name, f.File.Commit.ID, f.File.Path); err != nil {
+		return err
+	}
+	if err := f.fs.apiClient.CompleteUpload(upload.ID, uint64(size)); err != nil {
+		return err
+	}
+	spill.dirty = false
+	return nil
+}
+
+// truncate sets the size of f, in the spills of its open handles or, if it
+// has none, in pfs.
+func (f *file) truncate(size int64) error {
+	f.lock.Lock()
+	handles := append([]*handle(nil), f.handles...)
+	f.lock.Unlock()
+	truncated := false
+	for _, h := range handles {
+		h.lock.Lock()
+		if h.spill != nil {
+			if err := h.spill.truncate(size); err != nil {
+				h.lock.Unlock()
+				return err
+			}
+			truncated = true
+		}
+		h.lock.Unlock()
+	}
+	if truncated {
+		return nil
+	}
+	spill, err := f.newSpill(size > 0)
+	if err != nil {
+		return err
+	}
+	defer spill.close()
+	if err := spill.truncate(size); err != nil {
+		return err
+	}
+	return f.upload(spill)
+}
+
+// spillSize returns the size of f in the spills of its open handles, ok is
+// false if none of them have been written to.
+func (f *file) spillSize() (size int64, ok bool, retErr error) {
+	f.lock.Lock()
+	handles := append([]*handle(nil), f.handles...)
+	f.lock.Unlock()
+	for _, h := range handles {
+		h.lock.Lock()
+		if h.spill != nil && h.spill.dirty {
+			spillSize, err := h.spill.size()
+			if err != nil {
+				h.lock.Unlock()
+				return 0, false, err
+			}
+			if !ok || spillSize > size {
+				size = spillSize
+			}
+			ok = true
+		}
+		h.lock.Unlock()
+	}
+	return size, ok, nil
+}
+
 func (f *filesystem) inode(file *pfsclient.File) uint64 {
 	f.lock.RLock()
 	inode, ok := f.inodes[key(file)]
@@ -358,29 +547,35 @@ type handle struct {
 	f      *file
 	w      io.WriteCloser
 	cursor int
-	lock   sync.Mutex
+	// spill is set on handles which can write at random offsets, see
+	// Options.RandomWrites.
+	spill *spill
+	lock  sync.Mutex
 }
 
 func (h *handle) Read(ctx context.Context, request *fuse.ReadRequest, response *fuse.ReadResponse) (retErr error) {
 	defer func() {
-		if retErr == nil {
-			protolion.Debug(&FileRead{&h.f.Node, string(response.Data), errorToString(retErr)})
-		} else {
-			protolion.Error(&FileRead{&h.f.Node, string(response.Data), errorToString(retErr)})
-		}
+		h.f.fs.logOp(&FileRead{&h.f.Node, response.Data, errorToString(retErr), request.Offset, int64(request.Size)}, retErr)
 	}()
-	var buffer bytes.Buffer
-	if err := h.f.fs.apiClient.GetFile(
-		h.f.File.Commit.Repo.Name,
-		h.f.File.Commit.ID,
-		h.f.File.Path,
-		request.Offset,
-		int64(request.Size),
-		h.f.fs.getFromCommitID(h.f.getRepoOrAliasName()),
-		h.f.fs.getFullFile(h.f.getRepoOrAliasName()),
-		h.f.Shard,
-		&buffer,
-	); err != nil {
+	h.lock.Lock()
+	if h.spill != nil {
+		defer h.lock.Unlock()
+		data, err := h.spill.readAt(request.Size, request.Offset)
+		if err != nil {
+			return err
+		}
+		response.Data = data
+		return nil
+	}
+	h.lock.Unlock()
+	var data []byte
+	var err error
+	if h.f.finished {
+		data, err = h.f.fs.readCache.read(h.f.cacheKey(), request.Offset, request.Size, h.f.read)
+	} else {
+		data, err = h.f.read(request.Offset, int64(request.Size))
+	}
+	if err != nil {
 		if grpc.Code(err) == codes.NotFound {
 			// ENOENT from read(2) is weird, let's call this EINVAL
 			// instead.
@@ -388,20 +583,57 @@ func (h *handle) Read(ctx context.Context, request *fuse.ReadRequest, response *
 		}
 		return err
 	}
-	response.Data = buffer.Bytes()
+	response.Data = data
 	return nil
 }
 
+// read reads size bytes of f from pfs, starting at offset.
+func (f *file) read(offset int64, size int64) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := f.fs.apiClient.GetFile(
+		f.File.Commit.Repo.Name,
+		f.File.Commit.ID,
+		f.File.Path,
+		offset,
+		size,
+		f.fs.getFromCommitID(f.getRepoOrAliasName()),
+		f.fs.getFullFile(f.getRepoOrAliasName()),
+		f.Shard,
+		&buffer,
+	); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// cacheKey identifies the content of f in the read cache, which depends on
+// how the file is sharded and diffed as well as the file itself.
+func (f *file) cacheKey() string {
+	return fmt.Sprintf("%s|%s|%t|%v",
+		key(f.File),
+		f.fs.getFromCommitID(f.getRepoOrAliasName()),
+		f.fs.getFullFile(f.getRepoOrAliasName()),
+		f.Shard,
+	)
+}
+
 func (h *handle) Write(ctx context.Context, request *fuse.WriteRequest, response *fuse.WriteResponse) (retErr error) {
 	defer func() {
-		if retErr == nil {
-			protolion.Debug(&FileWrite{&h.f.Node, string(request.Data), request.Offset, errorToString(retErr)})
-		} else {
-			protolion.Error(&FileWrite{&h.f.Node, string(request.Data), request.Offset, errorToString(retErr)})
-		}
+		h.f.fs.logOp(&FileWrite{&h.f.Node, request.Data, request.Offset, errorToString(retErr)}, retErr)
 	}()
 	h.lock.Lock()
 	defer h.lock.Unlock()
+	if h.spill != nil {
+		written, err := h.spill.writeAt(request.Data, request.Offset)
+		if err != nil {
+			return err
+		}
+		response.Size = written
+		if h.f.size < request.Offset+int64(written) {
+			h.f.size = request.Offset + int64(written)
+		}
+		return nil
+	}
 	if h.w == nil {
 		w, err := h.f.fs.apiClient.PutFileWriter(
 			h.f.File.Commit.Repo.Name, h.f.File.Commit.ID, h.f.File.Path, h.f.delimiter())
@@ -436,7 +668,10 @@ func (h *handle) Write(ctx context.Context, request *fuse.WriteRequest, response
 	return nil
 }
 
-func (h *handle) Flush(ctx context.Context, req *fuse.FlushRequest) error {
+func (h *handle) Flush(ctx context.Context, req *fuse.FlushRequest) (retErr error) {
+	defer func() {
+		h.f.fs.logOp(&FileFlush{&h.f.Node, errorToString(retErr)}, retErr)
+	}()
 	h.lock.Lock()
 	defer h.lock.Unlock()
 	if h.w != nil {
@@ -446,11 +681,42 @@ func (h *handle) Flush(ctx context.Context, req *fuse.FlushRequest) error {
 			return err
 		}
 	}
-	return nil
+	return h.upload()
 }
 
 func (h *handle) Release(ctx context.Context, req *fuse.ReleaseRequest) error {
-	return nil
+	h.f.lock.Lock()
+	defer h.f.lock.Unlock()
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	// Flush has already uploaded anything written through the handle,
+	// unless that failed.  Then the handle and its spill are kept, so that
+	// the file's size still includes what was written and syncing the file
+	// uploads it again.
+	if err := h.upload(); err != nil {
+		return err
+	}
+	for i, handle := range h.f.handles {
+		if handle == h {
+			h.f.handles = append(h.f.handles[:i], h.f.handles[i+1:]...)
+			break
+		}
+	}
+	if h.spill == nil {
+		return nil
+	}
+	spill := h.spill
+	h.spill = nil
+	return spill.close()
+}
+
+// upload uploads the handle's spill if it's been written to, h.lock must be
+// held.
+func (h *handle) upload() error {
+	if h.spill == nil || !h.spill.dirty {
+		return nil
+	}
+	return h.f.upload(h.spill)
 }
 
 func (d *directory) copy() *directory {
@@ -470,6 +736,7 @@ func (d *directory) copy() *directory {
 			Shard:     d.Shard,
 			RepoAlias: d.RepoAlias,
 		},
+		finished: d.finished,
 	}
 }
 
@@ -522,6 +789,23 @@ func (f *filesystem) getFullFile(nameOrAlias string) bool {
 }
 
 func (d *directory) lookUpRepo(ctx context.Context, name string) (fs.Node, error) {
+	if d.fs.opts.FollowBranches && len(d.fs.CommitMounts) == 0 {
+		result, err := d.fs.repoDir(name, func() (*directory, error) {
+			return d.newRepoDir(ctx, name)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+	result, err := d.newRepoDir(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (d *directory) newRepoDir(ctx context.Context, name string) (*directory, error) {
 	commitMount := d.fs.getCommitMount(name)
 	if commitMount == nil {
 		return nil, fuse.EPERM
@@ -557,6 +841,7 @@ func (d *directory) lookUpRepo(ctx context.Context, name string) (fs.Node, error
 	} else {
 		result.Write = true
 	}
+	result.finished = isFinishedCommit(commitMount.Commit.ID, commitInfo)
 	result.Modified = commitInfo.Finished
 
 	return result, nil
@@ -564,6 +849,21 @@ func (d *directory) lookUpRepo(ctx context.Context, name string) (fs.Node, error
 
 func (d *directory) lookUpCommit(ctx context.Context, name string) (fs.Node, error) {
 	commitID := commitPathToID(name)
+	followed := false
+	if d.fs.opts.FollowBranches {
+		isBranch, err := d.fs.isBranch(d.File.Commit.Repo.Name, commitID)
+		if err != nil {
+			return nil, err
+		}
+		if isBranch {
+			head, err := d.fs.branchHead(d.File.Commit.Repo.Name, commitID)
+			if err != nil {
+				return nil, err
+			}
+			commitID = head
+			followed = true
+		}
+	}
 	commitInfo, err := d.fs.apiClient.InspectCommit(
 		d.File.Commit.Repo.Name,
 		commitID,
@@ -581,6 +881,8 @@ func (d *directory) lookUpCommit(ctx context.Context, name string) (fs.Node, err
 	} else {
 		result.Write = true
 	}
+	result.finished = isFinishedCommit(commitID, commitInfo)
+	result.followed = followed
 	result.Modified = commitInfo.Finished
 	return result, nil
 }
@@ -727,6 +1029,29 @@ func errorToString(err error) string {
 	return err.Error()
 }
 
+// isFinishedCommit returns true if commitInfo, which was looked up by
+// commitID, is finished and commitID refers to it rather than to a branch.
+func isFinishedCommit(commitID string, commitInfo *pfsclient.CommitInfo) bool {
+	return commitInfo.CommitType == pfsclient.CommitType_COMMIT_TYPE_READ && commitInfo.Commit.ID == commitID
+}
+
+func isFinished(node fs.Node) bool {
+	switch n := node.(type) {
+	case *directory:
+		return n.finished
+	case *file:
+		return n.finished
+	}
+	return false
+}
+
+// isFollowedBranch returns true if node is the directory of a followed
+// branch.
+func isFollowedBranch(node fs.Node) bool {
+	dir, ok := node.(*directory)
+	return ok && dir.followed
+}
+
 func getNode(node fs.Node) *Node {
 	switch n := node.(type) {
 	default:
@@ -3,6 +3,7 @@
 package fuse
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -50,21 +51,17 @@ func TestSeekRead(t *testing.T) {
 		fmt.Printf("==== %v - err (%v)\n", time.Now(), err)
 
 		fmt.Printf("==== %v - offset (%v)\n", time.Now(), offset)
-		require.YesError(t, err)
-		require.Equal(t, int64(0), offset)
+		// Files in finished commits are seekable.
+		require.NoError(t, err)
+		require.Equal(t, int64(6), offset)
 
 		fmt.Printf("==== Seeked to %v\n", offset)
 
-		/* Leaving in place so the test's intention is clear / for repro'ing manually for mac
-
 		word2 := make([]byte, 3)
 		n2, err := file.Read(word2)
 		require.NoError(t, err)
 		require.Equal(t, 3, n2)
 		require.Equal(t, "baz", string(word2))
-
-		fmt.Printf("==== Read word len %v : %v\n", n2, string(word2)) */
-
 	}, false)
 }
 
@@ -155,3 +152,51 @@ func TestSeekWriteBackwards(t *testing.T) {
 		require.NoError(t, c.FinishCommit(repo, commit.ID)) */
 	}, false)
 }
+
+func TestRandomWrites(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipped because of short mode")
+	}
+
+	testFuseWithOptions(t, func(c *client.APIClient, mountpoint string) {
+		repo := "test"
+		require.NoError(t, c.CreateRepo(repo))
+		commit, err := c.StartCommit(repo, "master")
+		require.NoError(t, err)
+		path := filepath.Join(mountpoint, repo, commitIDToPath(commit.ID), "file")
+		file, err := os.Create(path)
+		require.NoError(t, err)
+
+		_, err = file.Write([]byte("foofoofoo"))
+		require.NoError(t, err)
+		// Overwrite the middle of the file.
+		_, err = file.WriteAt([]byte("bar"), 3)
+		require.NoError(t, err)
+		// Leave a gap, which reads as zeros.
+		_, err = file.WriteAt([]byte("baz"), 12)
+		require.NoError(t, err)
+		data := make([]byte, 6)
+		_, err = file.ReadAt(data, 3)
+		require.NoError(t, err)
+		require.Equal(t, "barfoo", string(data))
+		require.NoError(t, file.Sync())
+		require.NoError(t, file.Truncate(6))
+		require.NoError(t, file.Close())
+
+		var buffer bytes.Buffer
+		require.NoError(t, c.GetFile(repo, commit.ID, "file", 0, 0, "", false, nil, &buffer))
+		require.Equal(t, "foobar", buffer.String())
+
+		// Reopening the file keeps its content.
+		file, err = os.OpenFile(path, os.O_RDWR, 0666)
+		require.NoError(t, err)
+		_, err = file.WriteAt([]byte("baz"), 0)
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+		require.NoError(t, c.FinishCommit(repo, commit.ID))
+
+		buffer.Reset()
+		require.NoError(t, c.GetFile(repo, commit.ID, "file", 0, 0, "", false, nil, &buffer))
+		require.Equal(t, "bazbar", buffer.String())
+	}, false, &Options{RandomWrites: true})
+}
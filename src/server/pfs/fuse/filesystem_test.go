@@ -14,6 +14,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"bazil.org/fuse/fs/fstestutil"
 	"github.com/sjezewski/pachyderm/src/client"
@@ -832,10 +833,122 @@ func TestListBranch(t *testing.T) {
 	}, false)
 }
 
+func TestFollowBranch(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipped because of short mode")
+	}
+
+	testFuseWithOptions(t, func(c *client.APIClient, mountpoint string) {
+		repo := "TestFollowBranch"
+		require.NoError(t, c.CreateRepo(repo))
+		commit1, err := c.StartCommit(repo, "master")
+		require.NoError(t, err)
+		_, err = c.PutFile(repo, commit1.ID, "file", strings.NewReader("foo\n"))
+		require.NoError(t, err)
+		require.NoError(t, c.FinishCommit(repo, commit1.ID))
+
+		path := filepath.Join(mountpoint, repo, "master", "file")
+		data, err := ioutil.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, "foo\n", string(data))
+		// Names which aren't branches aren't followed.
+		_, err = os.Stat(filepath.Join(mountpoint, repo, "nonexistent"))
+		require.YesError(t, err)
+		file, err := os.Open(path)
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, file.Close())
+		}()
+
+		// The branch doesn't move until the new commit finishes.
+		commit2, err := c.StartCommit(repo, "master")
+		require.NoError(t, err)
+		_, err = c.PutFile(repo, commit2.ID, "file", strings.NewReader("bar\n"))
+		require.NoError(t, err)
+		data, err = ioutil.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, "foo\n", string(data))
+		require.NoError(t, c.FinishCommit(repo, commit2.ID))
+
+		deadline := time.Now().Add(10 * time.Second)
+		for {
+			data, err = ioutil.ReadFile(path)
+			require.NoError(t, err)
+			if string(data) == "foo\nbar\n" || time.Now().After(deadline) {
+				break
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+		require.Equal(t, "foo\nbar\n", string(data))
+
+		// Files which were already open keep reading the old commit.
+		data, err = ioutil.ReadAll(file)
+		require.NoError(t, err)
+		require.Equal(t, "foo\n", string(data))
+	}, false, &Options{FollowBranches: true})
+}
+
+func TestCopyInCopyOut(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipped because of short mode")
+	}
+
+	testFuse(t, func(c *client.APIClient, mountpoint string) {
+		repo := "TestCopyInCopyOut"
+		require.NoError(t, c.CreateRepo(repo))
+		commit1, err := c.StartCommit(repo, "master")
+		require.NoError(t, err)
+		_, err = c.PutFile(repo, commit1.ID, "dir/file", strings.NewReader("foo\n"))
+		require.NoError(t, err)
+		require.NoError(t, c.FinishCommit(repo, commit1.ID))
+		commit2, err := c.StartCommit(repo, "master")
+		require.NoError(t, err)
+
+		dir, err := ioutil.TempDir("", "pachyderm-test-copy-")
+		require.NoError(t, err)
+		defer func() {
+			_ = os.RemoveAll(dir)
+		}()
+		commitMounts := []*CommitMount{
+			{Commit: commit1},
+			{Commit: commit2, Alias: "out"},
+		}
+		// What was left behind by an earlier run is removed.
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "out"), 0777))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "out", "stale"), []byte("stale\n"), 0666))
+		require.NoError(t, CopyIn(c, dir, commitMounts))
+		require.NoError(t, CopyIn(c, dir, commitMounts))
+		data, err := ioutil.ReadFile(filepath.Join(dir, repo, "dir", "file"))
+		require.NoError(t, err)
+		require.Equal(t, "foo\n", string(data))
+		// Open commits start out empty.
+		infos, err := ioutil.ReadDir(filepath.Join(dir, "out"))
+		require.NoError(t, err)
+		require.Equal(t, 0, len(infos))
+
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "out", "dir"), 0777))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "out", "dir", "file"), []byte("bar\n"), 0666))
+		require.NoError(t, CopyOut(c, dir, commitMounts))
+		require.NoError(t, c.FinishCommit(repo, commit2.ID))
+		var buffer bytes.Buffer
+		require.NoError(t, c.GetFile(repo, commit2.ID, "dir/file", 0, 0, "", false, nil, &buffer))
+		require.Equal(t, "foo\nbar\n", buffer.String())
+	}, false)
+}
+
 func testFuse(
 	t *testing.T,
 	test func(client *client.APIClient, mountpoint string),
 	allCommits bool,
+) {
+	testFuseWithOptions(t, test, allCommits, nil)
+}
+
+func testFuseWithOptions(
+	t *testing.T,
+	test func(client *client.APIClient, mountpoint string),
+	allCommits bool,
+	opts *Options,
 ) {
 	// don't leave goroutines running
 	var wg sync.WaitGroup
@@ -893,7 +1006,7 @@ func testFuse(
 
 	apiClient, err := client.NewFromAddress(localAddress)
 	require.NoError(t, err)
-	mounter := NewMounter(localAddress, apiClient)
+	mounter := NewMounterWithOptions(localAddress, apiClient, opts)
 	mountpoint := filepath.Join(tmp, "mnt")
 	require.NoError(t, os.Mkdir(mountpoint, 0700))
 	ready := make(chan bool)
@@ -0,0 +1,62 @@
+// +build linux
+
+package fuse
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pkg/require"
+)
+
+func getxattr(t *testing.T, path string, name string) string {
+	buf := make([]byte, 1024)
+	n, err := syscall.Getxattr(path, name, buf)
+	require.NoError(t, err)
+	return string(buf[:n])
+}
+
+func TestXattrs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipped because of short mode")
+	}
+
+	testFuse(t, func(c *client.APIClient, mountpoint string) {
+		repo := "test"
+		require.NoError(t, c.CreateRepo(repo))
+		commit, err := c.StartCommit(repo, "master")
+		require.NoError(t, err)
+		_, err = c.PutFile(repo, commit.ID, "dir/file", strings.NewReader("foo\n"))
+		require.NoError(t, err)
+		require.NoError(t, c.FinishCommit(repo, commit.ID))
+
+		path := filepath.Join(mountpoint, repo, commitIDToPath(commit.ID), "dir", "file")
+		buf := make([]byte, 1024)
+		n, err := syscall.Listxattr(path, buf)
+		require.NoError(t, err)
+		names := strings.Split(strings.TrimSuffix(string(buf[:n]), "\x00"), "\x00")
+		sort.Strings(names)
+		require.Equal(t, []string{xattrCommitModified, xattrProvenance, xattrSize}, names)
+
+		require.Equal(t, commit.ID, getxattr(t, path, xattrCommitModified))
+		require.Equal(t, "4", getxattr(t, path, xattrSize))
+		hash := sha256.Sum256([]byte("foo\n"))
+		require.Equal(t, hex.EncodeToString(hash[:]), getxattr(t, path, xattrHash))
+		require.Equal(t, "", getxattr(t, path, xattrProvenance))
+		// There's no job for this mount.
+		_, err = syscall.Getxattr(path, xattrJob, buf)
+		require.YesError(t, err)
+
+		// Directories have the same attributes, except for the hash.
+		dir := filepath.Dir(path)
+		require.Equal(t, commit.ID, getxattr(t, dir, xattrCommitModified))
+		_, err = syscall.Getxattr(dir, xattrHash, buf)
+		require.YesError(t, err)
+	}, false)
+}
@@ -1,6 +1,8 @@
 package fuse
 
 import (
+	"io"
+
 	"github.com/sjezewski/pachyderm/src/client"
 	pfsclient "github.com/sjezewski/pachyderm/src/client/pfs"
 )
@@ -34,5 +36,39 @@ type Mounter interface {
 // NewMounter creates a new Mounter.
 // Address can be left blank, it's used only for aesthetic purposes.
 func NewMounter(address string, apiClient *client.APIClient) Mounter {
-	return newMounter(address, apiClient)
+	return newMounter(address, apiClient, nil)
+}
+
+// NewMounterWithOptions creates a new Mounter whose mounts use opts, nil
+// opts are the same as NewMounter.
+func NewMounterWithOptions(address string, apiClient *client.APIClient, opts *Options) Mounter {
+	return newMounter(address, apiClient, opts)
+}
+
+// Options are optional settings for mounts, the zero value is the default.
+type Options struct {
+	// RandomWrites lets files be written at any offset and truncated to any
+	// size.  Files opened for writing are staged in a local spill file and
+	// uploaded, replacing the file's previous content, when they're flushed
+	// or synced.  Without it writes must be sequential and are appended to
+	// the file as they're made.
+	RandomWrites bool
+	// SpillDir is the directory spill files are kept in, it defaults to
+	// os.TempDir().
+	SpillDir string
+	// ReadCacheBytes is the size of the in memory cache of data read from
+	// finished commits, it defaults to 64MB.
+	ReadCacheBytes int64
+	// FollowBranches makes each branch's directory, repo/branch-name, the
+	// branch's newest finished commit.  It switches to the next commit as
+	// soon as it finishes, files which are already open keep reading the
+	// commit they were opened in.  Without it a branch's directory reads
+	// whichever commit is the branch's head at the time of each call.
+	FollowBranches bool
+	// JobID is the job whose output commit is mounted, if any.  It's
+	// exposed as the user.pfs.job extended attribute of output files.
+	JobID string
+	// Trace, if set, is where operations on the filesystem are recorded,
+	// they can be replayed with Replay.
+	Trace io.Writer
 }
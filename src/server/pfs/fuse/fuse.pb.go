@@ -26,6 +26,7 @@ It has these top-level messages:
 	FileOpen
 	FileWrite
 	FileRemove
+	FileFlush
 */
 package fuse
 
@@ -334,8 +335,10 @@ func (m *FileAttr) GetResult() *Attr {
 }
 
 type FileSetAttr struct {
-	File  *Node  `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
-	Error string `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
+	File    *Node  `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	Error   string `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
+	Size    uint64 `protobuf:"varint,3,opt,name=size" json:"size,omitempty"`
+	SetSize bool   `protobuf:"varint,4,opt,name=set_size,json=setSize" json:"set_size,omitempty"`
 }
 
 func (m *FileSetAttr) Reset()                    { *m = FileSetAttr{} }
@@ -351,9 +354,11 @@ func (m *FileSetAttr) GetFile() *Node {
 }
 
 type FileRead struct {
-	File  *Node  `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
-	Data  string `protobuf:"bytes,2,opt,name=data" json:"data,omitempty"`
-	Error string `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
+	File   *Node  `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	Data   []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Error  string `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
+	Offset int64  `protobuf:"varint,4,opt,name=offset" json:"offset,omitempty"`
+	Size   int64  `protobuf:"varint,5,opt,name=size" json:"size,omitempty"`
 }
 
 func (m *FileRead) Reset()                    { *m = FileRead{} }
@@ -371,6 +376,7 @@ func (m *FileRead) GetFile() *Node {
 type FileOpen struct {
 	File  *Node  `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
 	Error string `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
+	Flags uint32 `protobuf:"varint,3,opt,name=flags" json:"flags,omitempty"`
 }
 
 func (m *FileOpen) Reset()                    { *m = FileOpen{} }
@@ -387,7 +393,7 @@ func (m *FileOpen) GetFile() *Node {
 
 type FileWrite struct {
 	File   *Node  `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
-	Data   string `protobuf:"bytes,2,opt,name=data" json:"data,omitempty"`
+	Data   []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	Offset int64  `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
 	Error  string `protobuf:"bytes,4,opt,name=error" json:"error,omitempty"`
 }
@@ -423,6 +429,23 @@ func (m *FileRemove) GetFile() *Node {
 	return nil
 }
 
+type FileFlush struct {
+	File  *Node  `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *FileFlush) Reset()                    { *m = FileFlush{} }
+func (m *FileFlush) String() string            { return proto.CompactTextString(m) }
+func (*FileFlush) ProtoMessage()               {}
+func (*FileFlush) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *FileFlush) GetFile() *Node {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CommitMount)(nil), "fuse.CommitMount")
 	proto.RegisterType((*Filesystem)(nil), "fuse.Filesystem")
@@ -441,52 +464,56 @@ func init() {
 	proto.RegisterType((*FileOpen)(nil), "fuse.FileOpen")
 	proto.RegisterType((*FileWrite)(nil), "fuse.FileWrite")
 	proto.RegisterType((*FileRemove)(nil), "fuse.FileRemove")
+	proto.RegisterType((*FileFlush)(nil), "fuse.FileFlush")
 }
 
 func init() { proto.RegisterFile("server/pfs/fuse/fuse.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 657 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xbc, 0x54, 0x4d, 0x6f, 0x13, 0x31,
-	0x10, 0xd5, 0x26, 0x9b, 0x28, 0x3b, 0x69, 0x69, 0x31, 0x15, 0x8a, 0x82, 0x0a, 0xd1, 0xc2, 0x21,
-	0xa7, 0x04, 0x15, 0x89, 0x33, 0x55, 0x2b, 0x4e, 0x04, 0x24, 0xb7, 0x12, 0xc7, 0x68, 0x1b, 0x8f,
-	0x5b, 0xab, 0xbb, 0xf1, 0xca, 0xf6, 0x16, 0x55, 0x9c, 0xf9, 0x47, 0x5c, 0xf8, 0x77, 0xc8, 0xf6,
-	0x7e, 0x21, 0x1a, 0xb5, 0x0d, 0x12, 0x97, 0x95, 0xe7, 0x63, 0xe7, 0xbd, 0x79, 0x33, 0x36, 0x8c,
-	0x35, 0xaa, 0x1b, 0x54, 0xf3, 0x9c, 0xeb, 0x39, 0x2f, 0x34, 0xba, 0xcf, 0x2c, 0x57, 0xd2, 0x48,
-	0x12, 0xda, 0xf3, 0xf8, 0x60, 0x95, 0x0a, 0x5c, 0x1b, 0x97, 0x91, 0x73, 0xed, 0x63, 0xe3, 0x57,
-	0x97, 0x52, 0x5e, 0xa6, 0x38, 0x77, 0xd6, 0x45, 0xc1, 0xe7, 0x46, 0x64, 0xa8, 0x4d, 0x92, 0xe5,
-	0x3e, 0x21, 0xfe, 0x15, 0xc0, 0xf0, 0x44, 0x66, 0x99, 0x30, 0x0b, 0x59, 0xac, 0x0d, 0x79, 0x0d,
-	0xfd, 0x95, 0x33, 0x47, 0xc1, 0x24, 0x98, 0x0e, 0x8f, 0x86, 0x33, 0x5b, 0xcc, 0x67, 0xd0, 0x32,
-	0x44, 0xde, 0xc2, 0x90, 0x09, 0xce, 0x97, 0x19, 0x9a, 0x2b, 0xc9, 0x46, 0x1d, 0x97, 0xb9, 0xe7,
-	0x32, 0x4f, 0x05, 0xe7, 0x0b, 0xe7, 0xa6, 0xc0, 0xea, 0x33, 0x79, 0x01, 0x11, 0x2f, 0xd2, 0x74,
-	0xc9, 0x45, 0x8a, 0xa3, 0xee, 0x24, 0x98, 0x0e, 0xe8, 0xc0, 0x3a, 0x3e, 0x8a, 0x14, 0xc9, 0x01,
-	0xf4, 0x92, 0x54, 0x24, 0x7a, 0x14, 0x4e, 0x82, 0x69, 0x44, 0xbd, 0x41, 0x26, 0xd0, 0xd3, 0x57,
-	0x89, 0x62, 0xa3, 0x9e, 0x2b, 0x0f, 0xae, 0xfc, 0x99, 0xf5, 0x50, 0x1f, 0x88, 0x39, 0x80, 0xfd,
-	0x5f, 0xdf, 0x6a, 0x83, 0x59, 0x93, 0x1f, 0x6c, 0xc8, 0x27, 0xef, 0x61, 0xd7, 0x37, 0xb0, 0xcc,
-	0x6c, 0xaf, 0x7a, 0xd4, 0x99, 0x74, 0xa7, 0xc3, 0xa3, 0xa7, 0x33, 0x27, 0x66, 0x4b, 0x05, 0xba,
-	0xb3, 0x6a, 0x0c, 0x1d, 0xff, 0x0c, 0x20, 0xfc, 0x2c, 0x19, 0x92, 0x43, 0x08, 0x5d, 0x03, 0x1e,
-	0x21, 0x72, 0x08, 0x96, 0x01, 0x75, 0x6e, 0x72, 0x08, 0xa0, 0x30, 0x97, 0x4b, 0xdf, 0x4c, 0xc7,
-	0x35, 0x13, 0x59, 0xcf, 0xb1, 0x6b, 0xe8, 0x00, 0x7a, 0xdf, 0x94, 0x30, 0x55, 0xff, 0xde, 0x68,
-	0x68, 0x87, 0x9b, 0x69, 0x0f, 0x32, 0xc9, 0x04, 0x17, 0x58, 0x69, 0x31, 0x9e, 0xf9, 0xb1, 0xce,
-	0xaa, 0xb1, 0xce, 0xce, 0xab, 0xb1, 0xd2, 0x3a, 0x37, 0x1e, 0x43, 0x78, 0x6c, 0x8c, 0x22, 0x04,
-	0xc2, 0x85, 0x64, 0x9e, 0xf5, 0x2e, 0x0d, 0x33, 0xc9, 0x30, 0x3e, 0x82, 0xfe, 0xa9, 0x50, 0xb8,
-	0x36, 0x96, 0x95, 0x58, 0x57, 0xe1, 0x90, 0x7a, 0xc3, 0xfe, 0xb3, 0x4e, 0x32, 0x2c, 0x9b, 0x70,
-	0xe7, 0x58, 0x41, 0x48, 0xa5, 0xb4, 0xd3, 0x07, 0x5e, 0xcb, 0x5e, 0x6a, 0xb1, 0xef, 0x35, 0x6c,
-	0xc6, 0x41, 0x5b, 0x39, 0x24, 0x86, 0xbe, 0x42, 0x5d, 0xa4, 0xa6, 0x5c, 0x15, 0xf0, 0xd9, 0x56,
-	0x53, 0x5a, 0x46, 0x2c, 0x0f, 0x54, 0x4a, 0x2a, 0xa7, 0x4e, 0x44, 0xbd, 0x11, 0x6b, 0xd8, 0xb5,
-	0x3c, 0x57, 0x46, 0xaa, 0x5b, 0xd7, 0xcc, 0x14, 0x22, 0x56, 0x39, 0xea, 0x49, 0x37, 0xd5, 0x9a,
-	0xe0, 0x26, 0x50, 0x5b, 0xe5, 0x1e, 0xd0, 0x1f, 0x01, 0xec, 0xd5, 0xa8, 0x9f, 0xa4, 0xbc, 0x2e,
-	0xf2, 0x47, 0xe0, 0xde, 0x21, 0x5d, 0x8b, 0x4b, 0x77, 0xa3, 0x00, 0xfb, 0xd0, 0x45, 0xa5, 0xca,
-	0x3b, 0x60, 0x8f, 0xf1, 0x77, 0x78, 0x56, 0xd3, 0xa0, 0x98, 0xb0, 0x53, 0xa1, 0x8e, 0xd3, 0xf4,
-	0x11, 0x54, 0xde, 0xb4, 0x24, 0xb0, 0x9b, 0xbe, 0xe3, 0xd3, 0xfc, 0xe4, 0xef, 0x11, 0xa1, 0x68,
-	0x69, 0x70, 0xa2, 0x30, 0x31, 0xf8, 0xef, 0xda, 0x3f, 0x60, 0xe0, 0x06, 0x9e, 0xd4, 0xb0, 0x8b,
-	0x6b, 0x26, 0xd4, 0x7f, 0x41, 0x65, 0x30, 0xb0, 0xab, 0xeb, 0x36, 0xec, 0xe5, 0x1f, 0x97, 0xbc,
-	0x5d, 0xc3, 0xdf, 0xf2, 0xed, 0xf7, 0xea, 0x04, 0x86, 0x16, 0xe5, 0x0c, 0xcd, 0x83, 0x80, 0xea,
-	0x22, 0x9d, 0x76, 0x91, 0x73, 0x4f, 0xd5, 0xee, 0xc3, 0xbd, 0x15, 0x08, 0x84, 0x2c, 0x31, 0x49,
-	0xb5, 0x8a, 0xf6, 0xbc, 0x81, 0xda, 0x07, 0x5f, 0xf5, 0x4b, 0x8e, 0xeb, 0x2d, 0x79, 0x65, 0x10,
-	0xd9, 0x0a, 0x5f, 0xdd, 0xa3, 0xb6, 0x0d, 0xb1, 0xe7, 0xd0, 0x97, 0x9c, 0x6b, 0xf4, 0x77, 0xa4,
-	0x4b, 0x4b, 0xab, 0x81, 0x0b, 0xdb, 0x70, 0x57, 0xfe, 0xed, 0xa7, 0x98, 0xc9, 0x9b, 0x07, 0xe1,
-	0xfd, 0x75, 0x27, 0xf7, 0xa1, 0xcb, 0x84, 0x2a, 0x1f, 0x63, 0x7b, 0xbc, 0x1b, 0xe9, 0xa2, 0xef,
-	0x1e, 0xd9, 0x77, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x81, 0xc0, 0x1c, 0x83, 0x07, 0x00,
-	0x00,
+	// 720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcd, 0x6e, 0x1a, 0x49,
+	0x10, 0xd6, 0xc0, 0xc0, 0x42, 0x61, 0xd6, 0xde, 0x5e, 0xb4, 0x62, 0x59, 0x79, 0x17, 0xcd, 0xee,
+	0x81, 0x13, 0xac, 0x1c, 0x29, 0x77, 0x64, 0xcb, 0xa7, 0x90, 0x48, 0xed, 0x28, 0x39, 0xa2, 0x31,
+	0x53, 0x6d, 0x5a, 0x9e, 0xa1, 0x51, 0x77, 0x8f, 0x23, 0x3b, 0x97, 0x5c, 0xf2, 0x46, 0xb9, 0xe4,
+	0xed, 0xa2, 0xae, 0x1e, 0x86, 0xb1, 0x6c, 0xe4, 0x3f, 0x29, 0x17, 0x54, 0x55, 0x5d, 0xd4, 0xf7,
+	0x53, 0x4d, 0x03, 0x03, 0x83, 0xfa, 0x0a, 0xf5, 0x64, 0x2d, 0xcc, 0x44, 0xe4, 0x06, 0xe9, 0x63,
+	0xbc, 0xd6, 0xca, 0x2a, 0x16, 0xba, 0x78, 0xd0, 0x5b, 0xa4, 0x12, 0x57, 0x96, 0x3a, 0xd6, 0xc2,
+	0xf8, 0xb3, 0xc1, 0x3f, 0x17, 0x4a, 0x5d, 0xa4, 0x38, 0xa1, 0xec, 0x3c, 0x17, 0x13, 0x2b, 0x33,
+	0x34, 0x36, 0xce, 0xd6, 0xbe, 0x21, 0xfa, 0x1e, 0x40, 0xe7, 0x58, 0x65, 0x99, 0xb4, 0x33, 0x95,
+	0xaf, 0x2c, 0xfb, 0x17, 0x9a, 0x0b, 0x4a, 0xfb, 0xc1, 0x30, 0x18, 0x75, 0x8e, 0x3a, 0x63, 0x37,
+	0xcc, 0x77, 0xf0, 0xe2, 0x88, 0xfd, 0x0f, 0x9d, 0x44, 0x0a, 0x31, 0xcf, 0xd0, 0x2e, 0x55, 0xd2,
+	0xaf, 0x51, 0xe7, 0x3e, 0x75, 0x9e, 0x48, 0x21, 0x66, 0x54, 0xe6, 0x90, 0x94, 0x31, 0xfb, 0x0b,
+	0xda, 0x22, 0x4f, 0xd3, 0xb9, 0x90, 0x29, 0xf6, 0xeb, 0xc3, 0x60, 0xd4, 0xe2, 0x2d, 0x57, 0x38,
+	0x95, 0x29, 0xb2, 0x1e, 0x34, 0xe2, 0x54, 0xc6, 0xa6, 0x1f, 0x0e, 0x83, 0x51, 0x9b, 0xfb, 0x84,
+	0x0d, 0xa1, 0x61, 0x96, 0xb1, 0x4e, 0xfa, 0x0d, 0x1a, 0x0f, 0x34, 0xfe, 0xcc, 0x55, 0xb8, 0x3f,
+	0x88, 0x04, 0x80, 0xfb, 0xbe, 0xb9, 0x36, 0x16, 0xb3, 0x6d, 0x7f, 0xb0, 0xa3, 0x9f, 0xbd, 0x86,
+	0xae, 0x17, 0x30, 0xcf, 0x9c, 0x56, 0xd3, 0xaf, 0x0d, 0xeb, 0xa3, 0xce, 0xd1, 0x6f, 0x63, 0x32,
+	0xb3, 0xe2, 0x02, 0xdf, 0x5b, 0x6c, 0x13, 0x13, 0x7d, 0x0b, 0x20, 0x7c, 0xab, 0x12, 0x64, 0x87,
+	0x10, 0x92, 0x00, 0x8f, 0xd0, 0x26, 0x04, 0xc7, 0x80, 0x53, 0x99, 0x1d, 0x02, 0x68, 0x5c, 0xab,
+	0xb9, 0x17, 0x53, 0x23, 0x31, 0x6d, 0x57, 0x99, 0x92, 0xa0, 0x1e, 0x34, 0x3e, 0x69, 0x69, 0x37,
+	0xfa, 0x7d, 0xb2, 0xa5, 0x1d, 0xee, 0xa6, 0xdd, 0xca, 0x54, 0x22, 0x85, 0xc4, 0x8d, 0x17, 0x83,
+	0xb1, 0x5f, 0xeb, 0x78, 0xb3, 0xd6, 0xf1, 0xfb, 0xcd, 0x5a, 0x79, 0xd9, 0x1b, 0x0d, 0x20, 0x9c,
+	0x5a, 0xab, 0x19, 0x83, 0x70, 0xa6, 0x12, 0xcf, 0xba, 0xcb, 0xc3, 0x4c, 0x25, 0x18, 0x1d, 0x41,
+	0xf3, 0x44, 0x6a, 0x5c, 0x59, 0xc7, 0x4a, 0xae, 0x36, 0xc7, 0x21, 0xf7, 0x89, 0xfb, 0xce, 0x2a,
+	0xce, 0xb0, 0x10, 0x41, 0x71, 0xa4, 0x21, 0xe4, 0x4a, 0xb9, 0xed, 0x83, 0x28, 0x6d, 0x2f, 0xbc,
+	0x38, 0xf0, 0x1e, 0x6e, 0xd7, 0xc1, 0x2b, 0x3d, 0x2c, 0x82, 0xa6, 0x46, 0x93, 0xa7, 0xb6, 0xb8,
+	0x2a, 0xe0, 0xbb, 0x9d, 0xa7, 0xbc, 0x38, 0x71, 0x3c, 0x50, 0x6b, 0xa5, 0xc9, 0x9d, 0x36, 0xf7,
+	0x49, 0x64, 0xa0, 0xeb, 0x78, 0x2e, 0xac, 0xd2, 0xd7, 0x24, 0x66, 0x04, 0xed, 0x64, 0x53, 0xe8,
+	0x07, 0x77, 0xa6, 0x6d, 0x0f, 0x77, 0x81, 0xba, 0x29, 0x0f, 0x80, 0x7e, 0x0d, 0x60, 0xbf, 0x44,
+	0x7d, 0xa3, 0xd4, 0x65, 0xbe, 0x7e, 0x02, 0xee, 0x3d, 0xd6, 0x55, 0xb8, 0xd4, 0x77, 0x1a, 0x70,
+	0x00, 0x75, 0xd4, 0xba, 0xf8, 0x0d, 0xb8, 0x30, 0xfa, 0x0c, 0xbf, 0x97, 0x34, 0x38, 0xc6, 0xc9,
+	0x89, 0xd4, 0xd3, 0x34, 0x7d, 0x02, 0x95, 0xff, 0x2a, 0x16, 0xb8, 0x9b, 0xbe, 0xe7, 0xdb, 0xfc,
+	0xe6, 0x1f, 0x30, 0x21, 0xaf, 0x78, 0x70, 0xac, 0x31, 0xb6, 0xf8, 0x72, 0xef, 0x1f, 0xb1, 0x70,
+	0x0b, 0xbf, 0x96, 0xb0, 0xb3, 0xcb, 0x44, 0xea, 0x9f, 0x82, 0x9a, 0x40, 0xcb, 0x5d, 0x5d, 0xba,
+	0x61, 0x7f, 0xdf, 0xfa, 0x91, 0x57, 0x67, 0x50, 0xfd, 0x05, 0xf7, 0x4a, 0x43, 0xc7, 0xa1, 0x9c,
+	0xa1, 0x7d, 0x14, 0x50, 0x39, 0xa4, 0x56, 0x19, 0xe2, 0xae, 0x97, 0x91, 0x37, 0xfe, 0x11, 0x09,
+	0x39, 0xc5, 0xec, 0x4f, 0x68, 0x19, 0xb4, 0x73, 0xaa, 0x87, 0xf4, 0xb8, 0xfc, 0x62, 0xd0, 0x9e,
+	0xc9, 0x1b, 0x8c, 0xbe, 0x04, 0x5e, 0x9a, 0xbb, 0x3f, 0x0f, 0x22, 0x32, 0x08, 0x93, 0xd8, 0xc6,
+	0x04, 0xb8, 0xc7, 0x29, 0xbe, 0x5f, 0x0a, 0xfb, 0x03, 0x9a, 0x4a, 0x08, 0x83, 0x96, 0xf0, 0xea,
+	0xbc, 0xc8, 0x4a, 0x76, 0x0d, 0xaa, 0x52, 0x1c, 0x7d, 0xf0, 0x0c, 0xde, 0xad, 0x71, 0xf5, 0x4c,
+	0xcd, 0x3d, 0x68, 0x88, 0x34, 0xbe, 0x30, 0xc4, 0xa1, 0xcb, 0x7d, 0x12, 0x65, 0xd0, 0x76, 0x73,
+	0x3f, 0xd2, 0x33, 0xfa, 0x1c, 0x69, 0x5b, 0x11, 0xf5, 0x5b, 0x22, 0x4a, 0x12, 0x61, 0x75, 0x7b,
+	0x4b, 0xff, 0x6f, 0xc3, 0x31, 0x53, 0x57, 0x8f, 0xc2, 0xbb, 0xf3, 0x0a, 0x1c, 0x40, 0x3d, 0x91,
+	0xba, 0x78, 0xfe, 0x5d, 0xb8, 0x03, 0x69, 0xea, 0x85, 0x9d, 0xa6, 0xb9, 0x59, 0x3e, 0xcf, 0xb1,
+	0xf3, 0x26, 0xfd, 0x33, 0xbc, 0xfa, 0x31, 0x00, 0x3b, 0x46, 0xb8, 0x68, 0x38, 0x08, 0x00, 0x00,
 }
@@ -20,12 +20,17 @@ const (
 type mounter struct {
 	address   string
 	apiClient *client.APIClient
+	opts      *Options
 }
 
-func newMounter(address string, apiClient *client.APIClient) Mounter {
+func newMounter(address string, apiClient *client.APIClient, opts *Options) Mounter {
+	if opts == nil {
+		opts = &Options{}
+	}
 	return &mounter{
 		address,
 		apiClient,
+		opts,
 	}
 }
 
@@ -92,7 +97,11 @@ func (m *mounter) Mount(
 	if debug {
 		config.Debug = func(msg interface{}) { lion.Printf("%+v", msg) }
 	}
-	if err := fs.New(conn, config).Serve(newFilesystem(m.apiClient, shard, commitMounts, allCommits)); err != nil {
+	server := fs.New(conn, config)
+	filesystem := newFilesystem(m.apiClient, shard, commitMounts, allCommits, m.opts)
+	filesystem.server = server
+	defer filesystem.close()
+	if err := server.Serve(filesystem); err != nil {
 		return err
 	}
 	<-conn.Ready
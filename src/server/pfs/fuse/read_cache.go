@@ -0,0 +1,110 @@
+package fuse
+
+import (
+	"container/list"
+	"sync"
+)
+
+const (
+	// readCacheBlockSize is the size of the aligned blocks of file data that
+	// the read cache holds.
+	readCacheBlockSize = 1024 * 1024
+	// defaultReadCacheBytes is the size of the read cache if
+	// Options.ReadCacheBytes isn't set.
+	defaultReadCacheBytes = 64 * 1024 * 1024
+)
+
+// readCache is a bounded cache of the data of files in finished commits,
+// which never changes.  Files are cached in aligned blocks so that reads of
+// nearby ranges share fetches, blocks are evicted in least recently used
+// order.
+type readCache struct {
+	blockSize int64
+	maxBytes  int64
+
+	lock  sync.Mutex
+	bytes int64
+	// lru holds the *readCacheEntry of every cached block, most recently
+	// used first.
+	lru     *list.List
+	entries map[readCacheKey]*list.Element
+}
+
+type readCacheKey struct {
+	file  string
+	index int64
+}
+
+type readCacheEntry struct {
+	key  readCacheKey
+	data []byte
+}
+
+func newReadCache(blockSize int64, maxBytes int64) *readCache {
+	return &readCache{
+		blockSize: blockSize,
+		maxBytes:  maxBytes,
+		lru:       list.New(),
+		entries:   make(map[readCacheKey]*list.Element),
+	}
+}
+
+// read returns up to size bytes of file starting at offset, fetching the
+// blocks which aren't cached with fetch.  fetch returns fewer bytes than
+// requested only at the end of the file.
+func (c *readCache) read(file string, offset int64, size int, fetch func(offset int64, size int64) ([]byte, error)) ([]byte, error) {
+	var result []byte
+	for int64(len(result)) < int64(size) {
+		position := offset + int64(len(result))
+		key := readCacheKey{file, position / c.blockSize}
+		data, ok := c.get(key)
+		if !ok {
+			var err error
+			data, err = fetch(key.index*c.blockSize, c.blockSize)
+			if err != nil {
+				return nil, err
+			}
+			c.put(key, data)
+		}
+		start := position - key.index*c.blockSize
+		if start >= int64(len(data)) {
+			break
+		}
+		end := int64(len(data))
+		if remaining := int64(size - len(result)); end-start > remaining {
+			end = start + remaining
+		}
+		result = append(result, data[start:end]...)
+		if int64(len(data)) < c.blockSize {
+			// This was the file's last block.
+			break
+		}
+	}
+	return result, nil
+}
+
+func (c *readCache) get(key readCacheKey) ([]byte, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(element)
+	return element.Value.(*readCacheEntry).data, true
+}
+
+func (c *readCache) put(key readCacheKey, data []byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.entries[key]; ok || int64(len(data)) > c.maxBytes {
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&readCacheEntry{key, data})
+	c.bytes += int64(len(data))
+	for c.bytes > c.maxBytes {
+		entry := c.lru.Remove(c.lru.Back()).(*readCacheEntry)
+		delete(c.entries, entry.key)
+		c.bytes -= int64(len(entry.data))
+	}
+}
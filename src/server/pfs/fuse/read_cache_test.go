@@ -0,0 +1,64 @@
+package fuse
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sjezewski/pachyderm/src/client/pkg/require"
+)
+
+func TestReadCache(t *testing.T) {
+	content := "0123456789abcdefghij"
+	var fetches []int64
+	fetch := func(offset int64, size int64) ([]byte, error) {
+		fetches = append(fetches, offset)
+		end := offset + size
+		if end > int64(len(content)) {
+			end = int64(len(content))
+		}
+		return []byte(content[offset:end]), nil
+	}
+	// Blocks of 4 bytes, room for 3 of them.
+	cache := newReadCache(4, 12)
+
+	data, err := cache.read("file", 2, 5, fetch)
+	require.NoError(t, err)
+	require.Equal(t, "23456", string(data))
+	require.Equal(t, []int64{0, 4}, fetches)
+
+	// Reads within cached blocks don't fetch.
+	data, err = cache.read("file", 0, 8, fetch)
+	require.NoError(t, err)
+	require.Equal(t, "01234567", string(data))
+	require.Equal(t, 2, len(fetches))
+
+	// Reads stop at the end of the file.
+	data, err = cache.read("file", 18, 10, fetch)
+	require.NoError(t, err)
+	require.Equal(t, "ij", string(data))
+	data, err = cache.read("file", 20, 10, fetch)
+	require.NoError(t, err)
+	require.Equal(t, "", string(data))
+	require.Equal(t, []int64{0, 4, 16, 20}, fetches)
+
+	// Blocks are evicted in least recently used order, caching the block
+	// at 8 evicts the one at 0.
+	fetches = nil
+	data, err = cache.read("file", 8, 4, fetch)
+	require.NoError(t, err)
+	require.Equal(t, "89ab", string(data))
+	data, err = cache.read("file", 4, 4, fetch)
+	require.NoError(t, err)
+	require.Equal(t, "4567", string(data))
+	data, err = cache.read("file", 0, 4, fetch)
+	require.NoError(t, err)
+	require.Equal(t, "0123", string(data))
+	require.Equal(t, []int64{8, 0}, fetches)
+
+	// Files don't share blocks.
+	data, err = cache.read("other", 0, 20, func(offset int64, size int64) ([]byte, error) {
+		return []byte(strings.ToUpper(content[offset : offset+size])), nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "0123456789ABCDEFGHIJ", string(data))
+}
@@ -0,0 +1,73 @@
+package fuse
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+)
+
+// spill is a local copy of a file which is being written at random offsets,
+// it's uploaded to pfs in full when the file is flushed.
+type spill struct {
+	file *os.File
+	// dirty is true if the spill has changed since it was last uploaded.
+	dirty bool
+}
+
+func newSpill(dir string) (*spill, error) {
+	file, err := ioutil.TempFile(dir, "pfs-spill")
+	if err != nil {
+		return nil, err
+	}
+	return &spill{file: file}, nil
+}
+
+func (s *spill) writeAt(data []byte, offset int64) (int, error) {
+	n, err := s.file.WriteAt(data, offset)
+	if n > 0 {
+		s.dirty = true
+	}
+	return n, err
+}
+
+func (s *spill) readAt(size int, offset int64) ([]byte, error) {
+	data := make([]byte, size)
+	n, err := s.file.ReadAt(data, offset)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (s *spill) truncate(size int64) error {
+	if err := s.file.Truncate(size); err != nil {
+		return err
+	}
+	s.dirty = true
+	return nil
+}
+
+func (s *spill) size() (int64, error) {
+	fileInfo, err := s.file.Stat()
+	if err != nil {
+		return 0, err
+	}
+	return fileInfo.Size(), nil
+}
+
+// reader returns a reader of the spill's whole content.
+func (s *spill) reader() (io.Reader, error) {
+	size, err := s.size()
+	if err != nil {
+		return nil, err
+	}
+	return io.NewSectionReader(s.file, 0, size), nil
+}
+
+func (s *spill) close() error {
+	closeErr := s.file.Close()
+	if err := os.Remove(s.file.Name()); err != nil {
+		return err
+	}
+	return closeErr
+}
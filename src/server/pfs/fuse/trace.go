@@ -0,0 +1,345 @@
+package fuse
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+	"syscall"
+
+	"bazil.org/fuse"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"go.pedge.io/lion/proto"
+)
+
+// A trace records the operations on a filesystem, one JSON object per line
+// with the operation's message type and the message.
+type traceEntry struct {
+	Op      string          `json:"op"`
+	Message json.RawMessage `json:"message"`
+}
+
+// recorder writes operations to a trace, see Options.Trace.  A nil
+// *recorder records nothing.
+type recorder struct {
+	lock      sync.Mutex
+	encoder   *json.Encoder
+	marshaler jsonpb.Marshaler
+}
+
+func newRecorder(w io.Writer) *recorder {
+	if w == nil {
+		return nil
+	}
+	return &recorder{encoder: json.NewEncoder(w)}
+}
+
+func (r *recorder) record(message proto.Message) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	var buffer bytes.Buffer
+	if err := r.marshaler.Marshal(&buffer, message); err != nil {
+		protolion.Errorf("error recording %s: %s", proto.MessageName(message), err.Error())
+		return
+	}
+	if err := r.encoder.Encode(&traceEntry{proto.MessageName(message), buffer.Bytes()}); err != nil {
+		protolion.Errorf("error recording %s: %s", proto.MessageName(message), err.Error())
+	}
+}
+
+// logOp logs an operation on the filesystem and records it, if the
+// filesystem is being traced.  Lookups of files which don't exist are
+// routine, so they're logged at debug level.
+func (f *filesystem) logOp(message proto.Message, err error) {
+	_, isLookup := message.(*DirectoryLookup)
+	if err == nil || (err == fuse.ENOENT && isLookup) {
+		protolion.Debug(message)
+	} else {
+		protolion.Error(message)
+	}
+	f.recorder.record(message)
+}
+
+// readTrace reads the operations in a trace.
+func readTrace(r io.Reader) ([]proto.Message, error) {
+	var result []proto.Message
+	decoder := json.NewDecoder(bufio.NewReader(r))
+	for {
+		var entry traceEntry
+		if err := decoder.Decode(&entry); err != nil {
+			if err == io.EOF {
+				return result, nil
+			}
+			return nil, err
+		}
+		messageType := proto.MessageType(entry.Op)
+		if messageType == nil {
+			return nil, fmt.Errorf("unrecognized operation in trace: %s", entry.Op)
+		}
+		message := reflect.New(messageType.Elem()).Interface().(proto.Message)
+		if err := jsonpb.Unmarshal(bytes.NewReader(entry.Message), message); err != nil {
+			return nil, err
+		}
+		result = append(result, message)
+	}
+}
+
+// A Mismatch is an operation whose result when it was replayed differs from
+// the recorded one.
+type Mismatch struct {
+	// Index is the position of the operation in the trace.
+	Index    int
+	Op       string
+	Path     string
+	Recorded string
+	Replayed string
+}
+
+func (m *Mismatch) String() string {
+	return fmt.Sprintf("%d: %s %s: recorded %q, replayed %q", m.Index, m.Op, m.Path, m.Recorded, m.Replayed)
+}
+
+// Replay performs the operations in a trace, recorded with Options.Trace,
+// against the filesystem mounted at mountPoint and returns the operations
+// whose results differ from the recorded ones.  The mount must have the
+// repos and commits that the trace refers to, commit IDs are assigned in
+// order so creating the same commits in the same order on a fresh cluster
+// reproduces them.  Errors are compared by whether there was one, not by
+// their message.
+func Replay(trace io.Reader, mountPoint string) ([]*Mismatch, error) {
+	messages, err := readTrace(trace)
+	if err != nil {
+		return nil, err
+	}
+	r := &replayer{
+		mountPoint: mountPoint,
+		open:       make(map[string][]*replayFile),
+	}
+	defer r.closeAll()
+	for i, message := range messages {
+		r.index = i
+		r.replay(message)
+	}
+	return r.mismatches, nil
+}
+
+type replayer struct {
+	mountPoint string
+	// commitMounts is true if the recorded filesystem mounted specific
+	// commits, which don't appear in paths.
+	commitMounts bool
+	// open holds the files opened by the trace, in the order they were
+	// opened, by path.
+	open       map[string][]*replayFile
+	index      int
+	mismatches []*Mismatch
+}
+
+// replayFile is a file opened by the trace.  Handles aren't always
+// seekable so it reads and writes sequentially whenever it can.
+type replayFile struct {
+	file   *os.File
+	offset int64
+}
+
+func (r *replayer) replay(message proto.Message) {
+	switch m := message.(type) {
+	case *Root:
+		r.commitMounts = len(m.GetFilesystem().GetCommitMounts()) > 0
+	case *DirectoryAttr:
+		r.attr(m, r.path(m.Directory), m.Result, m.Error)
+	case *FileAttr:
+		r.attr(m, r.path(m.File), m.Result, m.Error)
+	case *DirectoryLookup:
+		p := filepath.Join(r.path(m.Directory), m.Name)
+		_, err := os.Lstat(p)
+		r.compareErr(m, p, m.Err, err)
+	case *DirectoryReadDirAll:
+		p := r.path(m.Directory)
+		names, err := readDirNames(p)
+		if r.compareErr(m, p, m.Error, err) && m.Error == "" {
+			var recorded []string
+			for _, dirent := range m.Result {
+				recorded = append(recorded, dirent.Name)
+			}
+			sort.Strings(recorded)
+			r.compare(m, p, fmt.Sprint(recorded), fmt.Sprint(names))
+		}
+	case *DirectoryCreate:
+		if m.Result == nil {
+			// The name of a file which failed to be created isn't
+			// recorded.
+			return
+		}
+		p := r.path(m.Result)
+		file, err := os.OpenFile(p, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
+		if r.compareErr(m, p, m.Error, err) && err == nil {
+			r.open[p] = append(r.open[p], &replayFile{file: file})
+		}
+	case *DirectoryMkdir:
+		if m.Result == nil {
+			return
+		}
+		p := r.path(m.Result)
+		r.compareErr(m, p, m.Error, os.Mkdir(p, 0777))
+	case *FileRemove:
+		p := filepath.Join(r.path(m.File), m.Name)
+		r.compareErr(m, p, m.Error, os.Remove(p))
+	case *FileSetAttr:
+		if !m.SetSize {
+			return
+		}
+		p := r.path(m.File)
+		r.compareErr(m, p, m.Error, os.Truncate(p, int64(m.Size)))
+	case *FileOpen:
+		p := r.path(m.File)
+		flags := int(m.Flags) & (os.O_RDONLY | os.O_WRONLY | os.O_RDWR | os.O_APPEND | os.O_TRUNC)
+		file, err := os.OpenFile(p, flags, 0666)
+		if r.compareErr(m, p, m.Error, err) && err == nil {
+			r.open[p] = append(r.open[p], &replayFile{file: file})
+		}
+	case *FileRead:
+		p := r.path(m.File)
+		data, err := r.file(p).read(m.Offset, m.Size)
+		if r.compareErr(m, p, m.Error, err) && m.Error == "" {
+			r.compare(m, p, string(m.Data), string(data))
+		}
+	case *FileWrite:
+		p := r.path(m.File)
+		r.compareErr(m, p, m.Error, r.file(p).write(m.Data, m.Offset))
+	case *FileFlush:
+		// The kernel flushes files when they're closed.
+		p := r.path(m.File)
+		if len(r.open[p]) == 0 {
+			return
+		}
+		file := r.open[p][0]
+		r.open[p] = r.open[p][1:]
+		r.compareErr(m, p, m.Error, file.file.Close())
+	}
+}
+
+func (r *replayer) attr(message proto.Message, p string, result *Attr, recordedErr string) {
+	fileInfo, err := os.Lstat(p)
+	if r.compareErr(message, p, recordedErr, err) && recordedErr == "" && result != nil {
+		r.compare(message, p, os.FileMode(result.Mode).String(), fileInfo.Mode().String())
+	}
+}
+
+// path returns the path of a recorded node in the mount.
+func (r *replayer) path(node *Node) string {
+	file := node.GetFile()
+	if file == nil || file.Commit == nil || file.Commit.Repo == nil || file.Commit.Repo.Name == "" {
+		return r.mountPoint
+	}
+	repo := file.Commit.Repo.Name
+	if node.RepoAlias != "" {
+		repo = node.RepoAlias
+	}
+	if file.Commit.ID == "" || r.commitMounts {
+		return filepath.Join(r.mountPoint, repo, file.Path)
+	}
+	return filepath.Join(r.mountPoint, repo, commitIDToPath(file.Commit.ID), file.Path)
+}
+
+// file returns the most recently opened file at p, opening it for reading if
+// the trace didn't.
+func (r *replayer) file(p string) *replayFile {
+	if files := r.open[p]; len(files) > 0 {
+		return files[len(files)-1]
+	}
+	file, err := os.Open(p)
+	if err != nil {
+		return &replayFile{}
+	}
+	result := &replayFile{file: file}
+	r.open[p] = append(r.open[p], result)
+	return result
+}
+
+func (r *replayer) closeAll() {
+	for _, files := range r.open {
+		for _, file := range files {
+			file.file.Close()
+		}
+	}
+}
+
+// compareErr records a mismatch if exactly one of the recorded and replayed
+// operations failed, it returns true if they match.
+func (r *replayer) compareErr(message proto.Message, p string, recorded string, replayed error) bool {
+	if (recorded == "") == (replayed == nil) {
+		return true
+	}
+	r.compare(message, p, recorded, errorToString(replayed))
+	return false
+}
+
+func (r *replayer) compare(message proto.Message, p string, recorded string, replayed string) {
+	if recorded == replayed {
+		return
+	}
+	r.mismatches = append(r.mismatches, &Mismatch{
+		Index:    r.index,
+		Op:       proto.MessageName(message),
+		Path:     p,
+		Recorded: recorded,
+		Replayed: replayed,
+	})
+}
+
+func (f *replayFile) read(offset int64, size int64) ([]byte, error) {
+	if f.file == nil {
+		return nil, syscall.ENOENT
+	}
+	data := make([]byte, size)
+	var n int
+	var err error
+	if offset == f.offset {
+		n, err = io.ReadFull(f.file, data)
+		f.offset += int64(n)
+	} else {
+		n, err = f.file.ReadAt(data, offset)
+	}
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = nil
+	}
+	return data[:n], err
+}
+
+func (f *replayFile) write(data []byte, offset int64) error {
+	if f.file == nil {
+		return syscall.ENOENT
+	}
+	if offset == f.offset {
+		n, err := f.file.Write(data)
+		f.offset += int64(n)
+		return err
+	}
+	_, err := syscall.Pwrite(int(f.file.Fd()), data, offset)
+	return err
+}
+
+func readDirNames(p string) ([]string, error) {
+	dir, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer dir.Close()
+	names, err := dir.Readdirnames(-1)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	return names, nil
+}
@@ -0,0 +1,97 @@
+package fuse
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pkg/require"
+)
+
+func TestRecordTrace(t *testing.T) {
+	var trace bytes.Buffer
+	recorder := newRecorder(&trace)
+	node := &Node{File: client.NewFile("repo", "master/0", "file")}
+	messages := []proto.Message{
+		&FileWrite{File: node, Data: []byte("foo"), Offset: 3},
+		&FileRead{File: node, Data: []byte("foo"), Offset: 3, Size: 10},
+		// Data which isn't UTF-8 is recorded as is.
+		&FileWrite{File: node, Data: []byte{0xff, 0x00, 0xfe}},
+		&FileFlush{File: node, Error: "error"},
+	}
+	for _, message := range messages {
+		recorder.record(message)
+	}
+	// A nil recorder records nothing.
+	newRecorder(nil).record(messages[0])
+
+	result, err := readTrace(&trace)
+	require.NoError(t, err)
+	require.Equal(t, len(messages), len(result))
+	for i, message := range messages {
+		require.True(t, proto.Equal(message, result[i]))
+	}
+
+	_, err = readTrace(bytes.NewBufferString(`{"op": "fuse.Unknown", "message": {}}`))
+	require.YesError(t, err)
+}
+
+func TestReplay(t *testing.T) {
+	mountPoint, err := ioutil.TempDir("", "pachyderm-test-")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(mountPoint)
+	}()
+	require.NoError(t, os.Mkdir(filepath.Join(mountPoint, "repo"), 0777))
+
+	repo := &Node{File: client.NewFile("repo", "", "")}
+	node := &Node{File: client.NewFile("repo", "", "file")}
+	var trace bytes.Buffer
+	recorder := newRecorder(&trace)
+	recorder.record(&DirectoryCreate{Directory: repo, Result: node})
+	recorder.record(&FileWrite{File: node, Data: []byte("foo")})
+	recorder.record(&FileFlush{File: node})
+	recorder.record(&FileRead{File: node, Data: []byte("foo"), Size: 10})
+	recorder.record(&FileRead{File: node, Data: []byte("bar"), Size: 10})
+	recorder.record(&DirectoryLookup{Directory: repo, Name: "missing", Err: "no such file or directory"})
+	recorder.record(&DirectoryLookup{Directory: repo, Name: "file", Err: "no such file or directory"})
+
+	mismatches, err := Replay(&trace, mountPoint)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(mismatches))
+	require.Equal(t, 4, mismatches[0].Index)
+	require.Equal(t, "bar", mismatches[0].Recorded)
+	require.Equal(t, "foo", mismatches[0].Replayed)
+	require.Equal(t, 6, mismatches[1].Index)
+	require.Equal(t, filepath.Join(mountPoint, "repo", "file"), mismatches[1].Path)
+}
+
+func TestReplayBinary(t *testing.T) {
+	mountPoint, err := ioutil.TempDir("", "pachyderm-test-")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(mountPoint)
+	}()
+	require.NoError(t, os.Mkdir(filepath.Join(mountPoint, "repo"), 0777))
+
+	repo := &Node{File: client.NewFile("repo", "", "")}
+	node := &Node{File: client.NewFile("repo", "", "file")}
+	data := []byte{0xff, 0x00, 0xfe, 0x80, '\n'}
+	var trace bytes.Buffer
+	recorder := newRecorder(&trace)
+	recorder.record(&DirectoryCreate{Directory: repo, Result: node})
+	recorder.record(&FileWrite{File: node, Data: data})
+	recorder.record(&FileFlush{File: node})
+	recorder.record(&FileRead{File: node, Data: data, Size: 10})
+
+	mismatches, err := Replay(&trace, mountPoint)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(mismatches))
+	replayed, err := ioutil.ReadFile(filepath.Join(mountPoint, "repo", "file"))
+	require.NoError(t, err)
+	require.Equal(t, data, replayed)
+}
@@ -0,0 +1,158 @@
+package fuse
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"bazil.org/fuse"
+	"golang.org/x/net/context"
+)
+
+// The extended attributes of nodes in commits, they're read only.
+const (
+	// xattrCommitModified is the ID of the commit which last modified a
+	// file or directory.
+	xattrCommitModified = "user.pfs.commit_modified"
+	// xattrSize is the size of a file or directory in bytes.
+	xattrSize = "user.pfs.size"
+	// xattrHash is the hex encoded SHA-256 of a file's content.  It isn't
+	// listed, because computing it reads the whole file, so tools which
+	// dump every attribute don't read every file.
+	xattrHash = "user.pfs.hash"
+	// xattrProvenance is the provenance of a node's commit, one repo/commit
+	// per line.
+	xattrProvenance = "user.pfs.provenance"
+	// xattrJob is the ID of the job writing a node's commit, see
+	// Options.JobID.
+	xattrJob = "user.pfs.job"
+)
+
+func (d *directory) Listxattr(ctx context.Context, request *fuse.ListxattrRequest, response *fuse.ListxattrResponse) error {
+	response.Append(d.xattrNames()...)
+	return nil
+}
+
+func (d *directory) Getxattr(ctx context.Context, request *fuse.GetxattrRequest, response *fuse.GetxattrResponse) error {
+	value, err := d.xattr(request.Name)
+	if err != nil {
+		return err
+	}
+	response.Xattr = []byte(value)
+	return nil
+}
+
+func (f *file) Listxattr(ctx context.Context, request *fuse.ListxattrRequest, response *fuse.ListxattrResponse) error {
+	response.Append(f.xattrNames()...)
+	return nil
+}
+
+func (f *file) Getxattr(ctx context.Context, request *fuse.GetxattrRequest, response *fuse.GetxattrResponse) error {
+	if request.Name != xattrHash {
+		return f.directory.Getxattr(ctx, request, response)
+	}
+	hash, err := f.contentHash()
+	if err != nil {
+		return err
+	}
+	response.Xattr = []byte(hash)
+	return nil
+}
+
+func (d *directory) xattrNames() []string {
+	if d.File.Commit.ID == "" {
+		return nil
+	}
+	result := []string{xattrProvenance}
+	if d.File.Path != "" {
+		result = append(result, xattrCommitModified, xattrSize)
+	}
+	if d.isOutput() {
+		result = append(result, xattrJob)
+	}
+	return result
+}
+
+func (d *directory) xattr(name string) (string, error) {
+	if d.File.Commit.ID == "" {
+		return "", fuse.ErrNoXattr
+	}
+	switch name {
+	case xattrProvenance:
+		commitInfo, err := d.fs.apiClient.InspectCommit(d.File.Commit.Repo.Name, d.File.Commit.ID)
+		if err != nil {
+			return "", err
+		}
+		var provenance []string
+		for _, commit := range commitInfo.Provenance {
+			provenance = append(provenance, fmt.Sprintf("%s/%s", commit.Repo.Name, commit.ID))
+		}
+		return strings.Join(provenance, "\n"), nil
+	case xattrCommitModified, xattrSize:
+		if d.File.Path == "" {
+			break
+		}
+		fileInfo, err := d.fs.apiClient.InspectFile(
+			d.File.Commit.Repo.Name,
+			d.File.Commit.ID,
+			d.File.Path,
+			d.fs.getFromCommitID(d.getRepoOrAliasName()),
+			d.fs.getFullFile(d.getRepoOrAliasName()),
+			d.Shard,
+		)
+		if err != nil {
+			return "", err
+		}
+		if name == xattrSize {
+			return fmt.Sprintf("%d", fileInfo.SizeBytes), nil
+		}
+		if fileInfo.CommitModified == nil {
+			return "", fuse.ErrNoXattr
+		}
+		return fileInfo.CommitModified.ID, nil
+	case xattrJob:
+		if d.isOutput() {
+			return d.fs.opts.JobID, nil
+		}
+	}
+	return "", fuse.ErrNoXattr
+}
+
+// isOutput returns true if d is in the output commit of the job the
+// filesystem is mounted for.
+func (d *directory) isOutput() bool {
+	return d.fs.opts.JobID != "" && d.Write
+}
+
+// contentHash returns the hex encoded SHA-256 of f's content, it's only
+// computed once for files in finished commits.
+func (f *file) contentHash() (string, error) {
+	f.lock.Lock()
+	hash := f.hash
+	f.lock.Unlock()
+	if hash != "" {
+		return hash, nil
+	}
+	hasher := sha256.New()
+	if err := f.fs.apiClient.GetFile(
+		f.File.Commit.Repo.Name,
+		f.File.Commit.ID,
+		f.File.Path,
+		0,
+		0,
+		f.fs.getFromCommitID(f.getRepoOrAliasName()),
+		f.fs.getFullFile(f.getRepoOrAliasName()),
+		f.Shard,
+		hasher,
+	); err != nil {
+		return "", err
+	}
+	hash = hex.EncodeToString(hasher.Sum(nil))
+	if f.finished {
+		f.lock.Lock()
+		f.hash = hash
+		f.lock.Unlock()
+	}
+	return hash, nil
+}
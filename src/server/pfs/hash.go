@@ -1,9 +1,13 @@
 package pfs
 
 import (
+	"fmt"
 	"hash/adler32"
+	"hash/fnv"
 	"path"
+	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/sjezewski/pachyderm/src/client/pfs"
 )
@@ -12,6 +16,9 @@ import (
 type Hasher struct {
 	FileModulus  uint64
 	BlockModulus uint64
+	// FileHash selects how files are hashed, nil means
+	// FileHashMethod_TOP_LEVEL.
+	FileHash *pfs.FileHash
 }
 
 // NewHasher creates a Hasher.
@@ -24,7 +31,25 @@ func NewHasher(fileModulus uint64, blockModulus uint64) *Hasher {
 
 // HashFile computes and returns a hash of a file.
 func (s *Hasher) HashFile(file *pfs.File) uint64 {
-	return uint64(adler32.Checksum([]byte(path.Clean(file.Path)))) % s.FileModulus
+	p := path.Clean(file.Path)
+	if len(p) > 0 && p[0] == '/' {
+		p = p[1:]
+	}
+	switch fileHashMethod(s.FileHash) {
+	case pfs.FileHashMethod_PATH:
+		return hashString(p) % s.FileModulus
+	case pfs.FileHashMethod_DIRECTORY:
+		var dir string
+		if i := strings.LastIndex(p, "/"); i >= 0 {
+			dir = p[:i]
+		}
+		return hashString(dir) % s.FileModulus
+	case pfs.FileHashMethod_PREFIX_RANGE:
+		return prefixRange(p, s.FileModulus)
+	case pfs.FileHashMethod_KEY:
+		return hashString(fileKey(s.FileHash.Key, p)) % s.FileModulus
+	}
+	return uint64(adler32.Checksum([]byte(topLevelPath(p)))) % s.FileModulus
 }
 
 // HashBlock computes and returns a hash of a block.
@@ -39,26 +64,61 @@ func (s *Hasher) HashBlock(file *pfs.File, block *pfs.Block) uint64 {
 	return uint64(adler32.Checksum([]byte(str))) % s.BlockModulus
 }
 
-// FileInShard checks if a given file belongs in a given shard, using only the
-// file's top-level path.  That is, for a path like foo/bar/buzz, FileInShard only
-// considers foo
+// FileInShard checks if a given file belongs in a given shard.  By default
+// only the file's top-level path is used.  That is, for a path like
+// foo/bar/buzz, FileInShard only considers foo.  The shard's FileHash can
+// select a different method.
+//
+// If the shard has a glob, files are in it only if they're in one of the
+// glob's datums, and all the files of a datum are in the same shard.  Datums
+// are hashed by their path, or by the shard's FileHash if it has one.
 func FileInShard(shard *pfs.Shard, file *pfs.File) bool {
+	if shard != nil && shard.Glob != "" {
+		datum, ok := globDatum(shard.Glob, cleanPath(file.Path))
+		if !ok {
+			return false
+		}
+		if shard.FileModulus == 0 {
+			return true
+		}
+		fileHash := shard.FileHash
+		if fileHash == nil {
+			fileHash = &pfs.FileHash{Method: pfs.FileHashMethod_PATH}
+		}
+		sharder := &Hasher{FileModulus: shard.FileModulus, FileHash: fileHash}
+		return sharder.HashFile(&pfs.File{Path: datum}) == shard.FileNumber
+	}
 	if shard == nil || shard.FileModulus == 0 {
 		// this lets us default to no filtering
 		return true
 	}
-	path := path.Clean(file.Path)
-	if len(path) > 0 && path[0] == '/' {
-		path = path[1:]
+	sharder := &Hasher{FileModulus: shard.FileModulus, FileHash: shard.FileHash}
+	return sharder.HashFile(file) == shard.FileNumber
+}
+
+// DirectoryInShard checks if a given directory belongs in a given shard.
+// With TOP_LEVEL hashing a directory is in the same shard as the files in
+// it, with any other method those files can be in any shard, so the
+// directory is in every shard.  With a glob, directories above the datums
+// are in every shard if they could contain a datum.
+func DirectoryInShard(shard *pfs.Shard, file *pfs.File) bool {
+	if shard != nil && shard.Glob != "" {
+		p := cleanPath(file.Path)
+		globParts := strings.Split(cleanPath(shard.Glob), "/")
+		var depth int
+		if p != "" {
+			depth = len(strings.Split(p, "/"))
+		}
+		if depth < len(globParts) {
+			match, err := path.Match(strings.Join(globParts[:depth], "/"), p)
+			return err == nil && match
+		}
+		return FileInShard(shard, file)
 	}
-	parts := strings.Split(path, "/")
-	var topLevelPath string
-	if len(parts) > 0 {
-		topLevelPath = parts[0]
+	if fileHashMethod(shard.GetFileHash()) != pfs.FileHashMethod_TOP_LEVEL {
+		return true
 	}
-	sharder := &Hasher{FileModulus: shard.FileModulus}
-	f := &pfs.File{Path: topLevelPath}
-	return sharder.HashFile(f) == shard.FileNumber
+	return FileInShard(shard, file)
 }
 
 // BlockInShard returns true if the block is in the given shard.
@@ -70,3 +130,146 @@ func BlockInShard(shard *pfs.Shard, file *pfs.File, block *pfs.Block) bool {
 	sharder := &Hasher{BlockModulus: shard.BlockModulus}
 	return sharder.HashBlock(file, block) == shard.BlockNumber
 }
+
+// ValidateFileHash returns an error if fileHash can't be used to hash files.
+func ValidateFileHash(fileHash *pfs.FileHash) error {
+	if fileHash == nil {
+		return nil
+	}
+	if _, ok := pfs.FileHashMethod_name[int32(fileHash.Method)]; !ok {
+		return fmt.Errorf("unrecognized file hash method: %d", fileHash.Method)
+	}
+	if fileHash.Method != pfs.FileHashMethod_KEY {
+		return nil
+	}
+	if fileHash.Key == "" {
+		return fmt.Errorf("the KEY file hash method requires a key")
+	}
+	_, err := compileKey(fileHash.Key)
+	return err
+}
+
+// ValidateGlob returns an error if glob can't be used to define datums.
+func ValidateGlob(glob string) error {
+	if glob == "" {
+		return nil
+	}
+	if cleanPath(glob) == "" {
+		return fmt.Errorf("glob %q matches no paths", glob)
+	}
+	// path.Match only reports a bad pattern if it gets as far as the bad
+	// part, so match against a path long enough to get there.
+	if _, err := path.Match(glob, strings.Repeat("a/", strings.Count(glob, "/")+1)); err != nil {
+		return fmt.Errorf("invalid glob %q: %s", glob, err.Error())
+	}
+	return nil
+}
+
+func fileHashMethod(fileHash *pfs.FileHash) pfs.FileHashMethod {
+	if fileHash == nil {
+		return pfs.FileHashMethod_TOP_LEVEL
+	}
+	return fileHash.Method
+}
+
+// cleanPath cleans p and removes its leading slash, the root directory is "".
+func cleanPath(p string) string {
+	p = strings.TrimPrefix(path.Clean(p), "/")
+	if p == "." {
+		return ""
+	}
+	return p
+}
+
+// globDatum returns the datum of glob which p is in, that's the prefix of p
+// with as many components as glob if it matches glob.
+func globDatum(glob string, p string) (string, bool) {
+	glob = cleanPath(glob)
+	depth := strings.Count(glob, "/") + 1
+	parts := strings.Split(p, "/")
+	if p == "" || len(parts) < depth {
+		return "", false
+	}
+	datum := strings.Join(parts[:depth], "/")
+	if match, err := path.Match(glob, datum); err != nil || !match {
+		return "", false
+	}
+	return datum, true
+}
+
+func topLevelPath(p string) string {
+	return path.Clean(strings.Split(p, "/")[0])
+}
+
+func hashString(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return h.Sum64()
+}
+
+// prefixRangeChars is the number of leading characters of a path which
+// determine its range.
+const prefixRangeChars = 8
+
+// prefixRange splits the space of paths into modulus contiguous ranges and
+// returns the one path is in.  Only printable ASCII characters are
+// distinguished, the rest sort with the nearest printable character.
+func prefixRange(p string, modulus uint64) uint64 {
+	var position float64
+	scale := 1.0
+	for i := 0; i < len(p) && i < prefixRangeChars; i++ {
+		c := p[i]
+		if c < ' ' {
+			c = ' '
+		} else if c > '~' {
+			c = '~'
+		}
+		scale /= '~' - ' ' + 1
+		position += float64(c-' ') * scale
+	}
+	result := uint64(position * float64(modulus))
+	if result >= modulus {
+		result = modulus - 1
+	}
+	return result
+}
+
+var (
+	keyRegexps     = make(map[string]*regexp.Regexp)
+	keyRegexpsLock sync.Mutex
+)
+
+// compileKey compiles the regex of a FileHash, keeping the result since
+// it's used for every file in a shard.
+func compileKey(key string) (*regexp.Regexp, error) {
+	keyRegexpsLock.Lock()
+	defer keyRegexpsLock.Unlock()
+	if re, ok := keyRegexps[key]; ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(key)
+	if err != nil {
+		return nil, err
+	}
+	keyRegexps[key] = re
+	return re, nil
+}
+
+// fileKey returns the part of p that key extracts, or p itself if it doesn't
+// match.
+func fileKey(key string, p string) string {
+	re, err := compileKey(key)
+	if err != nil {
+		// Keys are validated when jobs are created, this only happens if
+		// someone bypasses that.
+		return p
+	}
+	match := re.FindStringSubmatch(p)
+	switch {
+	case match == nil:
+		return p
+	case len(match) > 1:
+		return match[1]
+	}
+	return match[0]
+}
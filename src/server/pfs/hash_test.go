@@ -0,0 +1,120 @@
+package pfs
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/sjezewski/pachyderm/src/client/pfs"
+	"github.com/sjezewski/pachyderm/src/client/pkg/require"
+)
+
+func newHasher(modulus uint64, method pfs.FileHashMethod, key string) *Hasher {
+	return &Hasher{
+		FileModulus: modulus,
+		FileHash:    &pfs.FileHash{Method: method, Key: key},
+	}
+}
+
+func TestHashFilePath(t *testing.T) {
+	hasher := newHasher(4, pfs.FileHashMethod_PATH, "")
+	// Files which share a top-level directory are spread across shards.
+	shards := make(map[uint64]bool)
+	for i := 0; i < 100; i++ {
+		shards[hasher.HashFile(&pfs.File{Path: fmt.Sprintf("dir/file%d", i)})] = true
+	}
+	require.Equal(t, 4, len(shards))
+	require.Equal(t, hasher.HashFile(&pfs.File{Path: "dir/file"}), hasher.HashFile(&pfs.File{Path: "/dir/file"}))
+	require.Equal(t, hasher.HashFile(&pfs.File{Path: "dir/file"}), hasher.HashFile(&pfs.File{Path: "dir//file"}))
+}
+
+func TestHashFileDirectory(t *testing.T) {
+	hasher := newHasher(16, pfs.FileHashMethod_DIRECTORY, "")
+	require.Equal(t, hasher.HashFile(&pfs.File{Path: "a/b/c"}), hasher.HashFile(&pfs.File{Path: "/a/b/d"}))
+	require.Equal(t, hasher.HashFile(&pfs.File{Path: "c"}), hasher.HashFile(&pfs.File{Path: "/d"}))
+	// Subdirectories aren't hashed with their parents.
+	shards := make(map[uint64]bool)
+	for i := 0; i < 100; i++ {
+		shards[hasher.HashFile(&pfs.File{Path: fmt.Sprintf("a/dir%d/file", i)})] = true
+	}
+	require.True(t, len(shards) > 1)
+}
+
+func TestHashFilePrefixRange(t *testing.T) {
+	hasher := newHasher(8, pfs.FileHashMethod_PREFIX_RANGE, "")
+	var paths []string
+	for c := ' '; c <= '~'; c++ {
+		if c != '/' {
+			paths = append(paths, fmt.Sprintf("%cfile", c))
+		}
+	}
+	sort.Strings(paths)
+	// Ranges are contiguous, so sorted paths have sorted shards.
+	var last uint64
+	for _, p := range paths {
+		shard := hasher.HashFile(&pfs.File{Path: p})
+		require.True(t, shard < 8)
+		require.True(t, shard >= last)
+		last = shard
+	}
+	require.Equal(t, uint64(7), last)
+	// Characters outside of printable ASCII sort with the nearest one.
+	require.Equal(t, uint64(0), prefixRange("", 8))
+	require.Equal(t, uint64(0), prefixRange("\x01", 8))
+	require.Equal(t, uint64(7), prefixRange("\xff\xff\xff\xff\xff\xff\xff\xff\xff", 8))
+	// Only the first prefixRangeChars characters matter.
+	require.Equal(t, hasher.HashFile(&pfs.File{Path: "abcdefghij"}), hasher.HashFile(&pfs.File{Path: "abcdefghzz"}))
+}
+
+func TestHashFileKey(t *testing.T) {
+	hasher := newHasher(16, pfs.FileHashMethod_KEY, `^user(\d+)_`)
+	require.Equal(t, hasher.HashFile(&pfs.File{Path: "user1_a.json"}), hasher.HashFile(&pfs.File{Path: "/user1_b.json"}))
+	// The key is what's hashed.
+	require.Equal(t, hashString("1")%16, hasher.HashFile(&pfs.File{Path: "user1_a.json"}))
+	// Paths the key doesn't match are hashed by their whole path.
+	require.Equal(t, hashString("other.json")%16, hasher.HashFile(&pfs.File{Path: "other.json"}))
+	// Without a group the whole match is the key.
+	hasher = newHasher(16, pfs.FileHashMethod_KEY, `^[a-z]+`)
+	require.Equal(t, hashString("user")%16, hasher.HashFile(&pfs.File{Path: "user1_a.json"}))
+}
+
+func TestDirectoryInShard(t *testing.T) {
+	require.True(t, DirectoryInShard(nil, &pfs.File{Path: "dir"}))
+	for _, method := range []pfs.FileHashMethod{
+		pfs.FileHashMethod_PATH,
+		pfs.FileHashMethod_DIRECTORY,
+		pfs.FileHashMethod_PREFIX_RANGE,
+		pfs.FileHashMethod_KEY,
+	} {
+		// The files in a directory can be in any shard, so the directory
+		// is in every shard.
+		for i := uint64(0); i < 4; i++ {
+			shard := &pfs.Shard{
+				FileNumber:  i,
+				FileModulus: 4,
+				FileHash:    &pfs.FileHash{Method: method, Key: "(.*)"},
+			}
+			require.True(t, DirectoryInShard(shard, &pfs.File{Path: "dir"}))
+		}
+	}
+	// With TOP_LEVEL hashing a directory is in exactly one shard, the
+	// shard of the files in it.
+	var shards []uint64
+	for i := uint64(0); i < 4; i++ {
+		shard := &pfs.Shard{FileNumber: i, FileModulus: 4}
+		if DirectoryInShard(shard, &pfs.File{Path: "dir"}) {
+			shards = append(shards, i)
+			require.True(t, FileInShard(shard, &pfs.File{Path: "dir/file"}))
+		}
+	}
+	require.Equal(t, 1, len(shards))
+}
+
+func TestValidateFileHash(t *testing.T) {
+	require.NoError(t, ValidateFileHash(nil))
+	require.NoError(t, ValidateFileHash(&pfs.FileHash{Method: pfs.FileHashMethod_PATH}))
+	require.NoError(t, ValidateFileHash(&pfs.FileHash{Method: pfs.FileHashMethod_KEY, Key: `^(\w+)_`}))
+	require.YesError(t, ValidateFileHash(&pfs.FileHash{Method: pfs.FileHashMethod(100)}))
+	require.YesError(t, ValidateFileHash(&pfs.FileHash{Method: pfs.FileHashMethod_KEY}))
+	require.YesError(t, ValidateFileHash(&pfs.FileHash{Method: pfs.FileHashMethod_KEY, Key: `(`}))
+}
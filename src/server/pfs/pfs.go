@@ -36,6 +36,11 @@ type ErrParentCommitNotFound struct {
 	error
 }
 
+// ErrUploadNotFound represents an upload-not-found error.
+type ErrUploadNotFound struct {
+	error
+}
+
 // NewErrFileNotFound creates a new ErrFileNotFound.
 func NewErrFileNotFound(file string, repo string, commitID string) *ErrFileNotFound {
 	return &ErrFileNotFound{
@@ -78,6 +83,13 @@ func NewErrParentCommitNotFound(repo string, commitID string) *ErrParentCommitNo
 	}
 }
 
+// NewErrUploadNotFound creates a new ErrUploadNotFound.
+func NewErrUploadNotFound(id string) *ErrUploadNotFound {
+	return &ErrUploadNotFound{
+		error: fmt.Errorf("upload %v not found", id),
+	}
+}
+
 // ByteRangeSize returns byteRange.Upper - byteRange.Lower.
 func ByteRangeSize(byteRange *pfs.ByteRange) uint64 {
 	return byteRange.Upper - byteRange.Lower
@@ -0,0 +1,199 @@
+/*
+Package replica copies commits from one Pachyderm cluster to another.
+
+A commit is copied by pulling it from the source cluster, which returns its
+metadata and references to the blocks holding its content, copying the
+blocks that the target cluster doesn't have and pushing the commit to the
+target cluster.  The commit keeps its ID, branch, clock and provenance, so
+its parent and provenance are copied first if the target cluster doesn't
+have them.
+*/
+package replica
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pfs"
+
+	"golang.org/x/net/context"
+)
+
+// Replicate copies a finished commit from the cluster that from is
+// connected to into the cluster that to is connected to, along with any of
+// its ancestors and provenance that the target cluster doesn't have.  Repos
+// are created in the target cluster as needed.  It returns the commits that
+// were copied, in the order they were copied.
+func Replicate(from *client.APIClient, to *client.APIClient, repoName string, commitID string) ([]*pfs.Commit, error) {
+	r := &replicator{
+		from:    from,
+		to:      to,
+		commits: make(map[string]map[string]*pfs.CommitInfo),
+		blocks:  make(map[string]bool),
+	}
+	if err := r.replicateCommit(client.NewCommit(repoName, commitID)); err != nil {
+		return nil, err
+	}
+	return r.replicated, nil
+}
+
+type replicator struct {
+	from *client.APIClient
+	to   *client.APIClient
+	// repos holds the names of the repos in the target cluster, it's nil
+	// until they're listed.
+	repos map[string]bool
+	// commits holds the commits in each repo of the target cluster, by ID,
+	// repos that haven't been looked at yet are missing.
+	commits map[string]map[string]*pfs.CommitInfo
+	// blocks holds the hashes of blocks known to be in the target cluster.
+	blocks     map[string]bool
+	replicated []*pfs.Commit
+}
+
+// replicateRepo creates a repo, and its provenance, in the target cluster if
+// they don't exist.
+func (r *replicator) replicateRepo(repoName string) error {
+	if r.commits[repoName] != nil {
+		return nil
+	}
+	if r.repos == nil {
+		repoInfos, err := r.to.ListRepo(nil)
+		if err != nil {
+			return err
+		}
+		r.repos = make(map[string]bool)
+		for _, repoInfo := range repoInfos {
+			r.repos[repoInfo.Repo.Name] = true
+		}
+	}
+	commits := make(map[string]*pfs.CommitInfo)
+	if r.repos[repoName] {
+		commitInfos, err := r.to.ListCommit([]*pfs.Commit{client.NewCommit(repoName, "")}, nil, client.CommitTypeNone, client.CommitStatusAll, false)
+		if err != nil {
+			return err
+		}
+		for _, commitInfo := range commitInfos {
+			commits[commitInfo.Commit.ID] = commitInfo
+		}
+	} else {
+		repoInfo, err := r.from.InspectRepo(repoName)
+		if err != nil {
+			return err
+		}
+		for _, repo := range repoInfo.Provenance {
+			if err := r.replicateRepo(repo.Name); err != nil {
+				return err
+			}
+		}
+		if _, err := r.to.PfsAPIClient.CreateRepo(context.Background(), &pfs.CreateRepoRequest{
+			Repo:       repoInfo.Repo,
+			Provenance: repoInfo.Provenance,
+		}); err != nil {
+			return err
+		}
+		r.repos[repoName] = true
+	}
+	r.commits[repoName] = commits
+	return nil
+}
+
+// replicateCommit copies a commit into the target cluster, if it isn't
+// there already, after copying its parent and provenance.
+func (r *replicator) replicateCommit(commit *pfs.Commit) error {
+	if err := r.replicateRepo(commit.Repo.Name); err != nil {
+		return err
+	}
+	// commit.ID may be a branch name.
+	commitInfo, err := r.from.InspectCommit(commit.Repo.Name, commit.ID)
+	if err != nil {
+		return err
+	}
+	if target, ok := r.commits[commit.Repo.Name][commitInfo.Commit.ID]; ok {
+		// Commit IDs are a branch and a clock, so the target cluster's
+		// commit may be an unrelated one with the same ID.
+		if !sameCommit(commitInfo, target) {
+			return fmt.Errorf("commit %s/%s in the target cluster is different from the one being replicated", commit.Repo.Name, commitInfo.Commit.ID)
+		}
+		return nil
+	}
+	replica, err := r.from.PullCommit(commit.Repo.Name, commitInfo.Commit.ID)
+	if err != nil {
+		return err
+	}
+	commitInfo = replica.CommitInfo
+	if commitInfo.ParentCommit != nil {
+		if err := r.replicateCommit(commitInfo.ParentCommit); err != nil {
+			return err
+		}
+	}
+	for _, provenance := range commitInfo.Provenance {
+		if err := r.replicateCommit(provenance); err != nil {
+			return err
+		}
+	}
+	for _, fileDiff := range replica.FileDiffs {
+		for _, blockRef := range fileDiff.BlockRefs {
+			if err := r.replicateBlock(blockRef.Block.Hash); err != nil {
+				return err
+			}
+		}
+	}
+	if err := r.to.PushCommit(replica); err != nil {
+		return err
+	}
+	r.commits[commit.Repo.Name][commitInfo.Commit.ID] = commitInfo
+	r.replicated = append(r.replicated, commitInfo.Commit)
+	return nil
+}
+
+// sameCommit returns true if two commits with the same ID have the same
+// parent, provenance and size.
+func sameCommit(a *pfs.CommitInfo, b *pfs.CommitInfo) bool {
+	if a.SizeBytes != b.SizeBytes || !sameCommitID(a.ParentCommit, b.ParentCommit) || len(a.Provenance) != len(b.Provenance) {
+		return false
+	}
+	provenance := make(map[string]bool)
+	for _, commit := range a.Provenance {
+		provenance[path.Join(commit.Repo.Name, commit.ID)] = true
+	}
+	for _, commit := range b.Provenance {
+		if !provenance[path.Join(commit.Repo.Name, commit.ID)] {
+			return false
+		}
+	}
+	return true
+}
+
+func sameCommitID(a *pfs.Commit, b *pfs.Commit) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Repo.Name == b.Repo.Name && a.ID == b.ID
+}
+
+// replicateBlock copies a block into the target cluster if InspectBlock
+// can't find it there.
+func (r *replicator) replicateBlock(hash string) error {
+	if r.blocks[hash] {
+		return nil
+	}
+	if _, err := r.to.InspectBlock(hash); err != nil {
+		reader, err := r.from.GetBlock(hash, 0, 0)
+		if err != nil {
+			return err
+		}
+		// Without a delimiter PutBlock stores everything it's given as a
+		// single block, so the block keeps its hash.
+		blockRefs, err := r.to.PutBlock(pfs.Delimiter_NONE, reader)
+		if err != nil {
+			return err
+		}
+		if len(blockRefs.BlockRef) == 0 || blockRefs.BlockRef[0].Block.Hash != hash {
+			return fmt.Errorf("block %s has a different hash after being copied", hash)
+		}
+	}
+	r.blocks[hash] = true
+	return nil
+}
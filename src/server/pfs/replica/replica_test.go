@@ -0,0 +1,133 @@
+package replica
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pfs"
+	"github.com/sjezewski/pachyderm/src/client/pkg/require"
+	pfsdb "github.com/sjezewski/pachyderm/src/server/pfs/db"
+	pfsserver "github.com/sjezewski/pachyderm/src/server/pfs/server"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func TestReplicate(t *testing.T) {
+	src, stopSrc := newCluster(t)
+	defer stopSrc()
+	dst, stopDst := newCluster(t)
+	defer stopDst()
+
+	require.NoError(t, src.CreateRepo("in"))
+	_, err := src.PfsAPIClient.CreateRepo(context.Background(), &pfs.CreateRepoRequest{
+		Repo:       client.NewRepo("out"),
+		Provenance: []*pfs.Repo{client.NewRepo("in")},
+	})
+	require.NoError(t, err)
+	commit1, err := src.StartCommit("in", "master")
+	require.NoError(t, err)
+	_, err = src.PutFile("in", commit1.ID, "file", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	require.NoError(t, src.FinishCommit("in", commit1.ID))
+	commit2, err := src.StartCommit("in", "master")
+	require.NoError(t, err)
+	_, err = src.PutFile("in", commit2.ID, "file", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+	require.NoError(t, src.FinishCommit("in", commit2.ID))
+	outCommit, err := src.PfsAPIClient.StartCommit(context.Background(), &pfs.StartCommitRequest{
+		Parent:     client.NewCommit("out", "master"),
+		Provenance: []*pfs.Commit{commit1},
+	})
+	require.NoError(t, err)
+	_, err = src.PutFile("out", outCommit.ID, "file", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	require.NoError(t, src.FinishCommit("out", outCommit.ID))
+
+	// The output commit's provenance is replicated first, the repos are
+	// created as needed.
+	commits, err := Replicate(src, dst, "out", "master")
+	require.NoError(t, err)
+	require.Equal(t, []*pfs.Commit{commit1, outCommit}, commits)
+	outInfo, err := dst.InspectCommit("out", outCommit.ID)
+	require.NoError(t, err)
+	require.Equal(t, []*pfs.Commit{commit1}, outInfo.Provenance)
+	repoInfo, err := dst.InspectRepo("out")
+	require.NoError(t, err)
+	require.Equal(t, []*pfs.Repo{client.NewRepo("in")}, repoInfo.Provenance)
+	var buffer bytes.Buffer
+	require.NoError(t, dst.GetFile("out", outCommit.ID, "file", 0, 0, "", false, nil, &buffer))
+	require.Equal(t, "foo\n", buffer.String())
+
+	// Only the commits dst doesn't have are replicated.
+	commits, err = Replicate(src, dst, "in", "master")
+	require.NoError(t, err)
+	require.Equal(t, []*pfs.Commit{commit2}, commits)
+	buffer.Reset()
+	require.NoError(t, dst.GetFile("in", "master", "file", 0, 0, "", false, nil, &buffer))
+	require.Equal(t, "foo\nbar\n", buffer.String())
+	commits, err = Replicate(src, dst, "in", "master")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(commits))
+
+	// Commits can be replicated back the other way.
+	commit3, err := dst.StartCommit("in", "master")
+	require.NoError(t, err)
+	_, err = dst.PutFile("in", commit3.ID, "file", strings.NewReader("baz\n"))
+	require.NoError(t, err)
+	require.NoError(t, dst.FinishCommit("in", commit3.ID))
+	commits, err = Replicate(dst, src, "in", commit3.ID)
+	require.NoError(t, err)
+	require.Equal(t, []*pfs.Commit{commit3}, commits)
+	buffer.Reset()
+	require.NoError(t, src.GetFile("in", "master", "file", 0, 0, "", false, nil, &buffer))
+	require.Equal(t, "foo\nbar\nbaz\n", buffer.String())
+
+	// A commit with the same ID but different content isn't skipped.
+	for _, c := range []*client.APIClient{src, dst} {
+		require.NoError(t, c.CreateRepo("other"))
+	}
+	srcCommit, err := src.StartCommit("other", "master")
+	require.NoError(t, err)
+	_, err = src.PutFile("other", srcCommit.ID, "file", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	require.NoError(t, src.FinishCommit("other", srcCommit.ID))
+	dstCommit, err := dst.StartCommit("other", "master")
+	require.NoError(t, err)
+	_, err = dst.PutFile("other", dstCommit.ID, "file", strings.NewReader("different\n"))
+	require.NoError(t, err)
+	require.NoError(t, dst.FinishCommit("other", dstCommit.ID))
+	require.Equal(t, srcCommit.ID, dstCommit.ID)
+	_, err = Replicate(src, dst, "other", srcCommit.ID)
+	require.YesError(t, err)
+}
+
+// newCluster serves pfs from a temporary directory and returns a client
+// for it and a function that stops the server.
+func newCluster(t *testing.T) (*client.APIClient, func()) {
+	dir, err := ioutil.TempDir("", "replica")
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	blockAPIServer, err := pfsserver.NewLocalBlockAPIServer(dir)
+	require.NoError(t, err)
+	driver, err := pfsdb.NewBoltDriver(address, filepath.Join(dir, "pfs.db"))
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	pfs.RegisterBlockAPIServer(server, blockAPIServer)
+	pfs.RegisterAPIServer(server, pfsserver.NewAPIServer(driver))
+	go server.Serve(listener)
+	c, err := client.NewFromAddress(address)
+	require.NoError(t, err)
+	return c, func() {
+		server.Stop()
+		os.RemoveAll(dir)
+	}
+}
@@ -0,0 +1,85 @@
+package s3
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// chunkedReader decodes a payload sent with chunked signatures
+// (aws-chunked), which is a series of chunks of the form:
+//
+//   hex(size);chunk-signature=signature\r\n
+//   data\r\n
+//
+// ending with a chunk of size 0.  The signatures aren't checked.
+type chunkedReader struct {
+	r *bufio.Reader
+	// remaining is the number of bytes left in the current chunk
+	remaining uint64
+	done      bool
+}
+
+func newChunkedReader(r io.Reader) io.Reader {
+	return &chunkedReader{r: bufio.NewReader(r)}
+}
+
+func (c *chunkedReader) Read(p []byte) (int, error) {
+	for c.remaining == 0 {
+		if c.done {
+			return 0, io.EOF
+		}
+		if err := c.readHeader(); err != nil {
+			return 0, err
+		}
+	}
+	if uint64(len(p)) > c.remaining {
+		p = p[:c.remaining]
+	}
+	n, err := c.r.Read(p)
+	c.remaining -= uint64(n)
+	if err == nil && c.remaining == 0 {
+		err = c.readCRLF()
+	}
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (c *chunkedReader) readHeader() error {
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	line = strings.TrimRight(line, "\r\n")
+	size := line
+	if i := strings.Index(line, ";"); i >= 0 {
+		size = line[:i]
+	}
+	c.remaining, err = strconv.ParseUint(size, 16, 64)
+	if err != nil {
+		return fmt.Errorf("invalid chunk header %q", line)
+	}
+	if c.remaining == 0 {
+		c.done = true
+	}
+	return nil
+}
+
+// readCRLF reads the line break that ends the data of a chunk.
+func (c *chunkedReader) readCRLF() error {
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		return err
+	}
+	if strings.TrimRight(line, "\r\n") != "" {
+		return fmt.Errorf("invalid chunk trailer %q", line)
+	}
+	return nil
+}
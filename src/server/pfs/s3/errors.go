@@ -0,0 +1,84 @@
+package s3
+
+import (
+	"encoding/xml"
+	"net/http"
+
+	pfsserver "github.com/sjezewski/pachyderm/src/server/pfs"
+
+	"go.pedge.io/lion/proto"
+)
+
+// s3Error is an error with an S3 error code.
+type s3Error struct {
+	status  int
+	code    string
+	message string
+}
+
+func newError(status int, code string, message string) *s3Error {
+	return &s3Error{
+		status:  status,
+		code:    code,
+		message: message,
+	}
+}
+
+func (e *s3Error) Error() string {
+	return e.message
+}
+
+var (
+	errNotImplemented = newError(http.StatusNotImplemented, "NotImplemented", "a header or query parameter you provided implies functionality that is not implemented")
+	errInvalidRange   = newError(http.StatusRequestedRangeNotSatisfiable, "InvalidRange", "the requested range is not satisfiable")
+	errNoSuchKey      = newError(http.StatusNotFound, "NoSuchKey", "the specified key does not exist")
+	errInvalidPart    = newError(http.StatusBadRequest, "InvalidPart", "one or more of the specified parts could not be found")
+)
+
+// bucketError converts an error from the driver about a bucket's repo or
+// commit to an s3Error.
+func bucketError(err error) error {
+	switch err.(type) {
+	case *pfsserver.ErrRepoNotFound, *pfsserver.ErrCommitNotFound:
+		return newError(http.StatusNotFound, "NoSuchBucket", err.Error())
+	case *pfsserver.ErrCommitFinished:
+		return newError(http.StatusConflict, "InvalidBucketState", err.Error())
+	}
+	return err
+}
+
+// objectError converts an error from the driver about an object to an
+// s3Error.
+func objectError(err error) error {
+	switch err.(type) {
+	case *pfsserver.ErrFileNotFound:
+		return newError(http.StatusNotFound, "NoSuchKey", err.Error())
+	case *pfsserver.ErrUploadNotFound:
+		return newError(http.StatusNotFound, "NoSuchUpload", err.Error())
+	}
+	return bucketError(err)
+}
+
+type errorResponse struct {
+	XMLName  xml.Name `xml:"Error"`
+	Code     string   `xml:"Code"`
+	Message  string   `xml:"Message"`
+	Resource string   `xml:"Resource"`
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	e, ok := err.(*s3Error)
+	if !ok {
+		protolion.Errorf("error serving s3 request %s %s: %s", r.Method, r.URL.Path, err.Error())
+		e = newError(http.StatusInternalServerError, "InternalError", err.Error())
+	}
+	if r.Method == "HEAD" {
+		w.WriteHeader(e.status)
+		return
+	}
+	writeXML(w, e.status, &errorResponse{
+		Code:     e.code,
+		Message:  e.message,
+		Resource: r.URL.Path,
+	})
+}
@@ -0,0 +1,234 @@
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pfs"
+	pfsserver "github.com/sjezewski/pachyderm/src/server/pfs"
+)
+
+// A multipart upload is a pfs upload of the object's file, and each part is
+// written to an upload of its own, whose ID is the part's ETag. Completing
+// the multipart upload appends the parts to the file in order.
+
+// startedCommitSuffix is appended to the IDs of multipart uploads which
+// started the commit they write to, so that the commit is finished once the
+// upload is done.
+const startedCommitSuffix = "-commit"
+
+type initiateMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	UploadID string   `xml:"UploadId"`
+}
+
+type completeMultipartUpload struct {
+	Parts []completedPart `xml:"Part"`
+}
+
+type completedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+type completeMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"CompleteMultipartUploadResult"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Location string   `xml:"Location"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	ETag     string   `xml:"ETag"`
+}
+
+func (h *handler) createMultipartUpload(w http.ResponseWriter, b *bucket, key string) error {
+	unlock := h.lockBucket(b)
+	defer unlock()
+	commit, started, err := h.openCommit(b)
+	if err != nil {
+		return err
+	}
+	// Uploads to a commit that another multipart upload started keep it
+	// open too.
+	h.lock.Lock()
+	if started || h.multipartCommits[commitKey(commit)] > 0 {
+		h.multipartCommits[commitKey(commit)]++
+		started = true
+	}
+	h.lock.Unlock()
+	upload, err := h.driver.BeginUpload(client.NewFile(commit.Repo.Name, commit.ID, key), pfs.Delimiter_LINE)
+	if err != nil {
+		if started {
+			if err := h.finishMultipartCommit(commit); err != nil {
+				return err
+			}
+		}
+		return objectError(err)
+	}
+	uploadID := upload.ID
+	if started {
+		uploadID += startedCommitSuffix
+	}
+	return writeXML(w, http.StatusOK, &initiateMultipartUploadResult{
+		Xmlns:    xmlns,
+		Bucket:   b.name,
+		Key:      key,
+		UploadID: uploadID,
+	})
+}
+
+func (h *handler) uploadPart(w http.ResponseWriter, r *http.Request, b *bucket, key string, query url.Values) error {
+	upload, _ := parseUploadID(query.Get("uploadId"))
+	partNumber, err := strconv.Atoi(query.Get("partNumber"))
+	if err != nil || partNumber < 1 || partNumber > 10000 {
+		return newError(http.StatusBadRequest, "InvalidArgument", "partNumber must be an integer between 1 and 10000")
+	}
+	uploadInfo, err := h.inspectUpload(upload, b, key)
+	if err != nil {
+		return err
+	}
+	part, err := h.driver.BeginUpload(uploadInfo.File, pfs.Delimiter_LINE)
+	if err != nil {
+		return objectError(err)
+	}
+	if err := h.driver.UploadPart(r.Context(), part, 0, requestBody(r)); err != nil {
+		return objectError(err)
+	}
+	w.Header().Set("ETag", fmt.Sprintf("\"%s\"", part.ID))
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (h *handler) completeMultipartUpload(w http.ResponseWriter, r *http.Request, b *bucket, key string, query url.Values) error {
+	upload, started := parseUploadID(query.Get("uploadId"))
+	var request completeMultipartUpload
+	if err := xml.NewDecoder(r.Body).Decode(&request); err != nil || len(request.Parts) == 0 {
+		return newError(http.StatusBadRequest, "MalformedXML", "the XML you provided was not well-formed or did not validate against our published schema")
+	}
+	uploadInfo, err := h.inspectUpload(upload, b, key)
+	if err != nil {
+		return err
+	}
+
+	// All the parts are checked before the file is touched.
+	var parts []*pfs.Upload
+	var sizes []uint64
+	for i, part := range request.Parts {
+		if i > 0 && part.PartNumber <= request.Parts[i-1].PartNumber {
+			return newError(http.StatusBadRequest, "InvalidPartOrder", "the list of parts was not in ascending order")
+		}
+		partUpload := &pfs.Upload{ID: strings.Trim(part.ETag, "\"")}
+		partInfo, err := h.driver.InspectUpload(partUpload)
+		if err != nil {
+			if _, ok := err.(*pfsserver.ErrUploadNotFound); ok {
+				return errInvalidPart
+			}
+			return err
+		}
+		if partInfo.File.Path != uploadInfo.File.Path || partInfo.File.Commit.ID != uploadInfo.File.Commit.ID {
+			return errInvalidPart
+		}
+		var size uint64
+		for _, uploadPart := range partInfo.Parts {
+			size += uploadPart.SizeBytes
+		}
+		parts = append(parts, partUpload)
+		sizes = append(sizes, size)
+	}
+
+	unlock := h.lockBucket(b)
+	defer unlock()
+	// The object replaces the file.
+	file := uploadInfo.File
+	if err := h.deleteRegularFile(client.NewFile(file.Commit.Repo.Name, file.Commit.ID, file.Path)); err != nil {
+		return objectError(err)
+	}
+	for i, part := range parts {
+		if err := h.driver.CompleteUpload(part, sizes[i]); err != nil {
+			return objectError(err)
+		}
+	}
+	if err := h.driver.CompleteUpload(upload, 0); err != nil {
+		return objectError(err)
+	}
+	if started {
+		if err := h.finishMultipartCommit(file.Commit); err != nil {
+			return err
+		}
+	}
+	return writeXML(w, http.StatusOK, &completeMultipartUploadResult{
+		Xmlns:    xmlns,
+		Location: fmt.Sprintf("/%s/%s", b.name, key),
+		Bucket:   b.name,
+		Key:      key,
+		ETag:     fmt.Sprintf("\"%s-%d\"", upload.ID, len(parts)),
+	})
+}
+
+func (h *handler) abortMultipartUpload(w http.ResponseWriter, b *bucket, key string, query url.Values) error {
+	upload, started := parseUploadID(query.Get("uploadId"))
+	uploadInfo, err := h.inspectUpload(upload, b, key)
+	if err != nil {
+		return err
+	}
+	unlock := h.lockBucket(b)
+	defer unlock()
+	// Completing the upload without any parts removes it without changing
+	// the file.
+	if err := h.driver.CompleteUpload(upload, 0); err != nil {
+		return objectError(err)
+	}
+	if started {
+		if err := h.finishMultipartCommit(uploadInfo.File.Commit); err != nil {
+			return err
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// inspectUpload returns the info of a multipart upload of key in b.
+func (h *handler) inspectUpload(upload *pfs.Upload, b *bucket, key string) (*pfs.UploadInfo, error) {
+	uploadInfo, err := h.driver.InspectUpload(upload)
+	if err != nil {
+		return nil, objectError(err)
+	}
+	if uploadInfo.File.Commit.Repo.Name != b.repo || strings.TrimPrefix(uploadInfo.File.Path, "/") != key {
+		return nil, objectError(pfsserver.NewErrUploadNotFound(upload.ID))
+	}
+	return uploadInfo, nil
+}
+
+// finishMultipartCommit finishes a commit that was started for multipart
+// uploads once none of them are in progress.  The count of uploads doesn't
+// survive a restart, in which case the commit is finished by the first
+// upload that's done.
+func (h *handler) finishMultipartCommit(commit *pfs.Commit) error {
+	h.lock.Lock()
+	h.multipartCommits[commitKey(commit)]--
+	remaining := h.multipartCommits[commitKey(commit)]
+	if remaining <= 0 {
+		delete(h.multipartCommits, commitKey(commit))
+	}
+	h.lock.Unlock()
+	if remaining > 0 {
+		return nil
+	}
+	return h.driver.FinishCommit(commit, false)
+}
+
+func parseUploadID(uploadID string) (*pfs.Upload, bool) {
+	started := strings.HasSuffix(uploadID, startedCommitSuffix)
+	return &pfs.Upload{ID: strings.TrimSuffix(uploadID, startedCommitSuffix)}, started
+}
+
+func commitKey(commit *pfs.Commit) string {
+	return fmt.Sprintf("%s/%s", commit.Repo.Name, commit.ID)
+}
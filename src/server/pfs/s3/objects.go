@@ -0,0 +1,351 @@
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pfs"
+	pfsserver "github.com/sjezewski/pachyderm/src/server/pfs"
+	"github.com/sjezewski/pachyderm/src/server/pfs/drive"
+
+	"go.pedge.io/lion/proto"
+	"go.pedge.io/proto/time"
+)
+
+func (h *handler) getObject(w http.ResponseWriter, r *http.Request, b *bucket, key string) error {
+	fileInfo, err := h.driver.InspectFile(b.file(key), nil, nil)
+	if err != nil {
+		return objectError(err)
+	}
+	if fileInfo.FileType != pfs.FileType_FILE_TYPE_REGULAR {
+		return errNoSuchKey
+	}
+	offset, size, partial, err := parseRange(r.Header.Get("Range"), fileInfo.SizeBytes)
+	if err != nil {
+		return err
+	}
+	var reader io.ReadCloser
+	if r.Method != "HEAD" && size > 0 {
+		reader, err = h.driver.GetFile(r.Context(), b.file(key), nil, int64(offset), int64(size), nil)
+		if err != nil {
+			return objectError(err)
+		}
+		defer func() {
+			if err := reader.Close(); err != nil {
+				protolion.Errorf("error closing %s: %s", key, err.Error())
+			}
+		}()
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Length", strconv.FormatUint(size, 10))
+	w.Header().Set("Accept-Ranges", "bytes")
+	if fileInfo.Modified != nil {
+		w.Header().Set("Last-Modified", prototime.TimestampToTime(fileInfo.Modified).UTC().Format(http.TimeFormat))
+	}
+	status := http.StatusOK
+	if partial {
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, offset+size-1, fileInfo.SizeBytes))
+		status = http.StatusPartialContent
+	}
+	w.WriteHeader(status)
+	if reader != nil {
+		if _, err := io.Copy(w, reader); err != nil {
+			protolion.Errorf("error writing %s: %s", key, err.Error())
+		}
+	}
+	return nil
+}
+
+// parseRange parses the Range header of a request for an object of the
+// given size into the offset and size of the range, and whether the range
+// is only part of the object.  Only single byte ranges are supported.
+func parseRange(header string, objectSize uint64) (uint64, uint64, bool, error) {
+	if header == "" {
+		return 0, objectSize, false, nil
+	}
+	if !strings.HasPrefix(header, "bytes=") || strings.Contains(header, ",") {
+		return 0, 0, false, errInvalidRange
+	}
+	parts := strings.SplitN(strings.TrimPrefix(header, "bytes="), "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, false, errInvalidRange
+	}
+	if parts[0] == "" {
+		// A suffix range, bytes=-n is the last n bytes.
+		n, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil || n == 0 || objectSize == 0 {
+			return 0, 0, false, errInvalidRange
+		}
+		if n > objectSize {
+			n = objectSize
+		}
+		return objectSize - n, n, true, nil
+	}
+	start, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil || start >= objectSize {
+		return 0, 0, false, errInvalidRange
+	}
+	end := objectSize - 1
+	if parts[1] != "" {
+		end, err = strconv.ParseUint(parts[1], 10, 64)
+		if err != nil || end < start {
+			return 0, 0, false, errInvalidRange
+		}
+		if end >= objectSize {
+			end = objectSize - 1
+		}
+	}
+	return start, end - start + 1, true, nil
+}
+
+func (h *handler) putObject(w http.ResponseWriter, r *http.Request, b *bucket, key string) error {
+	hash := md5.New()
+	if err := h.withWriteCommit(b, func(commit *pfs.Commit) error {
+		file := client.NewFile(commit.Repo.Name, commit.ID, key)
+		if strings.HasSuffix(key, "/") {
+			// Keys ending in a slash are directory markers.
+			if _, err := h.driver.InspectFile(client.NewFile(commit.Repo.Name, commit.ID, key), nil, nil); err == nil {
+				return nil
+			}
+			return h.driver.MakeDirectory(file)
+		}
+		// The object replaces the file.
+		if err := h.deleteRegularFile(client.NewFile(commit.Repo.Name, commit.ID, key)); err != nil {
+			return err
+		}
+		return h.driver.PutFile(r.Context(), file, pfs.Delimiter_LINE, io.TeeReader(requestBody(r), hash))
+	}); err != nil {
+		return objectError(err)
+	}
+	w.Header().Set("ETag", fmt.Sprintf("\"%x\"", hash.Sum(nil)))
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (h *handler) deleteObject(w http.ResponseWriter, b *bucket, key string) error {
+	if err := h.withWriteCommit(b, func(commit *pfs.Commit) error {
+		return h.deleteRegularFile(client.NewFile(commit.Repo.Name, commit.ID, key))
+	}); err != nil {
+		return objectError(err)
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// deleteRegularFile deletes file if it exists and isn't a directory, since
+// an object key never refers to a whole directory.
+func (h *handler) deleteRegularFile(file *pfs.File) error {
+	fileInfo, err := h.driver.InspectFile(file, nil, nil)
+	if err != nil {
+		if _, ok := err.(*pfsserver.ErrFileNotFound); ok {
+			return nil
+		}
+		return err
+	}
+	if fileInfo.FileType != pfs.FileType_FILE_TYPE_REGULAR {
+		return nil
+	}
+	return h.driver.DeleteFile(file)
+}
+
+// requestBody returns the payload of r, decoding it if it was sent with
+// chunked signatures.
+func requestBody(r *http.Request) io.Reader {
+	if r.Header.Get("x-amz-content-sha256") == "STREAMING-AWS4-HMAC-SHA256-PAYLOAD" {
+		return newChunkedReader(r.Body)
+	}
+	return r.Body
+}
+
+type listBucketResult struct {
+	XMLName               xml.Name       `xml:"ListBucketResult"`
+	Xmlns                 string         `xml:"xmlns,attr"`
+	Name                  string         `xml:"Name"`
+	Prefix                string         `xml:"Prefix"`
+	Delimiter             string         `xml:"Delimiter,omitempty"`
+	MaxKeys               int            `xml:"MaxKeys"`
+	IsTruncated           bool           `xml:"IsTruncated"`
+	Marker                string         `xml:"Marker,omitempty"`
+	NextMarker            string         `xml:"NextMarker,omitempty"`
+	KeyCount              *int           `xml:"KeyCount,omitempty"`
+	ContinuationToken     string         `xml:"ContinuationToken,omitempty"`
+	NextContinuationToken string         `xml:"NextContinuationToken,omitempty"`
+	StartAfter            string         `xml:"StartAfter,omitempty"`
+	Contents              []object       `xml:"Contents"`
+	CommonPrefixes        []commonPrefix `xml:"CommonPrefixes"`
+}
+
+type object struct {
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	Size         uint64 `xml:"Size"`
+	StorageClass string `xml:"StorageClass"`
+}
+
+type commonPrefix struct {
+	Prefix string `xml:"Prefix"`
+}
+
+// listObjects serves both ListObjects and ListObjectsV2, which differ only
+// in how they page through the keys.
+func (h *handler) listObjects(w http.ResponseWriter, b *bucket, query url.Values) error {
+	result := &listBucketResult{
+		Xmlns:     xmlns,
+		Name:      b.name,
+		Prefix:    query.Get("prefix"),
+		Delimiter: query.Get("delimiter"),
+		MaxKeys:   1000,
+	}
+	if maxKeys := query.Get("max-keys"); maxKeys != "" {
+		n, err := strconv.Atoi(maxKeys)
+		if err != nil || n < 0 {
+			return newError(http.StatusBadRequest, "InvalidArgument", fmt.Sprintf("invalid max-keys %s", maxKeys))
+		}
+		result.MaxKeys = n
+	}
+	v2 := query.Get("list-type") == "2"
+	marker := query.Get("marker")
+	if v2 {
+		result.StartAfter = query.Get("start-after")
+		result.ContinuationToken = query.Get("continuation-token")
+		marker = result.StartAfter
+		if result.ContinuationToken != "" {
+			token, err := base64.StdEncoding.DecodeString(result.ContinuationToken)
+			if err != nil {
+				return newError(http.StatusBadRequest, "InvalidArgument", "invalid continuation-token")
+			}
+			marker = string(token)
+		}
+	} else {
+		result.Marker = marker
+	}
+	if _, err := h.driver.InspectCommit(b.commit()); err != nil {
+		return bucketError(err)
+	}
+	fileInfos, prefixes, err := h.walk(b, result.Prefix, result.Delimiter)
+	if err != nil {
+		return err
+	}
+
+	// Objects and common prefixes are listed together in key order.
+	var entries []listEntry
+	for _, fileInfo := range fileInfos {
+		entries = append(entries, listEntry{strings.TrimPrefix(fileInfo.File.Path, "/"), fileInfo})
+	}
+	for _, prefix := range prefixes {
+		entries = append(entries, listEntry{prefix, nil})
+	}
+	sort.Sort(listEntriesByKey(entries))
+	var last string
+	for _, e := range entries {
+		if e.key <= marker {
+			continue
+		}
+		if len(result.Contents)+len(result.CommonPrefixes) == result.MaxKeys {
+			result.IsTruncated = true
+			break
+		}
+		if e.fileInfo == nil {
+			result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{e.key})
+		} else {
+			result.Contents = append(result.Contents, object{
+				Key:          e.key,
+				LastModified: formatTimestamp(e.fileInfo.Modified),
+				Size:         e.fileInfo.SizeBytes,
+				StorageClass: "STANDARD",
+			})
+		}
+		last = e.key
+	}
+	if v2 {
+		keyCount := len(result.Contents) + len(result.CommonPrefixes)
+		result.KeyCount = &keyCount
+		if result.IsTruncated {
+			result.NextContinuationToken = base64.StdEncoding.EncodeToString([]byte(last))
+		}
+	} else if result.IsTruncated {
+		result.NextMarker = last
+	}
+	return writeXML(w, http.StatusOK, result)
+}
+
+// listEntry is an object, or a common prefix if fileInfo is nil.
+type listEntry struct {
+	key      string
+	fileInfo *pfs.FileInfo
+}
+
+type listEntriesByKey []listEntry
+
+func (a listEntriesByKey) Len() int           { return len(a) }
+func (a listEntriesByKey) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a listEntriesByKey) Less(i, j int) bool { return a[i].key < a[j].key }
+
+// walk returns the files in b whose keys start with prefix and don't
+// contain delimiter after the prefix, and the common prefixes that the rest
+// of the keys are rolled up into.
+func (h *handler) walk(b *bucket, prefix string, delimiter string) ([]*pfs.FileInfo, []string, error) {
+	var fileInfos []*pfs.FileInfo
+	prefixes := make(map[string]bool)
+	var walkDir func(dir string) error
+	walkDir = func(dir string) error {
+		dirInfos, err := h.driver.ListFile(b.file(dir), nil, nil, drive.ListFileNORMAL)
+		if err != nil {
+			return err
+		}
+		for _, fileInfo := range dirInfos {
+			key := strings.TrimPrefix(fileInfo.File.Path, "/")
+			if fileInfo.FileType == pfs.FileType_FILE_TYPE_DIR {
+				dirKey := key + "/"
+				switch {
+				case !strings.HasPrefix(dirKey, prefix) && !strings.HasPrefix(prefix, dirKey):
+				case delimiter == "/" && strings.HasPrefix(dirKey, prefix):
+					// Everything in the directory is rolled up into the
+					// same prefix, so there's no need to list it.
+					rest := dirKey[len(prefix):]
+					prefixes[prefix+rest[:strings.Index(rest, "/")+1]] = true
+				default:
+					if err := walkDir(key); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if !strings.HasPrefix(key, prefix) {
+				continue
+			}
+			if delimiter != "" {
+				if i := strings.Index(key[len(prefix):], delimiter); i >= 0 {
+					prefixes[key[:len(prefix)+i+len(delimiter)]] = true
+					continue
+				}
+			}
+			fileInfos = append(fileInfos, fileInfo)
+		}
+		return nil
+	}
+	var dir string
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		dir = prefix[:i]
+	}
+	if err := walkDir(dir); err != nil {
+		if _, ok := err.(*pfsserver.ErrFileNotFound); ok {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	var prefixList []string
+	for prefix := range prefixes {
+		prefixList = append(prefixList, prefix)
+	}
+	return fileInfos, prefixList, nil
+}
@@ -0,0 +1,293 @@
+/*
+Package s3 serves pfs over an S3 compatible HTTP API.
+
+Buckets are named repo.branch or repo.commit, where a commit ID of the form
+branch/clock is written branch.clock since bucket names can't contain
+slashes. Object keys are file paths. Only path style requests
+(http://host/bucket/key) are supported and requests aren't authenticated.
+
+Writes to a branch go to the head commit of the branch if it's open,
+otherwise a commit is started for the write and finished once it's done. A
+multipart upload keeps the commit it started open until the upload is
+completed or aborted, so writes made to the branch in the meantime show up
+in the same commit.
+*/
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pfs"
+	pfsserver "github.com/sjezewski/pachyderm/src/server/pfs"
+	"github.com/sjezewski/pachyderm/src/server/pfs/drive"
+
+	"go.pedge.io/lion/proto"
+	"go.pedge.io/pb/go/google/protobuf"
+	"go.pedge.io/proto/time"
+)
+
+const (
+	xmlns = "http://s3.amazonaws.com/doc/2006-03-01/"
+	// timeFormat is the format of timestamps in S3 responses.
+	timeFormat = "2006-01-02T15:04:05.000Z"
+)
+
+type handler struct {
+	driver drive.Driver
+
+	lock sync.Mutex
+	// bucketLocks serialize the writes to each bucket, so that a write never
+	// lands in a commit that another write is finishing.
+	bucketLocks map[string]*sync.Mutex
+	// multipartCommits counts the multipart uploads in progress in each of
+	// the commits that were started for multipart uploads.
+	multipartCommits map[string]int
+}
+
+// NewHandler returns an http.Handler which serves the contents of driver
+// over the S3 API.
+func NewHandler(driver drive.Driver) http.Handler {
+	return &handler{
+		driver:           driver,
+		bucketLocks:      make(map[string]*sync.Mutex),
+		multipartCommits: make(map[string]int),
+	}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucketName, key := splitPath(r.URL.Path)
+	if err := h.serve(w, r, bucketName, key); err != nil {
+		writeError(w, r, err)
+	}
+}
+
+func (h *handler) serve(w http.ResponseWriter, r *http.Request, bucketName string, key string) error {
+	query := r.URL.Query()
+	if bucketName == "" {
+		if r.Method != "GET" {
+			return errNotImplemented
+		}
+		return h.listBuckets(w)
+	}
+	b, err := parseBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	if key == "" {
+		switch {
+		case r.Method == "HEAD":
+			return h.headBucket(w, b)
+		case r.Method == "GET" && hasParam(query, "location"):
+			return writeXML(w, http.StatusOK, &locationConstraint{Xmlns: xmlns})
+		case r.Method == "GET" && !hasParam(query, "uploads"):
+			return h.listObjects(w, b, query)
+		}
+		return errNotImplemented
+	}
+	switch {
+	case r.Method == "GET" || r.Method == "HEAD":
+		return h.getObject(w, r, b, key)
+	case r.Method == "PUT" && hasParam(query, "uploadId"):
+		return h.uploadPart(w, r, b, key, query)
+	case r.Method == "PUT" && r.Header.Get("x-amz-copy-source") == "":
+		return h.putObject(w, r, b, key)
+	case r.Method == "POST" && hasParam(query, "uploads"):
+		return h.createMultipartUpload(w, b, key)
+	case r.Method == "POST" && hasParam(query, "uploadId"):
+		return h.completeMultipartUpload(w, r, b, key, query)
+	case r.Method == "DELETE" && hasParam(query, "uploadId"):
+		return h.abortMultipartUpload(w, b, key, query)
+	case r.Method == "DELETE":
+		return h.deleteObject(w, b, key)
+	}
+	return errNotImplemented
+}
+
+// splitPath splits the path of a path style request into a bucket name and
+// an object key.
+func splitPath(path string) (string, string) {
+	parts := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+func hasParam(query map[string][]string, name string) bool {
+	_, ok := query[name]
+	return ok
+}
+
+// bucket is a repo and a branch or commit ID.
+type bucket struct {
+	name     string
+	repo     string
+	commitID string
+}
+
+func parseBucket(name string) (*bucket, error) {
+	parts := strings.SplitN(name, ".", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, newError(http.StatusBadRequest, "InvalidBucketName", fmt.Sprintf("bucket %s must be named repo.branch or repo.commit", name))
+	}
+	commitID := parts[1]
+	if i := strings.LastIndex(commitID, "."); i >= 0 {
+		if _, err := strconv.ParseUint(commitID[i+1:], 10, 64); err == nil {
+			commitID = commitID[:i] + "/" + commitID[i+1:]
+		}
+	}
+	return &bucket{
+		name:     name,
+		repo:     parts[0],
+		commitID: commitID,
+	}, nil
+}
+
+func (b *bucket) commit() *pfs.Commit {
+	return client.NewCommit(b.repo, b.commitID)
+}
+
+func (b *bucket) file(key string) *pfs.File {
+	return client.NewFile(b.repo, b.commitID, key)
+}
+
+type listAllMyBucketsResult struct {
+	XMLName xml.Name     `xml:"ListAllMyBucketsResult"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	Owner   owner        `xml:"Owner"`
+	Buckets []bucketInfo `xml:"Buckets>Bucket"`
+}
+
+type owner struct {
+	ID          string `xml:"ID"`
+	DisplayName string `xml:"DisplayName"`
+}
+
+type bucketInfo struct {
+	Name         string `xml:"Name"`
+	CreationDate string `xml:"CreationDate"`
+}
+
+type locationConstraint struct {
+	XMLName xml.Name `xml:"LocationConstraint"`
+	Xmlns   string   `xml:"xmlns,attr"`
+}
+
+// listBuckets lists a bucket for each branch of each repo.
+func (h *handler) listBuckets(w http.ResponseWriter) error {
+	repoInfos, err := h.driver.ListRepo(nil)
+	if err != nil {
+		return err
+	}
+	result := &listAllMyBucketsResult{
+		Xmlns: xmlns,
+		Owner: owner{ID: "pachyderm", DisplayName: "pachyderm"},
+	}
+	for _, repoInfo := range repoInfos {
+		branches, err := h.driver.ListBranch(repoInfo.Repo, pfs.CommitStatus_NORMAL)
+		if err != nil {
+			return err
+		}
+		sort.Strings(branches)
+		for _, branch := range branches {
+			result.Buckets = append(result.Buckets, bucketInfo{
+				Name:         fmt.Sprintf("%s.%s", repoInfo.Repo.Name, branch),
+				CreationDate: formatTimestamp(repoInfo.Created),
+			})
+		}
+	}
+	return writeXML(w, http.StatusOK, result)
+}
+
+func (h *handler) headBucket(w http.ResponseWriter, b *bucket) error {
+	if _, err := h.driver.InspectCommit(b.commit()); err != nil {
+		return bucketError(err)
+	}
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// withWriteCommit calls f with an open commit to write to in b, see
+// openCommit.  If the commit was started for f it's finished when f
+// returns, or cancelled if f fails.
+func (h *handler) withWriteCommit(b *bucket, f func(commit *pfs.Commit) error) error {
+	unlock := h.lockBucket(b)
+	defer unlock()
+	commit, started, err := h.openCommit(b)
+	if err != nil {
+		return err
+	}
+	if err := f(commit); err != nil {
+		if started {
+			if err := h.driver.FinishCommit(commit, true); err != nil {
+				protolion.Errorf("error cancelling commit %s/%s: %s", commit.Repo.Name, commit.ID, err.Error())
+			}
+		}
+		return err
+	}
+	if started {
+		return h.driver.FinishCommit(commit, false)
+	}
+	return nil
+}
+
+// openCommit returns an open commit to write to in b, and whether it was
+// started by openCommit.  Commits are started on branches whose head commit
+// is finished, commits which are named by ID must still be open.
+func (h *handler) openCommit(b *bucket) (*pfs.Commit, bool, error) {
+	commitInfo, err := h.driver.InspectCommit(b.commit())
+	if err == nil && commitInfo.CommitType == pfs.CommitType_COMMIT_TYPE_WRITE {
+		return commitInfo.Commit, false, nil
+	}
+	if strings.Contains(b.commitID, "/") {
+		if err != nil {
+			return nil, false, bucketError(err)
+		}
+		return nil, false, bucketError(pfsserver.NewErrCommitFinished(b.repo, b.commitID))
+	}
+	// The head of the branch is finished, or the branch doesn't exist yet.
+	commit, err := h.driver.StartCommit(b.commit(), nil)
+	if err != nil {
+		return nil, false, bucketError(err)
+	}
+	return commit, true, nil
+}
+
+func (h *handler) lockBucket(b *bucket) func() {
+	h.lock.Lock()
+	lock, ok := h.bucketLocks[b.name]
+	if !ok {
+		lock = &sync.Mutex{}
+		h.bucketLocks[b.name] = lock
+	}
+	h.lock.Unlock()
+	lock.Lock()
+	return lock.Unlock
+}
+
+func formatTimestamp(timestamp *google_protobuf.Timestamp) string {
+	if timestamp == nil {
+		return time.Unix(0, 0).UTC().Format(timeFormat)
+	}
+	return prototime.TimestampToTime(timestamp).UTC().Format(timeFormat)
+}
+
+func writeXML(w http.ResponseWriter, status int, v interface{}) error {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	if _, err := w.Write([]byte(xml.Header)); err != nil {
+		return nil
+	}
+	if err := xml.NewEncoder(w).Encode(v); err != nil {
+		protolion.Errorf("error writing s3 response: %s", err.Error())
+	}
+	return nil
+}
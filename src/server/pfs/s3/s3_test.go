@@ -0,0 +1,324 @@
+package s3
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	pclient "github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pfs"
+	"github.com/sjezewski/pachyderm/src/client/pkg/require"
+	pfsdb "github.com/sjezewski/pachyderm/src/server/pfs/db"
+	"github.com/sjezewski/pachyderm/src/server/pfs/drive"
+	pfsserver "github.com/sjezewski/pachyderm/src/server/pfs/server"
+
+	"google.golang.org/grpc"
+)
+
+func TestObjects(t *testing.T) {
+	c, d, stop := newServer(t)
+	defer stop()
+	require.NoError(t, d.CreateRepo(pclient.NewRepo("repo"), nil))
+
+	putObject(t, c, "repo.master", "dir/foo", "foo\nbar\n")
+	require.Equal(t, "foo\nbar\n", getObject(t, c, "repo.master", "dir/foo", ""))
+	require.Equal(t, "bar\n", getObject(t, c, "repo.master", "dir/foo", "bytes=4-"))
+	require.Equal(t, "o\nb", getObject(t, c, "repo.master", "dir/foo", "bytes=2-4"))
+	require.Equal(t, "ar\n", getObject(t, c, "repo.master", "dir/foo", "bytes=-3"))
+	headObjectOutput, err := c.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String("repo.master"),
+		Key:    aws.String("dir/foo"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(8), *headObjectOutput.ContentLength)
+
+	// Putting an object replaces it, in a new commit.
+	putObject(t, c, "repo.master", "dir/foo", "baz\n")
+	require.Equal(t, "baz\n", getObject(t, c, "repo.master", "dir/foo", ""))
+	require.Equal(t, "foo\nbar\n", getObject(t, c, "repo.master.0", "dir/foo", ""))
+	commitInfo, err := d.InspectCommit(pclient.NewCommit("repo", "master"))
+	require.NoError(t, err)
+	require.Equal(t, "master/1", commitInfo.Commit.ID)
+	require.Equal(t, pfs.CommitType_COMMIT_TYPE_READ, commitInfo.CommitType)
+
+	// Finished commits can't be written to.
+	_, err = c.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("repo.master.0"),
+		Key:    aws.String("bar"),
+		Body:   strings.NewReader("bar\n"),
+	})
+	requireErrorCode(t, "InvalidBucketState", err)
+
+	// Writes go to the head of the branch if it's open.
+	commit, err := d.StartCommit(pclient.NewCommit("repo", "master"), nil)
+	require.NoError(t, err)
+	putObject(t, c, "repo.master", "bar", "bar\n")
+	require.Equal(t, "bar\n", getObject(t, c, "repo."+strings.Replace(commit.ID, "/", ".", 1), "bar", ""))
+	require.NoError(t, d.FinishCommit(commit, false))
+
+	_, err = c.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String("repo.master"),
+		Key:    aws.String("bar"),
+	})
+	require.NoError(t, err)
+	_, err = c.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("repo.master"),
+		Key:    aws.String("bar"),
+	})
+	requireErrorCode(t, "NoSuchKey", err)
+	_, err = c.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("nonexistent.master"),
+		Key:    aws.String("bar"),
+	})
+	requireErrorCode(t, "NoSuchBucket", err)
+
+	listBucketsOutput, err := c.ListBuckets(&s3.ListBucketsInput{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(listBucketsOutput.Buckets))
+	require.Equal(t, "repo.master", *listBucketsOutput.Buckets[0].Name)
+}
+
+func TestListObjects(t *testing.T) {
+	c, d, stop := newServer(t)
+	defer stop()
+	require.NoError(t, d.CreateRepo(pclient.NewRepo("repo"), nil))
+	for _, key := range []string{"a", "dir/b", "dir/c", "dir/sub/d", "e"} {
+		putObject(t, c, "repo.master", key, key)
+	}
+
+	for _, test := range []struct {
+		prefix   string
+		delim    string
+		keys     []string
+		prefixes []string
+	}{
+		{"", "", []string{"a", "dir/b", "dir/c", "dir/sub/d", "e"}, nil},
+		{"", "/", []string{"a", "e"}, []string{"dir/"}},
+		{"dir/", "/", []string{"dir/b", "dir/c"}, []string{"dir/sub/"}},
+		{"dir/s", "", []string{"dir/sub/d"}, nil},
+		{"di", "/", nil, []string{"dir/"}},
+		{"", "b", []string{"a", "dir/c", "e"}, []string{"dir/b", "dir/sub"}},
+		{"nonexistent/", "/", nil, nil},
+	} {
+		output, err := c.ListObjectsV2(&s3.ListObjectsV2Input{
+			Bucket:    aws.String("repo.master"),
+			Prefix:    aws.String(test.prefix),
+			Delimiter: aws.String(test.delim),
+		})
+		require.NoError(t, err)
+		var keys []string
+		for _, object := range output.Contents {
+			keys = append(keys, *object.Key)
+		}
+		var prefixes []string
+		for _, prefix := range output.CommonPrefixes {
+			prefixes = append(prefixes, *prefix.Prefix)
+		}
+		require.Equal(t, test.keys, keys)
+		require.Equal(t, test.prefixes, prefixes)
+	}
+
+	// Listing pages through the keys in order.
+	var keys []string
+	input := &s3.ListObjectsV2Input{
+		Bucket:  aws.String("repo.master"),
+		MaxKeys: aws.Int64(2),
+	}
+	for {
+		output, err := c.ListObjectsV2(input)
+		require.NoError(t, err)
+		require.True(t, len(output.Contents) <= 2)
+		for _, object := range output.Contents {
+			keys = append(keys, *object.Key)
+		}
+		if !*output.IsTruncated {
+			break
+		}
+		input.ContinuationToken = output.NextContinuationToken
+	}
+	require.Equal(t, []string{"a", "dir/b", "dir/c", "dir/sub/d", "e"}, keys)
+	keys = nil
+	require.NoError(t, c.ListObjectsPages(&s3.ListObjectsInput{
+		Bucket:    aws.String("repo.master"),
+		Delimiter: aws.String("/"),
+		MaxKeys:   aws.Int64(1),
+	}, func(output *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, object := range output.Contents {
+			keys = append(keys, *object.Key)
+		}
+		for _, prefix := range output.CommonPrefixes {
+			keys = append(keys, *prefix.Prefix)
+		}
+		return true
+	}))
+	require.Equal(t, []string{"a", "dir/", "e"}, keys)
+}
+
+func TestMultipartUpload(t *testing.T) {
+	c, d, stop := newServer(t)
+	defer stop()
+	require.NoError(t, d.CreateRepo(pclient.NewRepo("repo"), nil))
+	putObject(t, c, "repo.master", "file", "old\n")
+
+	createOutput, err := c.CreateMultipartUpload(&s3.CreateMultipartUploadInput{
+		Bucket: aws.String("repo.master"),
+		Key:    aws.String("file"),
+	})
+	require.NoError(t, err)
+	// Parts can be uploaded in any order.
+	part2 := uploadPart(t, c, createOutput, 2, "world\n")
+	part1 := uploadPart(t, c, createOutput, 1, "xxx\n")
+	part1 = uploadPart(t, c, createOutput, 1, "hello\n")
+
+	// The commit the upload started stays open until it's complete.
+	commitInfo, err := d.InspectCommit(pclient.NewCommit("repo", "master"))
+	require.NoError(t, err)
+	require.Equal(t, pfs.CommitType_COMMIT_TYPE_WRITE, commitInfo.CommitType)
+	_, err = c.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket:   aws.String("repo.master"),
+		Key:      aws.String("file"),
+		UploadId: createOutput.UploadId,
+		MultipartUpload: &s3.CompletedMultipartUpload{
+			Parts: []*s3.CompletedPart{part2, part1},
+		},
+	})
+	requireErrorCode(t, "InvalidPartOrder", err)
+	_, err = c.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket:   aws.String("repo.master"),
+		Key:      aws.String("file"),
+		UploadId: createOutput.UploadId,
+		MultipartUpload: &s3.CompletedMultipartUpload{
+			Parts: []*s3.CompletedPart{part1, part2},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "hello\nworld\n", getObject(t, c, "repo.master", "file", ""))
+	commitInfo, err = d.InspectCommit(pclient.NewCommit("repo", "master"))
+	require.NoError(t, err)
+	require.Equal(t, pfs.CommitType_COMMIT_TYPE_READ, commitInfo.CommitType)
+	_, err = c.UploadPart(&s3.UploadPartInput{
+		Bucket:     aws.String("repo.master"),
+		Key:        aws.String("file"),
+		UploadId:   createOutput.UploadId,
+		PartNumber: aws.Int64(3),
+		Body:       strings.NewReader("foo\n"),
+	})
+	requireErrorCode(t, "NoSuchUpload", err)
+
+	// Aborting an upload leaves the file alone.
+	createOutput, err = c.CreateMultipartUpload(&s3.CreateMultipartUploadInput{
+		Bucket: aws.String("repo.master"),
+		Key:    aws.String("file"),
+	})
+	require.NoError(t, err)
+	uploadPart(t, c, createOutput, 1, "foo\n")
+	_, err = c.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+		Bucket:   aws.String("repo.master"),
+		Key:      aws.String("file"),
+		UploadId: createOutput.UploadId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "hello\nworld\n", getObject(t, c, "repo.master", "file", ""))
+	commitInfo, err = d.InspectCommit(pclient.NewCommit("repo", "master"))
+	require.NoError(t, err)
+	require.Equal(t, pfs.CommitType_COMMIT_TYPE_READ, commitInfo.CommitType)
+}
+
+func TestChunkedReader(t *testing.T) {
+	payload := "5;chunk-signature=abc\r\nhello\r\n" +
+		"7;chunk-signature=def\r\n, world\r\n" +
+		"0;chunk-signature=ghi\r\n\r\n"
+	value, err := ioutil.ReadAll(newChunkedReader(strings.NewReader(payload)))
+	require.NoError(t, err)
+	require.Equal(t, "hello, world", string(value))
+
+	_, err = ioutil.ReadAll(newChunkedReader(strings.NewReader("5;chunk-signature=abc\r\nhel")))
+	require.YesError(t, err)
+}
+
+func putObject(t *testing.T, c *s3.S3, bucket string, key string, value string) {
+	_, err := c.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   strings.NewReader(value),
+	})
+	require.NoError(t, err)
+}
+
+func getObject(t *testing.T, c *s3.S3, bucket string, key string, byteRange string) string {
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+	if byteRange != "" {
+		input.Range = aws.String(byteRange)
+	}
+	output, err := c.GetObject(input)
+	require.NoError(t, err)
+	defer output.Body.Close()
+	var buffer bytes.Buffer
+	_, err = buffer.ReadFrom(output.Body)
+	require.NoError(t, err)
+	return buffer.String()
+}
+
+func uploadPart(t *testing.T, c *s3.S3, createOutput *s3.CreateMultipartUploadOutput, partNumber int64, value string) *s3.CompletedPart {
+	output, err := c.UploadPart(&s3.UploadPartInput{
+		Bucket:     createOutput.Bucket,
+		Key:        createOutput.Key,
+		UploadId:   createOutput.UploadId,
+		PartNumber: aws.Int64(partNumber),
+		Body:       strings.NewReader(value),
+	})
+	require.NoError(t, err)
+	return &s3.CompletedPart{
+		ETag:       output.ETag,
+		PartNumber: aws.Int64(partNumber),
+	}
+}
+
+func requireErrorCode(t *testing.T, code string, err error) {
+	require.YesError(t, err)
+	awsErr, ok := err.(awserr.Error)
+	require.True(t, ok)
+	require.Equal(t, code, awsErr.Code())
+}
+
+// newServer serves pfs from a temporary directory over the S3 API, and
+// returns an S3 client for it, its driver and a function that stops it.
+func newServer(t *testing.T) (*s3.S3, drive.Driver, func()) {
+	dir, err := ioutil.TempDir("", "s3")
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	blockAPIServer, err := pfsserver.NewLocalBlockAPIServer(dir)
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	pfs.RegisterBlockAPIServer(grpcServer, blockAPIServer)
+	go grpcServer.Serve(listener)
+	driver, err := pfsdb.NewBoltDriver(listener.Addr().String(), filepath.Join(dir, "pfs.db"))
+	require.NoError(t, err)
+	server := httptest.NewServer(NewHandler(driver))
+	c := s3.New(session.New(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials("id", "secret", ""),
+		Endpoint:         aws.String(server.URL),
+		Region:           aws.String("us-east-1"),
+		S3ForcePathStyle: aws.Bool(true),
+		DisableSSL:       aws.Bool(true),
+	}))
+	return c, driver, func() {
+		server.Close()
+		grpcServer.Stop()
+		os.RemoveAll(dir)
+	}
+}
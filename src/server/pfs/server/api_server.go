@@ -9,6 +9,7 @@ import (
 
 	"github.com/sjezewski/pachyderm/src/client/pfs"
 	"github.com/sjezewski/pachyderm/src/server/pfs/drive"
+	"github.com/sjezewski/pachyderm/src/server/pkg/promutil"
 
 	"go.pedge.io/pb/go/google/protobuf"
 	"go.pedge.io/proto/rpclog"
@@ -29,7 +30,7 @@ type apiServer struct {
 
 func newAPIServer(driver drive.Driver) *apiServer {
 	return &apiServer{
-		Logger: protorpclog.NewLogger("pfs.API"),
+		Logger: promutil.NewLogger("pfs.API"),
 		driver: driver,
 	}
 }
@@ -126,6 +127,19 @@ func (a *apiServer) ReplayCommit(ctx context.Context, request *pfs.ReplayCommitR
 	return &pfs.Commits{Commit: commits}, nil
 }
 
+func (a *apiServer) PullCommit(ctx context.Context, request *pfs.PullCommitRequest) (response *pfs.CommitReplica, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	return a.driver.PullCommit(request.Commit)
+}
+
+func (a *apiServer) PushCommit(ctx context.Context, request *pfs.PushCommitRequest) (response *google_protobuf.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	if err := a.driver.PushCommit(request.Replica); err != nil {
+		return nil, err
+	}
+	return google_protobuf.EmptyInstance, nil
+}
+
 func (a *apiServer) ListBranch(ctx context.Context, request *pfs.ListBranchRequest) (response *pfs.Branches, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	branches, err := a.driver.ListBranch(request.Repo, request.Status)
@@ -211,16 +225,72 @@ func (a *apiServer) PutFile(putFileServer pfs.API_PutFileServer) (retErr error)
 			r = &reader
 			delimiter = request.Delimiter
 		}
-		if err := a.driver.PutFile(request.File, delimiter, r); err != nil {
+		if err := a.driver.PutFile(putFileServer.Context(), request.File, delimiter, r); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+func (a *apiServer) BeginUpload(ctx context.Context, request *pfs.BeginUploadRequest) (response *pfs.Upload, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	upload, err := a.driver.BeginUpload(request.File, request.Delimiter)
+	if err != nil {
+		return nil, err
+	}
+	return upload, nil
+}
+
+func (a *apiServer) UploadPart(uploadPartServer pfs.API_UploadPartServer) (retErr error) {
+	var request *pfs.UploadPartRequest
+	func() { a.Log(request, nil, nil, 0) }()
+	defer drainUploadPartServer(uploadPartServer)
+	defer func() {
+		if err := uploadPartServer.SendAndClose(google_protobuf.EmptyInstance); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	request, err := uploadPartServer.Recv()
+	if err != nil {
+		return err
+	}
+	if request.Upload == nil {
+		return fmt.Errorf("request.Upload cannot be nil")
+	}
+	reader := uploadPartReader{
+		server: uploadPartServer,
+	}
+	// buffer.Write cannot error
+	reader.buffer.Write(request.Value)
+	return a.driver.UploadPart(uploadPartServer.Context(), request.Upload, request.OffsetBytes, &reader)
+}
+
+func (a *apiServer) InspectUpload(ctx context.Context, request *pfs.InspectUploadRequest) (response *pfs.UploadInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	if request.Upload == nil {
+		return nil, fmt.Errorf("request.Upload cannot be nil")
+	}
+	uploadInfo, err := a.driver.InspectUpload(request.Upload)
+	if err != nil {
+		return nil, err
+	}
+	return uploadInfo, nil
+}
+
+func (a *apiServer) CompleteUpload(ctx context.Context, request *pfs.CompleteUploadRequest) (response *google_protobuf.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	if request.Upload == nil {
+		return nil, fmt.Errorf("request.Upload cannot be nil")
+	}
+	if err := a.driver.CompleteUpload(request.Upload, request.SizeBytes); err != nil {
+		return nil, err
+	}
+	return google_protobuf.EmptyInstance, nil
+}
+
 func (a *apiServer) GetFile(request *pfs.GetFileRequest, apiGetFileServer pfs.API_GetFileServer) (retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
-	file, err := a.driver.GetFile(request.File, request.Shard, request.OffsetBytes, request.SizeBytes, request.DiffMethod)
+	file, err := a.driver.GetFile(apiGetFileServer.Context(), request.File, request.Shard, request.OffsetBytes, request.SizeBytes, request.DiffMethod)
 	if err != nil {
 		return err
 	}
@@ -300,6 +370,23 @@ func (r *putFileReader) Read(p []byte) (int, error) {
 	return r.buffer.Read(p)
 }
 
+type uploadPartReader struct {
+	server pfs.API_UploadPartServer
+	buffer bytes.Buffer
+}
+
+func (r *uploadPartReader) Read(p []byte) (int, error) {
+	if r.buffer.Len() == 0 {
+		request, err := r.server.Recv()
+		if err != nil {
+			return 0, err
+		}
+		//buffer.Write cannot error
+		r.buffer.Write(request.Value)
+	}
+	return r.buffer.Read(p)
+}
+
 func (a *apiServer) getVersion(ctx context.Context) (int64, error) {
 	md, ok := metadata.FromContext(ctx)
 	if !ok {
@@ -324,3 +411,13 @@ func drainFileServer(putFileServer interface {
 		}
 	}
 }
+
+func drainUploadPartServer(uploadPartServer interface {
+	Recv() (*pfs.UploadPartRequest, error)
+}) {
+	for {
+		if _, err := uploadPartServer.Recv(); err != nil {
+			break
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package server
+
+import (
+	"container/list"
+	"expvar"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	pfsclient "github.com/sjezewski/pachyderm/src/client/pfs"
+	"go.pedge.io/lion/proto"
+)
+
+// diskCacheStats are the stats of the block disk caches on this server,
+// published under /debug/vars.
+var diskCacheStats struct {
+	Hits      int64
+	Misses    int64
+	Evictions int64
+	// Corruptions is the number of cached blocks whose content didn't match
+	// their hash, they're removed and counted as misses.
+	Corruptions int64
+	Bytes       int64
+}
+
+func init() {
+	expvar.Publish("block_disk_cache", expvar.Func(func() interface{} {
+		return map[string]int64{
+			"hits":        atomic.LoadInt64(&diskCacheStats.Hits),
+			"misses":      atomic.LoadInt64(&diskCacheStats.Misses),
+			"evictions":   atomic.LoadInt64(&diskCacheStats.Evictions),
+			"corruptions": atomic.LoadInt64(&diskCacheStats.Corruptions),
+			"bytes":       atomic.LoadInt64(&diskCacheStats.Bytes),
+		}
+	}))
+}
+
+// diskCache is a bounded cache of blocks on local disk, blocks are evicted
+// in least recently used order.  A nil *diskCache caches nothing.
+type diskCache struct {
+	dir      string
+	maxBytes int64
+
+	mu    sync.Mutex
+	bytes int64
+	// lru holds the *diskCacheEntry of every cached block, most recently
+	// used first.
+	lru     *list.List
+	entries map[string]*list.Element
+}
+
+type diskCacheEntry struct {
+	hash string
+	size int64
+}
+
+// newDiskCache creates a diskCache of at most maxBytes in dir.  Blocks
+// already in dir are kept, with the most recently modified ones treated as
+// the most recently used.
+func newDiskCache(dir string, maxBytes int64) (*diskCache, error) {
+	c := &diskCache{
+		dir:      dir,
+		maxBytes: maxBytes,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+	// Anything in the tmp dir is left over from puts which didn't finish.
+	if err := os.RemoveAll(c.tmpDir()); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(c.tmpDir(), 0777); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(c.blockDir(), 0777); err != nil {
+		return nil, err
+	}
+	fileInfos, err := ioutil.ReadDir(c.blockDir())
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(byModTime(fileInfos))
+	for _, fileInfo := range fileInfos {
+		c.add(fileInfo.Name(), fileInfo.Size())
+	}
+	c.mu.Lock()
+	c.evict()
+	c.mu.Unlock()
+	return c, nil
+}
+
+// get returns the content of a block, if it's cached.
+func (c *diskCache) get(block *pfsclient.Block) ([]byte, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	element, ok := c.entries[block.Hash]
+	if ok {
+		c.lru.MoveToFront(element)
+	}
+	c.mu.Unlock()
+	if !ok {
+		atomic.AddInt64(&diskCacheStats.Misses, 1)
+		return nil, false
+	}
+	data, err := ioutil.ReadFile(c.path(block.Hash))
+	if err != nil {
+		// The block may have been evicted since we looked it up.
+		if !os.IsNotExist(err) {
+			protolion.Errorf("error reading cached block %s: %s", block.Hash, err.Error())
+		}
+		c.remove(block.Hash)
+		atomic.AddInt64(&diskCacheStats.Misses, 1)
+		return nil, false
+	}
+	hash := newHash()
+	hash.Write(data)
+	if getBlock(hash).Hash != block.Hash {
+		protolion.Errorf("cached block %s is corrupt, removing it", block.Hash)
+		c.remove(block.Hash)
+		atomic.AddInt64(&diskCacheStats.Corruptions, 1)
+		atomic.AddInt64(&diskCacheStats.Misses, 1)
+		return nil, false
+	}
+	atomic.AddInt64(&diskCacheStats.Hits, 1)
+	return data, true
+}
+
+// put adds a block to the cache, evicting other blocks to make room for it.
+func (c *diskCache) put(block *pfsclient.Block, data []byte) error {
+	if c == nil || int64(len(data)) > c.maxBytes {
+		return nil
+	}
+	c.mu.Lock()
+	_, ok := c.entries[block.Hash]
+	c.mu.Unlock()
+	if ok {
+		return nil
+	}
+	// Blocks are written to a temporary file first so that a partially
+	// written block is never read.
+	tmp, err := ioutil.TempFile(c.tmpDir(), block.Hash)
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), c.path(block.Hash)); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	c.add(block.Hash, int64(len(data)))
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.evict()
+	return nil
+}
+
+func (c *diskCache) add(hash string, size int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[hash]; ok {
+		return
+	}
+	c.entries[hash] = c.lru.PushFront(&diskCacheEntry{hash: hash, size: size})
+	c.bytes += size
+	atomic.AddInt64(&diskCacheStats.Bytes, size)
+}
+
+func (c *diskCache) remove(hash string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[hash]; ok {
+		c.removeElement(element)
+	}
+}
+
+// evict removes the least recently used blocks until the cache is within
+// its size limit, c.mu must be held.
+func (c *diskCache) evict() {
+	for c.bytes > c.maxBytes {
+		c.removeElement(c.lru.Back())
+		atomic.AddInt64(&diskCacheStats.Evictions, 1)
+	}
+}
+
+// removeElement removes a block from the cache, c.mu must be held.
+func (c *diskCache) removeElement(element *list.Element) {
+	entry := element.Value.(*diskCacheEntry)
+	c.lru.Remove(element)
+	delete(c.entries, entry.hash)
+	c.bytes -= entry.size
+	atomic.AddInt64(&diskCacheStats.Bytes, -entry.size)
+	if err := os.Remove(c.path(entry.hash)); err != nil && !os.IsNotExist(err) {
+		protolion.Errorf("error removing cached block %s: %s", entry.hash, err.Error())
+	}
+}
+
+func (c *diskCache) blockDir() string {
+	return filepath.Join(c.dir, "block")
+}
+
+func (c *diskCache) tmpDir() string {
+	return filepath.Join(c.dir, "tmp")
+}
+
+func (c *diskCache) path(hash string) string {
+	return filepath.Join(c.blockDir(), hash)
+}
+
+type byModTime []os.FileInfo
+
+func (b byModTime) Len() int           { return len(b) }
+func (b byModTime) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+func (b byModTime) Less(i, j int) bool { return b[i].ModTime().Before(b[j].ModTime()) }
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	pfsclient "github.com/sjezewski/pachyderm/src/client/pfs"
+	"github.com/sjezewski/pachyderm/src/client/pkg/require"
+)
+
+func testBlock(content string) (*pfsclient.Block, []byte) {
+	hash := newHash()
+	hash.Write([]byte(content))
+	return getBlock(hash), []byte(content)
+}
+
+func TestDiskCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "disk_cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	// room for three blocks
+	cache, err := newDiskCache(dir, 30)
+	require.NoError(t, err)
+
+	var blocks []*pfsclient.Block
+	for i := 0; i < 4; i++ {
+		block, data := testBlock(fmt.Sprintf("block%05d", i))
+		blocks = append(blocks, block)
+		if i == 3 {
+			// Make block 0 the most recently used, so block 1 is evicted.
+			_, ok := cache.get(blocks[0])
+			require.True(t, ok)
+		}
+		require.NoError(t, cache.put(block, data))
+	}
+	for i, block := range blocks {
+		data, ok := cache.get(block)
+		require.Equal(t, i != 1, ok)
+		if ok {
+			require.Equal(t, fmt.Sprintf("block%05d", i), string(data))
+		}
+	}
+
+	// Blocks larger than the cache aren't cached.
+	block, data := testBlock("this block is larger than the cache")
+	require.NoError(t, cache.put(block, data))
+	_, ok := cache.get(block)
+	require.False(t, ok)
+
+	// The cache survives restarts.
+	cache, err = newDiskCache(dir, 30)
+	require.NoError(t, err)
+	for i, block := range blocks {
+		_, ok := cache.get(block)
+		require.Equal(t, i != 1, ok)
+	}
+}
+
+func TestDiskCacheCorruption(t *testing.T) {
+	dir, err := ioutil.TempDir("", "disk_cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	cache, err := newDiskCache(dir, 1024)
+	require.NoError(t, err)
+
+	block, data := testBlock("foo")
+	require.NoError(t, cache.put(block, data))
+	require.NoError(t, ioutil.WriteFile(cache.path(block.Hash), []byte("bar"), 0666))
+	corruptions := atomic.LoadInt64(&diskCacheStats.Corruptions)
+	_, ok := cache.get(block)
+	require.False(t, ok)
+	require.Equal(t, corruptions+1, atomic.LoadInt64(&diskCacheStats.Corruptions))
+	// The corrupt block is removed so it can be cached again.
+	_, err = os.Stat(cache.path(block.Hash))
+	require.True(t, os.IsNotExist(err))
+	require.NoError(t, cache.put(block, data))
+	cached, ok := cache.get(block)
+	require.True(t, ok)
+	require.Equal(t, "foo", string(cached))
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	pfsclient "github.com/sjezewski/pachyderm/src/client/pfs"
+	"github.com/sjezewski/pachyderm/src/server/pkg/promutil"
 	"go.pedge.io/pb/go/google/protobuf"
 	"go.pedge.io/proto/rpclog"
 	"go.pedge.io/proto/stream"
@@ -26,7 +27,7 @@ type localBlockAPIServer struct {
 
 func newLocalBlockAPIServer(dir string) (*localBlockAPIServer, error) {
 	server := &localBlockAPIServer{
-		Logger: protorpclog.NewLogger("pfs.BlockAPIServer.Local"),
+		Logger: promutil.NewLogger("pfs.BlockAPIServer.Local"),
 		dir:    dir,
 	}
 	if err := os.MkdirAll(server.tmpDir(), 0777); err != nil {
@@ -102,7 +103,7 @@ func (s *localBlockAPIServer) GetBlock(request *pfsclient.GetBlockRequest, getBl
 	} else {
 		reader = io.NewSectionReader(file, int64(request.OffsetBytes), int64(request.SizeBytes))
 	}
-	return protostream.WriteToStreamingBytesServer(reader, getBlockServer)
+	return protostream.WriteToStreamingBytesServer(countingReader{reader}, getBlockServer)
 }
 
 func (s *localBlockAPIServer) DeleteBlock(ctx context.Context, request *pfsclient.DeleteBlockRequest) (response *google_protobuf.Empty, retErr error) {
@@ -180,6 +181,7 @@ func readBlock(delimiter pfsclient.Delimiter, reader *bufio.Reader, decoder *jso
 		buffer.Write(value)
 		hash.Write(value)
 		bytesWritten += len(value)
+		blockBytesWritten.Add(float64(len(value)))
 		if bytesWritten > blockSize && delimiter != pfsclient.Delimiter_NONE {
 			break
 		}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	"io"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	blockBytesRead = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "block",
+		Name:      "bytes_read_total",
+		Help:      "The number of bytes of blocks served by this pachd.",
+	})
+	blockBytesWritten = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "block",
+		Name:      "bytes_written_total",
+		Help:      "The number of bytes of blocks put to this pachd.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(blockBytesRead)
+	prometheus.MustRegister(blockBytesWritten)
+	for name, stat := range map[string]*int64{
+		"hits_total":        &diskCacheStats.Hits,
+		"misses_total":      &diskCacheStats.Misses,
+		"evictions_total":   &diskCacheStats.Evictions,
+		"corruptions_total": &diskCacheStats.Corruptions,
+	} {
+		stat := stat
+		prometheus.MustRegister(prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: "pachyderm",
+			Subsystem: "block_disk_cache",
+			Name:      name,
+			Help:      "The block disk cache's " + name[:len(name)-len("_total")] + ".",
+		}, func() float64 { return float64(atomic.LoadInt64(stat)) }))
+	}
+	prometheus.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: "pachyderm",
+		Subsystem: "block_disk_cache",
+		Name:      "bytes",
+		Help:      "The size of the blocks in the block disk cache.",
+	}, func() float64 { return float64(atomic.LoadInt64(&diskCacheStats.Bytes)) }))
+	prometheus.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: "pachyderm",
+		Subsystem: "block_disk_cache",
+		Name:      "hit_ratio",
+		Help:      "The fraction of block disk cache lookups which were hits.",
+	}, func() float64 {
+		hits := atomic.LoadInt64(&diskCacheStats.Hits)
+		misses := atomic.LoadInt64(&diskCacheStats.Misses)
+		if hits+misses == 0 {
+			return 0
+		}
+		return float64(hits) / float64(hits+misses)
+	}))
+}
+
+// countingReader counts the bytes read through it in blockBytesRead.
+type countingReader struct {
+	reader io.Reader
+}
+
+func (r countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	blockBytesRead.Add(float64(n))
+	return n, err
+}
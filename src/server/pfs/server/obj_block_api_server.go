@@ -20,6 +20,7 @@ import (
 	"github.com/sjezewski/pachyderm/src/client"
 	pfsclient "github.com/sjezewski/pachyderm/src/client/pfs"
 	"github.com/sjezewski/pachyderm/src/server/pkg/obj"
+	"github.com/sjezewski/pachyderm/src/server/pkg/promutil"
 )
 
 type objBlockAPIServer struct {
@@ -30,22 +31,26 @@ type objBlockAPIServer struct {
 	cache       *groupcache.Group
 }
 
-func newObjBlockAPIServer(dir string, cacheBytes int64, objClient obj.Client) (*objBlockAPIServer, error) {
+func newObjBlockAPIServer(dir string, cacheBytes int64, diskCache *diskCache, objClient obj.Client) (*objBlockAPIServer, error) {
 	localServer, err := newLocalBlockAPIServer(dir)
 	if err != nil {
 		return nil, err
 	}
 	return &objBlockAPIServer{
-		Logger:      protorpclog.NewLogger("pfs.BlockAPI.Obj"),
+		Logger:      promutil.NewLogger("pfs.BlockAPI.Obj"),
 		dir:         dir,
 		localServer: localServer,
 		objClient:   objClient,
-		cache: groupcache.NewGroup("block", 1024*1024*1024*10,
+		cache: groupcache.NewGroup("block", cacheBytes,
 			groupcache.GetterFunc(func(ctx groupcache.Context, key string, dest groupcache.Sink) (retErr error) {
+				block := client.NewBlock(key)
+				if data, ok := diskCache.get(block); ok {
+					return dest.SetBytes(data)
+				}
 				var reader io.ReadCloser
 				var err error
 				backoff.RetryNotify(func() error {
-					reader, err = objClient.Reader(localServer.blockPath(client.NewBlock(key)), 0, 0)
+					reader, err = objClient.Reader(localServer.blockPath(block), 0, 0)
 					if err != nil && objClient.IsRetryable(err) {
 						return err
 					}
@@ -64,16 +69,21 @@ func newObjBlockAPIServer(dir string, cacheBytes int64, objClient obj.Client) (*
 						retErr = err
 					}
 				}()
-				block, err := ioutil.ReadAll(reader)
+				data, err := ioutil.ReadAll(reader)
 				if err != nil {
 					return err
 				}
-				return dest.SetBytes(block)
+				if err := diskCache.put(block, data); err != nil {
+					// The block can still be served, it'll just be read from
+					// the object store again next time.
+					protolion.Errorf("error caching block %s on disk: %s", key, err.Error())
+				}
+				return dest.SetBytes(data)
 			})),
 	}, nil
 }
 
-func newAmazonBlockAPIServer(dir string, cacheBytes int64) (*objBlockAPIServer, error) {
+func newAmazonBlockAPIServer(dir string, cacheBytes int64, diskCache *diskCache) (*objBlockAPIServer, error) {
 	bucket, err := ioutil.ReadFile("/amazon-secret/bucket")
 	if err != nil {
 		return nil, err
@@ -98,10 +108,10 @@ func newAmazonBlockAPIServer(dir string, cacheBytes int64) (*objBlockAPIServer,
 	if err != nil {
 		return nil, err
 	}
-	return newObjBlockAPIServer(dir, cacheBytes, objClient)
+	return newObjBlockAPIServer(dir, cacheBytes, diskCache, objClient)
 }
 
-func newGoogleBlockAPIServer(dir string, cacheBytes int64) (*objBlockAPIServer, error) {
+func newGoogleBlockAPIServer(dir string, cacheBytes int64, diskCache *diskCache) (*objBlockAPIServer, error) {
 	bucket, err := ioutil.ReadFile("/google-secret/bucket")
 	if err != nil {
 		return nil, err
@@ -110,10 +120,10 @@ func newGoogleBlockAPIServer(dir string, cacheBytes int64) (*objBlockAPIServer,
 	if err != nil {
 		return nil, err
 	}
-	return newObjBlockAPIServer(dir, cacheBytes, objClient)
+	return newObjBlockAPIServer(dir, cacheBytes, diskCache, objClient)
 }
 
-func newMicrosoftBlockAPIServer(dir string, cacheBytes int64) (*objBlockAPIServer, error) {
+func newMicrosoftBlockAPIServer(dir string, cacheBytes int64, diskCache *diskCache) (*objBlockAPIServer, error) {
 	container, err := ioutil.ReadFile("/microsoft-secret/container")
 	if err != nil {
 		return nil, err
@@ -130,7 +140,35 @@ func newMicrosoftBlockAPIServer(dir string, cacheBytes int64) (*objBlockAPIServe
 	if err != nil {
 		return nil, err
 	}
-	return newObjBlockAPIServer(dir, cacheBytes, objClient)
+	return newObjBlockAPIServer(dir, cacheBytes, diskCache, objClient)
+}
+
+func newCustomBlockAPIServer(dir string, cacheBytes int64, diskCache *diskCache) (*objBlockAPIServer, error) {
+	bucket, err := ioutil.ReadFile("/custom-secret/bucket")
+	if err != nil {
+		return nil, err
+	}
+	id, err := ioutil.ReadFile("/custom-secret/id")
+	if err != nil {
+		return nil, err
+	}
+	secret, err := ioutil.ReadFile("/custom-secret/secret")
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := ioutil.ReadFile("/custom-secret/endpoint")
+	if err != nil {
+		return nil, err
+	}
+	secure, err := ioutil.ReadFile("/custom-secret/secure")
+	if err != nil {
+		return nil, err
+	}
+	objClient, err := obj.NewCustomAmazonClient(string(bucket), string(id), string(secret), string(endpoint), string(secure) == "1")
+	if err != nil {
+		return nil, err
+	}
+	return newObjBlockAPIServer(dir, cacheBytes, diskCache, objClient)
 }
 
 func (s *objBlockAPIServer) PutBlock(putBlockServer pfsclient.BlockAPI_PutBlockServer) (retErr error) {
@@ -216,6 +254,7 @@ func (s *objBlockAPIServer) GetBlock(request *pfsclient.GetBlockRequest, getBloc
 	} else {
 		data = nil
 	}
+	blockBytesRead.Add(float64(len(data)))
 	return getBlockServer.Send(&google_protobuf.BytesValue{Value: data})
 }
 
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"path/filepath"
+
 	pfsclient "github.com/sjezewski/pachyderm/src/client/pfs"
 	"github.com/sjezewski/pachyderm/src/server/pfs/drive"
 	"github.com/sjezewski/pachyderm/src/server/pkg/obj"
@@ -15,6 +17,9 @@ const (
 	AmazonBackendEnvVar    = "AMAZON"
 	GoogleBackendEnvVar    = "GOOGLE"
 	MicrosoftBackendEnvVar = "MICROSOFT"
+	// CustomBackendEnvVar is an S3 compatible object store at a custom
+	// endpoint.
+	CustomBackendEnvVar = "CUSTOM"
 )
 
 // APIServer represents and api server.
@@ -34,32 +39,55 @@ func NewLocalBlockAPIServer(dir string) (pfsclient.BlockAPIServer, error) {
 
 // NewObjBlockAPIServer create a BlockAPIServer from an obj.Client.
 func NewObjBlockAPIServer(dir string, cacheBytes int64, objClient obj.Client) (pfsclient.BlockAPIServer, error) {
-	return newObjBlockAPIServer(dir, cacheBytes, objClient)
+	return newObjBlockAPIServer(dir, cacheBytes, nil, objClient)
 }
 
 // NewBlockAPIServer creates a BlockAPIServer using the credentials it finds in
-// the environment
-func NewBlockAPIServer(dir string, cacheBytes int64, backend string) (pfsclient.BlockAPIServer, error) {
+// the environment. Object store backends cache up to cacheBytes of blocks in
+// memory and diskCacheBytes on disk under dir, a diskCacheBytes of 0 disables
+// the disk cache.
+func NewBlockAPIServer(dir string, cacheBytes int64, diskCacheBytes int64, backend string) (pfsclient.BlockAPIServer, error) {
+	var diskCache *diskCache
+	switch backend {
+	case AmazonBackendEnvVar, GoogleBackendEnvVar, MicrosoftBackendEnvVar, CustomBackendEnvVar:
+		if diskCacheBytes > 0 {
+			var err error
+			diskCache, err = newDiskCache(filepath.Join(dir, "cache"), diskCacheBytes)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	switch backend {
 	case AmazonBackendEnvVar:
 		// amazon doesn't like leading slashes
 		if dir[0] == '/' {
 			dir = dir[1:]
 		}
-		blockAPIServer, err := newAmazonBlockAPIServer(dir, cacheBytes)
+		blockAPIServer, err := newAmazonBlockAPIServer(dir, cacheBytes, diskCache)
 		if err != nil {
 			return nil, err
 		}
 		return blockAPIServer, nil
 	case GoogleBackendEnvVar:
 		// TODO figure out if google likes leading slashses
-		blockAPIServer, err := newGoogleBlockAPIServer(dir, cacheBytes)
+		blockAPIServer, err := newGoogleBlockAPIServer(dir, cacheBytes, diskCache)
 		if err != nil {
 			return nil, err
 		}
 		return blockAPIServer, nil
 	case MicrosoftBackendEnvVar:
-		blockAPIServer, err := newMicrosoftBlockAPIServer(dir, cacheBytes)
+		blockAPIServer, err := newMicrosoftBlockAPIServer(dir, cacheBytes, diskCache)
+		if err != nil {
+			return nil, err
+		}
+		return blockAPIServer, nil
+	case CustomBackendEnvVar:
+		// S3 compatible stores don't like leading slashes either
+		if dir[0] == '/' {
+			dir = dir[1:]
+		}
+		blockAPIServer, err := newCustomBlockAPIServer(dir, cacheBytes, diskCache)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,26 @@
+package server
+
+import (
+	"github.com/golang/groupcache/consistenthash"
+)
+
+// virtualNodes is the number of points each server has on the ring, more
+// points spread keys more evenly between servers.
+const virtualNodes = 128
+
+// ring is a consistent hash ring of server addresses.
+type ring struct {
+	m *consistenthash.Map
+}
+
+func newRing(addresses []string) *ring {
+	m := consistenthash.New(virtualNodes, nil)
+	m.Add(addresses...)
+	return &ring{m}
+}
+
+// get returns the address of the server which owns key, or "" if the ring
+// is empty.
+func (r *ring) get(key string) string {
+	return r.m.Get(key)
+}
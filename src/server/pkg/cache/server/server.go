@@ -1,14 +1,15 @@
 package server
 
 import (
-	"hash/adler32"
 	"sync"
 	"time"
 
 	"github.com/golang/groupcache"
 	pb "github.com/golang/groupcache/groupcachepb"
+	"github.com/sjezewski/pachyderm/src/client/pkg/grpcutil"
 	"github.com/sjezewski/pachyderm/src/client/pkg/shard"
 	"github.com/sjezewski/pachyderm/src/server/pkg/cache/groupcachepb"
+	"github.com/sjezewski/pachyderm/src/server/pkg/promutil"
 	"go.pedge.io/proto/rpclog"
 	"golang.org/x/net/context"
 )
@@ -17,28 +18,34 @@ import (
 type CacheServer interface {
 	groupcachepb.GroupCacheServer
 	shard.Frontend
-	shard.Server
 }
 
-// NewCacheServer creates a new CacheServer.
-func NewCacheServer(router shard.Router, shards uint64) CacheServer {
-	server := &groupCacheServer{
-		Logger:      protorpclog.NewLogger("CacheServer"),
-		router:      router,
-		localShards: make(map[uint64]bool),
-		shards:      shards,
-	}
+// NewCacheServer creates a new CacheServer. Keys are placed on the servers
+// that sharder knows about with a consistent hash, so that only the keys of
+// servers which join or leave move when the servers change.
+func NewCacheServer(sharder shard.Sharder, dialer grpcutil.Dialer, localAddress string) CacheServer {
+	server := newGroupCacheServer(sharder, dialer, localAddress)
 	groupcache.RegisterPeerPicker(func() groupcache.PeerPicker { return server })
 	return server
 }
 
 type groupCacheServer struct {
 	protorpclog.Logger
-	router      shard.Router
-	localShards map[uint64]bool
-	mu          sync.Mutex
-	version     int64
-	shards      uint64
+	sharder      shard.Sharder
+	dialer       grpcutil.Dialer
+	localAddress string
+	mu           sync.Mutex
+	ring         *ring
+}
+
+func newGroupCacheServer(sharder shard.Sharder, dialer grpcutil.Dialer, localAddress string) *groupCacheServer {
+	return &groupCacheServer{
+		Logger:       promutil.NewLogger("CacheServer"),
+		sharder:      sharder,
+		dialer:       dialer,
+		localAddress: localAddress,
+		ring:         newRing(nil),
+	}
 }
 
 func (s *groupCacheServer) Get(
@@ -56,25 +63,25 @@ func (s *groupCacheServer) Get(
 }
 
 func (s *groupCacheServer) PickPeer(key string) (groupcache.ProtoGetter, bool) {
-	shard := uint64(adler32.Checksum([]byte(key))) % s.shards
-	if s.localShards[shard] {
+	s.mu.Lock()
+	address := s.ring.get(key)
+	s.mu.Unlock()
+	if address == "" || address == s.localAddress {
 		return nil, false
 	}
 	return &protoGetter{
-		shard:   shard,
-		version: s.version,
-		router:  s.router,
+		address: address,
+		dialer:  s.dialer,
 	}, true
 }
 
 type protoGetter struct {
-	shard   uint64
-	version int64
-	router  shard.Router
+	address string
+	dialer  grpcutil.Dialer
 }
 
 func (p *protoGetter) Get(ctx groupcache.Context, in *pb.GetRequest, out *pb.GetResponse) error {
-	conn, err := p.router.GetClientConn(p.shard, p.version)
+	conn, err := p.dialer.Dial(p.address)
 	if err != nil {
 		return err
 	}
@@ -95,21 +102,23 @@ func (p *protoGetter) Get(ctx groupcache.Context, in *pb.GetRequest, out *pb.Get
 	return nil
 }
 
-func (s *groupCacheServer) AddShard(shard uint64) error {
-	s.mu.Lock()
-	s.localShards[shard] = true
-	s.mu.Unlock()
-	return nil
-}
-
-func (s *groupCacheServer) DeleteShard(shard uint64) error {
+// Version rebuilds the ring from the servers which have shards in version.
+func (s *groupCacheServer) Version(version int64) error {
+	shardToAddress, err := s.sharder.GetShardToAddress(version)
+	if err != nil {
+		return err
+	}
+	var addresses []string
+	seen := make(map[string]bool)
+	for _, address := range shardToAddress {
+		if !seen[address] {
+			seen[address] = true
+			addresses = append(addresses, address)
+		}
+	}
+	ring := newRing(addresses)
 	s.mu.Lock()
-	delete(s.localShards, shard)
+	s.ring = ring
 	s.mu.Unlock()
 	return nil
 }
-
-func (s *groupCacheServer) Version(version int64) error {
-	s.version = version
-	return nil
-}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"fmt"
+	"hash/adler32"
+	"testing"
+
+	"github.com/golang/groupcache"
+	"github.com/sjezewski/pachyderm/src/client/pkg/grpcutil"
+	"github.com/sjezewski/pachyderm/src/client/pkg/require"
+	"github.com/sjezewski/pachyderm/src/client/pkg/shard"
+	"google.golang.org/grpc"
+)
+
+const numKeys = 10000
+
+func addresses(n int) []string {
+	var result []string
+	for i := 0; i < n; i++ {
+		result = append(result, fmt.Sprintf("10.0.0.%d:650", i))
+	}
+	return result
+}
+
+func owners(r *ring) map[string]string {
+	result := make(map[string]string)
+	for i := 0; i < numKeys; i++ {
+		key := fmt.Sprintf("block-%d", i)
+		result[key] = r.get(key)
+	}
+	return result
+}
+
+func TestRingBalance(t *testing.T) {
+	counts := make(map[string]int)
+	for _, owner := range owners(newRing(addresses(5))) {
+		counts[owner]++
+	}
+	require.Equal(t, 5, len(counts))
+	for _, count := range counts {
+		// Each server should own about a fifth of the keys.
+		require.True(t, count > numKeys/10 && count < numKeys*3/10, fmt.Sprintf("unbalanced ring: %v", counts))
+	}
+}
+
+// moduloMoved returns the number of keys which move when placing keys by
+// their hash modulo the number of servers, as the cache used to.
+func moduloMoved(before int, after int) int {
+	var moved int
+	for i := 0; i < numKeys; i++ {
+		hash := adler32.Checksum([]byte(fmt.Sprintf("block-%d", i)))
+		if hash%uint32(before) != hash%uint32(after) {
+			moved++
+		}
+	}
+	return moved
+}
+
+func TestRingMovement(t *testing.T) {
+	before := owners(newRing(addresses(5)))
+
+	// Adding a server only moves keys to that server.
+	after := owners(newRing(addresses(6)))
+	var moved int
+	for key, owner := range after {
+		if owner != before[key] {
+			require.Equal(t, addresses(6)[5], owner)
+			moved++
+		}
+	}
+	t.Logf("%d of %d keys moved when adding a server, %d with modulo hashing", moved, numKeys, moduloMoved(5, 6))
+	require.True(t, moved < moduloMoved(5, 6)/2)
+
+	// Removing a server only moves that server's keys.
+	after = owners(newRing(addresses(4)))
+	moved = 0
+	for key, owner := range after {
+		if owner != before[key] {
+			require.Equal(t, addresses(5)[4], before[key])
+			moved++
+		}
+	}
+	t.Logf("%d of %d keys moved when removing a server, %d with modulo hashing", moved, numKeys, moduloMoved(5, 4))
+	require.True(t, moved < moduloMoved(5, 4)/2)
+}
+
+func TestPickPeer(t *testing.T) {
+	sharder := shard.NewLocalSharder(addresses(3), 16)
+	server := newGroupCacheServer(sharder, grpcutil.NewDialer(grpc.WithInsecure()), addresses(3)[0])
+	// There are no peers until the first version.
+	_, ok := server.PickPeer("key")
+	require.False(t, ok)
+
+	require.NoError(t, server.Version(0))
+	var local int
+	for key, owner := range owners(newRing(addresses(3))) {
+		_, ok := server.PickPeer(key)
+		require.Equal(t, owner != addresses(3)[0], ok)
+		if !ok {
+			local++
+		}
+	}
+	require.True(t, local > 0 && local < numKeys)
+}
+
+func TestStats(t *testing.T) {
+	group := groupcache.NewGroup("test", 1024, groupcache.GetterFunc(func(ctx groupcache.Context, key string, dest groupcache.Sink) error {
+		return dest.SetString(key)
+	}))
+	var value string
+	for i := 0; i < 2; i++ {
+		require.NoError(t, group.Get(nil, "key", groupcache.StringSink(&value)))
+	}
+	groupStats := stats()["test"]
+	require.NotNil(t, groupStats)
+	require.Equal(t, int64(2), groupStats.Gets)
+	require.Equal(t, int64(1), groupStats.CacheHits)
+	require.Equal(t, 0.5, groupStats.HitRate)
+	require.Equal(t, int64(1), groupStats.MainCache.Items)
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"expvar"
+	"sync"
+
+	"github.com/golang/groupcache"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	groups     []*groupcache.Group
+	groupsLock sync.Mutex
+)
+
+func init() {
+	groupcache.RegisterNewGroupHook(func(group *groupcache.Group) {
+		groupsLock.Lock()
+		defer groupsLock.Unlock()
+		groups = append(groups, group)
+	})
+	// Published under /debug/vars, each pachd reports the stats of its own
+	// caches.
+	expvar.Publish("groupcache", expvar.Func(func() interface{} {
+		return stats()
+	}))
+	prometheus.MustRegister(statsCollector{})
+}
+
+// GroupStats are the stats of a groupcache group on this server.
+type GroupStats struct {
+	Gets           int64
+	CacheHits      int64
+	PeerLoads      int64
+	PeerErrors     int64
+	LocalLoads     int64
+	LocalLoadErrs  int64
+	ServerRequests int64
+	// HitRate is the fraction of gets served from this server's caches.
+	HitRate   float64
+	MainCache groupcache.CacheStats
+	HotCache  groupcache.CacheStats
+}
+
+// stats returns the stats of every group, by name.
+func stats() map[string]*GroupStats {
+	groupsLock.Lock()
+	defer groupsLock.Unlock()
+	result := make(map[string]*GroupStats)
+	for _, group := range groups {
+		groupStats := &GroupStats{
+			Gets:           group.Stats.Gets.Get(),
+			CacheHits:      group.Stats.CacheHits.Get(),
+			PeerLoads:      group.Stats.PeerLoads.Get(),
+			PeerErrors:     group.Stats.PeerErrors.Get(),
+			LocalLoads:     group.Stats.LocalLoads.Get(),
+			LocalLoadErrs:  group.Stats.LocalLoadErrs.Get(),
+			ServerRequests: group.Stats.ServerRequests.Get(),
+			MainCache:      group.CacheStats(groupcache.MainCache),
+			HotCache:       group.CacheStats(groupcache.HotCache),
+		}
+		if groupStats.Gets > 0 {
+			groupStats.HitRate = float64(groupStats.CacheHits) / float64(groupStats.Gets)
+		}
+		result[group.Name()] = groupStats
+	}
+	return result
+}
+
+var (
+	getsDesc       = groupDesc("gets_total", "The number of gets of the group on this server.")
+	cacheHitsDesc  = groupDesc("cache_hits_total", "The number of gets served from this server's caches.")
+	peerLoadsDesc  = groupDesc("peer_loads_total", "The number of gets loaded from another server.")
+	peerErrorsDesc = groupDesc("peer_errors_total", "The number of errors loading from another server.")
+	localLoadsDesc = groupDesc("local_loads_total", "The number of gets loaded by this server.")
+	hitRatioDesc   = groupDesc("hit_ratio", "The fraction of gets served from this server's caches.")
+	cacheBytesDesc = groupDesc("cache_bytes", "The size of the group's main cache on this server.")
+)
+
+func groupDesc(name string, help string) *prometheus.Desc {
+	return prometheus.NewDesc(prometheus.BuildFQName("pachyderm", "groupcache", name), help, []string{"group"}, nil)
+}
+
+// statsCollector exports the stats of every group to Prometheus.
+type statsCollector struct{}
+
+func (statsCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{getsDesc, cacheHitsDesc, peerLoadsDesc, peerErrorsDesc, localLoadsDesc, hitRatioDesc, cacheBytesDesc} {
+		ch <- desc
+	}
+}
+
+func (statsCollector) Collect(ch chan<- prometheus.Metric) {
+	for name, groupStats := range stats() {
+		for desc, value := range map[*prometheus.Desc]int64{
+			getsDesc:       groupStats.Gets,
+			cacheHitsDesc:  groupStats.CacheHits,
+			peerLoadsDesc:  groupStats.PeerLoads,
+			peerErrorsDesc: groupStats.PeerErrors,
+			localLoadsDesc: groupStats.LocalLoads,
+		} {
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, float64(value), name)
+		}
+		ch <- prometheus.MustNewConstMetric(hitRatioDesc, prometheus.GaugeValue, groupStats.HitRate, name)
+		ch <- prometheus.MustNewConstMetric(cacheBytesDesc, prometheus.GaugeValue, float64(groupStats.MainCache.Bytes), name)
+	}
+}
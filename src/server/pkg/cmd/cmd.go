@@ -1,11 +1,13 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
 
 	"github.com/sjezewski/pachyderm/src/client/pfs"
+	"github.com/sjezewski/pachyderm/src/client/pkg/tracing"
 	"github.com/spf13/cobra"
 )
 
@@ -24,6 +26,15 @@ func RunFixedArgs(numArgs int, run func([]string) error) func(*cobra.Command, []
 	}
 }
 
+// Run wraps a function in a function that exits if it errors.
+func Run(run func([]string) error) func(*cobra.Command, []string) {
+	return func(cmd *cobra.Command, args []string) {
+		if err := run(args); err != nil {
+			ErrorAndExit("%v", err)
+		}
+	}
+}
+
 // RunBoundedArgs wraps a function in a function
 // that checks its argument count is within a range.
 func RunBoundedArgs(min int, max int, run func([]string) error) func(*cobra.Command, []string) {
@@ -39,11 +50,14 @@ func RunBoundedArgs(min int, max int, run func([]string) error) func(*cobra.Comm
 	}
 }
 
-// ErrorAndExit errors with the given format and args, and then exits.
+// ErrorAndExit errors with the given format and args, and then exits.  The
+// process's root span is finished first, since deferred calls don't run.
 func ErrorAndExit(format string, args ...interface{}) {
-	if errString := strings.TrimSpace(fmt.Sprintf(format, args...)); errString != "" {
+	errString := strings.TrimSpace(fmt.Sprintf(format, args...))
+	if errString != "" {
 		fmt.Fprintf(os.Stderr, "%s\n", errString)
 	}
+	tracing.FinishRootSpan(errors.New(errString))
 	os.Exit(1)
 }
 
@@ -0,0 +1,210 @@
+// Package cron parses cron specs, which say when scheduled pipeline inputs
+// fire.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A Schedule is a set of times.
+type Schedule interface {
+	// Next returns the first time in the schedule after t, or the zero time
+	// if there isn't one.
+	Next(t time.Time) time.Time
+}
+
+// The shorthands Parse accepts in place of the five fields of a spec.
+var shorthands = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Parse parses a cron spec, which is either the five standard fields
+// "minute hour day-of-month month day-of-week", a shorthand such as
+// "@daily", or an interval "@every <duration>" such as "@every 90m".
+// Fields are "*", numbers, ranges "1-5", steps "*/15" or "1-30/2" and lists
+// of them "1,15,30"; months and days of the week may also be given by name,
+// "JAN" or "MON".
+func Parse(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "@every") {
+		interval, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, "@every")))
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron spec %q: %s", spec, err.Error())
+		}
+		if interval < time.Second {
+			return nil, fmt.Errorf("invalid cron spec %q: the interval must be at least a second", spec)
+		}
+		return every(interval), nil
+	}
+	if expanded, ok := shorthands[spec]; ok {
+		spec = expanded
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron spec %q: expected 5 fields, got %d", spec, len(fields))
+	}
+	result := &fieldSchedule{}
+	for i, f := range []struct {
+		field *uint64
+		bound bound
+	}{
+		{&result.minute, minutes},
+		{&result.hour, hours},
+		{&result.dom, daysOfMonth},
+		{&result.month, months},
+		{&result.dow, daysOfWeek},
+	} {
+		bits, err := parseField(fields[i], f.bound)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron spec %q: %s", spec, err.Error())
+		}
+		*f.field = bits
+	}
+	// Sunday is both 0 and 7.
+	if result.dow&(1<<7) != 0 {
+		result.dow |= 1
+	}
+	result.domStar = fields[2] == "*" || strings.HasPrefix(fields[2], "*/")
+	result.dowStar = fields[4] == "*" || strings.HasPrefix(fields[4], "*/")
+	return result, nil
+}
+
+// every is a schedule which fires at a fixed interval.
+type every time.Duration
+
+func (e every) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(e)).Truncate(time.Second)
+}
+
+// fieldSchedule is a schedule of five cron fields, each one is a bit set of
+// the values it matches.
+type fieldSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// domStar and dowStar are true if the day of month and day of week
+	// fields were unrestricted, if neither was then a day matches if either
+	// of them does.
+	domStar, dowStar bool
+}
+
+// Next walks forward from t one field at a time, skipping whole months, days
+// and hours that don't match.
+func (s *fieldSchedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	// Every schedule which can fire at all does so within 5 years, that's
+	// how long it takes for February 29th to fall on every day of the week.
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s *fieldSchedule) matchDay(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+type bound struct {
+	min, max uint
+	names    map[string]uint
+}
+
+var (
+	minutes     = bound{0, 59, nil}
+	hours       = bound{0, 23, nil}
+	daysOfMonth = bound{1, 31, nil}
+	months      = bound{1, 12, map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	daysOfWeek = bound{0, 7, map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// parseField returns the bit set of the values that field matches.
+func parseField(field string, b bound) (uint64, error) {
+	var result uint64
+	for _, part := range strings.Split(field, ",") {
+		step := uint(1)
+		if i := strings.Index(part, "/"); i != -1 {
+			n, err := strconv.ParseUint(part[i+1:], 10, 8)
+			if err != nil || n == 0 {
+				return 0, fmt.Errorf("invalid step in %q", part)
+			}
+			step = uint(n)
+			part = part[:i]
+		}
+		var low, high uint
+		switch {
+		case part == "*":
+			low, high = b.min, b.max
+		case strings.Contains(part, "-"):
+			i := strings.Index(part, "-")
+			var err error
+			if low, err = parseValue(part[:i], b); err != nil {
+				return 0, err
+			}
+			if high, err = parseValue(part[i+1:], b); err != nil {
+				return 0, err
+			}
+			if low > high {
+				return 0, fmt.Errorf("invalid range %q", part)
+			}
+		default:
+			value, err := parseValue(part, b)
+			if err != nil {
+				return 0, err
+			}
+			low, high = value, value
+			if step != 1 {
+				// "5/15" means every 15 starting at 5.
+				high = b.max
+			}
+		}
+		for value := low; value <= high; value += step {
+			result |= 1 << value
+		}
+	}
+	return result, nil
+}
+
+func parseValue(s string, b bound) (uint, error) {
+	if value, ok := b.names[strings.ToLower(s)]; ok {
+		return value, nil
+	}
+	value, err := strconv.ParseUint(s, 10, 8)
+	if err != nil || uint(value) < b.min || uint(value) > b.max {
+		return 0, fmt.Errorf("%q is not between %d and %d", s, b.min, b.max)
+	}
+	return uint(value), nil
+}
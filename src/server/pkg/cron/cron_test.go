@@ -0,0 +1,60 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sjezewski/pachyderm/src/client/pkg/require"
+)
+
+func TestNext(t *testing.T) {
+	start := time.Date(2016, time.November, 1, 10, 30, 15, 0, time.UTC)
+	for _, c := range []struct {
+		spec string
+		next time.Time
+	}{
+		{"* * * * *", time.Date(2016, time.November, 1, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2016, time.November, 1, 10, 45, 0, 0, time.UTC)},
+		{"5 * * * *", time.Date(2016, time.November, 1, 11, 5, 0, 0, time.UTC)},
+		{"0 2 * * *", time.Date(2016, time.November, 2, 2, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2016, time.November, 2, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2016, time.November, 1, 11, 0, 0, 0, time.UTC)},
+		// November 1st 2016 is a Tuesday.
+		{"0 0 * * MON-FRI", time.Date(2016, time.November, 2, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2016, time.November, 6, 0, 0, 0, 0, time.UTC)},
+		{"30 9 1,15 * *", time.Date(2016, time.November, 15, 9, 30, 0, 0, time.UTC)},
+		{"0 0 1 jan *", time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2020, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		// When both days are restricted either one matches.
+		{"0 0 20 * 5", time.Date(2016, time.November, 4, 0, 0, 0, 0, time.UTC)},
+		{"@every 90m", time.Date(2016, time.November, 1, 12, 0, 15, 0, time.UTC)},
+	} {
+		schedule, err := Parse(c.spec)
+		require.NoError(t, err)
+		require.Equal(t, c.next, schedule.Next(start), c.spec)
+	}
+
+	schedule, err := Parse("0 0 31 2 *")
+	require.NoError(t, err)
+	require.True(t, schedule.Next(start).IsZero())
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"* * * FOO *",
+		"@every",
+		"@every 10ms",
+	} {
+		_, err := Parse(spec)
+		require.YesError(t, err, spec)
+	}
+}
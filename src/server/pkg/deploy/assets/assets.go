@@ -33,6 +33,9 @@ var (
 	amazonSecretName       = "amazon-secret"
 	googleSecretName       = "google-secret"
 	microsoftSecretName    = "microsoft-secret"
+	customSecretName       = "custom-secret"
+	tlsSecretName          = "pachd-tls"
+	tlsServerName          = "pachd"
 	initName               = "pachd-init"
 	trueVal                = true
 	jsonEncoderHandle      = &codec.JsonHandle{
@@ -41,6 +44,11 @@ var (
 		},
 		Indent: 2,
 	}
+	// boltEnvVar makes pachd use its bolt driver, see AssetOpts.Bolt.
+	boltEnvVar = api.EnvVar{
+		Name:  "DATABASE_TYPE",
+		Value: "bolt",
+	}
 )
 
 type backend int
@@ -50,6 +58,7 @@ const (
 	amazonBackend
 	googleBackend
 	microsoftBackend
+	customBackend
 )
 
 // ServiceAccount returns a kubernetes service account for use with Pachyderm.
@@ -66,8 +75,10 @@ func ServiceAccount() *api.ServiceAccount {
 	}
 }
 
-// PachdRc returns a pachd replication controller.
-func PachdRc(shards uint64, backend backend, hostPath string, logLevel string, version string) *api.ReplicationController {
+// PachdRc returns a pachd replication controller. If tls is not nil, pachd
+// serves over TLS using the files in the pachd-tls secret.  If bolt is true
+// pachd stores its metadata in BoltDB, see AssetOpts.Bolt.
+func PachdRc(shards uint64, backend backend, hostPath string, logLevel string, version string, tls *TLSOpts, s3Gateway bool, bolt bool) *api.ReplicationController {
 	image := pachdImage
 	if version != "" {
 		image += ":" + version
@@ -149,6 +160,152 @@ func PachdRc(shards uint64, backend backend, hostPath string, logLevel string, v
 			Name:      microsoftSecretName,
 			MountPath: "/" + microsoftSecretName,
 		})
+	case customBackend:
+		backendEnvVar = server.CustomBackendEnvVar
+		volumes = append(volumes, api.Volume{
+			Name: customSecretName,
+			VolumeSource: api.VolumeSource{
+				Secret: &api.SecretVolumeSource{
+					SecretName: customSecretName,
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, api.VolumeMount{
+			Name:      customSecretName,
+			MountPath: "/" + customSecretName,
+		})
+	}
+	env := []api.EnvVar{
+		{
+			Name:  "PACH_ROOT",
+			Value: "/pach",
+		},
+		{
+			Name:  "NUM_SHARDS",
+			Value: strconv.FormatUint(shards, 10),
+		},
+		{
+			Name:  "STORAGE_BACKEND",
+			Value: backendEnvVar,
+		},
+		{
+			Name: "PACHD_POD_NAMESPACE",
+			ValueFrom: &api.EnvVarSource{
+				FieldRef: &api.ObjectFieldSelector{
+					APIVersion: "v1",
+					FieldPath:  "metadata.namespace",
+				},
+			},
+		},
+		{
+			Name:  "JOB_SHIM_IMAGE",
+			Value: fmt.Sprintf("pachyderm/job-shim:%s", version),
+		},
+		{
+			Name:  "JOB_IMAGE_PULL_POLICY",
+			Value: "IfNotPresent",
+		},
+		{
+			Name:  "PACHD_VERSION",
+			Value: version,
+		},
+		{
+			Name:  "METRICS",
+			Value: metrics,
+		},
+		{
+			Name:  "LOG_LEVEL",
+			Value: logLevel,
+		},
+	}
+	if bolt {
+		env = append(env, boltEnvVar)
+	}
+	if tls != nil {
+		tlsDir := "/" + tlsSecretName
+		volumes = append(volumes, api.Volume{
+			Name: tlsSecretName,
+			VolumeSource: api.VolumeSource{
+				Secret: &api.SecretVolumeSource{
+					SecretName: tlsSecretName,
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, api.VolumeMount{
+			Name:      tlsSecretName,
+			MountPath: tlsDir,
+		})
+		// pachd serves with tls.crt and, since it also dials itself and
+		// other pachd pods, is a client which trusts ca.crt.
+		env = append(env, []api.EnvVar{
+			{
+				Name:  "TLS_CERT_FILE",
+				Value: filepath.Join(tlsDir, "tls.crt"),
+			},
+			{
+				Name:  "TLS_KEY_FILE",
+				Value: filepath.Join(tlsDir, "tls.key"),
+			},
+			{
+				Name:  "TLS_SECRET",
+				Value: tlsSecretName,
+			},
+			{
+				Name:  "PACH_TLS",
+				Value: "true",
+			},
+			{
+				Name:  "PACH_TLS_CA",
+				Value: filepath.Join(tlsDir, "ca.crt"),
+			},
+			{
+				Name:  "PACH_TLS_SERVER_NAME",
+				Value: tlsServerName,
+			},
+		}...)
+		if tls.Mutual {
+			env = append(env, []api.EnvVar{
+				{
+					Name:  "TLS_CLIENT_CA_FILE",
+					Value: filepath.Join(tlsDir, "ca.crt"),
+				},
+				{
+					Name:  "PACH_TLS_CERT",
+					Value: filepath.Join(tlsDir, "tls.crt"),
+				},
+				{
+					Name:  "PACH_TLS_KEY",
+					Value: filepath.Join(tlsDir, "tls.key"),
+				},
+			}...)
+		}
+	}
+	ports := []api.ContainerPort{
+		{
+			ContainerPort: 650,
+			Protocol:      "TCP",
+			Name:          "api-grpc-port",
+		},
+		{
+			ContainerPort: 651,
+			Name:          "trace-port",
+		},
+		{
+			ContainerPort: 652,
+			Protocol:      "TCP",
+			Name:          "api-http-port",
+		},
+	}
+	if s3Gateway {
+		env = append(env, api.EnvVar{
+			Name:  "S3_GATEWAY",
+			Value: "true",
+		})
+		ports = append(ports, api.ContainerPort{
+			ContainerPort: 653,
+			Protocol:      "TCP",
+			Name:          "s3-port",
+		})
 	}
 	replicas := int32(1)
 	return &api.ReplicationController{
@@ -169,66 +326,20 @@ func PachdRc(shards uint64, backend backend, hostPath string, logLevel string, v
 				ObjectMeta: api.ObjectMeta{
 					Name:   pachdName,
 					Labels: labels(pachdName),
+					// Lets a Prometheus server which discovers pods find
+					// pachd's metrics.
+					Annotations: map[string]string{
+						"prometheus.io/scrape": "true",
+						"prometheus.io/port":   "651",
+					},
 				},
 				Spec: api.PodSpec{
 					Containers: []api.Container{
 						{
-							Name:  pachdName,
-							Image: image,
-							Env: []api.EnvVar{
-								{
-									Name:  "PACH_ROOT",
-									Value: "/pach",
-								},
-								{
-									Name:  "NUM_SHARDS",
-									Value: strconv.FormatUint(shards, 10),
-								},
-								{
-									Name:  "STORAGE_BACKEND",
-									Value: backendEnvVar,
-								},
-								{
-									Name: "PACHD_POD_NAMESPACE",
-									ValueFrom: &api.EnvVarSource{
-										FieldRef: &api.ObjectFieldSelector{
-											APIVersion: "v1",
-											FieldPath:  "metadata.namespace",
-										},
-									},
-								},
-								{
-									Name:  "JOB_SHIM_IMAGE",
-									Value: fmt.Sprintf("pachyderm/job-shim:%s", version),
-								},
-								{
-									Name:  "JOB_IMAGE_PULL_POLICY",
-									Value: "IfNotPresent",
-								},
-								{
-									Name:  "PACHD_VERSION",
-									Value: version,
-								},
-								{
-									Name:  "METRICS",
-									Value: metrics,
-								},
-								{
-									Name:  "LOG_LEVEL",
-									Value: logLevel,
-								},
-							},
-							Ports: []api.ContainerPort{
-								{
-									ContainerPort: 650,
-									Protocol:      "TCP",
-									Name:          "api-grpc-port",
-								},
-								{
-									ContainerPort: 651,
-									Name:          "trace-port",
-								},
-							},
+							Name:         pachdName,
+							Image:        image,
+							Env:          env,
+							Ports:        ports,
 							VolumeMounts: volumeMounts,
 							SecurityContext: &api.SecurityContext{
 								Privileged: &trueVal, // god is this dumb
@@ -246,7 +357,31 @@ func PachdRc(shards uint64, backend backend, hostPath string, logLevel string, v
 }
 
 // PachdService returns a pachd service.
-func PachdService() *api.Service {
+func PachdService(s3Gateway bool) *api.Service {
+	ports := []api.ServicePort{
+		{
+			Port:     650,
+			Name:     "api-grpc-port",
+			NodePort: 30650,
+		},
+		{
+			Port:     651,
+			Name:     "trace-port",
+			NodePort: 30651,
+		},
+		{
+			Port:     652,
+			Name:     "api-http-port",
+			NodePort: 30652,
+		},
+	}
+	if s3Gateway {
+		ports = append(ports, api.ServicePort{
+			Port:     653,
+			Name:     "s3-port",
+			NodePort: 30653,
+		})
+	}
 	return &api.Service{
 		TypeMeta: unversioned.TypeMeta{
 			Kind:       "Service",
@@ -261,18 +396,7 @@ func PachdService() *api.Service {
 			Selector: map[string]string{
 				"app": pachdName,
 			},
-			Ports: []api.ServicePort{
-				{
-					Port:     650,
-					Name:     "api-grpc-port",
-					NodePort: 30650,
-				},
-				{
-					Port:     651,
-					Name:     "trace-port",
-					NodePort: 30651,
-				},
-			},
+			Ports: ports,
 		},
 	}
 }
@@ -494,11 +618,24 @@ func RethinkService() *api.Service {
 }
 
 // InitJob returns a pachd-init job.
-func InitJob(version string) *extensions.Job {
+func InitJob(version string, bolt bool) *extensions.Job {
 	image := pachdImage
 	if version != "" {
 		image += ":" + version
 	}
+	env := []api.EnvVar{
+		{
+			Name:  "PACH_ROOT",
+			Value: "/pach",
+		},
+		{
+			Name:  "INIT",
+			Value: "true",
+		},
+	}
+	if bolt {
+		env = append(env, boltEnvVar)
+	}
 	return &extensions.Job{
 		TypeMeta: unversioned.TypeMeta{
 			Kind:       "Job",
@@ -520,18 +657,9 @@ func InitJob(version string) *extensions.Job {
 				Spec: api.PodSpec{
 					Containers: []api.Container{
 						{
-							Name:  initName,
-							Image: image,
-							Env: []api.EnvVar{
-								{
-									Name:  "PACH_ROOT",
-									Value: "/pach",
-								},
-								{
-									Name:  "INIT",
-									Value: "true",
-								},
-							},
+							Name:            initName,
+							Image:           image,
+							Env:             env,
 							ImagePullPolicy: "IfNotPresent",
 						},
 					},
@@ -707,8 +835,60 @@ func MicrosoftSecret(container string, id string, secret string) *api.Secret {
 	}
 }
 
+// CustomSecret creates a secret for an S3 compatible object store with the
+// following parameters:
+//   bucket   - S3 bucket name
+//   id       - access key id
+//   secret   - secret access key
+//   endpoint - host and optionally port of the object store
+//   secure   - whether to connect to the endpoint over TLS
+func CustomSecret(bucket string, id string, secret string, endpoint string, secure bool) *api.Secret {
+	secureVal := "0"
+	if secure {
+		secureVal = "1"
+	}
+	return &api.Secret{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: api.ObjectMeta{
+			Name:   customSecretName,
+			Labels: labels(customSecretName),
+		},
+		Data: map[string][]byte{
+			"bucket":   []byte(bucket),
+			"id":       []byte(id),
+			"secret":   []byte(secret),
+			"endpoint": []byte(endpoint),
+			"secure":   []byte(secureVal),
+		},
+	}
+}
+
+// TLSSecret creates a secret holding pachd's TLS certificate and key and the
+// CA bundle that pachd's clients verify it against.
+func TLSSecret(tls *TLSOpts) *api.Secret {
+	return &api.Secret{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: api.ObjectMeta{
+			Name:   tlsSecretName,
+			Labels: labels(tlsSecretName),
+		},
+		Data: map[string][]byte{
+			"tls.crt": tls.Cert,
+			"tls.key": tls.Key,
+			"ca.crt":  tls.CA,
+		},
+	}
+}
+
 // RethinkVolume creates a persistent volume with a backend
-// (local, amazon, google), a name, and a size in gigabytes.
+// (local, amazon, google, microsoft, custom), a name, and a size in gigabytes.
+// Custom backends, like local ones, keep rethink's data on the host.
 func RethinkVolume(backend backend, hostPath string, name string, size int) *api.PersistentVolume {
 	spec := &api.PersistentVolume{
 		TypeMeta: unversioned.TypeMeta{
@@ -754,7 +934,7 @@ func RethinkVolume(backend backend, hostPath string, name string, size int) *api
 				DataDiskURI: dataDiskURI,
 			},
 		}
-	case localBackend:
+	case localBackend, customBackend:
 		spec.Spec.PersistentVolumeSource = api.PersistentVolumeSource{
 			HostPath: &api.HostPathVolumeSource{
 				Path: filepath.Join(hostPath, "rethink"),
@@ -796,6 +976,27 @@ type AssetOpts struct {
 	RethinkdbCacheSize string
 	Version            string
 	LogLevel           string
+	// TLS, if not nil, makes pachd serve over TLS.
+	TLS *TLSOpts
+	// S3Gateway makes pachd serve an S3 compatible gateway to PFS.  The
+	// gateway doesn't authenticate requests beyond mutual TLS.
+	S3Gateway bool
+	// Bolt makes pachd store its metadata in BoltDB under /pach rather than
+	// in rethinkdb, which isn't deployed.  /pach is only kept across
+	// restarts by the local backend, and BoltDB can't be shared, so it's
+	// for single node local clusters.
+	Bolt bool
+}
+
+// TLSOpts are the PEM encoded files pachd uses to serve over TLS.
+type TLSOpts struct {
+	Cert []byte
+	Key  []byte
+	// CA is the bundle used to verify Cert, it should contain Cert itself
+	// if Cert is self-signed.
+	CA []byte
+	// Mutual requires clients to present a certificate signed by CA.
+	Mutual bool
 }
 
 // WriteAssets writes the assets to w.
@@ -806,28 +1007,36 @@ func WriteAssets(w io.Writer, opts *AssetOpts, backend backend,
 	ServiceAccount().CodecEncodeSelf(encoder)
 	fmt.Fprintf(w, "\n")
 
-	RethinkVolume(backend, hostPath, volumeName, volumeSize).CodecEncodeSelf(encoder)
-	fmt.Fprintf(w, "\n")
-	RethinkVolumeClaim(volumeSize).CodecEncodeSelf(encoder)
-	fmt.Fprintf(w, "\n")
+	if !opts.Bolt {
+		RethinkVolume(backend, hostPath, volumeName, volumeSize).CodecEncodeSelf(encoder)
+		fmt.Fprintf(w, "\n")
+		RethinkVolumeClaim(volumeSize).CodecEncodeSelf(encoder)
+		fmt.Fprintf(w, "\n")
+	}
 
 	EtcdRc(hostPath).CodecEncodeSelf(encoder)
 	fmt.Fprintf(w, "\n")
 	EtcdService().CodecEncodeSelf(encoder)
 	fmt.Fprintf(w, "\n")
 
-	RethinkService().CodecEncodeSelf(encoder)
-	fmt.Fprintf(w, "\n")
-	RethinkRc(backend, volumeName, hostPath, opts.RethinkdbCacheSize).CodecEncodeSelf(encoder)
-	fmt.Fprintf(w, "\n")
+	if !opts.Bolt {
+		RethinkService().CodecEncodeSelf(encoder)
+		fmt.Fprintf(w, "\n")
+		RethinkRc(backend, volumeName, hostPath, opts.RethinkdbCacheSize).CodecEncodeSelf(encoder)
+		fmt.Fprintf(w, "\n")
+	}
 
-	InitJob(opts.Version).CodecEncodeSelf(encoder)
+	InitJob(opts.Version, opts.Bolt).CodecEncodeSelf(encoder)
 	fmt.Fprintf(w, "\n")
 
-	PachdService().CodecEncodeSelf(encoder)
+	PachdService(opts.S3Gateway).CodecEncodeSelf(encoder)
 	fmt.Fprintf(w, "\n")
-	PachdRc(opts.Shards, backend, hostPath, opts.LogLevel, opts.Version).CodecEncodeSelf(encoder)
+	PachdRc(opts.Shards, backend, hostPath, opts.LogLevel, opts.Version, opts.TLS, opts.S3Gateway, opts.Bolt).CodecEncodeSelf(encoder)
 	fmt.Fprintf(w, "\n")
+	if opts.TLS != nil {
+		TLSSecret(opts.TLS).CodecEncodeSelf(encoder)
+		fmt.Fprintf(w, "\n")
+	}
 
 	if opts.Registry {
 		RegistryRc().CodecEncodeSelf(encoder)
@@ -867,6 +1076,16 @@ func WriteMicrosoftAssets(w io.Writer, opts *AssetOpts, container string, id str
 	fmt.Fprintf(w, "\n")
 }
 
+// WriteCustomAssets writes assets to an S3 compatible object store at a
+// custom endpoint, rethink's data is kept under hostPath.
+func WriteCustomAssets(w io.Writer, opts *AssetOpts, bucket string, id string, secret string,
+	endpoint string, secure bool, hostPath string, volumeSize int) {
+	WriteAssets(w, opts, customBackend, "", volumeSize, hostPath)
+	encoder := codec.NewEncoder(w, jsonEncoderHandle)
+	CustomSecret(bucket, id, secret, endpoint, secure).CodecEncodeSelf(encoder)
+	fmt.Fprintf(w, "\n")
+}
+
 func labels(name string) map[string]string {
 	return map[string]string{
 		"app":   name,
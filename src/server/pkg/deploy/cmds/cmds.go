@@ -5,15 +5,16 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/url"
 	"os"
 	"strconv"
 
 	"github.com/sjezewski/pachyderm/src/client/version"
+	"github.com/sjezewski/pachyderm/src/server/pkg/cmd"
 	"github.com/sjezewski/pachyderm/src/server/pkg/deploy"
 	"github.com/sjezewski/pachyderm/src/server/pkg/deploy/assets"
 	"github.com/spf13/cobra"
-	"go.pedge.io/pkg/cobra"
 	"go.pedge.io/pkg/exec"
 )
 
@@ -26,11 +27,20 @@ func DeployCmd() *cobra.Command {
 	var registry bool
 	var rethinkdbCacheSize string
 	var logLevel string
+	var tlsCert string
+	var tlsKey string
+	var tlsCA string
+	var tlsMutual bool
+	var s3Gateway bool
+	var insecure bool
+	var bolt bool
 	cmd := &cobra.Command{
-		Use:   "deploy [amazon bucket id secret token region volume-name volume-size-in-GB | google bucket volume-name volume-size-in-GB | microsoft container storage-account-name storage-account-key volume-uri volume-size-in-GB]",
+		Use:   "deploy [amazon bucket id secret token region volume-name volume-size-in-GB | google bucket volume-name volume-size-in-GB | microsoft container storage-account-name storage-account-key volume-uri volume-size-in-GB | custom bucket id secret endpoint volume-size-in-GB]",
 		Short: "Print a kubernetes manifest for a Pachyderm cluster.",
-		Long:  "Print a kubernetes manifest for a Pachyderm cluster.",
-		Run: pkgcobra.RunBoundedArgs(pkgcobra.Bounds{Min: 0, Max: 8}, func(args []string) error {
+		Long: "Print a kubernetes manifest for a Pachyderm cluster.\n\n" +
+			"The custom backend stores data in an S3 compatible object store, such as Minio or Ceph, " +
+			"at endpoint (e.g. \"minio.example.com:9000\") and keeps rethink's data under --host-path.",
+		Run: cmd.RunBoundedArgs(0, 8, func(args []string) error {
 			version := version.PrettyPrintVersion(version.Version)
 			if dev {
 				version = deploy.DevVersionTag
@@ -47,6 +57,20 @@ func DeployCmd() *cobra.Command {
 				RethinkdbCacheSize: rethinkdbCacheSize,
 				Version:            version,
 				LogLevel:           logLevel,
+				S3Gateway:          s3Gateway,
+				Bolt:               bolt,
+			}
+			if bolt && len(args) != 0 {
+				return fmt.Errorf("--bolt is only supported by local deployments")
+			}
+			if tlsCert != "" || tlsKey != "" {
+				tlsOpts, err := readTLSOpts(tlsCert, tlsKey, tlsCA, tlsMutual)
+				if err != nil {
+					return err
+				}
+				opts.TLS = tlsOpts
+			} else if tlsCA != "" || tlsMutual {
+				return fmt.Errorf("--tls-ca and --tls-mutual require --tls-cert and --tls-key")
 			}
 			if len(args) == 0 {
 				assets.WriteLocalAssets(out, opts, hostPath)
@@ -89,8 +113,17 @@ func DeployCmd() *cobra.Command {
 						return fmt.Errorf("volume size needs to be an integer; instead got %v", args[5])
 					}
 					assets.WriteMicrosoftAssets(out, opts, args[1], args[2], args[3], volumeURI.String(), volumeSize)
+				case "custom":
+					if len(args) != 6 {
+						return fmt.Errorf("expected 6 args, got %d", len(args))
+					}
+					volumeSize, err := strconv.Atoi(args[5])
+					if err != nil {
+						return fmt.Errorf("volume size needs to be an integer; instead got %v", args[5])
+					}
+					assets.WriteCustomAssets(out, opts, args[1], args[2], args[3], args[4], !insecure, hostPath, volumeSize)
 				default:
-					return fmt.Errorf("expected one of google, amazon, or microsoft; instead got '%v'", args[0])
+					return fmt.Errorf("expected one of google, amazon, microsoft, or custom; instead got '%v'", args[0])
 				}
 			}
 			if !dryRun {
@@ -105,12 +138,46 @@ func DeployCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().IntVarP(&shards, "shards", "s", 32, "The static number of shards for pfs.")
-	cmd.Flags().StringVarP(&hostPath, "host-path", "p", "/tmp/pach", "the path on the host machine where data will be stored; this is only relevant if you are running pachyderm locally.")
+	cmd.Flags().StringVarP(&hostPath, "host-path", "p", "/tmp/pach", "the path on the host machine where data will be stored; this is only relevant if you are running pachyderm locally or with a custom backend.")
 	cmd.Flags().BoolVarP(&dev, "dev", "d", false, "Don't use a specific version of pachyderm/pachd.")
 	cmd.Flags().BoolVarP(&dryRun, "dry-run", "", false, "Don't actually deploy pachyderm to Kubernetes, instead just print the manifest.")
 	cmd.Flags().BoolVarP(&registry, "registry", "r", true, "Deploy a docker registry along side pachyderm.")
 	cmd.Flags().StringVar(&rethinkdbCacheSize, "rethinkdb-cache-size", "768M", "Size of in-memory cache to use for Pachyderm's RethinkDB instance, "+
 		"e.g. \"2G\". Default is \"768M\". Size is specified in bytes, with allowed SI suffixes (M, K, G, Mi, Ki, Gi, etc)")
 	cmd.Flags().StringVar(&logLevel, "log-level", "info", "The level of log messages to print options are, from least to most verbose: \"error\", \"info\", \"debug\".")
+	cmd.Flags().StringVar(&tlsCert, "tls-cert", "", "A PEM certificate for pachd to serve its API over TLS with, it should be valid for the name \"pachd\".")
+	cmd.Flags().StringVar(&tlsKey, "tls-key", "", "The PEM key for --tls-cert.")
+	cmd.Flags().StringVar(&tlsCA, "tls-ca", "", "A PEM bundle of CAs which signed --tls-cert, defaults to --tls-cert itself for self-signed certificates.")
+	cmd.Flags().BoolVar(&tlsMutual, "tls-mutual", false, "Require clients to present a certificate signed by --tls-ca.")
+	cmd.Flags().BoolVar(&s3Gateway, "s3-gateway", false, "Serve an S3 compatible gateway to PFS on port 653, it doesn't authenticate requests so it should be used with --tls-mutual or on a trusted network.")
+	cmd.Flags().BoolVar(&bolt, "bolt", false, "Store pachd's metadata in BoltDB under --host-path rather than deploying RethinkDB, only for local single node clusters.")
+	cmd.Flags().BoolVar(&insecure, "insecure", false, "Connect to a custom backend's endpoint over plain HTTP rather than HTTPS.")
 	return cmd
 }
+
+func readTLSOpts(certPath string, keyPath string, caPath string, mutual bool) (*assets.TLSOpts, error) {
+	if certPath == "" || keyPath == "" {
+		return nil, fmt.Errorf("--tls-cert and --tls-key must be passed together")
+	}
+	if caPath == "" {
+		caPath = certPath
+	}
+	cert, err := ioutil.ReadFile(certPath)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ioutil.ReadFile(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	ca, err := ioutil.ReadFile(caPath)
+	if err != nil {
+		return nil, err
+	}
+	return &assets.TLSOpts{
+		Cert:   cert,
+		Key:    key,
+		CA:     ca,
+		Mutual: mutual,
+	}, nil
+}
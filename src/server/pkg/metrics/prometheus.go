@@ -0,0 +1,137 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pfs"
+	"github.com/sjezewski/pachyderm/src/client/pps"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.pedge.io/lion/proto"
+)
+
+var (
+	commitsDesc = prometheus.NewDesc(
+		"pachyderm_commits",
+		"The number of commits, by state: open, finished, cancelled or archived.",
+		[]string{"state"},
+		nil,
+	)
+	jobsDesc = prometheus.NewDesc(
+		"pachyderm_jobs",
+		"The number of jobs, by state.",
+		[]string{"state"},
+		nil,
+	)
+)
+
+// NewCollector returns a Prometheus collector of the number of commits and
+// jobs in the cluster, by state, which it gets from pachClient.  Counting
+// lists every commit and job, so the counts are kept for interval rather
+// than fetched on every scrape.
+func NewCollector(pachClient *client.APIClient, interval time.Duration) prometheus.Collector {
+	return &collector{
+		pachClient: pachClient,
+		interval:   interval,
+	}
+}
+
+type collector struct {
+	pachClient *client.APIClient
+	interval   time.Duration
+	// lock guards the fields below, it's held while the counts are
+	// fetched so that concurrent scrapes don't fetch them again.
+	lock    sync.Mutex
+	updated time.Time
+	commits map[string]int
+	jobs    map[string]int
+}
+
+func (c *collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- commitsDesc
+	ch <- jobsDesc
+}
+
+func (c *collector) Collect(ch chan<- prometheus.Metric) {
+	commits, jobs := c.counts()
+	for state, count := range commits {
+		ch <- prometheus.MustNewConstMetric(commitsDesc, prometheus.GaugeValue, float64(count), state)
+	}
+	for state, count := range jobs {
+		ch <- prometheus.MustNewConstMetric(jobsDesc, prometheus.GaugeValue, float64(count), state)
+	}
+}
+
+// counts returns the commit and job counts, fetching them if they're older
+// than c.interval.  Counts which couldn't be fetched are nil until they're
+// fetched again.
+func (c *collector) counts() (map[string]int, map[string]int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.updated.IsZero() && time.Since(c.updated) < c.interval {
+		return c.commits, c.jobs
+	}
+	c.updated = time.Now()
+	var err error
+	if c.commits, err = c.commitCounts(); err != nil {
+		protolion.Errorf("error collecting commit metrics: %s", err.Error())
+	}
+	if c.jobs, err = c.jobCounts(); err != nil {
+		protolion.Errorf("error collecting job metrics: %s", err.Error())
+	}
+	return c.commits, c.jobs
+}
+
+func (c *collector) commitCounts() (map[string]int, error) {
+	repoInfos, err := c.pachClient.ListRepo(nil)
+	if err != nil {
+		return nil, err
+	}
+	counts := map[string]int{
+		"open":      0,
+		"finished":  0,
+		"cancelled": 0,
+		"archived":  0,
+	}
+	if len(repoInfos) == 0 {
+		return counts, nil
+	}
+	var fromCommits []*pfs.Commit
+	for _, repoInfo := range repoInfos {
+		fromCommits = append(fromCommits, client.NewCommit(repoInfo.Repo.Name, ""))
+	}
+	commitInfos, err := c.pachClient.ListCommit(fromCommits, nil, client.CommitTypeNone, pfs.CommitStatus_ALL, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, commitInfo := range commitInfos {
+		switch {
+		case commitInfo.Cancelled:
+			counts["cancelled"]++
+		case commitInfo.Archived:
+			counts["archived"]++
+		case commitInfo.Finished != nil:
+			counts["finished"]++
+		default:
+			counts["open"]++
+		}
+	}
+	return counts, nil
+}
+
+func (c *collector) jobCounts() (map[string]int, error) {
+	jobInfos, err := c.pachClient.ListJob("", nil)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int)
+	for _, name := range pps.JobState_name {
+		counts[name] = 0
+	}
+	for _, jobInfo := range jobInfos {
+		counts[jobInfo.State.String()]++
+	}
+	return counts, nil
+}
@@ -20,10 +20,28 @@ type amazonClient struct {
 }
 
 func newAmazonClient(bucket string, id string, secret string, token string, region string) (*amazonClient, error) {
-	session := session.New(&aws.Config{
+	return newAmazonClientWithConfig(bucket, &aws.Config{
 		Credentials: credentials.NewStaticCredentials(id, secret, token),
 		Region:      aws.String(region),
 	})
+}
+
+func newCustomAmazonClient(bucket string, id string, secret string, endpoint string, secure bool) (*amazonClient, error) {
+	return newAmazonClientWithConfig(bucket, &aws.Config{
+		Credentials: credentials.NewStaticCredentials(id, secret, ""),
+		// S3 compatible stores generally ignore the region, but the SDK
+		// won't sign requests without one.
+		Region:   aws.String("us-east-1"),
+		Endpoint: aws.String(endpoint),
+		// Most S3 compatible stores don't support virtual hosted buckets,
+		// which need a DNS entry per bucket.
+		S3ForcePathStyle: aws.Bool(true),
+		DisableSSL:       aws.Bool(!secure),
+	})
+}
+
+func newAmazonClientWithConfig(bucket string, config *aws.Config) (*amazonClient, error) {
+	session := session.New(config)
 	return &amazonClient{
 		bucket:   bucket,
 		s3:       s3.New(session),
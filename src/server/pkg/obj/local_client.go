@@ -0,0 +1,151 @@
+package obj
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+// localTmpDir is the directory under a local client's dir which holds
+// objects that are still being written.
+const localTmpDir = ".tmp"
+
+type localClient struct {
+	dir string
+}
+
+func newLocalClient(dir string) (*localClient, error) {
+	if err := os.MkdirAll(filepath.Join(dir, localTmpDir), 0777); err != nil {
+		return nil, err
+	}
+	return &localClient{dir: dir}, nil
+}
+
+func (c *localClient) Writer(name string) (io.WriteCloser, error) {
+	// Objects are written to a temporary file and moved into place when
+	// they're closed, so that a partially written object is never read.
+	file, err := ioutil.TempFile(filepath.Join(c.dir, localTmpDir), "object")
+	if err != nil {
+		return nil, err
+	}
+	return &localWriter{
+		file: file,
+		path: c.path(name),
+	}, nil
+}
+
+func (c *localClient) Reader(name string, offset uint64, size uint64) (io.ReadCloser, error) {
+	file, err := os.Open(c.path(name))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := file.Seek(int64(offset), 0); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if size == 0 {
+		return file, nil
+	}
+	return &localReader{
+		Reader: io.LimitReader(file, int64(size)),
+		file:   file,
+	}, nil
+}
+
+func (c *localClient) Delete(name string) error {
+	return os.Remove(c.path(name))
+}
+
+func (c *localClient) Walk(prefix string, fn func(name string) error) error {
+	// Only the directory containing prefix needs to be walked.
+	root := c.dir
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		root = c.path(prefix[:i])
+	}
+	err := filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(c.dir, p)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		if info.IsDir() {
+			if name == localTmpDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasPrefix(name, prefix) {
+			return nil
+		}
+		return fn(name)
+	})
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (c *localClient) Exists(name string) bool {
+	_, err := os.Stat(c.path(name))
+	return err == nil
+}
+
+func (c *localClient) IsRetryable(err error) bool {
+	if pathErr, ok := err.(*os.PathError); ok {
+		err = pathErr.Err
+	}
+	if linkErr, ok := err.(*os.LinkError); ok {
+		err = linkErr.Err
+	}
+	errno, ok := err.(syscall.Errno)
+	return ok && errno.Temporary()
+}
+
+func (c *localClient) IsNotExist(err error) bool {
+	return os.IsNotExist(err)
+}
+
+func (c *localClient) IsIgnorable(err error) bool {
+	return false
+}
+
+func (c *localClient) path(name string) string {
+	return filepath.Join(c.dir, filepath.FromSlash(path.Clean("/"+name)))
+}
+
+type localWriter struct {
+	file *os.File
+	path string
+}
+
+func (w *localWriter) Write(p []byte) (int, error) {
+	return w.file.Write(p)
+}
+
+func (w *localWriter) Close() error {
+	if err := w.file.Close(); err != nil {
+		os.Remove(w.file.Name())
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(w.path), 0777); err != nil {
+		os.Remove(w.file.Name())
+		return err
+	}
+	return os.Rename(w.file.Name(), w.path)
+}
+
+type localReader struct {
+	io.Reader
+	file *os.File
+}
+
+func (r *localReader) Close() error {
+	return r.file.Close()
+}
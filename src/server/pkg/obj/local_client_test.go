@@ -0,0 +1,63 @@
+package obj
+
+import (
+	"io/ioutil"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/sjezewski/pachyderm/src/client/pkg/require"
+)
+
+func TestLocalClient(t *testing.T) {
+	dir, err := ioutil.TempDir("", "obj_local_client")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	client, err := NewLocalClient(dir)
+	require.NoError(t, err)
+
+	for _, name := range []string{"a", "dir/b", "dir/c", "dirt", "other/d"} {
+		writer, err := client.Writer(name)
+		require.NoError(t, err)
+		_, err = writer.Write([]byte("0123456789"))
+		require.NoError(t, err)
+		// Objects don't exist until they're completely written.
+		require.False(t, client.Exists(name))
+		require.NoError(t, writer.Close())
+		require.True(t, client.Exists(name))
+	}
+
+	reader, err := client.Reader("dir/b", 2, 5)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, "23456", string(data))
+	reader, err = client.Reader("dir/b", 7, 0)
+	require.NoError(t, err)
+	data, err = ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, "789", string(data))
+
+	walk := func(prefix string) []string {
+		var names []string
+		require.NoError(t, client.Walk(prefix, func(name string) error {
+			names = append(names, name)
+			return nil
+		}))
+		sort.Strings(names)
+		return names
+	}
+	require.Equal(t, []string{"a", "dir/b", "dir/c", "dirt", "other/d"}, walk(""))
+	require.Equal(t, []string{"dir/b", "dir/c", "dirt"}, walk("dir"))
+	require.Equal(t, []string{"dir/b", "dir/c"}, walk("dir/"))
+	require.Equal(t, []string{"dir/c"}, walk("dir/c"))
+	require.Equal(t, 0, len(walk("missing/")))
+
+	require.NoError(t, client.Delete("dir/b"))
+	require.False(t, client.Exists("dir/b"))
+	_, err = client.Reader("dir/b", 0, 0)
+	require.True(t, client.IsNotExist(err))
+	require.False(t, client.IsRetryable(err))
+}
@@ -60,6 +60,24 @@ func NewAmazonClient(bucket string, id string, secret string, token string,
 	return newAmazonClient(bucket, id, secret, token, region)
 }
 
+// NewCustomAmazonClient creates an amazon client for an S3 compatible object
+// store, such as Minio or Ceph, at a custom endpoint.  Buckets are addressed
+// by path rather than by host name.  It takes:
+//   bucket   - S3 bucket name
+//   id       - access key id
+//   secret   - secret access key
+//   endpoint - host and optionally port of the object store
+//   secure   - whether to connect to the endpoint over TLS
+func NewCustomAmazonClient(bucket string, id string, secret string, endpoint string, secure bool) (Client, error) {
+	return newCustomAmazonClient(bucket, id, secret, endpoint, secure)
+}
+
+// NewLocalClient creates a client which stores objects as files under dir,
+// it's meant for testing code which uses object storage without one.
+func NewLocalClient(dir string) (Client, error) {
+	return newLocalClient(dir)
+}
+
 // NewExponentialBackOffConfig creates an exponential back-off config with
 // longer wait times than the default.
 func NewExponentialBackOffConfig() *backoff.ExponentialBackOff {
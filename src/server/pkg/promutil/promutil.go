@@ -0,0 +1,61 @@
+package promutil
+
+import (
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.pedge.io/proto/rpclog"
+)
+
+var rpcDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Namespace: "pachyderm",
+		Subsystem: "rpc",
+		Name:      "duration_seconds",
+		Help:      "The latency of RPCs to pachd, by whether they returned an error.",
+		// 1ms to about 4 minutes, some RPCs block until commits finish.
+		Buckets: prometheus.ExponentialBuckets(0.001, 4, 10),
+	},
+	[]string{"service", "method", "result"},
+)
+
+func init() {
+	prometheus.MustRegister(rpcDuration)
+}
+
+// NewLogger returns a protorpclog.Logger which, as well as logging RPCs,
+// records their latency and errors in Prometheus.
+func NewLogger(serviceName string) protorpclog.Logger {
+	return &logger{serviceName}
+}
+
+type logger struct {
+	serviceName string
+}
+
+func (l *logger) Log(request proto.Message, response proto.Message, err error, duration time.Duration) {
+	methodName := getMethodName(2)
+	protorpclog.Log(l.serviceName, methodName, request, response, err, duration)
+	// Servers also log when RPCs start, with no response, error or duration,
+	// those aren't observations.
+	if response == nil && err == nil && duration == 0 {
+		return
+	}
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	rpcDuration.WithLabelValues(l.serviceName, methodName, result).Observe(duration.Seconds())
+}
+
+// getMethodName returns the name of the method depth calls above the
+// caller, as protorpclog does.
+func getMethodName(depth int) string {
+	pc := make([]uintptr, 2+depth)
+	runtime.Callers(2+depth, pc)
+	split := strings.Split(runtime.FuncForPC(pc[0]).Name(), ".")
+	return split[len(split)-1]
+}
@@ -0,0 +1,42 @@
+package promutil
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_model/go"
+	"github.com/sjezewski/pachyderm/src/client/pkg/require"
+)
+
+type testServer struct{}
+
+func sampleCount(t *testing.T, method string, result string) uint64 {
+	metric := &io_prometheus_client.Metric{}
+	require.NoError(t, rpcDuration.WithLabelValues("test.API", method, result).(prometheus.Histogram).Write(metric))
+	return metric.Histogram.GetSampleCount()
+}
+
+func (s *testServer) Succeed() {
+	logger := NewLogger("test.API")
+	func() { logger.Log(nil, nil, nil, 0) }()
+	defer func(start time.Time) { logger.Log(nil, &io_prometheus_client.Metric{}, nil, time.Since(start)) }(time.Now())
+}
+
+func (s *testServer) Fail() {
+	logger := NewLogger("test.API")
+	func() { logger.Log(nil, nil, nil, 0) }()
+	defer func(start time.Time) { logger.Log(nil, nil, fmt.Errorf("failed"), time.Since(start)) }(time.Now())
+}
+
+func TestLogger(t *testing.T) {
+	s := &testServer{}
+	s.Succeed()
+	s.Succeed()
+	s.Fail()
+	// The logs when the RPCs start aren't observed.
+	require.Equal(t, uint64(2), sampleCount(t, "Succeed", "ok"))
+	require.Equal(t, uint64(0), sampleCount(t, "Succeed", "error"))
+	require.Equal(t, uint64(1), sampleCount(t, "Fail", "error"))
+}
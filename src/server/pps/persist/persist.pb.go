@@ -139,6 +139,7 @@ type JobInfo struct {
 	CommitIndex        string                      `protobuf:"bytes,11,opt,name=commit_index,json=commitIndex" json:"commit_index,omitempty"`
 	DefaultShardModuli []uint64                    `protobuf:"varint,15,rep,packed,name=default_shard_moduli,json=defaultShardModuli" json:"default_shard_moduli,omitempty"`
 	Shard              uint64                      `protobuf:"varint,19,opt,name=shard" json:"shard,omitempty"`
+	Combinator         pps.Combinator              `protobuf:"varint,21,opt,name=combinator,enum=pps.Combinator" json:"combinator,omitempty"`
 }
 
 func (m *JobInfo) Reset()                    { *m = JobInfo{} }
@@ -232,6 +233,13 @@ type Chunk struct {
 	// a unix timestamp representing the last time we received a ContinueJob
 	// for this chunk
 	LeaseTime uint64 `protobuf:"varint,8,opt,name=lease_time,json=leaseTime" json:"lease_time,omitempty"`
+	// the number of times the chunk has been claimed by a pod
+	Attempts uint64 `protobuf:"varint,9,opt,name=attempts" json:"attempts,omitempty"`
+	// why each failed attempt failed, in chronological order
+	Errors []string `protobuf:"bytes,10,rep,name=errors" json:"errors,omitempty"`
+	// a unix timestamp before which the chunk can't be claimed, it's set when
+	// a failed chunk is retried after a backoff
+	RetryTime uint64 `protobuf:"varint,11,opt,name=retry_time,json=retryTime" json:"retry_time,omitempty"`
 }
 
 func (m *Chunk) Reset()                    { *m = Chunk{} }
@@ -344,6 +352,7 @@ type PipelineInfo struct {
 	RecentError     string                      `protobuf:"bytes,9,opt,name=recent_error,json=recentError" json:"recent_error,omitempty"`
 	JobCounts       map[int32]int32             `protobuf:"bytes,10,rep,name=job_counts,json=jobCounts" json:"job_counts,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	Stopped         bool                        `protobuf:"varint,11,opt,name=stopped" json:"stopped,omitempty"`
+	Combinator      pps.Combinator              `protobuf:"varint,14,opt,name=combinator,enum=pps.Combinator" json:"combinator,omitempty"`
 }
 
 func (m *PipelineInfo) Reset()                    { *m = PipelineInfo{} }
@@ -594,6 +603,10 @@ type RevokeChunkRequest struct {
 	// if the number of pods that have processed this chunk exceeds maxPods,
 	// then we switch the state of the chunk to FAILED instead of UNASSIGNED
 	MaxPods uint64 `protobuf:"varint,3,opt,name=maxPods" json:"maxPods,omitempty"`
+	// why the chunk's pod failed
+	Error string `protobuf:"bytes,4,opt,name=error" json:"error,omitempty"`
+	// if the chunk is retried, it can't be claimed again until retry_time
+	RetryTime uint64 `protobuf:"varint,5,opt,name=retry_time,json=retryTime" json:"retry_time,omitempty"`
 }
 
 func (m *RevokeChunkRequest) Reset()                    { *m = RevokeChunkRequest{} }
@@ -1723,123 +1736,127 @@ var _API_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("server/pps/persist/persist.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1873 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xbc, 0x57, 0xe1, 0x92, 0xda, 0xc8,
-	0x11, 0x5e, 0x81, 0x00, 0xd1, 0x2c, 0xa0, 0x1d, 0xaf, 0x37, 0x32, 0xb9, 0x8b, 0x37, 0x72, 0x2e,
-	0xe6, 0x36, 0x17, 0xd6, 0xb5, 0x77, 0xb9, 0xba, 0x24, 0xe7, 0xa4, 0x30, 0x60, 0x17, 0xc4, 0xb1,
-	0x89, 0xc0, 0x97, 0xab, 0xab, 0x5c, 0x51, 0x02, 0xcd, 0x7a, 0xb5, 0x16, 0x1a, 0x45, 0x12, 0x3e,
-	0x6f, 0x7e, 0xe4, 0x11, 0x52, 0x95, 0x1f, 0x79, 0x9a, 0x3c, 0x49, 0x5e, 0x20, 0xcf, 0x91, 0x9a,
-	0x1e, 0x09, 0x24, 0x40, 0xac, 0x49, 0x52, 0xf7, 0x83, 0x42, 0xd3, 0xd3, 0xf3, 0x4d, 0xf7, 0x74,
-	0xf7, 0x37, 0x3d, 0x70, 0x1a, 0x50, 0xff, 0x2d, 0xf5, 0xcf, 0x3d, 0x2f, 0x38, 0xf7, 0xa8, 0x1f,
-	0xd8, 0x41, 0x18, 0xff, 0xb7, 0x3c, 0x9f, 0x85, 0x8c, 0x54, 0x3c, 0x2f, 0x68, 0x45, 0xa2, 0xc6,
-	0x0f, 0x5f, 0x33, 0xf6, 0xda, 0xa1, 0xe7, 0x38, 0x35, 0x5d, 0x5c, 0x9e, 0xd3, 0xb9, 0x17, 0xde,
-	0x08, 0xcd, 0xc6, 0xfd, 0xf5, 0xc9, 0xd0, 0x9e, 0xd3, 0x20, 0x34, 0xe7, 0x5e, 0xa4, 0x70, 0x3c,
-	0x73, 0x6c, 0xea, 0x86, 0xe7, 0xde, 0x65, 0xc0, 0x7f, 0xeb, 0x52, 0x6e, 0x82, 0x17, 0x49, 0xf5,
-	0x7f, 0x14, 0xa0, 0x34, 0x60, 0xd3, 0xbe, 0x7b, 0xc9, 0xc8, 0x5d, 0x28, 0x5e, 0xb3, 0xe9, 0xc4,
-	0xb6, 0x34, 0xe9, 0x54, 0x6a, 0x96, 0x8d, 0xc2, 0x35, 0x9b, 0xf6, 0x2d, 0xf2, 0x09, 0x94, 0x43,
-	0xdf, 0x74, 0x83, 0x4b, 0xe6, 0xcf, 0xb5, 0xdc, 0xa9, 0xd4, 0xac, 0x5c, 0xd4, 0x5a, 0x1c, 0x61,
-	0x1c, 0x4b, 0x8d, 0x95, 0x02, 0x79, 0x00, 0x55, 0xcf, 0xf6, 0xa8, 0x63, 0xbb, 0x74, 0xe2, 0x9a,
-	0x73, 0xaa, 0xe5, 0x11, 0xeb, 0x30, 0x16, 0xbe, 0x30, 0xe7, 0x94, 0x7c, 0x0c, 0xea, 0x52, 0xe9,
-	0x2d, 0xf7, 0x99, 0xb9, 0xda, 0xf1, 0xa9, 0xd4, 0x94, 0x8d, 0x7a, 0x2c, 0xff, 0x4a, 0x88, 0xc9,
-	0x6f, 0x41, 0xf5, 0x4c, 0xdf, 0x74, 0x1c, 0xea, 0xd8, 0xc1, 0x7c, 0x12, 0x78, 0x74, 0xa6, 0x11,
-	0x34, 0xe2, 0x18, 0x8d, 0x18, 0xae, 0x26, 0x47, 0x1e, 0x9d, 0x19, 0x75, 0x2f, 0x2d, 0x20, 0x1f,
-	0x41, 0xd1, 0x76, 0xbd, 0x45, 0x18, 0x68, 0x85, 0xd3, 0x7c, 0xb3, 0x72, 0x51, 0xc5, 0x65, 0xe8,
-	0xb3, 0xb7, 0x08, 0x8d, 0x68, 0x92, 0x3c, 0x04, 0xf0, 0x4c, 0x9f, 0xba, 0xe1, 0xe4, 0x9a, 0x4d,
-	0xb5, 0x22, 0xee, 0xa0, 0xc4, 0xaa, 0x46, 0x59, 0xcc, 0x0d, 0xd8, 0x94, 0x7c, 0x06, 0xa5, 0x20,
-	0x34, 0xfd, 0x90, 0x5a, 0x5a, 0x09, 0xb5, 0x1a, 0x2d, 0x11, 0x90, 0x56, 0x1c, 0x90, 0xd6, 0x38,
-	0x0e, 0x88, 0x11, 0xab, 0x92, 0xcf, 0x41, 0xb9, 0xb4, 0x5d, 0x3b, 0xb8, 0xa2, 0x96, 0xa6, 0xdc,
-	0xba, 0x6c, 0xa9, 0x4b, 0x1e, 0x41, 0x95, 0x2d, 0x42, 0x6f, 0x11, 0x4e, 0x66, 0x6c, 0x3e, 0xb7,
-	0x43, 0xad, 0x8c, 0x8b, 0x2b, 0x2d, 0x1e, 0xd8, 0x0e, 0x8a, 0x8c, 0x43, 0xa1, 0x21, 0x46, 0xe4,
-	0x04, 0x8a, 0x53, 0xdf, 0x74, 0x67, 0x57, 0xda, 0x11, 0x9e, 0x7c, 0x34, 0x22, 0x0f, 0xa0, 0x10,
-	0x84, 0x66, 0x48, 0x35, 0x38, 0x95, 0x9a, 0xb5, 0xd5, 0x31, 0x8c, 0xb8, 0xd0, 0x10, 0x73, 0xe4,
-	0xc7, 0x70, 0x28, 0xf6, 0x99, 0xd8, 0xae, 0x45, 0xdf, 0x69, 0x15, 0x84, 0xa8, 0x08, 0x59, 0x9f,
-	0x8b, 0xc8, 0x23, 0x38, 0xb6, 0xe8, 0xa5, 0xb9, 0x70, 0xc2, 0x49, 0x70, 0x65, 0xfa, 0xd6, 0x64,
-	0xce, 0xac, 0x85, 0x63, 0x6b, 0xf5, 0xd3, 0x7c, 0x53, 0x36, 0x48, 0x34, 0x37, 0xe2, 0x53, 0xbf,
-	0xc7, 0x19, 0x72, 0x0c, 0x05, 0xd4, 0xd4, 0xee, 0x60, 0x88, 0xc5, 0x60, 0x20, 0x2b, 0xb2, 0x5a,
-	0x18, 0xc8, 0xca, 0xa1, 0x5a, 0x1d, 0xc8, 0x4a, 0x55, 0xad, 0x0d, 0x64, 0xa5, 0xa6, 0xd6, 0x07,
-	0xb2, 0xa2, 0xaa, 0x47, 0xfa, 0xef, 0x20, 0x3f, 0x64, 0x16, 0x21, 0x20, 0x63, 0x12, 0x89, 0x84,
-	0xc4, 0xef, 0xcd, 0x23, 0xc9, 0xdd, 0x72, 0x24, 0xfa, 0xbf, 0x25, 0x28, 0x74, 0xae, 0x16, 0xee,
-	0x1b, 0x52, 0x83, 0xdc, 0x32, 0xbd, 0x73, 0xb6, 0x95, 0x48, 0xf9, 0x5c, 0x32, 0xe5, 0x4f, 0xa0,
-	0x18, 0x79, 0x95, 0x47, 0xaf, 0xa2, 0x11, 0xf7, 0x44, 0x9c, 0x8b, 0x2c, 0x3c, 0xc1, 0x01, 0x97,
-	0xb2, 0xef, 0x5c, 0xea, 0x6b, 0x05, 0x81, 0x81, 0x03, 0xf2, 0x13, 0x90, 0x3d, 0x66, 0x05, 0x5a,
-	0x11, 0xb3, 0x4e, 0x6d, 0x25, 0xea, 0xbb, 0x35, 0x64, 0x96, 0x81, 0xb3, 0xe4, 0xe7, 0x71, 0x54,
-	0x4a, 0x18, 0x95, 0x1f, 0xa4, 0xd4, 0xd0, 0xe6, 0x54, 0x7c, 0x3e, 0x04, 0x70, 0xa8, 0x19, 0xd0,
-	0x09, 0xaf, 0x79, 0x4c, 0x24, 0xd9, 0x28, 0xa3, 0x84, 0x27, 0x8f, 0xfe, 0x19, 0x14, 0x71, 0x4d,
-	0x40, 0xce, 0xa0, 0x38, 0xc3, 0x2f, 0x4d, 0xc2, 0xfd, 0xc9, 0x26, 0xb0, 0x11, 0x69, 0xe8, 0xbf,
-	0x06, 0x25, 0xa2, 0x80, 0x80, 0x9c, 0x83, 0x82, 0x07, 0xe2, 0x5e, 0xb2, 0x68, 0xe5, 0x71, 0x6a,
-	0x65, 0xa4, 0x68, 0x94, 0xae, 0xc5, 0x87, 0x3e, 0x86, 0xf2, 0x80, 0x4d, 0x5f, 0xe2, 0x71, 0x67,
-	0x31, 0xc8, 0xfe, 0x11, 0xfb, 0x2b, 0x9a, 0x84, 0xae, 0x67, 0x81, 0x2e, 0xf3, 0x39, 0xb7, 0x23,
-	0x9f, 0x93, 0x65, 0x97, 0x7f, 0xff, 0xb2, 0xd3, 0x1d, 0x38, 0x69, 0x5b, 0xd6, 0xcb, 0x84, 0x49,
-	0x06, 0xfd, 0xf3, 0x82, 0x06, 0x61, 0xb6, 0x35, 0xc5, 0x6c, 0xdf, 0xa2, 0xa9, 0x44, 0x69, 0xe6,
-	0x93, 0xa5, 0xa9, 0xff, 0x4b, 0x86, 0xc3, 0x61, 0xc4, 0x7b, 0xc8, 0xc4, 0x1b, 0x24, 0x2a, 0x6d,
-	0x21, 0x51, 0x0d, 0x4a, 0x31, 0x77, 0x56, 0x31, 0x11, 0xe2, 0xe1, 0x9e, 0x8c, 0xbd, 0x8d, 0x61,
-	0x0f, 0xf7, 0x61, 0xd8, 0xb3, 0x25, 0xc3, 0xca, 0x89, 0x5c, 0x5b, 0x39, 0x94, 0xa4, 0xd9, 0x33,
-	0xa8, 0x44, 0xa9, 0xe0, 0x53, 0x8f, 0x61, 0xc5, 0x54, 0x2e, 0xca, 0x78, 0x58, 0x06, 0xf5, 0x98,
-	0x01, 0x62, 0x96, 0x7f, 0x93, 0x5f, 0x02, 0xcc, 0x7c, 0x6a, 0x86, 0xd4, 0x9a, 0x98, 0x61, 0x44,
-	0xc9, 0xbb, 0xc2, 0x57, 0x8e, 0xb4, 0xdb, 0xe1, 0x8a, 0x72, 0x4a, 0x09, 0xca, 0x21, 0xcd, 0x38,
-	0x65, 0x14, 0x4c, 0x99, 0xb4, 0x9d, 0xeb, 0x3c, 0xe8, 0xd3, 0x19, 0xbf, 0x0d, 0xa8, 0xef, 0x33,
-	0x1f, 0x59, 0xb7, 0x6c, 0x54, 0x84, 0xac, 0xc7, 0x45, 0xe4, 0x19, 0x00, 0x4f, 0x84, 0x19, 0x5b,
-	0xb8, 0x61, 0xa0, 0x01, 0x7a, 0xde, 0x4c, 0x57, 0x79, 0x22, 0xa4, 0x3c, 0x33, 0x3b, 0xa8, 0xda,
-	0x73, 0x43, 0xff, 0xc6, 0x28, 0x5f, 0xc7, 0x63, 0x1e, 0xc7, 0x20, 0x64, 0x9e, 0x47, 0x2d, 0xa4,
-	0x5b, 0xc5, 0x88, 0x87, 0x8d, 0x2f, 0xa1, 0x96, 0x5e, 0x46, 0x54, 0xc8, 0xbf, 0xa1, 0x37, 0x98,
-	0x0e, 0x05, 0x83, 0x7f, 0x72, 0x4f, 0xdf, 0x9a, 0xce, 0x42, 0x94, 0x41, 0xc1, 0x10, 0x83, 0x5f,
-	0xe5, 0xbe, 0x90, 0x06, 0xb2, 0x92, 0x57, 0x65, 0xfd, 0x1d, 0x90, 0xa4, 0x1d, 0x9d, 0x2b, 0xd3,
-	0x7d, 0x4d, 0xc9, 0x2f, 0x40, 0x89, 0x73, 0x09, 0xc1, 0x2a, 0x17, 0xf7, 0x32, 0x4d, 0x37, 0x96,
-	0xaa, 0xe4, 0x67, 0x20, 0x87, 0x37, 0x5e, 0x5c, 0x72, 0xeb, 0x64, 0xc5, 0x91, 0xc7, 0x37, 0x1e,
-	0x35, 0x50, 0x49, 0x7f, 0x09, 0xd5, 0x24, 0x4c, 0x40, 0x7e, 0x93, 0xc8, 0xea, 0x04, 0xc1, 0xec,
-	0xd8, 0x79, 0x99, 0xf0, 0x48, 0x35, 0x3e, 0x7c, 0x38, 0x5a, 0x4c, 0x83, 0x99, 0x6f, 0x4f, 0x69,
-	0x0a, 0x39, 0xae, 0xcd, 0x87, 0x50, 0xb7, 0xdd, 0x99, 0xb3, 0xb0, 0x38, 0xbe, 0x1d, 0xda, 0xa6,
-	0x83, 0xce, 0x29, 0x46, 0x2d, 0x12, 0xf7, 0x85, 0x14, 0x13, 0x01, 0xd3, 0x43, 0x14, 0x47, 0x9a,
-	0x1c, 0xf1, 0xea, 0x8a, 0x52, 0x46, 0xff, 0xa7, 0x04, 0xda, 0x72, 0xd3, 0x98, 0x25, 0xf7, 0xde,
-	0x2f, 0xa1, 0x38, 0xc3, 0x63, 0x0a, 0x70, 0xe7, 0x95, 0xa2, 0x38, 0xbc, 0x60, 0x65, 0x58, 0xfe,
-	0x16, 0xc3, 0x56, 0xf4, 0xc7, 0x6b, 0x2e, 0x83, 0xfe, 0x74, 0x07, 0xaa, 0x91, 0xcd, 0x51, 0xdc,
-	0x5b, 0x10, 0x13, 0x77, 0x14, 0xf6, 0xdd, 0xec, 0xbe, 0x5f, 0xc0, 0xbb, 0xa0, 0x3d, 0xb7, 0x83,
-	0x70, 0x6b, 0x68, 0x96, 0x8e, 0x49, 0xb7, 0x9d, 0xf8, 0x7d, 0x28, 0xe0, 0x98, 0xb3, 0xa5, 0xbb,
-	0x98, 0x4f, 0xa9, 0x8f, 0x6b, 0x64, 0x23, 0x1a, 0xe9, 0x7f, 0x93, 0xa0, 0xf1, 0xca, 0xb3, 0xcc,
-	0x90, 0xa6, 0x4b, 0x37, 0xda, 0xe9, 0xbd, 0xb8, 0xb3, 0x99, 0xbe, 0x3c, 0xf6, 0x60, 0x82, 0xfc,
-	0x06, 0x13, 0xe8, 0xdf, 0xc2, 0x07, 0xeb, 0xf6, 0x60, 0xfd, 0xee, 0x65, 0x51, 0x82, 0x05, 0x72,
-	0x29, 0x16, 0xd0, 0xaf, 0xe1, 0xde, 0x13, 0x87, 0xcd, 0xde, 0x7c, 0x0f, 0xde, 0xea, 0x8f, 0xa1,
-	0xde, 0xb6, 0x2c, 0xd1, 0x1e, 0x44, 0x3b, 0xec, 0xd3, 0x49, 0xbc, 0x80, 0xa3, 0x8e, 0x63, 0xda,
-	0xf3, 0x14, 0x40, 0xc6, 0x8d, 0xa9, 0x43, 0xde, 0x63, 0x71, 0x05, 0x6e, 0xb6, 0x47, 0x7c, 0x52,
-	0xef, 0xc3, 0x91, 0x41, 0x5d, 0xfa, 0x5d, 0x0a, 0xef, 0x1e, 0x28, 0xb8, 0xdd, 0x0a, 0xb1, 0x84,
-	0xe3, 0xbe, 0xc5, 0xa7, 0x3c, 0x66, 0x89, 0x83, 0x10, 0x0d, 0x5d, 0xc9, 0x63, 0x16, 0x3f, 0x03,
-	0x7d, 0x00, 0xe4, 0x29, 0xde, 0xee, 0xff, 0x07, 0x2c, 0x0b, 0x88, 0x41, 0xdf, 0xb2, 0x37, 0xf4,
-	0x7f, 0xc7, 0xe2, 0x71, 0x9f, 0x9b, 0xef, 0x86, 0xbc, 0x53, 0xcc, 0x8b, 0x5b, 0x3c, 0x1a, 0xea,
-	0x9f, 0x43, 0xfd, 0x8f, 0xa6, 0xcd, 0xdf, 0x1c, 0x06, 0x0d, 0x3c, 0xe6, 0x06, 0x74, 0x55, 0xf4,
-	0x52, 0x76, 0xcf, 0xa3, 0xff, 0x05, 0x2a, 0x68, 0x57, 0x54, 0xf2, 0x4d, 0x28, 0xa0, 0x19, 0x5b,
-	0x2b, 0x4f, 0x38, 0x20, 0x14, 0xf6, 0x2a, 0x76, 0x7e, 0xef, 0xf8, 0xd4, 0xb4, 0x6e, 0xd0, 0x6a,
-	0xc5, 0x10, 0x03, 0xfd, 0x5b, 0x38, 0x59, 0xb2, 0xa5, 0xe8, 0x44, 0xe3, 0xd3, 0x69, 0x40, 0x9e,
-	0x3f, 0xac, 0xa4, 0xb5, 0x87, 0x15, 0x17, 0x6e, 0xe3, 0xd1, 0xdc, 0x36, 0x1e, 0x3d, 0xfb, 0x03,
-	0xc0, 0xaa, 0x27, 0x26, 0x35, 0x80, 0x57, 0x2f, 0xda, 0xa3, 0x51, 0xff, 0xd9, 0x8b, 0x5e, 0x57,
-	0x3d, 0x20, 0x87, 0xa0, 0x2c, 0x47, 0x12, 0xa9, 0x40, 0x69, 0xf4, 0xaa, 0xd3, 0xe9, 0x8d, 0x46,
-	0x6a, 0x8e, 0x00, 0x14, 0x9f, 0xb6, 0xfb, 0xcf, 0x7b, 0x5d, 0x35, 0xcf, 0xd5, 0x46, 0xc3, 0xe7,
-	0xfd, 0xf1, 0xb8, 0xd7, 0x55, 0xe5, 0xb3, 0x47, 0x1c, 0x32, 0xf6, 0x8d, 0xeb, 0x75, 0x8c, 0x5e,
-	0x7b, 0xdc, 0x53, 0x0f, 0xf8, 0xf7, 0xab, 0x61, 0x97, 0x7f, 0x4b, 0xfc, 0xbb, 0xdb, 0x7b, 0xde,
-	0x1b, 0xf7, 0xd4, 0xdc, 0xc5, 0xdf, 0xeb, 0x90, 0x6f, 0x0f, 0xfb, 0xe4, 0x31, 0x54, 0x3b, 0xd8,
-	0x70, 0xc4, 0xef, 0xe7, 0xad, 0x5c, 0xda, 0xd8, 0x2a, 0xd5, 0x0f, 0xc8, 0x97, 0x00, 0x7d, 0x97,
-	0x37, 0x5b, 0xf8, 0xaa, 0x3c, 0x41, 0xad, 0x95, 0x20, 0x3a, 0xb6, 0x1d, 0xab, 0x0f, 0x39, 0xd7,
-	0x2e, 0xfb, 0xf6, 0x3b, 0xa8, 0x17, 0x89, 0xe2, 0xc5, 0x77, 0xb7, 0x2d, 0x0e, 0xf4, 0x03, 0xf2,
-	0x29, 0x54, 0xbb, 0xd4, 0xa1, 0x2b, 0xd3, 0x97, 0x01, 0x69, 0x9c, 0x6c, 0x34, 0x58, 0xbd, 0xb9,
-	0x17, 0xde, 0xe8, 0x07, 0xa4, 0x0b, 0xf7, 0x52, 0x8b, 0x82, 0xa7, 0xcc, 0x8f, 0x49, 0x84, 0x54,
-	0x53, 0x9c, 0xb2, 0x03, 0xe5, 0x1b, 0x38, 0xda, 0xb8, 0x4f, 0xc9, 0x47, 0xe9, 0xeb, 0x20, 0xe3,
-	0xbe, 0x6d, 0x34, 0xb6, 0xf9, 0x23, 0xa2, 0xa7, 0x1f, 0x3c, 0x92, 0x48, 0x07, 0xea, 0xcb, 0x88,
-	0x44, 0x2f, 0x92, 0x93, 0xf5, 0x25, 0x42, 0xbe, 0xc3, 0xc0, 0x36, 0xd4, 0x96, 0x20, 0xd1, 0x03,
-	0x64, 0x1d, 0x03, 0xc5, 0x3b, 0x20, 0x3e, 0x01, 0x65, 0xc4, 0xdf, 0xfd, 0x3c, 0xb0, 0xab, 0x93,
-	0xcd, 0x0a, 0x65, 0x1f, 0x88, 0xd8, 0x30, 0xf5, 0x04, 0xc8, 0xee, 0x8a, 0x76, 0x6c, 0xdc, 0x07,
-	0x92, 0xbe, 0x89, 0xfe, 0x7b, 0xa8, 0xc7, 0x50, 0x7f, 0x46, 0x53, 0x77, 0xf9, 0x7a, 0x8c, 0xb3,
-	0x61, 0xf5, 0x03, 0xf2, 0x35, 0x1c, 0x6d, 0xf4, 0x02, 0x6b, 0x61, 0xce, 0xea, 0x15, 0xd6, 0xc2,
-	0x9c, 0x52, 0x41, 0xc3, 0x88, 0x48, 0xc3, 0x5d, 0xb6, 0x65, 0xfb, 0x65, 0x27, 0x18, 0x2a, 0x6d,
-	0xdd, 0xd9, 0xf6, 0x24, 0xdc, 0x6a, 0xe2, 0xfd, 0x4c, 0x13, 0x13, 0xe9, 0xf8, 0x35, 0xdc, 0xd9,
-	0xd2, 0xa7, 0x90, 0x87, 0xa9, 0xb5, 0xd9, 0x9d, 0xcc, 0x0e, 0x27, 0xfe, 0x04, 0x77, 0xb7, 0x76,
-	0x1c, 0xe4, 0xe3, 0x9d, 0xd8, 0xc9, 0xae, 0x64, 0x07, 0xfa, 0x57, 0x40, 0x36, 0x1b, 0x0e, 0xf2,
-	0xd3, 0x14, 0x74, 0x66, 0x47, 0xb2, 0x33, 0xa5, 0xca, 0x22, 0x72, 0x6d, 0xc7, 0x21, 0x19, 0x6a,
-	0x3b, 0x96, 0x3f, 0x01, 0x25, 0xee, 0x4d, 0xc8, 0x07, 0x29, 0x63, 0xd6, 0x5a, 0x96, 0x9d, 0x18,
-	0xb0, 0x6a, 0x50, 0xc8, 0x8f, 0xd2, 0x57, 0xdc, 0x7a, 0xe7, 0xd2, 0xd8, 0x72, 0x57, 0x0a, 0x8c,
-	0x55, 0x53, 0xb2, 0x86, 0xb1, 0xd1, 0xad, 0x64, 0x60, 0x74, 0xa1, 0x92, 0xe8, 0x46, 0x48, 0x3a,
-	0x9d, 0x36, 0xfb, 0x94, 0x6c, 0x94, 0x44, 0x1f, 0xb2, 0x86, 0xb2, 0xd9, 0xa1, 0x64, 0xa0, 0x8c,
-	0xa1, 0xbe, 0x76, 0x67, 0x93, 0x07, 0xdb, 0x4b, 0x21, 0x75, 0xa3, 0x37, 0xb4, 0x4d, 0xb4, 0x44,
-	0xf2, 0x5f, 0x20, 0x7f, 0x88, 0x15, 0x4f, 0x99, 0x9f, 0xa6, 0xc2, 0x3b, 0x9b, 0x4b, 0x79, 0x69,
-	0x7f, 0x11, 0x97, 0x76, 0xc6, 0xb2, 0xcc, 0xb8, 0x3e, 0x29, 0x7f, 0x53, 0x8a, 0xd0, 0xa6, 0x45,
-	0x9c, 0xfc, 0xf4, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x45, 0xca, 0x38, 0x94, 0x73, 0x17, 0x00,
-	0x00,
+	// 1951 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x5f, 0x73, 0xdb, 0x58,
+	0x15, 0x8f, 0x6c, 0xd9, 0x96, 0x8f, 0xe3, 0x3f, 0xb9, 0x4d, 0x83, 0x6a, 0x76, 0x69, 0x50, 0x59,
+	0xea, 0x0d, 0x8b, 0xd3, 0xc9, 0x2e, 0x3b, 0x0b, 0x6c, 0x61, 0x52, 0xdb, 0xed, 0xd8, 0x94, 0x36,
+	0xc8, 0xee, 0xb2, 0xb3, 0xc3, 0x8e, 0x47, 0xb6, 0x6e, 0x1a, 0xa5, 0xb2, 0xae, 0x90, 0xe4, 0x6e,
+	0xc3, 0x03, 0x1f, 0x80, 0x07, 0x66, 0x78, 0xe4, 0xa3, 0x30, 0xbc, 0xf3, 0xb9, 0x98, 0x7b, 0xae,
+	0x24, 0x4b, 0xb2, 0xe5, 0xd4, 0xc0, 0xf0, 0x90, 0x89, 0xee, 0xb9, 0xe7, 0xfe, 0x74, 0xfe, 0xfe,
+	0xee, 0x91, 0xe1, 0xd8, 0xa7, 0xde, 0x5b, 0xea, 0x9d, 0xba, 0xae, 0x7f, 0xea, 0x52, 0xcf, 0xb7,
+	0xfc, 0x20, 0xfa, 0xdf, 0x75, 0x3d, 0x16, 0x30, 0x52, 0x73, 0x5d, 0xbf, 0x1b, 0x8a, 0xda, 0xdf,
+	0x7f, 0xcd, 0xd8, 0x6b, 0x9b, 0x9e, 0xe2, 0xd6, 0x6c, 0x79, 0x79, 0x4a, 0x17, 0x6e, 0x70, 0x23,
+	0x34, 0xdb, 0xf7, 0xb3, 0x9b, 0x81, 0xb5, 0xa0, 0x7e, 0x60, 0x2c, 0xdc, 0x50, 0xe1, 0x70, 0x6e,
+	0x5b, 0xd4, 0x09, 0x4e, 0xdd, 0x4b, 0x9f, 0xff, 0x65, 0xa5, 0xdc, 0x04, 0x37, 0x94, 0x6a, 0xff,
+	0x2a, 0x41, 0x65, 0xc4, 0x66, 0x43, 0xe7, 0x92, 0x91, 0xbb, 0x50, 0xbe, 0x66, 0xb3, 0xa9, 0x65,
+	0xaa, 0xd2, 0xb1, 0xd4, 0xa9, 0xea, 0xa5, 0x6b, 0x36, 0x1b, 0x9a, 0xe4, 0x13, 0xa8, 0x06, 0x9e,
+	0xe1, 0xf8, 0x97, 0xcc, 0x5b, 0xa8, 0x85, 0x63, 0xa9, 0x53, 0x3b, 0x6b, 0x74, 0x39, 0xc2, 0x24,
+	0x92, 0xea, 0x2b, 0x05, 0xf2, 0x00, 0xea, 0xae, 0xe5, 0x52, 0xdb, 0x72, 0xe8, 0xd4, 0x31, 0x16,
+	0x54, 0x2d, 0x22, 0xd6, 0x7e, 0x24, 0x7c, 0x61, 0x2c, 0x28, 0xf9, 0x18, 0x5a, 0xb1, 0xd2, 0x5b,
+	0xee, 0x33, 0x73, 0xd4, 0xc3, 0x63, 0xa9, 0x23, 0xeb, 0xcd, 0x48, 0xfe, 0x95, 0x10, 0x93, 0x5f,
+	0x43, 0xcb, 0x35, 0x3c, 0xc3, 0xb6, 0xa9, 0x6d, 0xf9, 0x8b, 0xa9, 0xef, 0xd2, 0xb9, 0x4a, 0xd0,
+	0x88, 0x43, 0x34, 0xe2, 0x62, 0xb5, 0x39, 0x76, 0xe9, 0x5c, 0x6f, 0xba, 0x69, 0x01, 0xf9, 0x08,
+	0xca, 0x96, 0xe3, 0x2e, 0x03, 0x5f, 0x2d, 0x1d, 0x17, 0x3b, 0xb5, 0xb3, 0x3a, 0x1e, 0x43, 0x9f,
+	0xdd, 0x65, 0xa0, 0x87, 0x9b, 0xe4, 0x21, 0x80, 0x6b, 0x78, 0xd4, 0x09, 0xa6, 0xd7, 0x6c, 0xa6,
+	0x96, 0xf1, 0x0d, 0x4a, 0xa4, 0xaa, 0x57, 0xc5, 0xde, 0x88, 0xcd, 0xc8, 0x67, 0x50, 0xf1, 0x03,
+	0xc3, 0x0b, 0xa8, 0xa9, 0x56, 0x50, 0xab, 0xdd, 0x15, 0x09, 0xe9, 0x46, 0x09, 0xe9, 0x4e, 0xa2,
+	0x84, 0xe8, 0x91, 0x2a, 0xf9, 0x1c, 0x94, 0x4b, 0xcb, 0xb1, 0xfc, 0x2b, 0x6a, 0xaa, 0xca, 0xad,
+	0xc7, 0x62, 0x5d, 0xf2, 0x08, 0xea, 0x6c, 0x19, 0xb8, 0xcb, 0x60, 0x3a, 0x67, 0x8b, 0x85, 0x15,
+	0xa8, 0x55, 0x3c, 0x5c, 0xeb, 0xf2, 0xc4, 0xf6, 0x50, 0xa4, 0xef, 0x0b, 0x0d, 0xb1, 0x22, 0x47,
+	0x50, 0x9e, 0x79, 0x86, 0x33, 0xbf, 0x52, 0x0f, 0x30, 0xf2, 0xe1, 0x8a, 0x3c, 0x80, 0x92, 0x1f,
+	0x18, 0x01, 0x55, 0xe1, 0x58, 0xea, 0x34, 0x56, 0x61, 0x18, 0x73, 0xa1, 0x2e, 0xf6, 0xc8, 0x0f,
+	0x61, 0x5f, 0xbc, 0x67, 0x6a, 0x39, 0x26, 0x7d, 0xa7, 0xd6, 0x10, 0xa2, 0x26, 0x64, 0x43, 0x2e,
+	0x22, 0x8f, 0xe0, 0xd0, 0xa4, 0x97, 0xc6, 0xd2, 0x0e, 0xa6, 0xfe, 0x95, 0xe1, 0x99, 0xd3, 0x05,
+	0x33, 0x97, 0xb6, 0xa5, 0x36, 0x8f, 0x8b, 0x1d, 0x59, 0x27, 0xe1, 0xde, 0x98, 0x6f, 0xfd, 0x16,
+	0x77, 0xc8, 0x21, 0x94, 0x50, 0x53, 0xbd, 0x83, 0x29, 0x16, 0x0b, 0x72, 0x0a, 0x30, 0x67, 0x8b,
+	0x99, 0xe5, 0x18, 0x01, 0xf3, 0xd4, 0xbb, 0x68, 0x54, 0x13, 0x8d, 0xea, 0xc5, 0x62, 0x3d, 0xa1,
+	0x32, 0x92, 0x15, 0xb9, 0x55, 0x1a, 0xc9, 0xca, 0x7e, 0xab, 0x3e, 0x92, 0x95, 0x7a, 0xab, 0x31,
+	0x92, 0x95, 0x46, 0xab, 0x39, 0x92, 0x95, 0x56, 0xeb, 0x40, 0xfb, 0x0d, 0x14, 0x2f, 0x98, 0x49,
+	0x08, 0xc8, 0x58, 0x75, 0xa2, 0x82, 0xf1, 0x79, 0x3d, 0x86, 0x85, 0x5b, 0x62, 0xa8, 0xfd, 0xa3,
+	0x00, 0xa5, 0xde, 0xd5, 0xd2, 0x79, 0x43, 0x1a, 0x50, 0x88, 0xfb, 0xa1, 0x60, 0x99, 0x89, 0x1e,
+	0x29, 0x24, 0x7b, 0xe4, 0x08, 0xca, 0x61, 0x18, 0x8a, 0x18, 0x86, 0xf2, 0x22, 0x76, 0x5d, 0x04,
+	0x52, 0x16, 0xae, 0xe3, 0x82, 0x4b, 0xd9, 0x77, 0x0e, 0xf5, 0xd4, 0x92, 0xc0, 0xc0, 0x05, 0xf9,
+	0x11, 0xc8, 0x2e, 0x33, 0x7d, 0xb5, 0x8c, 0x65, 0xda, 0xea, 0x26, 0x08, 0xa1, 0x7b, 0xc1, 0x4c,
+	0x1d, 0x77, 0xc9, 0x4f, 0xa3, 0x34, 0x56, 0x30, 0x62, 0xdf, 0x4b, 0xa9, 0xa1, 0xcd, 0xa9, 0x84,
+	0x7e, 0x08, 0x60, 0x53, 0xc3, 0xa7, 0x53, 0x4e, 0x12, 0x58, 0x79, 0xb2, 0x5e, 0x45, 0x09, 0xaf,
+	0x36, 0xd2, 0x06, 0xc5, 0x08, 0x02, 0xce, 0x2e, 0x3e, 0x56, 0x96, 0xac, 0xc7, 0x6b, 0xee, 0x13,
+	0xf5, 0x3c, 0xe6, 0xf9, 0x2a, 0x1c, 0x17, 0x79, 0x21, 0x89, 0x15, 0x87, 0xf4, 0x68, 0xe0, 0xdd,
+	0x08, 0xc8, 0x9a, 0x80, 0x44, 0x09, 0x87, 0xd4, 0x3e, 0x83, 0x32, 0x9a, 0xe1, 0x93, 0x13, 0x28,
+	0xcf, 0xf1, 0x49, 0x95, 0xd0, 0x25, 0xb2, 0x6e, 0xab, 0x1e, 0x6a, 0x68, 0xbf, 0x04, 0x25, 0xa4,
+	0x21, 0x9f, 0x9c, 0x82, 0x82, 0x31, 0x76, 0x2e, 0x59, 0x78, 0xf2, 0x30, 0x75, 0x32, 0x54, 0xd4,
+	0x2b, 0xd7, 0xe2, 0x41, 0x9b, 0x40, 0x75, 0xc4, 0x66, 0x2f, 0x31, 0x83, 0x79, 0x2c, 0xb6, 0x7b,
+	0x11, 0xfc, 0x19, 0x4d, 0xc2, 0x68, 0xe6, 0x81, 0xc6, 0x3d, 0x55, 0xd8, 0xd2, 0x53, 0xc9, 0xd6,
+	0x2f, 0xbe, 0x7f, 0xeb, 0x6b, 0x36, 0x1c, 0x9d, 0x9b, 0xe6, 0xcb, 0x84, 0x49, 0x3a, 0xfd, 0xe3,
+	0x92, 0xfa, 0x41, 0xbe, 0x35, 0xe5, 0x7c, 0xdf, 0xc2, 0xad, 0x04, 0x3d, 0x14, 0x93, 0xf4, 0xa0,
+	0xfd, 0xa5, 0x04, 0xfb, 0x17, 0x21, 0xf7, 0xe2, 0x6d, 0xb0, 0x46, 0xe4, 0xd2, 0x06, 0x22, 0x57,
+	0xa1, 0x12, 0xf1, 0x77, 0x1d, 0x0b, 0x21, 0x5a, 0xee, 0x78, 0x6b, 0x6c, 0x62, 0xf9, 0xfd, 0x5d,
+	0x58, 0xfe, 0x24, 0x66, 0x79, 0x39, 0x51, 0x6b, 0x2b, 0x87, 0x92, 0x54, 0x7f, 0x02, 0xb5, 0xb0,
+	0x14, 0x3c, 0xea, 0x32, 0x6c, 0xc2, 0xda, 0x59, 0x15, 0x83, 0xa5, 0x53, 0x97, 0xe9, 0x20, 0x76,
+	0xf9, 0x33, 0xf9, 0x39, 0xc0, 0xdc, 0xa3, 0x46, 0x40, 0xcd, 0xa9, 0x11, 0xa8, 0xe5, 0x5b, 0xd3,
+	0x57, 0x0d, 0xb5, 0xcf, 0x83, 0x15, 0xed, 0x55, 0x92, 0xb4, 0xd7, 0x89, 0x4a, 0x46, 0xc1, 0x92,
+	0x49, 0xdb, 0x99, 0xe5, 0x62, 0x8f, 0xce, 0xf9, 0x8d, 0x84, 0x8d, 0x87, 0xfd, 0x59, 0xd5, 0x6b,
+	0x42, 0x36, 0xe0, 0x22, 0xf2, 0x0c, 0x80, 0x17, 0xc2, 0x9c, 0x2d, 0x9d, 0x40, 0xb4, 0x69, 0xed,
+	0xac, 0x93, 0x26, 0x8e, 0x44, 0x4a, 0x79, 0x65, 0xf6, 0x50, 0x75, 0xe0, 0x04, 0xde, 0x8d, 0x5e,
+	0xbd, 0x8e, 0xd6, 0x3c, 0x8f, 0x7e, 0xc0, 0x5c, 0x97, 0x9a, 0xd8, 0xd0, 0x8a, 0x1e, 0x2d, 0x33,
+	0x34, 0xdd, 0xb8, 0x95, 0xa6, 0xdb, 0x5f, 0x42, 0x23, 0xfd, 0x1e, 0xd2, 0x82, 0xe2, 0x1b, 0x7a,
+	0x83, 0xf5, 0x53, 0xd2, 0xf9, 0x23, 0x0f, 0xcd, 0x5b, 0xc3, 0x5e, 0x8a, 0xbe, 0x29, 0xe9, 0x62,
+	0xf1, 0x8b, 0xc2, 0x17, 0xd2, 0x48, 0x56, 0x8a, 0x2d, 0x59, 0x7b, 0x07, 0x24, 0x69, 0x78, 0xef,
+	0xca, 0x70, 0x5e, 0x53, 0xf2, 0x33, 0x50, 0xa2, 0xe2, 0x43, 0xb0, 0xda, 0xd9, 0xbd, 0x5c, 0x5f,
+	0xf5, 0x58, 0x95, 0xfc, 0x04, 0xe4, 0xe0, 0xc6, 0x8d, 0x7a, 0x34, 0x4b, 0x98, 0x1c, 0x79, 0x72,
+	0xe3, 0x52, 0x1d, 0x95, 0xb4, 0x97, 0x50, 0x4f, 0xc2, 0xf8, 0xe4, 0x57, 0x89, 0x36, 0x48, 0x30,
+	0xd2, 0x96, 0x37, 0xef, 0xbb, 0x89, 0x95, 0xe6, 0xc1, 0x87, 0xe3, 0xe5, 0xcc, 0x9f, 0x7b, 0xd6,
+	0x8c, 0xa6, 0x90, 0xa3, 0x66, 0x7e, 0x08, 0x4d, 0xcb, 0x99, 0xdb, 0x4b, 0x93, 0xe3, 0x5b, 0x81,
+	0x65, 0xd8, 0xe8, 0x9c, 0xa2, 0x37, 0x42, 0xf1, 0x50, 0x48, 0xb1, 0x72, 0xb0, 0x9e, 0x44, 0x37,
+	0xa5, 0xd9, 0x14, 0xef, 0xdb, 0xb0, 0xc6, 0xb4, 0x7f, 0x4a, 0xa0, 0xc6, 0x2f, 0x8d, 0x68, 0x75,
+	0xe7, 0xf7, 0x25, 0x14, 0xe7, 0x18, 0x26, 0x5f, 0x2d, 0xa4, 0x14, 0x45, 0xf0, 0xfc, 0x95, 0x61,
+	0xc5, 0x5b, 0x0c, 0x5b, 0xf1, 0x25, 0x6f, 0xd2, 0x1c, 0xbe, 0xd4, 0x6c, 0xa8, 0x87, 0x36, 0x87,
+	0x79, 0xef, 0x42, 0xc4, 0xf4, 0xaa, 0x94, 0xe0, 0x84, 0xbc, 0xeb, 0x60, 0xb7, 0x84, 0xf7, 0x41,
+	0x7d, 0x6e, 0xf9, 0xc1, 0xc6, 0xd4, 0xc4, 0x8e, 0x49, 0xb7, 0x45, 0xfc, 0x3e, 0x94, 0x70, 0xcd,
+	0xe9, 0xd5, 0x59, 0x2e, 0x66, 0xd4, 0xc3, 0x33, 0xb2, 0x1e, 0xae, 0xb4, 0xbf, 0x4a, 0xd0, 0x7e,
+	0xe5, 0x9a, 0x46, 0x40, 0xd3, 0xbd, 0x1e, 0xbe, 0xe9, 0xbd, 0xc8, 0xb6, 0x93, 0xbe, 0x6d, 0x76,
+	0xa0, 0x8e, 0xe2, 0x1a, 0x75, 0x68, 0xdf, 0xc2, 0x07, 0x59, 0x7b, 0xb0, 0xe1, 0x77, 0xb2, 0x28,
+	0x41, 0x1b, 0x85, 0x14, 0x6d, 0x68, 0xd7, 0x70, 0xef, 0x89, 0xcd, 0xe6, 0x6f, 0xfe, 0x0f, 0xde,
+	0x6a, 0x8f, 0xa1, 0x79, 0x6e, 0x9a, 0x62, 0x9e, 0x08, 0xdf, 0xb0, 0xcb, 0xe8, 0xf1, 0x02, 0x0e,
+	0x7a, 0xb6, 0x61, 0x2d, 0x52, 0x00, 0x39, 0x57, 0xac, 0x06, 0x45, 0x97, 0x45, 0x1d, 0xb8, 0x3e,
+	0xa2, 0xf1, 0x4d, 0x6d, 0x08, 0x07, 0x3a, 0x75, 0xe8, 0x77, 0x29, 0xbc, 0x7b, 0xa0, 0xe0, 0xeb,
+	0x56, 0x88, 0x15, 0x5c, 0x0f, 0x4d, 0xbe, 0xe5, 0x32, 0x53, 0x04, 0x42, 0x0c, 0x95, 0x15, 0x97,
+	0x99, 0x3c, 0x06, 0xda, 0x08, 0xc8, 0x53, 0x1c, 0x07, 0xfe, 0x07, 0x58, 0x7f, 0x97, 0x80, 0xe8,
+	0xf4, 0x2d, 0x7b, 0x43, 0xff, 0x7b, 0x30, 0x9e, 0xf8, 0x85, 0xf1, 0xee, 0x82, 0x8f, 0xab, 0x45,
+	0x71, 0xef, 0x87, 0x4b, 0x4e, 0xed, 0xa2, 0xe6, 0x64, 0x11, 0x37, 0x5c, 0x64, 0x66, 0xc6, 0x52,
+	0x76, 0x66, 0xfc, 0x1c, 0x9a, 0xbf, 0x37, 0x2c, 0xfe, 0x79, 0xa5, 0x53, 0xdf, 0x65, 0x8e, 0x4f,
+	0x57, 0x54, 0x21, 0xe5, 0x8f, 0x56, 0xda, 0x9f, 0xa0, 0x86, 0xce, 0x84, 0x44, 0xd1, 0x81, 0x12,
+	0xda, 0xbe, 0xb1, 0x5f, 0x85, 0xd7, 0x42, 0x61, 0x27, 0x8a, 0xe0, 0x2e, 0x79, 0xd4, 0x30, 0x6f,
+	0xd0, 0x55, 0x45, 0x17, 0x0b, 0xed, 0x5b, 0x38, 0x8a, 0x39, 0x16, 0xb1, 0x63, 0xda, 0x68, 0x43,
+	0x91, 0x7f, 0x43, 0x4a, 0x99, 0x6f, 0x48, 0x2e, 0xdc, 0xc4, 0xbe, 0x85, 0x4d, 0xec, 0x7b, 0xf2,
+	0x3b, 0x80, 0xd5, 0x34, 0x4f, 0x1a, 0x00, 0xaf, 0x5e, 0x9c, 0x8f, 0xc7, 0xc3, 0x67, 0x2f, 0x06,
+	0xfd, 0xd6, 0x1e, 0xd9, 0x07, 0x25, 0x5e, 0x49, 0xa4, 0x06, 0x95, 0xf1, 0xab, 0x5e, 0x6f, 0x30,
+	0x1e, 0xb7, 0x0a, 0x04, 0xa0, 0xfc, 0xf4, 0x7c, 0xf8, 0x7c, 0xd0, 0x6f, 0x15, 0xb9, 0xda, 0xf8,
+	0xe2, 0xf9, 0x70, 0x32, 0x19, 0xf4, 0x5b, 0xf2, 0xc9, 0x23, 0x80, 0x95, 0x6f, 0x5c, 0xaf, 0xa7,
+	0x0f, 0xce, 0x27, 0x83, 0xd6, 0x1e, 0x7f, 0x7e, 0x75, 0xd1, 0xe7, 0xcf, 0x12, 0x7f, 0xee, 0x0f,
+	0x9e, 0x0f, 0x26, 0x83, 0x56, 0xe1, 0xec, 0x6f, 0x4d, 0x28, 0x9e, 0x5f, 0x0c, 0xc9, 0x63, 0xa8,
+	0xf7, 0x70, 0xae, 0x89, 0x7e, 0x2a, 0xd8, 0xc8, 0xc0, 0xed, 0x8d, 0x52, 0x6d, 0x8f, 0x7c, 0x09,
+	0x30, 0x74, 0xf8, 0x4c, 0x87, 0x1f, 0xd0, 0x47, 0xa8, 0xb5, 0x12, 0x84, 0x61, 0xdb, 0x72, 0x7a,
+	0x9f, 0x33, 0x74, 0xfc, 0x79, 0x70, 0x07, 0xf5, 0x42, 0x51, 0x74, 0xf8, 0xee, 0xa6, 0xc3, 0xbe,
+	0xb6, 0x47, 0x3e, 0x85, 0x7a, 0x9f, 0xda, 0x74, 0x65, 0x7a, 0x9c, 0x90, 0xf6, 0xd1, 0xda, 0x1c,
+	0x37, 0xe0, 0x3f, 0xb3, 0x68, 0x7b, 0xa4, 0x0f, 0xf7, 0x52, 0x87, 0xfc, 0xa7, 0xcc, 0x8b, 0xa8,
+	0x87, 0xd4, 0x53, 0x4c, 0xb4, 0x05, 0xe5, 0x1b, 0x38, 0x58, 0xbb, 0x85, 0xc9, 0x47, 0xe9, 0x4b,
+	0x24, 0xe7, 0x96, 0x6e, 0xb7, 0x37, 0xf9, 0x23, 0xb2, 0xa7, 0xed, 0x3d, 0x92, 0x48, 0x0f, 0x9a,
+	0x71, 0x46, 0xc2, 0x0f, 0x9f, 0xa3, 0xec, 0x11, 0x21, 0xdf, 0x62, 0xe0, 0x39, 0x34, 0x62, 0x90,
+	0xf0, 0x3b, 0x27, 0x8b, 0x81, 0xe2, 0x2d, 0x10, 0x9f, 0x80, 0x32, 0x0e, 0x0c, 0x0f, 0x13, 0xbb,
+	0x8a, 0x6c, 0x5e, 0x2a, 0x87, 0x40, 0xc4, 0x0b, 0x53, 0x5f, 0x1a, 0xf9, 0xb3, 0xd4, 0x96, 0x17,
+	0x0f, 0x81, 0xa4, 0xef, 0xaf, 0xff, 0x1c, 0xea, 0x31, 0x34, 0x9f, 0xd1, 0xd4, 0x04, 0x90, 0xcd,
+	0x71, 0x3e, 0xac, 0xb6, 0x47, 0xbe, 0x86, 0x83, 0xb5, 0x09, 0x22, 0x93, 0xe6, 0xbc, 0x09, 0x23,
+	0x93, 0xe6, 0x94, 0x0a, 0x1a, 0x46, 0x44, 0x19, 0x6e, 0xb3, 0x2d, 0xdf, 0x2f, 0x2b, 0xc1, 0x50,
+	0x69, 0xeb, 0x4e, 0x36, 0x17, 0xe1, 0x46, 0x13, 0xef, 0xe7, 0x9a, 0x98, 0x28, 0xc7, 0xaf, 0xe1,
+	0xce, 0x86, 0xe9, 0x86, 0x3c, 0x4c, 0x9d, 0xcd, 0x9f, 0x7f, 0xb6, 0x38, 0xf1, 0x07, 0xb8, 0xbb,
+	0x71, 0x4e, 0x21, 0x1f, 0x6f, 0xc5, 0x4e, 0xce, 0x32, 0x5b, 0xd0, 0xbf, 0x02, 0xb2, 0x3e, 0xa6,
+	0x90, 0x1f, 0xa7, 0xa0, 0x73, 0xe7, 0x98, 0xad, 0x25, 0x55, 0x15, 0x99, 0x3b, 0xb7, 0x6d, 0x92,
+	0xa3, 0xb6, 0xe5, 0xf8, 0x13, 0x50, 0xa2, 0x89, 0x86, 0x7c, 0x90, 0x32, 0x26, 0x33, 0xe8, 0x6c,
+	0xc5, 0x80, 0xd5, 0x58, 0x43, 0x7e, 0x90, 0xbe, 0xe2, 0xb2, 0xf3, 0x4e, 0x7b, 0xc3, 0x5d, 0x29,
+	0x30, 0x56, 0xa3, 0x4c, 0x06, 0x63, 0x6d, 0xc6, 0xc9, 0xc1, 0xe8, 0x43, 0x2d, 0x31, 0xc3, 0x90,
+	0x74, 0x39, 0xad, 0x4f, 0x37, 0xf9, 0x28, 0x89, 0xe1, 0x25, 0x83, 0xb2, 0x3e, 0xd6, 0xe4, 0xa0,
+	0x4c, 0xa0, 0x99, 0xb9, 0xb3, 0xc9, 0x83, 0xcd, 0xad, 0x90, 0xba, 0xd1, 0xdb, 0xea, 0x3a, 0x5a,
+	0xa2, 0xf8, 0xcf, 0x90, 0x3f, 0xc4, 0x89, 0xa7, 0xcc, 0x4b, 0x53, 0xe1, 0x9d, 0xf5, 0xa3, 0xbc,
+	0xb5, 0xbf, 0x88, 0x5a, 0x3b, 0xe7, 0x58, 0x6e, 0x5e, 0x9f, 0x54, 0xbf, 0xa9, 0x84, 0x68, 0xb3,
+	0x32, 0x6e, 0x7e, 0xfa, 0xef, 0x01, 0x00, 0xfa, 0xbe, 0xaa, 0xc4, 0x5e, 0x18, 0x00, 0x00,
 }
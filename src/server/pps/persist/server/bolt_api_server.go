@@ -0,0 +1,992 @@
+package server
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
+	"github.com/sjezewski/pachyderm/src/client/pfs"
+	ppsclient "github.com/sjezewski/pachyderm/src/client/pps"
+	"github.com/sjezewski/pachyderm/src/server/pkg/promutil"
+	"github.com/sjezewski/pachyderm/src/server/pps/persist"
+
+	"go.pedge.io/pb/go/google/protobuf"
+	"go.pedge.io/pkg/time"
+	"go.pedge.io/proto/rpclog"
+	"go.pedge.io/proto/time"
+	"golang.org/x/net/context"
+)
+
+// The bolt server keeps each table in a bucket of the same name, keyed by
+// the table's rethinkdb primary key.  The rethinkdb changefeeds are replaced
+// by in-process subscriptions, which is why only one pachd may use a given
+// database file.
+
+const boltOpenTimeout = 5 * time.Second
+
+// boltChange is a change to a document; oldVal is nil if the document was
+// created, newVal is nil if it was deleted.
+type boltChange struct {
+	table  Table
+	oldVal proto.Message
+	newVal proto.Message
+}
+
+// boltSubscriber receives the changes to a table.  Changes are queued
+// without bound so that a slow subscriber can never block writers.
+type boltSubscriber struct {
+	table   Table
+	lock    sync.Mutex
+	queue   []*boltChange
+	pending chan struct{}
+}
+
+func (s *boltSubscriber) push(change *boltChange) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.queue = append(s.queue, change)
+	select {
+	case s.pending <- struct{}{}:
+	default:
+	}
+}
+
+// next blocks until there's a change, or ctx is done.
+func (s *boltSubscriber) next(ctx context.Context) (*boltChange, error) {
+	for {
+		s.lock.Lock()
+		if len(s.queue) > 0 {
+			change := s.queue[0]
+			s.queue = s.queue[1:]
+			s.lock.Unlock()
+			return change, nil
+		}
+		s.lock.Unlock()
+		select {
+		case <-s.pending:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+type boltAPIServer struct {
+	protorpclog.Logger
+	db    *bolt.DB
+	timer pkgtime.Timer
+
+	// lock is held while writing and while subscribing, so that
+	// subscribers see every change made after their initial documents.
+	lock        sync.Mutex
+	subscribers map[*boltSubscriber]bool
+	// changed is closed and replaced after every write.
+	changed chan struct{}
+}
+
+func newBoltAPIServer(path string) (*boltAPIServer, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: boltOpenTimeout})
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		for _, table := range tables {
+			if _, err := tx.CreateBucketIfNotExists([]byte(table)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &boltAPIServer{
+		Logger:      promutil.NewLogger("pps.persist.API"),
+		db:          db,
+		timer:       pkgtime.NewSystemTimer(),
+		subscribers: make(map[*boltSubscriber]bool),
+		changed:     make(chan struct{}),
+	}, nil
+}
+
+func (a *boltAPIServer) Close() error {
+	return a.db.Close()
+}
+
+// boltWriter is used within a read-write transaction to modify documents
+// and record the changes.
+type boltWriter struct {
+	tx      *bolt.Tx
+	changes []*boltChange
+}
+
+func (w *boltWriter) get(table Table, key string, message proto.Message) (bool, error) {
+	return getMessage(w.tx, table, key, message)
+}
+
+func (w *boltWriter) put(table Table, key string, message proto.Message) error {
+	bucket := w.tx.Bucket([]byte(table))
+	oldVal := proto.Clone(message)
+	oldVal.Reset()
+	ok, err := getMessage(w.tx, table, key, oldVal)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		oldVal = nil
+	}
+	value, err := proto.Marshal(message)
+	if err != nil {
+		return err
+	}
+	if err := bucket.Put([]byte(key), value); err != nil {
+		return err
+	}
+	w.changes = append(w.changes, &boltChange{
+		table:  table,
+		oldVal: oldVal,
+		newVal: proto.Clone(message),
+	})
+	return nil
+}
+
+func (w *boltWriter) insert(table Table, key string, message proto.Message) error {
+	if w.tx.Bucket([]byte(table)).Get([]byte(key)) != nil {
+		return fmt.Errorf("%v %v already exists", table, key)
+	}
+	return w.put(table, key, message)
+}
+
+// delete deletes the documents for which f returns true.
+func (w *boltWriter) delete(table Table, newMessage func() proto.Message, f func(proto.Message) bool) error {
+	bucket := w.tx.Bucket([]byte(table))
+	var keys [][]byte
+	var oldVals []proto.Message
+	if err := bucket.ForEach(func(k, v []byte) error {
+		message := newMessage()
+		if err := proto.Unmarshal(v, message); err != nil {
+			return err
+		}
+		if f(message) {
+			keys = append(keys, k)
+			oldVals = append(oldVals, message)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for i, key := range keys {
+		if err := bucket.Delete(key); err != nil {
+			return err
+		}
+		w.changes = append(w.changes, &boltChange{
+			table:  table,
+			oldVal: oldVals[i],
+		})
+	}
+	return nil
+}
+
+// update runs f in a read-write transaction and then sends the changes it
+// made to the subscribers.  f must not open other transactions.
+func (a *boltAPIServer) update(f func(w *boltWriter) error) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	var changes []*boltChange
+	if err := a.db.Update(func(tx *bolt.Tx) error {
+		w := &boltWriter{tx: tx}
+		if err := f(w); err != nil {
+			return err
+		}
+		changes = w.changes
+		return nil
+	}); err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	for _, change := range changes {
+		for s := range a.subscribers {
+			if s.table == change.table {
+				s.push(change)
+			}
+		}
+	}
+	close(a.changed)
+	a.changed = make(chan struct{})
+	return nil
+}
+
+// changes returns a channel that's closed the next time the database
+// changes.
+func (a *boltAPIServer) changes() <-chan struct{} {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.changed
+}
+
+// waitFor calls f in a read-only transaction until it returns true or an
+// error, blocking until the database changes in between calls.
+func (a *boltAPIServer) waitFor(ctx context.Context, f func(tx *bolt.Tx) (bool, error)) error {
+	for {
+		changed := a.changes()
+		var done bool
+		if err := a.db.View(func(tx *bolt.Tx) error {
+			var err error
+			done, err = f(tx)
+			return err
+		}); err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// subscribe calls initial in a read-only transaction and returns a
+// subscriber that receives every change to table made after that.  The
+// subscriber must be passed to unsubscribe when it's no longer used.
+func (a *boltAPIServer) subscribe(table Table, initial func(tx *bolt.Tx) error) (*boltSubscriber, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if err := a.db.View(initial); err != nil {
+		return nil, err
+	}
+	s := &boltSubscriber{
+		table:   table,
+		pending: make(chan struct{}, 1),
+	}
+	a.subscribers[s] = true
+	return s, nil
+}
+
+func (a *boltAPIServer) unsubscribe(s *boltSubscriber) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	delete(a.subscribers, s)
+}
+
+func getMessage(tx *bolt.Tx, table Table, key string, message proto.Message) (bool, error) {
+	value := tx.Bucket([]byte(table)).Get([]byte(key))
+	if value == nil {
+		return false, nil
+	}
+	return true, proto.Unmarshal(value, message)
+}
+
+func forEachJobInfo(tx *bolt.Tx, f func(*persist.JobInfo) error) error {
+	return tx.Bucket([]byte(jobInfosTable)).ForEach(func(k, v []byte) error {
+		jobInfo := &persist.JobInfo{}
+		if err := proto.Unmarshal(v, jobInfo); err != nil {
+			return err
+		}
+		return f(jobInfo)
+	})
+}
+
+func forEachPipelineInfo(tx *bolt.Tx, f func(*persist.PipelineInfo) error) error {
+	return tx.Bucket([]byte(pipelineInfosTable)).ForEach(func(k, v []byte) error {
+		pipelineInfo := &persist.PipelineInfo{}
+		if err := proto.Unmarshal(v, pipelineInfo); err != nil {
+			return err
+		}
+		return f(pipelineInfo)
+	})
+}
+
+func forEachChunk(tx *bolt.Tx, f func(*persist.Chunk) error) error {
+	return tx.Bucket([]byte(chunksTable)).ForEach(func(k, v []byte) error {
+		chunk := &persist.Chunk{}
+		if err := proto.Unmarshal(v, chunk); err != nil {
+			return err
+		}
+		return f(chunk)
+	})
+}
+
+// Timestamp cannot be set
+func (a *boltAPIServer) CreateJobInfo(ctx context.Context, request *persist.JobInfo) (response *persist.JobInfo, err error) {
+	if request.JobID == "" {
+		return nil, fmt.Errorf("request.JobID should be set")
+	}
+	if request.Started != nil {
+		return nil, fmt.Errorf("request.Started should be unset")
+	}
+	if request.CommitIndex != "" {
+		return nil, fmt.Errorf("request.CommitIndex should be unset")
+	}
+	request.Started = prototime.TimeToTimestamp(time.Now())
+	var commits []*pfs.Commit
+	for _, input := range request.Inputs {
+		commits = append(commits, input.Commit)
+	}
+	request.CommitIndex, err = genCommitIndex(commits)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.update(func(w *boltWriter) error {
+		return w.insert(jobInfosTable, request.JobID, request)
+	}); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (a *boltAPIServer) InspectJob(ctx context.Context, request *ppsclient.InspectJobRequest) (response *persist.JobInfo, err error) {
+	if request.Job == nil {
+		return nil, fmt.Errorf("request.Job cannot be nil")
+	}
+
+	jobInfo := &persist.JobInfo{}
+	if err := a.waitFor(ctx, func(tx *bolt.Tx) (bool, error) {
+		ok, err := getMessage(tx, jobInfosTable, request.Job.ID, jobInfo)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, fmt.Errorf("%v %v not found", jobInfosTable, request.Job.ID)
+		}
+		if request.BlockState {
+			return jobInfo.State == ppsclient.JobState_JOB_EMPTY ||
+				jobInfo.State == ppsclient.JobState_JOB_SUCCESS ||
+				jobInfo.State == ppsclient.JobState_JOB_FAILURE, nil
+		}
+		return true, nil
+	}); err != nil {
+		return nil, err
+	}
+	return jobInfo, nil
+}
+
+func (a *boltAPIServer) ListJobInfos(ctx context.Context, request *ppsclient.ListJobRequest) (response *persist.JobInfos, retErr error) {
+	commitIndexVal, err := genCommitIndex(request.InputCommit)
+	if err != nil {
+		return nil, err
+	}
+	result := &persist.JobInfos{}
+	if err := a.db.View(func(tx *bolt.Tx) error {
+		return forEachJobInfo(tx, func(jobInfo *persist.JobInfo) error {
+			if request.Pipeline != nil && jobInfo.PipelineName != request.Pipeline.Name {
+				return nil
+			}
+			if len(request.InputCommit) > 0 && jobInfo.CommitIndex != commitIndexVal {
+				return nil
+			}
+			result.JobInfo = append(result.JobInfo, jobInfo)
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (a *boltAPIServer) DeleteJobInfo(ctx context.Context, request *ppsclient.Job) (response *google_protobuf.Empty, err error) {
+	if err := a.update(func(w *boltWriter) error {
+		return w.delete(jobInfosTable, newJobInfo, func(message proto.Message) bool {
+			return message.(*persist.JobInfo).JobID == request.ID
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return google_protobuf.EmptyInstance, nil
+}
+
+func (a *boltAPIServer) DeleteJobInfosForPipeline(ctx context.Context, request *ppsclient.Pipeline) (response *google_protobuf.Empty, err error) {
+	if err := a.update(func(w *boltWriter) error {
+		return w.delete(jobInfosTable, newJobInfo, func(message proto.Message) bool {
+			return message.(*persist.JobInfo).PipelineName == request.Name
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return google_protobuf.EmptyInstance, nil
+}
+
+// updateJobInfo applies f to the JobInfo with the given ID.
+func (a *boltAPIServer) updateJobInfo(jobID string, f func(*persist.JobInfo)) error {
+	return a.update(func(w *boltWriter) error {
+		jobInfo := &persist.JobInfo{}
+		ok, err := w.get(jobInfosTable, jobID, jobInfo)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("%v %v not found", jobInfosTable, jobID)
+		}
+		f(jobInfo)
+		return w.put(jobInfosTable, jobID, jobInfo)
+	})
+}
+
+func (a *boltAPIServer) CreateJobOutput(ctx context.Context, request *persist.JobOutput) (response *google_protobuf.Empty, err error) {
+	if err := a.updateJobInfo(request.JobID, func(jobInfo *persist.JobInfo) {
+		jobInfo.OutputCommit = request.OutputCommit
+	}); err != nil {
+		return nil, err
+	}
+	return google_protobuf.EmptyInstance, nil
+}
+
+func (a *boltAPIServer) CreateJobState(ctx context.Context, request *persist.JobState) (response *google_protobuf.Empty, err error) {
+	if request.Finished != nil {
+		return nil, fmt.Errorf("request.Finished should be unset")
+	}
+	if request.State == ppsclient.JobState_JOB_SUCCESS ||
+		request.State == ppsclient.JobState_JOB_FAILURE {
+		request.Finished = prototime.TimeToTimestamp(time.Now())
+	}
+	if err := a.updateJobInfo(request.JobID, func(jobInfo *persist.JobInfo) {
+		jobInfo.State = request.State
+		jobInfo.Finished = request.Finished
+	}); err != nil {
+		return nil, err
+	}
+	return google_protobuf.EmptyInstance, nil
+}
+
+// updatePipelineInfo applies f to the PipelineInfo with the given name.
+func (a *boltAPIServer) updatePipelineInfo(pipelineName string, f func(*persist.PipelineInfo)) error {
+	return a.update(func(w *boltWriter) error {
+		pipelineInfo := &persist.PipelineInfo{}
+		ok, err := w.get(pipelineInfosTable, pipelineName, pipelineInfo)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("%v %v not found", pipelineInfosTable, pipelineName)
+		}
+		f(pipelineInfo)
+		return w.put(pipelineInfosTable, pipelineName, pipelineInfo)
+	})
+}
+
+func (a *boltAPIServer) UpdatePipelineState(ctx context.Context, request *persist.UpdatePipelineStateRequest) (response *google_protobuf.Empty, err error) {
+	if err := a.updatePipelineInfo(request.PipelineName, func(pipelineInfo *persist.PipelineInfo) {
+		pipelineInfo.State = request.State
+		pipelineInfo.RecentError = request.RecentError
+	}); err != nil {
+		return nil, err
+	}
+	return google_protobuf.EmptyInstance, nil
+}
+
+func (a *boltAPIServer) UpdatePipelineStopped(ctx context.Context, request *persist.UpdatePipelineStoppedRequest) (response *google_protobuf.Empty, err error) {
+	if err := a.updatePipelineInfo(request.PipelineName, func(pipelineInfo *persist.PipelineInfo) {
+		pipelineInfo.Stopped = request.Stopped
+	}); err != nil {
+		return nil, err
+	}
+	return google_protobuf.EmptyInstance, nil
+}
+
+func (a *boltAPIServer) BlockPipelineState(ctx context.Context, request *persist.BlockPipelineStateRequest) (response *google_protobuf.Empty, err error) {
+	if err := a.waitFor(ctx, func(tx *bolt.Tx) (bool, error) {
+		pipelineInfo := &persist.PipelineInfo{}
+		ok, err := getMessage(tx, pipelineInfosTable, request.PipelineName, pipelineInfo)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, fmt.Errorf("%v %v not found", pipelineInfosTable, request.PipelineName)
+		}
+		return pipelineInfo.State == request.State, nil
+	}); err != nil {
+		return nil, err
+	}
+	return google_protobuf.EmptyInstance, nil
+}
+
+func (a *boltAPIServer) DeleteAll(ctx context.Context, request *google_protobuf.Empty) (response *google_protobuf.Empty, retErr error) {
+	if err := a.update(func(w *boltWriter) error {
+		if err := w.delete(jobInfosTable, newJobInfo, func(proto.Message) bool { return true }); err != nil {
+			return err
+		}
+		return w.delete(pipelineInfosTable, newPipelineInfo, func(proto.Message) bool { return true })
+	}); err != nil {
+		return nil, err
+	}
+	return google_protobuf.EmptyInstance, nil
+}
+
+// timestamp cannot be set
+func (a *boltAPIServer) CreatePipelineInfo(ctx context.Context, request *persist.PipelineInfo) (response *google_protobuf.Empty, err error) {
+	if request.CreatedAt != nil {
+		return nil, ErrTimestampSet
+	}
+	request.CreatedAt = prototime.TimeToTimestamp(a.timer.Now())
+	if err := a.update(func(w *boltWriter) error {
+		return w.insert(pipelineInfosTable, request.PipelineName, request)
+	}); err != nil {
+		return nil, err
+	}
+	return google_protobuf.EmptyInstance, nil
+}
+
+func (a *boltAPIServer) UpdatePipelineInfo(ctx context.Context, request *persist.PipelineInfo) (response *google_protobuf.Empty, err error) {
+	if request.CreatedAt != nil {
+		return nil, ErrTimestampSet
+	}
+	if err := a.updatePipelineInfo(request.PipelineName, func(pipelineInfo *persist.PipelineInfo) {
+		createdAt := pipelineInfo.CreatedAt
+		version := pipelineInfo.Version
+		*pipelineInfo = *request
+		pipelineInfo.CreatedAt = createdAt
+		pipelineInfo.Version = version + 1
+	}); err != nil {
+		return nil, err
+	}
+	return google_protobuf.EmptyInstance, nil
+}
+
+func (a *boltAPIServer) GetPipelineInfo(ctx context.Context, request *ppsclient.Pipeline) (response *persist.PipelineInfo, err error) {
+	pipelineInfo := &persist.PipelineInfo{}
+	if err := a.db.View(func(tx *bolt.Tx) error {
+		ok, err := getMessage(tx, pipelineInfosTable, request.Name, pipelineInfo)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("%v %v not found", pipelineInfosTable, request.Name)
+		}
+		pipelineInfo.JobCounts = make(map[int32]int32)
+		return forEachJobInfo(tx, func(jobInfo *persist.JobInfo) error {
+			if jobInfo.PipelineName == request.Name {
+				pipelineInfo.JobCounts[int32(jobInfo.State)]++
+			}
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return pipelineInfo, nil
+}
+
+func (a *boltAPIServer) ListPipelineInfos(ctx context.Context, request *persist.ListPipelineInfosRequest) (response *persist.PipelineInfos, retErr error) {
+	result := &persist.PipelineInfos{}
+	if err := a.db.View(func(tx *bolt.Tx) error {
+		return forEachPipelineInfo(tx, func(pipelineInfo *persist.PipelineInfo) error {
+			if request.Shard == nil || pipelineInfo.Shard == request.Shard.Number {
+				result.PipelineInfo = append(result.PipelineInfo, pipelineInfo)
+			}
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (a *boltAPIServer) DeletePipelineInfo(ctx context.Context, request *ppsclient.Pipeline) (response *google_protobuf.Empty, err error) {
+	if err := a.update(func(w *boltWriter) error {
+		return w.delete(pipelineInfosTable, newPipelineInfo, func(message proto.Message) bool {
+			return message.(*persist.PipelineInfo).PipelineName == request.Name
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return google_protobuf.EmptyInstance, nil
+}
+
+// changeType returns the type of a change to a subscription, given whether
+// the old and new values match the subscription's filter.  ok is false if
+// the change isn't visible to the subscription.  This is how rethinkdb
+// reports changes to filtered changefeeds.
+func changeType(change *boltChange, oldMatch bool, newMatch bool) (changeType persist.ChangeType, ok bool) {
+	oldMatch = oldMatch && change.oldVal != nil
+	newMatch = newMatch && change.newVal != nil
+	switch {
+	case oldMatch && newMatch:
+		return persist.ChangeType_UPDATE, true
+	case newMatch:
+		return persist.ChangeType_CREATE, true
+	case oldMatch:
+		return persist.ChangeType_DELETE, true
+	}
+	return 0, false
+}
+
+func (a *boltAPIServer) SubscribePipelineInfos(request *persist.SubscribePipelineInfosRequest, server persist.API_SubscribePipelineInfosServer) (retErr error) {
+	match := func(message proto.Message) bool {
+		return message != nil && (request.Shard == nil || message.(*persist.PipelineInfo).Shard == request.Shard.Number)
+	}
+	// Changes to State are not reported, see the rethink server.
+	withoutState := func(message proto.Message) *persist.PipelineInfo {
+		if message == nil {
+			return nil
+		}
+		pipelineInfo := proto.Clone(message).(*persist.PipelineInfo)
+		pipelineInfo.State = 0
+		return pipelineInfo
+	}
+
+	var initial []*persist.PipelineInfo
+	s, err := a.subscribe(pipelineInfosTable, func(tx *bolt.Tx) error {
+		if !request.IncludeInitial {
+			return nil
+		}
+		return forEachPipelineInfo(tx, func(pipelineInfo *persist.PipelineInfo) error {
+			if match(pipelineInfo) {
+				initial = append(initial, withoutState(pipelineInfo))
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+	defer a.unsubscribe(s)
+
+	for _, pipelineInfo := range initial {
+		if err := server.Send(&persist.PipelineInfoChange{
+			Pipeline: pipelineInfo,
+			Type:     persist.ChangeType_CREATE,
+		}); err != nil {
+			return err
+		}
+	}
+	for {
+		change, err := s.next(server.Context())
+		if err != nil {
+			return err
+		}
+		oldVal, newVal := withoutState(change.oldVal), withoutState(change.newVal)
+		typ, ok := changeType(change, match(change.oldVal), match(change.newVal))
+		if !ok || (typ == persist.ChangeType_UPDATE && proto.Equal(oldVal, newVal)) {
+			continue
+		}
+		pipelineInfo := newVal
+		if typ == persist.ChangeType_DELETE {
+			pipelineInfo = oldVal
+		}
+		if err := server.Send(&persist.PipelineInfoChange{
+			Pipeline: pipelineInfo,
+			Type:     typ,
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+func (a *boltAPIServer) SubscribeJobInfos(request *persist.SubscribeJobInfosRequest, server persist.API_SubscribeJobInfosServer) (retErr error) {
+	match := func(message proto.Message) bool {
+		if message == nil {
+			return false
+		}
+		jobInfo := message.(*persist.JobInfo)
+		if request.Shard != nil && jobInfo.Shard != request.Shard.Number {
+			return false
+		}
+		if len(request.State) == 0 {
+			return true
+		}
+		for _, state := range request.State {
+			if jobInfo.State == state {
+				return true
+			}
+		}
+		return false
+	}
+
+	var initial []*persist.JobInfo
+	s, err := a.subscribe(jobInfosTable, func(tx *bolt.Tx) error {
+		if !request.IncludeInitial {
+			return nil
+		}
+		return forEachJobInfo(tx, func(jobInfo *persist.JobInfo) error {
+			if match(jobInfo) {
+				initial = append(initial, jobInfo)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+	defer a.unsubscribe(s)
+
+	for _, jobInfo := range initial {
+		if err := server.Send(&persist.JobInfoChange{
+			JobInfo: jobInfo,
+			Type:    persist.ChangeType_CREATE,
+		}); err != nil {
+			return err
+		}
+	}
+	for {
+		change, err := s.next(server.Context())
+		if err != nil {
+			return err
+		}
+		typ, ok := changeType(change, match(change.oldVal), match(change.newVal))
+		if !ok || (typ == persist.ChangeType_UPDATE && !request.IncludeChanges) {
+			continue
+		}
+		jobInfo := change.newVal
+		if typ == persist.ChangeType_DELETE {
+			jobInfo = change.oldVal
+		}
+		if err := server.Send(&persist.JobInfoChange{
+			JobInfo: jobInfo.(*persist.JobInfo),
+			Type:    typ,
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+// AddChunk inserts an array of chunks into the database
+func (a *boltAPIServer) AddChunk(ctx context.Context, request *persist.AddChunkRequest) (response *google_protobuf.Empty, err error) {
+	if err := a.update(func(w *boltWriter) error {
+		for _, chunk := range request.Chunks {
+			if err := w.insert(chunksTable, chunk.ID, chunk); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return google_protobuf.EmptyInstance, nil
+}
+
+// ClaimChunk atomically switches the state of a chunk from UNASSIGNED to ASSIGNED
+func (a *boltAPIServer) ClaimChunk(ctx context.Context, request *persist.ClaimChunkRequest) (response *persist.Chunk, err error) {
+	var chunk *persist.Chunk
+	for {
+		changed := a.changes()
+		// retryTime is the earliest time a chunk which is waiting to be
+		// retried can be claimed.
+		var retryTime uint64
+		// Since bolt serializes read-write transactions, the chunk can't be
+		// claimed by someone else between finding and updating it.
+		if err := a.update(func(w *boltWriter) error {
+			now := uint64(time.Now().Unix())
+			if err := forEachChunk(w.tx, func(c *persist.Chunk) error {
+				if chunk != nil || c.JobID != request.JobID || c.State != persist.ChunkState_UNASSIGNED {
+					return nil
+				}
+				if c.RetryTime > now {
+					if retryTime == 0 || c.RetryTime < retryTime {
+						retryTime = c.RetryTime
+					}
+					return nil
+				}
+				chunk = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if chunk == nil {
+				return nil
+			}
+			chunk.Owner = request.Pod.Name
+			chunk.State = persist.ChunkState_ASSIGNED
+			chunk.LeaseTime = uint64(time.Now().Unix())
+			chunk.Pods = append(chunk.Pods, request.Pod)
+			chunk.Attempts++
+			return w.put(chunksTable, chunk.ID, chunk)
+		}); err != nil {
+			return nil, err
+		}
+		if chunk != nil {
+			return chunk, nil
+		}
+		var retry <-chan time.Time
+		if retryTime != 0 {
+			retry = time.After(time.Unix(int64(retryTime), 0).Sub(time.Now()))
+		}
+		select {
+		case <-changed:
+		case <-retry:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// updateAssignedChunk applies f to a chunk if it's assigned to the given
+// pod, and returns the chunk.
+func (a *boltAPIServer) updateAssignedChunk(chunkID string, podName string, f func(*persist.Chunk)) (*persist.Chunk, error) {
+	chunk := &persist.Chunk{}
+	if err := a.update(func(w *boltWriter) error {
+		ok, err := w.get(chunksTable, chunkID, chunk)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("%v %v not found", chunksTable, chunkID)
+		}
+		if chunk.Owner != podName || chunk.State != persist.ChunkState_ASSIGNED {
+			return nil
+		}
+		f(chunk)
+		return w.put(chunksTable, chunkID, chunk)
+	}); err != nil {
+		return nil, err
+	}
+	return chunk, nil
+}
+
+// RenewChunk updates the LeaseTime of a chunk to the current time
+func (a *boltAPIServer) RenewChunk(ctx context.Context, request *persist.RenewChunkRequest) (response *persist.Chunk, err error) {
+	return a.updateAssignedChunk(request.ChunkID, request.PodName, func(chunk *persist.Chunk) {
+		chunk.LeaseTime = uint64(time.Now().Unix())
+	})
+}
+
+// FinishChunk atomically switches the state of a chunk from ASSIGNED to SUCCESS
+func (a *boltAPIServer) FinishChunk(ctx context.Context, request *persist.FinishChunkRequest) (response *persist.Chunk, err error) {
+	return a.updateAssignedChunk(request.ChunkID, request.PodName, func(chunk *persist.Chunk) {
+		chunk.State = persist.ChunkState_SUCCESS
+	})
+}
+
+// RevokeChunk atomically switches the state of a chunk from ASSIGNED to either
+// FAILED or UNASSIGNED, depending on whether the number of pods in this chunk
+// exceeds a given number.
+func (a *boltAPIServer) RevokeChunk(ctx context.Context, request *persist.RevokeChunkRequest) (response *persist.Chunk, err error) {
+	return a.updateAssignedChunk(request.ChunkID, request.PodName, func(chunk *persist.Chunk) {
+		chunk.Errors = append(chunk.Errors, request.Error)
+		chunk.RetryTime = request.RetryTime
+		if uint64(len(chunk.Pods)) >= request.MaxPods {
+			chunk.State = persist.ChunkState_FAILED
+		} else {
+			chunk.State = persist.ChunkState_UNASSIGNED
+		}
+	})
+}
+
+func (a *boltAPIServer) StartJob(ctx context.Context, job *ppsclient.Job) (response *persist.JobInfo, err error) {
+	jobInfo := &persist.JobInfo{}
+	if err := a.update(func(w *boltWriter) error {
+		ok, err := w.get(jobInfosTable, job.ID, jobInfo)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("%v %v not found", jobInfosTable, job.ID)
+		}
+		if jobInfo.State != ppsclient.JobState_JOB_CREATING {
+			return nil
+		}
+		jobInfo.State = ppsclient.JobState_JOB_RUNNING
+		return w.put(jobInfosTable, job.ID, jobInfo)
+	}); err != nil {
+		return nil, err
+	}
+	return jobInfo, nil
+}
+
+func (a *boltAPIServer) SubscribeChunks(request *persist.SubscribeChunksRequest, server persist.API_SubscribeChunksServer) (retErr error) {
+	match := func(message proto.Message) bool {
+		return message != nil && message.(*persist.Chunk).JobID == request.Job.ID
+	}
+
+	var initial []*persist.Chunk
+	s, err := a.subscribe(chunksTable, func(tx *bolt.Tx) error {
+		if !request.IncludeInitial {
+			return nil
+		}
+		return forEachChunk(tx, func(chunk *persist.Chunk) error {
+			if match(chunk) {
+				initial = append(initial, chunk)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+	defer a.unsubscribe(s)
+
+	for _, chunk := range initial {
+		if err := server.Send(&persist.ChunkChange{
+			Chunk: chunk,
+			Type:  persist.ChangeType_CREATE,
+		}); err != nil {
+			return err
+		}
+	}
+	if err := server.Send(&persist.ChunkChange{
+		Ready: true,
+	}); err != nil {
+		return err
+	}
+	for {
+		change, err := s.next(server.Context())
+		if err != nil {
+			return err
+		}
+		typ, ok := changeType(change, match(change.oldVal), match(change.newVal))
+		if !ok {
+			continue
+		}
+		chunk := change.newVal
+		if typ == persist.ChangeType_DELETE {
+			chunk = change.oldVal
+		}
+		if err := server.Send(&persist.ChunkChange{
+			Chunk: chunk.(*persist.Chunk),
+			Type:  typ,
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+func (a *boltAPIServer) GetChunksForJob(ctx context.Context, job *ppsclient.Job) (response *persist.Chunks, err error) {
+	response = &persist.Chunks{}
+	if err := a.db.View(func(tx *bolt.Tx) error {
+		return forEachChunk(tx, func(chunk *persist.Chunk) error {
+			if chunk.JobID == job.ID {
+				response.Chunks = append(response.Chunks, chunk)
+			}
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (a *boltAPIServer) DeleteChunksForJob(ctx context.Context, job *ppsclient.Job) (response *google_protobuf.Empty, err error) {
+	if err := a.update(func(w *boltWriter) error {
+		return w.delete(chunksTable, newChunk, func(message proto.Message) bool {
+			return message.(*persist.Chunk).JobID == job.ID
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return google_protobuf.EmptyInstance, nil
+}
+
+// SetJobStatus blocks until there are no chunks left to be processed.
+func (a *boltAPIServer) SetJobStatus(ctx context.Context, job *ppsclient.Job) (response *google_protobuf.Empty, err error) {
+	if err := a.waitFor(ctx, func(tx *bolt.Tx) (bool, error) {
+		var remaining int
+		if err := forEachChunk(tx, func(chunk *persist.Chunk) error {
+			if chunk.State == persist.ChunkState_UNASSIGNED || chunk.State == persist.ChunkState_ASSIGNED {
+				remaining++
+			}
+			return nil
+		}); err != nil {
+			return false, err
+		}
+		return remaining == 0, nil
+	}); err != nil {
+		return nil, err
+	}
+	return google_protobuf.EmptyInstance, nil
+}
+
+func newJobInfo() proto.Message      { return &persist.JobInfo{} }
+func newPipelineInfo() proto.Message { return &persist.PipelineInfo{} }
+func newChunk() proto.Message        { return &persist.Chunk{} }
@@ -10,6 +10,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/sjezewski/pachyderm/src/client/pfs"
 	ppsclient "github.com/sjezewski/pachyderm/src/client/pps"
+	"github.com/sjezewski/pachyderm/src/server/pkg/promutil"
 	"github.com/sjezewski/pachyderm/src/server/pps/persist"
 
 	"go.pedge.io/pb/go/google/protobuf"
@@ -180,7 +181,7 @@ func newRethinkAPIServer(address string, databaseName string) (*rethinkAPIServer
 		return nil, err
 	}
 	return &rethinkAPIServer{
-		protorpclog.NewLogger("pps.persist.API"),
+		promutil.NewLogger("pps.persist.API"),
 		session,
 		databaseName,
 		pkgtime.NewSystemTimer(),
@@ -558,18 +559,80 @@ func (a *rethinkAPIServer) AddChunk(ctx context.Context, request *persist.AddChu
 
 // ClaimChunk atomically switches the state of a chunk from UNASSIGNED to ASSIGNED
 func (a *rethinkAPIServer) ClaimChunk(ctx context.Context, request *persist.ClaimChunkRequest) (response *persist.Chunk, err error) {
+	// The changefeed is opened before looking for chunks, so that chunks
+	// which become unassigned in between aren't missed.
 	cursor, err := a.getTerm(chunksTable).Filter(map[string]interface{}{
 		"JobID": request.JobID,
 		"State": persist.ChunkState_UNASSIGNED,
-	}).Changes(gorethink.ChangesOpts{
-		IncludeInitial: true,
-	}).Field("new_val").Run(a.session)
+	}).Changes().Field("new_val").Run(a.session)
 	if err != nil {
 		return nil, err
 	}
 	defer cursor.Close()
-	chunk := &persist.Chunk{}
-	for cursor.Next(chunk) {
+	changed := make(chan struct{}, 1)
+	go func() {
+		defer close(changed)
+		var chunk persist.Chunk
+		for cursor.Next(&chunk) {
+			select {
+			case changed <- struct{}{}:
+			default:
+			}
+		}
+	}()
+	for {
+		chunk, retryTime, err := a.claimChunk(request)
+		if err != nil {
+			return nil, err
+		}
+		if chunk != nil {
+			return chunk, nil
+		}
+		// The changefeed won't tell us when a chunk which is waiting to be
+		// retried becomes claimable, so we also wait for the earliest one.
+		var retry <-chan time.Time
+		if retryTime != 0 {
+			retry = time.After(time.Unix(int64(retryTime), 0).Sub(time.Now()))
+		}
+		select {
+		case _, ok := <-changed:
+			if !ok {
+				if err := cursor.Err(); err != nil {
+					return nil, err
+				}
+				return nil, fmt.Errorf("changefeed of chunks of job %s closed", request.JobID)
+			}
+		case <-retry:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// claimChunk claims one of a job's unassigned chunks which can be claimed
+// now, if any.  Otherwise it returns the earliest time that one which is
+// waiting to be retried can be claimed, or 0 if none are.
+func (a *rethinkAPIServer) claimChunk(request *persist.ClaimChunkRequest) (*persist.Chunk, uint64, error) {
+	cursor, err := a.getTerm(chunksTable).Filter(map[string]interface{}{
+		"JobID": request.JobID,
+		"State": persist.ChunkState_UNASSIGNED,
+	}).Run(a.session)
+	if err != nil {
+		return nil, 0, err
+	}
+	var chunks []*persist.Chunk
+	if err := cursor.All(&chunks); err != nil {
+		return nil, 0, err
+	}
+	now := uint64(time.Now().Unix())
+	var retryTime uint64
+	for _, chunk := range chunks {
+		if chunk.RetryTime > now {
+			if retryTime == 0 || chunk.RetryTime < retryTime {
+				retryTime = chunk.RetryTime
+			}
+			continue
+		}
 		changes, err := a.getTerm(chunksTable).Get(chunk.ID).Update(func(chunk gorethink.Term) gorethink.Term {
 			return gorethink.Branch(
 				// The state of the chunk might have changed between when we query
@@ -580,6 +643,7 @@ func (a *rethinkAPIServer) ClaimChunk(ctx context.Context, request *persist.Clai
 					"State":       persist.ChunkState_ASSIGNED,
 					"TimeTouched": time.Now().Unix(),
 					"Pods":        chunk.Field("Pods").Append(request.Pod),
+					"Attempts":    chunk.Field("Attempts").Default(0).Add(1),
 				},
 				nil,
 			)
@@ -587,21 +651,20 @@ func (a *rethinkAPIServer) ClaimChunk(ctx context.Context, request *persist.Clai
 			ReturnChanges: true,
 		}).Field("changes").Field("new_val").Run(a.session)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 		var changedChunks []*persist.Chunk
 		if err := changes.All(&changedChunks); err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 		// If len(changedChunks) == 1, that means we successfully updated
 		// the chunk.  Update can fail when there's another process trying
 		// to claim the same chunk.
 		if len(changedChunks) == 1 {
-			chunk = changedChunks[0]
-			break
+			return changedChunks[0], 0, nil
 		}
 	}
-	return chunk, nil
+	return nil, retryTime, nil
 }
 
 // RenewChunk updates the LeaseTime of a chunk to the current time
@@ -667,6 +730,8 @@ func (a *rethinkAPIServer) RevokeChunk(ctx context.Context, request *persist.Rev
 				persist.ChunkState_FAILED,
 				persist.ChunkState_UNASSIGNED,
 			),
+			"Errors":    gorethink.Row.Field("Errors").Default([]string{}).Append(request.Error),
+			"RetryTime": request.RetryTime,
 		},
 		nil,
 	), gorethink.UpdateOpts{
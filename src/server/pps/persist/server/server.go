@@ -26,3 +26,9 @@ type APIServer interface {
 func NewRethinkAPIServer(address string, databaseName string) (APIServer, error) {
 	return newRethinkAPIServer(address, databaseName)
 }
+
+// NewBoltAPIServer creates an APIServer which stores its data in a BoltDB
+// file at the given path.
+func NewBoltAPIServer(path string) (APIServer, error) {
+	return newBoltAPIServer(path)
+}
@@ -1,13 +1,18 @@
 package pps
 
 import (
+	"github.com/sjezewski/pachyderm/src/client/pkg/grpcutil"
 	"google.golang.org/grpc"
 )
 
 // NewInternalPodAPIClientFromAddress creates an InternalPodAPIClient
-// connecting to pachd at pachAddr.
+// connecting to pachd at pachAddr. TLS is configured from the environment.
 func NewInternalPodAPIClientFromAddress(pachAddr string) (InternalPodAPIClient, error) {
-	clientConn, err := grpc.Dial(pachAddr, grpc.WithInsecure())
+	dialOption, err := grpcutil.DialOptionFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	clientConn, err := grpc.Dial(pachAddr, dialOption)
 	if err != nil {
 		return nil, err
 	}
@@ -85,9 +85,15 @@ func (m *StartPodResponse) GetCommitMounts() []*fuse.CommitMount {
 }
 
 type FinishPodRequest struct {
-	ChunkID string `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId" json:"chunk_id,omitempty"`
-	PodName string `protobuf:"bytes,2,opt,name=pod_name,json=podName" json:"pod_name,omitempty"`
-	Success bool   `protobuf:"varint,3,opt,name=success" json:"success,omitempty"`
+	ChunkID string    `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId" json:"chunk_id,omitempty"`
+	PodName string    `protobuf:"bytes,2,opt,name=pod_name,json=podName" json:"pod_name,omitempty"`
+	Success bool      `protobuf:"varint,3,opt,name=success" json:"success,omitempty"`
+	Job     *pps1.Job `protobuf:"bytes,4,opt,name=job" json:"job,omitempty"`
+	// why the pod failed, if it did
+	Error string `protobuf:"bytes,5,opt,name=error" json:"error,omitempty"`
+	// the code the transform exited with, if the pod failed because it exited
+	// with a code it doesn't accept
+	ExitCode int64 `protobuf:"varint,6,opt,name=exit_code,json=exitCode" json:"exit_code,omitempty"`
 }
 
 func (m *FinishPodRequest) Reset()                    { *m = FinishPodRequest{} }
@@ -95,6 +101,13 @@ func (m *FinishPodRequest) String() string            { return proto.CompactText
 func (*FinishPodRequest) ProtoMessage()               {}
 func (*FinishPodRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
 
+func (m *FinishPodRequest) GetJob() *pps1.Job {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
 type FinishPodResponse struct {
 	// If fail is true, the pod is expected to exit with a non-zero code
 	// so that k8s knows to reschedule the pod.
@@ -278,32 +291,34 @@ var _InternalPodAPI_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("server/pps/pps.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 419 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xa4, 0x53, 0x5d, 0xcb, 0xd3, 0x30,
-	0x18, 0xb5, 0x56, 0x5d, 0xfb, 0x54, 0x5f, 0xdf, 0x37, 0x4e, 0xad, 0xf5, 0x66, 0xf4, 0xc6, 0x0a,
-	0xb2, 0x42, 0x05, 0x41, 0xf0, 0xc6, 0x0d, 0xc4, 0x0d, 0x94, 0x11, 0xbd, 0xf2, 0x66, 0xf4, 0x23,
-	0xdd, 0xa2, 0x6d, 0x12, 0x9b, 0x54, 0xf4, 0x77, 0xf8, 0xbb, 0xfc, 0x4f, 0x92, 0x6c, 0x5d, 0x6b,
-	0x87, 0xde, 0x78, 0xd1, 0x92, 0xe7, 0x3c, 0x27, 0xe7, 0xe4, 0xe4, 0x03, 0xa6, 0x92, 0x34, 0xdf,
-	0x48, 0x13, 0x0b, 0x21, 0xf5, 0x37, 0x17, 0x0d, 0x57, 0x1c, 0xd9, 0x42, 0xc8, 0xe0, 0xf1, 0x8e,
-	0xf3, 0x5d, 0x45, 0x62, 0x03, 0x65, 0x6d, 0x19, 0x93, 0x5a, 0xa8, 0x1f, 0x07, 0x46, 0x10, 0x74,
-	0xf3, 0x4a, 0x19, 0x97, 0xad, 0x24, 0xe6, 0x77, 0xec, 0x4d, 0xf3, 0x8a, 0x12, 0xa6, 0x4c, 0x4f,
-	0x94, 0x72, 0x8c, 0x0e, 0x9d, 0xc2, 0xb7, 0x70, 0xf7, 0x83, 0x4a, 0x1b, 0xb5, 0xe1, 0x05, 0x26,
-	0x5f, 0x5b, 0x22, 0x15, 0x0a, 0xc0, 0xfe, 0xcc, 0x33, 0xdf, 0x9a, 0x59, 0x91, 0x97, 0x38, 0x73,
-	0xcd, 0x5d, 0xf3, 0x0c, 0x6b, 0x10, 0x3d, 0x02, 0x47, 0xf0, 0x62, 0xcb, 0xd2, 0x9a, 0xf8, 0xd7,
-	0x67, 0x56, 0xe4, 0xe2, 0x89, 0xe0, 0xc5, 0xfb, 0xb4, 0x26, 0xe1, 0x4f, 0x0b, 0x2e, 0x7b, 0x29,
-	0x29, 0x38, 0x93, 0x44, 0xf3, 0xf3, 0x7d, 0xcb, 0xbe, 0x6c, 0x69, 0x61, 0x04, 0x5d, 0x3c, 0x31,
-	0xf5, 0xaa, 0x40, 0xcf, 0xc0, 0x55, 0x4d, 0xca, 0x64, 0xc9, 0x9b, 0xda, 0x68, 0x79, 0xc9, 0x85,
-	0x31, 0xfb, 0xd8, 0xa1, 0xb8, 0x27, 0xa0, 0x17, 0x70, 0x27, 0xe7, 0x75, 0x4d, 0xd5, 0xb6, 0xe6,
-	0x2d, 0x53, 0xd2, 0xb7, 0x67, 0x76, 0xe4, 0x25, 0x57, 0x73, 0x93, 0x7b, 0x69, 0x5a, 0xef, 0x74,
-	0x07, 0xdf, 0xce, 0xfb, 0x42, 0x86, 0x19, 0x5c, 0xbe, 0xa1, 0x8c, 0xca, 0xfd, 0x20, 0xe0, 0x3f,
-	0x16, 0xf5, 0xf7, 0x7c, 0xc8, 0x87, 0x89, 0x6c, 0xf3, 0x9c, 0x48, 0xed, 0x6d, 0x45, 0x0e, 0xee,
-	0xca, 0xf0, 0x09, 0x5c, 0x0d, 0x3c, 0x8e, 0xc9, 0x11, 0xdc, 0x28, 0x53, 0x5a, 0x19, 0x03, 0x07,
-	0x9b, 0x71, 0xb8, 0x06, 0xb4, 0xe4, 0x4c, 0x51, 0xd6, 0x92, 0xff, 0x5d, 0x4e, 0xf8, 0x14, 0xee,
-	0xfd, 0xa1, 0xd5, 0xdb, 0x92, 0xef, 0x54, 0x75, 0xb6, 0x7a, 0x9c, 0xfc, 0xb2, 0xe0, 0x62, 0xc5,
-	0x14, 0x69, 0x58, 0x5a, 0x6d, 0x78, 0xf1, 0x7a, 0xb3, 0x42, 0x2f, 0xc1, 0xe9, 0xce, 0x0a, 0x4d,
-	0xcd, 0xae, 0x8f, 0x6e, 0x41, 0x70, 0x7f, 0x84, 0x1e, 0xf4, 0xc3, 0x6b, 0x68, 0x01, 0xde, 0xc0,
-	0x18, 0x3d, 0x34, 0xbc, 0xf3, 0x58, 0x81, 0x7f, 0xde, 0x38, 0x69, 0xbc, 0x02, 0xf7, 0xb4, 0x63,
-	0xe8, 0xe0, 0x34, 0x3e, 0xa5, 0xe0, 0xc1, 0x18, 0xee, 0x66, 0x2f, 0x6e, 0x7e, 0xd2, 0xef, 0x23,
-	0xbb, 0x65, 0x6e, 0xf0, 0xf3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xaf, 0xa9, 0x80, 0x41, 0x43,
-	0x03, 0x00, 0x00,
+	// 457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0x25, 0x64, 0xbb, 0x4d, 0xa6, 0xb0, 0xec, 0x9a, 0x02, 0x21, 0x7b, 0xa9, 0x72, 0x21, 0x48,
+	0xa8, 0x95, 0x8a, 0x84, 0x84, 0xc4, 0x85, 0xad, 0x84, 0xe8, 0x4a, 0xa0, 0xca, 0x70, 0xe2, 0x52,
+	0xa5, 0xc9, 0x64, 0x37, 0xd0, 0x78, 0x8c, 0xed, 0x20, 0xf8, 0x0e, 0xbe, 0x84, 0x0f, 0xe1, 0x9f,
+	0x90, 0xdd, 0xcd, 0xb6, 0xa4, 0xc0, 0x85, 0x43, 0x22, 0xcf, 0x9b, 0xf1, 0x7b, 0x33, 0xcf, 0x36,
+	0x0c, 0x35, 0xaa, 0x2f, 0xa8, 0x26, 0x52, 0x6a, 0xfb, 0x8d, 0xa5, 0x22, 0x43, 0xcc, 0x97, 0x52,
+	0xc7, 0xa7, 0x17, 0x44, 0x17, 0x6b, 0x9c, 0x38, 0x68, 0xd5, 0x94, 0x13, 0xac, 0xa5, 0xf9, 0xb6,
+	0xa9, 0x88, 0xe3, 0x76, 0x5f, 0xa9, 0x27, 0x65, 0xa3, 0xd1, 0xfd, 0xae, 0x72, 0xc3, 0x7c, 0x5d,
+	0xa1, 0x30, 0x2e, 0x27, 0x4b, 0xdd, 0x45, 0x77, 0x95, 0x92, 0xd7, 0x70, 0xe7, 0x9d, 0xc9, 0x94,
+	0x59, 0x50, 0xc1, 0xf1, 0x73, 0x83, 0xda, 0xb0, 0x18, 0xfc, 0x8f, 0xb4, 0x8a, 0xbc, 0x91, 0x97,
+	0x0e, 0xa6, 0xc1, 0xd8, 0xd6, 0x9e, 0xd3, 0x8a, 0x5b, 0x90, 0x3d, 0x84, 0x40, 0x52, 0xb1, 0x14,
+	0x59, 0x8d, 0xd1, 0xcd, 0x91, 0x97, 0x86, 0xbc, 0x2f, 0xa9, 0x78, 0x9b, 0xd5, 0x98, 0x7c, 0xf7,
+	0xe0, 0x78, 0x4b, 0xa5, 0x25, 0x09, 0x8d, 0xb6, 0x3e, 0xbf, 0x6c, 0xc4, 0xa7, 0x65, 0x55, 0x38,
+	0xc2, 0x90, 0xf7, 0x5d, 0x3c, 0x2f, 0xd8, 0x13, 0x08, 0x8d, 0xca, 0x84, 0x2e, 0x49, 0xd5, 0x8e,
+	0x6b, 0x30, 0x3d, 0x72, 0x62, 0xef, 0x5b, 0x94, 0x6f, 0x0b, 0xd8, 0x33, 0xb8, 0x9d, 0x53, 0x5d,
+	0x57, 0x66, 0x59, 0x53, 0x23, 0x8c, 0x8e, 0xfc, 0x91, 0x9f, 0x0e, 0xa6, 0x27, 0x63, 0x37, 0xf7,
+	0xcc, 0xa5, 0xde, 0xd8, 0x0c, 0xbf, 0x95, 0x6f, 0x03, 0x9d, 0xfc, 0xf0, 0xe0, 0xf8, 0x55, 0x25,
+	0x2a, 0x7d, 0xb9, 0x33, 0xe1, 0x3f, 0xba, 0xfa, 0xfb, 0x80, 0x2c, 0x82, 0xbe, 0x6e, 0xf2, 0x1c,
+	0xb5, 0x15, 0xf7, 0xd2, 0x80, 0xb7, 0x61, 0xeb, 0xd8, 0xc1, 0x9f, 0x1c, 0x1b, 0x42, 0x0f, 0x95,
+	0x22, 0x15, 0xf5, 0x1c, 0xdb, 0x26, 0x60, 0xa7, 0x10, 0xe2, 0xd7, 0xca, 0x2c, 0x73, 0x2a, 0x30,
+	0x3a, 0x1c, 0x79, 0xa9, 0xcf, 0x03, 0x0b, 0xcc, 0xa8, 0xc0, 0xe4, 0x11, 0x9c, 0xec, 0xb4, 0x7c,
+	0xe5, 0x24, 0x83, 0x83, 0x32, 0xab, 0xd6, 0xae, 0xdf, 0x80, 0xbb, 0x75, 0x72, 0x0e, 0x6c, 0x46,
+	0xc2, 0x54, 0xa2, 0xc1, 0xff, 0x9d, 0x2e, 0x79, 0x0c, 0x77, 0x7f, 0xe3, 0xda, 0xca, 0xda, 0xbe,
+	0x5a, 0x59, 0xbb, 0x9e, 0xfe, 0xf4, 0xe0, 0x68, 0x2e, 0x0c, 0x2a, 0x91, 0xad, 0x17, 0x54, 0xbc,
+	0x5c, 0xcc, 0xd9, 0x73, 0x08, 0xda, 0xb3, 0x67, 0x43, 0x67, 0x40, 0xe7, 0x56, 0xc5, 0xf7, 0x3a,
+	0xe8, 0x86, 0x3f, 0xb9, 0xc1, 0xce, 0x60, 0xb0, 0x23, 0xcc, 0x1e, 0xb8, 0xba, 0xfd, 0xb1, 0xe2,
+	0x68, 0x3f, 0x71, 0xcd, 0xf1, 0x02, 0xc2, 0x6b, 0xc7, 0xd8, 0x46, 0xa9, 0x7b, 0xe8, 0xf1, 0xfd,
+	0x2e, 0xdc, 0xee, 0x3e, 0xeb, 0x7d, 0xb0, 0xef, 0x6d, 0x75, 0xe8, 0x5e, 0xc4, 0xd3, 0x5f, 0x03,
+	0x00, 0x35, 0xe1, 0x9d, 0x15, 0x93, 0x03, 0x00, 0x00,
 }
@@ -67,7 +67,7 @@ func PrintPipelineInfo(w io.Writer, pipelineInfo *ppsclient.PipelineInfo) {
 
 // PrintJobInputHeader pretty prints a job input header.
 func PrintJobInputHeader(w io.Writer) {
-	fmt.Fprint(w, "NAME\tCOMMIT\tPARTITION\tINCREMENTAL\t\n")
+	fmt.Fprint(w, "NAME\tCOMMIT\tPARTITION\tINCREMENTAL\tGLOB\t\n")
 }
 
 // PrintJobInput pretty-prints a job input.
@@ -75,19 +75,26 @@ func PrintJobInput(w io.Writer, jobInput *ppsclient.JobInput) {
 	fmt.Fprintf(w, "%s\t", jobInput.Commit.Repo.Name)
 	fmt.Fprintf(w, "%s\t", jobInput.Commit.ID)
 	fmt.Fprintf(w, "%s\t", jobInput.Method.Partition)
-	fmt.Fprintf(w, "%s\t\n", jobInput.Method.Incremental)
+	fmt.Fprintf(w, "%s\t", jobInput.Method.Incremental)
+	fmt.Fprintf(w, "%s\t\n", globOrDash(jobInput.Glob))
 }
 
 // PrintPipelineInputHeader prints a pipeline input header.
 func PrintPipelineInputHeader(w io.Writer) {
-	fmt.Fprint(w, "NAME\tPARTITION\tINCREMENTAL\t\n")
+	fmt.Fprint(w, "NAME\tPARTITION\tINCREMENTAL\tGLOB\tCRON\t\n")
 }
 
 // PrintPipelineInput pretty-prints a pipeline input.
 func PrintPipelineInput(w io.Writer, pipelineInput *ppsclient.PipelineInput) {
 	fmt.Fprintf(w, "%s\t", pipelineInput.Repo.Name)
 	fmt.Fprintf(w, "%s\t", pipelineInput.Method.Partition)
-	fmt.Fprintf(w, "%s\t\n", pipelineInput.Method.Incremental)
+	fmt.Fprintf(w, "%s\t", pipelineInput.Method.Incremental)
+	fmt.Fprintf(w, "%s\t", globOrDash(pipelineInput.Glob))
+	if pipelineInput.Cron != nil {
+		fmt.Fprintf(w, "%s\t\n", pipelineInput.Cron.Spec)
+	} else {
+		fmt.Fprintf(w, "-\t\n")
+	}
 }
 
 // PrintJobCountsHeader prints a job counts header.
@@ -107,6 +114,7 @@ Started: {{prettyAgo .Started}} {{if .Finished}}
 Duration: {{prettyDuration .Started .Finished}} {{end}}
 State: {{jobState .State}}
 ParallelismSpec: {{.ParallelismSpec}}
+Combinator: {{.Combinator}}
 Inputs:
 {{jobInputs .}}Transform:
 {{prettyTransform .Transform}}
@@ -130,6 +138,7 @@ func PrintDetailedPipelineInfo(pipelineInfo *ppsclient.PipelineInfo) error {
 Created: {{prettyAgo .CreatedAt}}
 State: {{pipelineState .State}}
 ParallelismSpec: {{.ParallelismSpec}}
+Combinator: {{.Combinator}}
 Inputs:
 {{pipelineInputs .}}Transform:
 {{prettyTransform .Transform}}
@@ -248,11 +257,11 @@ func prettyTransform(transform *ppsclient.Transform) (string, error) {
 func prettyChunks(chunks []*ppsclient.Chunk) (string, error) {
 	var buffer bytes.Buffer
 	for i, chunk := range chunks {
-		fmt.Fprintf(&buffer, "\nChunk %d: %s\n", i+1, chunkState(chunk.State))
+		fmt.Fprintf(&buffer, "\nChunk %d: %s, attempts: %d\n", i+1, chunkState(chunk.State), chunk.Attempts)
 		writer := tabwriter.NewWriter(&buffer, 20, 1, 3, ' ', 0)
-		fmt.Fprintf(writer, "Pod Name\tOutput Commit\tState\t\n")
+		fmt.Fprintf(writer, "Pod Name\tOutput Commit\tState\tError\t\n")
 		for _, pod := range chunk.Pods {
-			fmt.Fprintf(writer, "%s\t%s\t%s\t\n", pod.Name, pod.OutputCommit.ID, podState(pod.State))
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t\n", pod.Name, pod.OutputCommit.ID, podState(pod.State), pod.Error)
 		}
 		if err := writer.Flush(); err != nil {
 			return "", err
@@ -262,6 +271,13 @@ func prettyChunks(chunks []*ppsclient.Chunk) (string, error) {
 	return buffer.String(), nil
 }
 
+func globOrDash(glob string) string {
+	if glob == "" {
+		return "-"
+	}
+	return glob
+}
+
 var funcMap = template.FuncMap{
 	"pipelineState":   pipelineState,
 	"jobState":        jobState,
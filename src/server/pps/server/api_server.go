@@ -13,8 +13,11 @@ import (
 
 	"github.com/sjezewski/pachyderm/src/client"
 	pfsclient "github.com/sjezewski/pachyderm/src/client/pfs"
+	"github.com/sjezewski/pachyderm/src/client/pkg/grpcutil"
+	"github.com/sjezewski/pachyderm/src/client/pkg/tracing"
 	"github.com/sjezewski/pachyderm/src/client/pkg/uuid"
 	ppsclient "github.com/sjezewski/pachyderm/src/client/pps"
+	pfsserver "github.com/sjezewski/pachyderm/src/server/pfs"
 	"github.com/sjezewski/pachyderm/src/server/pfs/fuse"
 	"github.com/sjezewski/pachyderm/src/server/pkg/lease"
 	ppsserver "github.com/sjezewski/pachyderm/src/server/pps"
@@ -95,6 +98,11 @@ type apiServer struct {
 	namespace          string
 	jobShimImage       string
 	jobImagePullPolicy string
+	// tlsSecret is the kubernetes secret holding pachd's TLS files, it's
+	// mounted into job pods at the same path it's mounted in pachd so that
+	// tlsOptions can be passed along to the job-shim unchanged.
+	tlsSecret  string
+	tlsOptions *grpcutil.TLSOptions
 }
 
 // JobInputs implements sort.Interface so job inputs can be sorted
@@ -166,6 +174,16 @@ func (a *apiServer) CreateJob(ctx context.Context, request *ppsclient.CreateJobR
 	// In case some inputs have not provided a method, we set the default
 	// method for them
 	setDefaultJobInputMethod(request.Inputs)
+	var globs []string
+	for _, input := range request.Inputs {
+		if err := validateMethod(input.Method); err != nil {
+			return nil, err
+		}
+		if err := validateGlob(input.Method, input.Glob); err != nil {
+			return nil, err
+		}
+		globs = append(globs, input.Glob)
+	}
 
 	var pipelineInfo *ppsclient.PipelineInfo
 	if request.Pipeline != nil {
@@ -180,6 +198,10 @@ func (a *apiServer) CreateJob(ctx context.Context, request *ppsclient.CreateJobR
 	if request.Pipeline != nil && request.Transform == nil {
 		request.Transform = pipelineInfo.Transform
 		request.ParallelismSpec = pipelineInfo.ParallelismSpec
+		request.Combinator = pipelineInfo.Combinator
+	}
+	if err := validateCombinator(request.Combinator, globs); err != nil {
+		return nil, err
 	}
 	repoSet := make(map[string]bool)
 	for _, input := range request.Inputs {
@@ -304,6 +326,7 @@ func (a *apiServer) CreateJob(ctx context.Context, request *ppsclient.CreateJobR
 		Inputs:       request.Inputs,
 		ParentJob:    request.ParentJob,
 		OutputCommit: outputCommit,
+		Combinator:   request.Combinator,
 		Shard: a.hasher.HashJob(&ppsclient.Job{
 			ID: jobID,
 		}),
@@ -324,7 +347,7 @@ func (a *apiServer) CreateJob(ctx context.Context, request *ppsclient.CreateJobR
 		if err != nil {
 			return nil, err
 		}
-		shardModuli, err = a.shardModuli(ctx, request.Inputs, numWorkers, repoToFromCommit)
+		shardModuli, err = a.shardModuli(ctx, request.Inputs, request.Combinator, numWorkers, repoToFromCommit)
 		_, ok := err.(*errEmptyInput)
 		if err != nil && !ok {
 			return nil, err
@@ -353,7 +376,7 @@ func (a *apiServer) CreateJob(ctx context.Context, request *ppsclient.CreateJobR
 
 		persistJobInfo.ParallelismSpec = &ppsclient.ParallelismSpec{
 			Strategy: ppsclient.ParallelismSpec_CONSTANT,
-			Constant: product(shardModuli),
+			Constant: numChunks(request.Combinator, shardModuli),
 		}
 		persistJobInfo.DefaultShardModuli = shardModuli
 	}
@@ -370,13 +393,13 @@ func (a *apiServer) CreateJob(ctx context.Context, request *ppsclient.CreateJobR
 	// that the job has been finished, when in reality the chunks haven't
 	// even been created.
 	//
-	// Right now numWorkers == numChunks, but it may not remain that way.
-	numChunks, err := GetExpectedNumWorkers(a.kubeClient, persistJobInfo.ParallelismSpec)
+	// Right now numWorkers == chunkCount, but it may not remain that way.
+	chunkCount, err := GetExpectedNumWorkers(a.kubeClient, persistJobInfo.ParallelismSpec)
 	if err != nil {
 		return nil, err
 	}
 	var chunks []*persist.Chunk
-	for i := 0; i < int(numChunks); i++ {
+	for i := 0; i < int(chunkCount); i++ {
 		chunk := &persist.Chunk{
 			ID:     uuid.New(),
 			JobID:  jobID,
@@ -411,7 +434,7 @@ func (a *apiServer) CreateJob(ctx context.Context, request *ppsclient.CreateJobR
 		}
 	}()
 
-	job, err := job(a.kubeClient, persistJobInfo, a.jobShimImage, a.jobImagePullPolicy)
+	job, err := job(a.kubeClient, persistJobInfo, a.jobShimImage, a.jobImagePullPolicy, a.tlsSecret, a.tlsOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -432,9 +455,14 @@ func (a *apiServer) CreateJob(ctx context.Context, request *ppsclient.CreateJobR
 // 2. Double the modulus of the input that currently has the highest size/modulus
 // ratio, but only if doing so does not result in empty shards.  If it does, we
 // remove the input from further consideration.
-// 3. Repeat step 2, until the product of the moduli hits the given parallelism,
-// or until all inputs have been removed from consideration.
-func (a *apiServer) shardModuli(ctx context.Context, inputs []*ppsclient.JobInput, parallelism uint64, repoToFromCommit map[string]*pfsclient.Commit) ([]uint64, error) {
+// 3. Repeat step 2, until the number of chunks the moduli make hits the given
+// parallelism, or until all inputs have been removed from consideration.
+//
+// Joined inputs have to be split the same way, so they share one modulus,
+// which is doubled for as long as some input has no empty shards.  Unioned
+// inputs which are empty, or which haven't changed since the parent job, get
+// a modulus of 0, since there's nothing for them to process.
+func (a *apiServer) shardModuli(ctx context.Context, inputs []*ppsclient.JobInput, combinator ppsclient.Combinator, parallelism uint64, repoToFromCommit map[string]*pfsclient.Commit) ([]uint64, error) {
 	pfsClient, err := a.getPfsClient()
 	if err != nil {
 		return nil, err
@@ -444,7 +472,8 @@ func (a *apiServer) shardModuli(ctx context.Context, inputs []*ppsclient.JobInpu
 	var inputSizes []uint64
 	limitHit := make(map[int]bool)
 	for i, input := range inputs {
-		if input.Method.Partition == ppsclient.Partition_REPO {
+		shardModuli = append(shardModuli, 1)
+		if input.Method.Partition == ppsclient.Partition_REPO && input.Glob == "" {
 			// A global input shouldn't be partitioned
 			limitHit[i] = true
 		}
@@ -460,16 +489,52 @@ func (a *apiServer) shardModuli(ctx context.Context, inputs []*ppsclient.JobInpu
 			if input.RunEmpty {
 				// An empty input shouldn't be partitioned
 				limitHit[i] = true
+			} else if combinator == ppsclient.Combinator_UNION {
+				// The other inputs of a union can still be processed
+				shardModuli[i] = 0
+				limitHit[i] = true
 			} else {
 				return nil, newErrEmptyInput(input.Commit.ID)
 			}
 		}
 
+		fromCommit := repoToFromCommit[input.Commit.Repo.Name]
+		if combinator == ppsclient.Combinator_UNION && fromCommit != nil && fromCommit.ID == input.Commit.ID {
+			// The parent job already processed this input
+			shardModuli[i] = 0
+			limitHit[i] = true
+		}
+
 		inputSizes = append(inputSizes, commitInfo.SizeBytes)
-		shardModuli = append(shardModuli, 1)
 	}
 
-	for product(shardModuli) < parallelism && len(limitHit) < len(inputs) {
+	if numChunks(combinator, shardModuli) == 0 {
+		return nil, newErrEmptyInput(inputs[0].Commit.ID)
+	}
+
+	if isJoin(combinator) {
+		modulus := uint64(1)
+	Double:
+		for modulus < parallelism {
+			for _, input := range inputs {
+				b, err := a.noEmptyShards(ctx, input, combinator, modulus*2, repoToFromCommit)
+				if err != nil {
+					return nil, err
+				}
+				if b {
+					modulus *= 2
+					continue Double
+				}
+			}
+			break
+		}
+		for i := range shardModuli {
+			shardModuli[i] = modulus
+		}
+		return shardModuli, nil
+	}
+
+	for numChunks(combinator, shardModuli) < parallelism && len(limitHit) < len(inputs) {
 		max := float64(0)
 		modulusIndex := 0
 		// Find the modulus to double
@@ -484,7 +549,7 @@ func (a *apiServer) shardModuli(ctx context.Context, inputs []*ppsclient.JobInpu
 			}
 		}
 
-		b, err := a.noEmptyShards(ctx, inputs[modulusIndex], shardModuli[modulusIndex]*2, repoToFromCommit)
+		b, err := a.noEmptyShards(ctx, inputs[modulusIndex], combinator, shardModuli[modulusIndex]*2, repoToFromCommit)
 		if err != nil {
 			return nil, err
 		}
@@ -518,23 +583,24 @@ func product(numbers []uint64) uint64 {
 // input and a modulus number.
 //
 // TODO: it's very inefficient as of now, since it involves many calls to ListFile
-func (a *apiServer) noEmptyShards(ctx context.Context, input *ppsclient.JobInput, modulus uint64, repoToFromCommit map[string]*pfsclient.Commit) (bool, error) {
+func (a *apiServer) noEmptyShards(ctx context.Context, input *ppsclient.JobInput, combinator ppsclient.Combinator, modulus uint64, repoToFromCommit map[string]*pfsclient.Commit) (bool, error) {
 	pfsClient, err := a.getPfsClient()
 	if err != nil {
 		return false, err
 	}
 
 	for i := 0; i < int(modulus); i++ {
+		shard, err := jobInputShard(input, combinator, uint64(i), modulus)
+		if err != nil {
+			return false, err
+		}
 		listFileRequest := &pfsclient.ListFileRequest{
 			File: &pfsclient.File{
 				Commit: input.Commit,
 				Path:   "", // the root directory
 			},
-			Shard: &pfsclient.Shard{
-				FileModulus:  1,
-				BlockModulus: 1,
-			},
-			Mode: pfsclient.ListFileMode_ListFile_RECURSE,
+			Shard: shard,
+			Mode:  pfsclient.ListFileMode_ListFile_RECURSE,
 		}
 		parentInputCommit := repoToFromCommit[input.Commit.Repo.Name]
 		if parentInputCommit != nil && input.Commit.ID != parentInputCommit.ID {
@@ -544,18 +610,6 @@ func (a *apiServer) noEmptyShards(ctx context.Context, input *ppsclient.JobInput
 			}
 		}
 
-		switch input.Method.Partition {
-		case ppsclient.Partition_BLOCK:
-			listFileRequest.Shard.BlockNumber = uint64(i)
-			listFileRequest.Shard.BlockModulus = modulus
-		case ppsclient.Partition_FILE:
-			listFileRequest.Shard.FileNumber = uint64(i)
-			listFileRequest.Shard.FileModulus = modulus
-		case ppsclient.Partition_REPO:
-		default:
-			return false, fmt.Errorf("unrecognized partition method; this is likely a bug")
-		}
-
 		fileInfos, err := pfsClient.ListFile(ctx, listFileRequest)
 		if err != nil {
 			return false, err
@@ -574,6 +628,48 @@ func (a *apiServer) noEmptyShards(ctx context.Context, input *ppsclient.JobInput
 	return true, nil
 }
 
+// jobInputShard returns the shard of input with the given number out of
+// modulus.  Inputs with a glob are split by datum, whatever their partition,
+// and when they're joined on a capture, by the part of the datum the glob
+// captures.
+func jobInputShard(input *ppsclient.JobInput, combinator ppsclient.Combinator, number uint64, modulus uint64) (*pfsclient.Shard, error) {
+	if input.Glob != "" {
+		pattern, key, _, err := parseGlob(input.Glob)
+		if err != nil {
+			return nil, err
+		}
+		shard := &pfsclient.Shard{
+			FileNumber:  number,
+			FileModulus: modulus,
+			Glob:        pattern,
+		}
+		if combinator == ppsclient.Combinator_JOIN_ON_CAPTURE {
+			shard.FileHash = &pfsclient.FileHash{
+				Method: pfsclient.FileHashMethod_KEY,
+				Key:    key,
+			}
+		}
+		return shard, nil
+	}
+	switch input.Method.Partition {
+	case ppsclient.Partition_BLOCK:
+		return &pfsclient.Shard{
+			BlockNumber:  number,
+			BlockModulus: modulus,
+		}, nil
+	case ppsclient.Partition_FILE:
+		return &pfsclient.Shard{
+			FileNumber:  number,
+			FileModulus: modulus,
+			FileHash:    input.Method.FileHash,
+		}, nil
+	case ppsclient.Partition_REPO:
+		// empty shard matches everything
+		return &pfsclient.Shard{}, nil
+	}
+	return nil, fmt.Errorf("unrecognized partition method: %v; this is likely a bug", input.Method.Partition)
+}
+
 func getJobID(req *ppsclient.CreateJobRequest) string {
 	// If the job belongs to a pipeline, and the pipeline has inputs,
 	// we want to make sure that the same
@@ -631,11 +727,15 @@ func (a *apiServer) InspectJob(ctx context.Context, request *ppsclient.InspectJo
 			} else {
 				pod.State = ppsclient.PodState_POD_FAILED
 			}
+			if i < len(chunk.Errors) {
+				pod.Error = chunk.Errors[i]
+			}
 			pods = append(pods, pod)
 		}
 		c := &ppsclient.Chunk{
-			ID:   chunk.ID,
-			Pods: pods,
+			ID:       chunk.ID,
+			Pods:     pods,
+			Attempts: chunk.Attempts,
 		}
 		switch chunk.State {
 		case persist.ChunkState_UNASSIGNED:
@@ -822,8 +922,13 @@ func (a *apiServer) StartPod(ctx context.Context, request *ppsserver.StartPodReq
 	})
 
 	var commitMounts []*fuse.CommitMount
-	filterNumbers := filterNumber(chunk.Index, chunk.Moduli)
+	filterNumbers := chunkFilterNumbers(jobInfo.Combinator, chunk.Index, chunk.Moduli)
 	for i, jobInput := range jobInfo.Inputs {
+		filterNumber, ok := filterNumbers[i]
+		if !ok {
+			// The chunk doesn't cover this input, so it isn't mounted at all.
+			continue
+		}
 		commitMount := &fuse.CommitMount{
 			Commit: jobInput.Commit,
 		}
@@ -839,22 +944,9 @@ func (a *apiServer) StartPod(ctx context.Context, request *ppsserver.StartPodReq
 			}
 		}
 
-		switch jobInput.Method.Partition {
-		case ppsclient.Partition_BLOCK:
-			commitMount.Shard = &pfsclient.Shard{
-				BlockNumber:  filterNumbers[i],
-				BlockModulus: chunk.Moduli[i],
-			}
-		case ppsclient.Partition_FILE:
-			commitMount.Shard = &pfsclient.Shard{
-				FileNumber:  filterNumbers[i],
-				FileModulus: chunk.Moduli[i],
-			}
-		case ppsclient.Partition_REPO:
-			// empty shard matches everything
-			commitMount.Shard = &pfsclient.Shard{}
-		default:
-			return nil, fmt.Errorf("unrecognized partition method: %v; this is likely a bug", jobInput.Method.Partition)
+		commitMount.Shard, err = jobInputShard(jobInput, jobInfo.Combinator, filterNumber, chunk.Moduli[i])
+		if err != nil {
+			return nil, err
 		}
 
 		commitMounts = append(commitMounts, commitMount)
@@ -935,14 +1027,15 @@ func (a *apiServer) FinishPod(ctx context.Context, request *ppsserver.FinishPodR
 			return nil, err
 		}
 	} else {
-		chunk, err = persistClient.RevokeChunk(ctx, &persist.RevokeChunkRequest{
-			ChunkID: request.ChunkID,
-			PodName: request.PodName,
-			MaxPods: MaxPodsPerChunk,
-		})
+		revokeRequest, err := a.failedPodRevocation(ctx, persistClient, request)
+		if err != nil {
+			return nil, err
+		}
+		chunk, err = persistClient.RevokeChunk(ctx, revokeRequest)
 		if err != nil {
 			return nil, err
 		}
+		chunkRevocations.WithLabelValues("pod_failed").Inc()
 	}
 
 	pfsAPIClient, err := a.getPfsClient()
@@ -963,6 +1056,34 @@ func (a *apiServer) FinishPod(ctx context.Context, request *ppsserver.FinishPodR
 	return response, nil
 }
 
+// failedPodRevocation returns the request which revokes the chunk of a pod
+// which failed, according to the retry policy of the pod's job.
+func (a *apiServer) failedPodRevocation(ctx context.Context, persistClient persist.APIClient, request *ppsserver.FinishPodRequest) (*persist.RevokeChunkRequest, error) {
+	reason := request.Error
+	if reason == "" {
+		reason = "pod failed"
+	}
+	chunk := &persist.Chunk{ID: request.ChunkID}
+	if request.Job == nil {
+		// Pods which don't send their job get the default policy.
+		return revokeChunkRequest(nil, chunk, request.PodName, reason, request.ExitCode, time.Now()), nil
+	}
+	jobInfo, err := persistClient.InspectJob(ctx, &ppsclient.InspectJobRequest{Job: request.Job})
+	if err != nil {
+		return nil, err
+	}
+	chunks, err := persistClient.GetChunksForJob(ctx, request.Job)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range chunks.Chunks {
+		if c.ID == request.ChunkID {
+			chunk = c
+		}
+	}
+	return revokeChunkRequest(jobInfo.Transform.GetRetryPolicy(), chunk, request.PodName, reason, request.ExitCode, time.Now()), nil
+}
+
 func (a *apiServer) CreatePipeline(ctx context.Context, request *ppsclient.CreatePipelineRequest) (response *google_protobuf.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	pfsAPIClient, err := a.getPfsClient()
@@ -980,6 +1101,53 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *ppsclient.Creat
 	if request.Pipeline == nil {
 		return nil, fmt.Errorf("pachyderm.ppsclient.pipelineserver: request.Pipeline cannot be nil")
 	}
+	var globs []string
+	for _, input := range request.Inputs {
+		if err := validateMethod(input.Method); err != nil {
+			return nil, err
+		}
+		if err := validateGlob(input.Method, input.Glob); err != nil {
+			return nil, err
+		}
+		globs = append(globs, input.Glob)
+	}
+	if err := validateCombinator(request.Combinator, globs); err != nil {
+		return nil, err
+	}
+	if err := validateCronInputs(request.Pipeline, request.Inputs); err != nil {
+		return nil, err
+	}
+	if !request.Update {
+		// This function needs to return newErrPipelineExists if the pipeline
+		// already exists
+		if _, err := a.InspectPipeline(
+			ctx,
+			&ppsclient.InspectPipelineRequest{Pipeline: request.Pipeline},
+		); err == nil {
+			return nil, newErrPipelineExists(request.Pipeline.Name)
+		}
+	}
+	var oldInputs []*ppsclient.PipelineInput
+	if request.Update {
+		if oldPipelineInfo, err := persistClient.GetPipelineInfo(ctx, request.Pipeline); err == nil {
+			oldInputs = oldPipelineInfo.Inputs
+		}
+	}
+	cronRepos, err := createCronRepos(ctx, pfsAPIClient, request.Inputs, oldInputs)
+	defer func() {
+		if retErr != nil {
+			// The output repo, which has these repos as provenance, is
+			// deleted first since it's deferred later.
+			for _, repo := range cronRepos {
+				if _, err := pfsAPIClient.DeleteRepo(ctx, &pfsclient.DeleteRepoRequest{Repo: repo}); err != nil {
+					protolion.Errorf("error deleting repo %s: %s", repo.Name, err.Error())
+				}
+			}
+		}
+	}()
+	if err != nil {
+		return nil, err
+	}
 
 	repoSet := make(map[string]bool)
 	for _, input := range request.Inputs {
@@ -997,14 +1165,6 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *ppsclient.Creat
 		provenance = append(provenance, input.Repo)
 	}
 	if !request.Update { // repo exists if it's an update
-		// This function needs to return newErrPipelineExists if the pipeline
-		// already exists
-		if _, err := a.InspectPipeline(
-			ctx,
-			&ppsclient.InspectPipelineRequest{Pipeline: request.Pipeline},
-		); err == nil {
-			return nil, newErrPipelineExists(request.Pipeline.Name)
-		}
 		if _, err := pfsAPIClient.CreateRepo(
 			ctx,
 			&pfsclient.CreateRepoRequest{
@@ -1032,6 +1192,7 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *ppsclient.Creat
 		OutputRepo:      repo,
 		Shard:           a.hasher.HashPipeline(request.Pipeline),
 		State:           ppsclient.PipelineState_PIPELINE_IDLE,
+		Combinator:      request.Combinator,
 	}
 	if !request.Update {
 		if _, err := persistClient.CreatePipelineInfo(ctx, persistPipelineInfo); err != nil {
@@ -1108,15 +1269,48 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *ppsclient.Creat
 }
 
 // setDefaultPipelineInputMethod sets method to the default for the inputs
-// that do not specify a method
+// that do not specify a method, cron inputs default to processing only the
+// newest tick.
 func setDefaultPipelineInputMethod(inputs []*ppsclient.PipelineInput) {
 	for _, input := range inputs {
 		if input.Method == nil {
-			input.Method = client.DefaultMethod
+			if input.Cron != nil {
+				input.Method = client.IncrementalReduceMethod
+			} else {
+				input.Method = client.DefaultMethod
+			}
 		}
 	}
 }
 
+// validateMethod returns an error if method can't be used to partition an
+// input.
+func validateMethod(method *ppsclient.Method) error {
+	if method.FileHash == nil {
+		return nil
+	}
+	if method.Partition != ppsclient.Partition_FILE {
+		return fmt.Errorf("a file hash can only be used with the FILE partition")
+	}
+	return pfsserver.ValidateFileHash(method.FileHash)
+}
+
+// validateGlob returns an error if glob can't be used to define the datums of
+// an input partitioned by method.
+func validateGlob(method *ppsclient.Method, glob string) error {
+	if glob == "" {
+		return nil
+	}
+	if method.FileHash != nil {
+		return fmt.Errorf("a glob can't be used with a file hash")
+	}
+	pattern, _, _, err := parseGlob(glob)
+	if err != nil {
+		return err
+	}
+	return pfsserver.ValidateGlob(pattern)
+}
+
 // setDefaultJobInputMethod sets method to the default for the inputs
 // that do not specify a method
 func setDefaultJobInputMethod(inputs []*ppsclient.JobInput) {
@@ -1450,6 +1644,7 @@ func newPipelineInfo(persistPipelineInfo *persist.PipelineInfo) *ppsclient.Pipel
 		State:           persistPipelineInfo.State,
 		RecentError:     persistPipelineInfo.RecentError,
 		JobCounts:       persistPipelineInfo.JobCounts,
+		Combinator:      persistPipelineInfo.Combinator,
 	}
 }
 
@@ -1471,6 +1666,15 @@ func (a *apiServer) runPipeline(ctx context.Context, pipelineInfo *ppsclient.Pip
 		return err
 	}
 
+	// The pipeline's cron inputs run for as long as the pipeline does.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for _, input := range pipelineInfo.Inputs {
+		if input.Cron != nil {
+			go a.runCron(ctx, pipelineInfo.Pipeline.Name, input)
+		}
+	}
+
 	repoToLeaves := make(map[string]map[string]bool)
 	rawInputRepos, err := a.rawInputs(ctx, pipelineInfo)
 	if err != nil {
@@ -1483,6 +1687,8 @@ func (a *apiServer) runPipeline(ctx context.Context, pipelineInfo *ppsclient.Pip
 	if err != nil {
 		return err
 	}
+	// A union's job uses the newest commit of each of its other inputs.
+	repoToNewest := make(map[string]string)
 	for {
 		var fromCommits []*pfsclient.Commit
 		for repo, leaves := range repoToLeaves {
@@ -1517,26 +1723,41 @@ func (a *apiServer) runPipeline(ctx context.Context, pipelineInfo *ppsclient.Pip
 			if commitInfo.ParentCommit != nil {
 				delete(repoToLeaves[commitInfo.ParentCommit.Repo.Name], commitInfo.ParentCommit.ID)
 			}
-			// generate all the permutations of leaves we could use this commit with
-			commitSets := [][]*pfsclient.Commit{[]*pfsclient.Commit{}}
-			for repoName, leaves := range repoToLeaves {
-				if repoName == commitInfo.Commit.Repo.Name {
-					continue
+			repoToNewest[commitInfo.Commit.Repo.Name] = commitInfo.Commit.ID
+			var commitSets [][]*pfsclient.Commit
+			if pipelineInfo.Combinator == ppsclient.Combinator_UNION {
+				// A union processes each input on its own, so it doesn't
+				// wait for the other inputs, and runs one job with
+				// whichever of them have commits.
+				var commitSet []*pfsclient.Commit
+				for repoName, newest := range repoToNewest {
+					if repoName != commitInfo.Commit.Repo.Name {
+						commitSet = append(commitSet, client.NewCommit(repoName, newest))
+					}
 				}
-				var newCommitSets [][]*pfsclient.Commit
-				for _, commitSet := range commitSets {
-					for leaf := range leaves {
-						newCommitSet := make([]*pfsclient.Commit, len(commitSet)+1)
-						copy(newCommitSet, commitSet)
-						newCommitSet[len(commitSet)] = client.NewCommit(repoName, leaf)
-						newCommitSets = append(newCommitSets, newCommitSet)
+				commitSets = append(commitSets, commitSet)
+			} else {
+				// generate all the permutations of leaves we could use this commit with
+				commitSets = [][]*pfsclient.Commit{[]*pfsclient.Commit{}}
+				for repoName, leaves := range repoToLeaves {
+					if repoName == commitInfo.Commit.Repo.Name {
+						continue
+					}
+					var newCommitSets [][]*pfsclient.Commit
+					for _, commitSet := range commitSets {
+						for leaf := range leaves {
+							newCommitSet := make([]*pfsclient.Commit, len(commitSet)+1)
+							copy(newCommitSet, commitSet)
+							newCommitSet[len(commitSet)] = client.NewCommit(repoName, leaf)
+							newCommitSets = append(newCommitSets, newCommitSet)
+						}
 					}
+					commitSets = newCommitSets
 				}
-				commitSets = newCommitSets
 			}
 			for _, commitSet := range commitSets {
 				// + 1 as the commitSet doesn't contain the commit we just got
-				if len(commitSet)+1 < len(rawInputRepos) {
+				if pipelineInfo.Combinator != ppsclient.Combinator_UNION && len(commitSet)+1 < len(rawInputRepos) {
 					continue
 				}
 				trueInputs, err := a.trueInputs(ctx, append(commitSet, commitInfo.Commit), pipelineInfo)
@@ -1558,6 +1779,7 @@ func (a *apiServer) runPipeline(ctx context.Context, pipelineInfo *ppsclient.Pip
 						ParallelismSpec: pipelineInfo.ParallelismSpec,
 						Inputs:          trueInputs,
 						ParentJob:       parentJob,
+						Combinator:      pipelineInfo.Combinator,
 					},
 				)
 				if err != nil {
@@ -1572,12 +1794,23 @@ func (a *apiServer) runPipeline(ctx context.Context, pipelineInfo *ppsclient.Pip
 // chunks (units of work that make up of a job) and updates the state of the job
 // as chunks are being finished.  It also squashes all the output commits of
 // the chunks into the final output commit.
-func (a *apiServer) jobManager(ctx context.Context, job *ppsclient.Job) error {
+func (a *apiServer) jobManager(ctx context.Context, job *ppsclient.Job) (retErr error) {
+	span, ctx := tracing.StartSpan(ctx, "pps.jobManager")
+	span.SetTag("job", job.ID)
+	defer func() { tracing.FinishSpan(span, retErr) }()
 	persistClient, err := a.getPersistClient()
 	if err != nil {
 		return err
 	}
 
+	persistJobInfo, err := persistClient.InspectJob(ctx, &ppsclient.InspectJobRequest{
+		Job: job,
+	})
+	if err != nil {
+		return err
+	}
+	retryPolicy := persistJobInfo.Transform.GetRetryPolicy()
+
 	chunkClient, err := persistClient.SubscribeChunks(ctx, &persist.SubscribeChunksRequest{
 		Job:            job,
 		IncludeInitial: true,
@@ -1627,13 +1860,13 @@ func (a *apiServer) jobManager(ctx context.Context, job *ppsclient.Job) error {
 						b := backoff.NewExponentialBackOff()
 						b.MaxElapsedTime = 0
 						backoff.Retry(func() error {
-							if _, err := persistClient.RevokeChunk(ctx, &persist.RevokeChunkRequest{
-								ChunkID: chunk.ID,
-								PodName: chunk.Owner,
-								MaxPods: MaxPodsPerChunk,
-							}); err != nil && !isContextCancelled(err) {
+							if _, err := persistClient.RevokeChunk(ctx, revokeChunkRequest(retryPolicy, chunk, chunk.Owner, "lease expired", 0, time.Now())); err != nil {
+								if isContextCancelled(err) {
+									return nil
+								}
 								return err
 							}
+							chunkRevocations.WithLabelValues("lease_expired").Inc()
 							return nil
 						}, b)
 					})
@@ -1689,7 +1922,7 @@ func (a *apiServer) jobManager(ctx context.Context, job *ppsclient.Job) error {
 	} else {
 		state = ppsclient.JobState_JOB_SUCCESS
 	}
-	if _, err := persistClient.CreateJobState(context.Background(), &persist.JobState{
+	if _, err := persistClient.CreateJobState(tracing.NewContext(context.Background(), span), &persist.JobState{
 		JobID: job.ID,
 		State: state,
 	}); err != nil {
@@ -1821,6 +2054,7 @@ func (a *apiServer) trueInputs(
 					Commit:   commit,
 					Method:   pipelineInput.Method,
 					RunEmpty: pipelineInput.RunEmpty,
+					Glob:     pipelineInput.Glob,
 				})
 			delete(repoToInput, commit.Repo.Name)
 		}
@@ -1830,6 +2064,34 @@ func (a *apiServer) trueInputs(
 		// no need to flush them, we can return them as is
 		return result, nil
 	}
+	if pipelineInfo.Combinator == ppsclient.Combinator_UNION {
+		// A union's job doesn't have every raw input, so only the inputs
+		// downstream of the ones it has are flushed to.
+		rawRepos := make(map[string]bool)
+		for _, commit := range rawInputs {
+			rawRepos[commit.Repo.Name] = true
+		}
+		toRepo = nil
+		for _, input := range pipelineInfo.Inputs {
+			if rawRepos[input.Repo.Name] {
+				toRepo = append(toRepo, input.Repo)
+				continue
+			}
+			repoInfo, err := pfsClient.InspectRepo(ctx, &pfsclient.InspectRepoRequest{Repo: input.Repo})
+			if err != nil {
+				return nil, err
+			}
+			for _, repo := range repoInfo.Provenance {
+				if rawRepos[repo.Name] {
+					toRepo = append(toRepo, input.Repo)
+					break
+				}
+			}
+		}
+		if len(toRepo) == len(result) {
+			return result, nil
+		}
+	}
 	// Flush the rawInputs up to true input repos of the pipeline
 	commitInfos, err := pfsClient.FlushCommit(
 		ctx,
@@ -1849,6 +2111,7 @@ func (a *apiServer) trueInputs(
 					Commit:   commitInfo.Commit,
 					Method:   pipelineInput.Method,
 					RunEmpty: pipelineInput.RunEmpty,
+					Glob:     pipelineInput.Glob,
 				})
 		}
 	}
@@ -1859,7 +2122,12 @@ func (a *apiServer) getPfsClient() (pfsclient.APIClient, error) {
 	if a.pfsAPIClient == nil {
 		var onceErr error
 		a.pfsClientOnce.Do(func() {
-			clientConn, err := grpc.Dial(a.address, grpc.WithInsecure())
+			dialOption, err := grpcutil.DialOptionFromEnv()
+			if err != nil {
+				onceErr = err
+				return
+			}
+			clientConn, err := grpc.Dial(a.address, dialOption)
 			if err != nil {
 				onceErr = err
 			}
@@ -1876,7 +2144,12 @@ func (a *apiServer) getPersistClient() (persist.APIClient, error) {
 	if a.persistAPIClient == nil {
 		var onceErr error
 		a.persistClientOnce.Do(func() {
-			clientConn, err := grpc.Dial(a.address, grpc.WithInsecure())
+			dialOption, err := grpcutil.DialOptionFromEnv()
+			if err != nil {
+				onceErr = err
+				return
+			}
+			clientConn, err := grpc.Dial(a.address, dialOption)
 			if err != nil {
 				onceErr = err
 			}
@@ -1903,6 +2176,7 @@ func newJobInfo(persistJobInfo *persist.JobInfo) (*ppsclient.JobInfo, error) {
 		Finished:        persistJobInfo.Finished,
 		OutputCommit:    persistJobInfo.OutputCommit,
 		State:           persistJobInfo.State,
+		Combinator:      persistJobInfo.Combinator,
 	}, nil
 }
 
@@ -1913,7 +2187,7 @@ func RepoNameToEnvString(repoName string) string {
 }
 
 // Convert a persist.JobInfo into a Kubernetes batch.Job spec
-func job(kubeClient *kube.Client, jobInfo *persist.JobInfo, jobShimImage string, jobImagePullPolicy string) (*batch.Job, error) {
+func job(kubeClient *kube.Client, jobInfo *persist.JobInfo, jobShimImage string, jobImagePullPolicy string, tlsSecret string, tlsOptions *grpcutil.TLSOptions) (*batch.Job, error) {
 	labels := labels(jobInfo.JobID)
 	parallelism64, err := GetExpectedNumWorkers(kubeClient, jobInfo.ParallelismSpec)
 	if err != nil {
@@ -1978,6 +2252,46 @@ func job(kubeClient *kube.Client, jobInfo *persist.JobInfo, jobShimImage string,
 			MountPath: secret.MountPath,
 		})
 	}
+	if tlsSecret != "" && tlsOptions != nil {
+		volumes = append(volumes, api.Volume{
+			Name: tlsSecret,
+			VolumeSource: api.VolumeSource{
+				Secret: &api.SecretVolumeSource{
+					SecretName: tlsSecret,
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, api.VolumeMount{
+			Name:      tlsSecret,
+			MountPath: "/" + tlsSecret,
+		})
+		jobEnv = append(jobEnv,
+			api.EnvVar{Name: grpcutil.TLSEnv, Value: "true"},
+			api.EnvVar{Name: grpcutil.TLSCAEnv, Value: tlsOptions.CAFile},
+			api.EnvVar{Name: grpcutil.TLSCertEnv, Value: tlsOptions.CertFile},
+			api.EnvVar{Name: grpcutil.TLSKeyEnv, Value: tlsOptions.KeyFile},
+			api.EnvVar{Name: grpcutil.TLSServerNameEnv, Value: tlsOptions.ServerName},
+		)
+	}
+
+	// Mounting pfs with FUSE requires a privileged container, in COPY mode
+	// the job-shim copies files to and from an emptyDir instead.
+	securityContext := &api.SecurityContext{
+		Privileged: &trueVal, // god is this dumb
+	}
+	if jobInfo.Transform.PfsMode == ppsclient.PfsMode_COPY {
+		securityContext = nil
+		volumes = append(volumes, api.Volume{
+			Name: "pfs",
+			VolumeSource: api.VolumeSource{
+				EmptyDir: &api.EmptyDirVolumeSource{},
+			},
+		})
+		volumeMounts = append(volumeMounts, api.VolumeMount{
+			Name:      "pfs",
+			MountPath: "/pfs",
+		})
+	}
 
 	return &batch.Job{
 		TypeMeta: unversioned.TypeMeta{
@@ -2003,12 +2317,10 @@ func job(kubeClient *kube.Client, jobInfo *persist.JobInfo, jobShimImage string,
 				Spec: api.PodSpec{
 					Containers: []api.Container{
 						{
-							Name:    "user",
-							Image:   image,
-							Command: []string{"/job-shim", jobInfo.JobID},
-							SecurityContext: &api.SecurityContext{
-								Privileged: &trueVal, // god is this dumb
-							},
+							Name:            "user",
+							Image:           image,
+							Command:         []string{"/job-shim", jobInfo.JobID},
+							SecurityContext: securityContext,
 							ImagePullPolicy: api.PullPolicy(jobImagePullPolicy),
 							Env:             jobEnv,
 							VolumeMounts:    volumeMounts,
@@ -2099,11 +2411,21 @@ func (a *apiServer) deletePipeline(ctx context.Context, pipeline *ppsclient.Pipe
 		return err
 	}
 
+	pipelineInfo, err := persistClient.GetPipelineInfo(ctx, pipeline)
+	if err != nil {
+		return err
+	}
 	if _, err := persistClient.DeletePipelineInfo(ctx, pipeline); err != nil {
 		return err
 	}
 
-	return nil
+	// The repos pps created for the pipeline's cron inputs go with it, the
+	// output repo is left for the user to delete.
+	pfsAPIClient, err := a.getPfsClient()
+	if err != nil {
+		return err
+	}
+	return deleteCronRepos(ctx, pfsAPIClient, pipelineInfo.Inputs)
 }
 
 func labels(app string) map[string]string {
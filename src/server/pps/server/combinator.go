@@ -0,0 +1,191 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	ppsclient "github.com/sjezewski/pachyderm/src/client/pps"
+)
+
+// validateCombinator returns an error if a pipeline or job whose inputs have
+// globs can't combine them with combinator.
+func validateCombinator(combinator ppsclient.Combinator, globs []string) error {
+	if _, ok := ppsclient.Combinator_name[int32(combinator)]; !ok {
+		return fmt.Errorf("unrecognized combinator: %d", combinator)
+	}
+	if !isJoin(combinator) {
+		return nil
+	}
+	for _, glob := range globs {
+		if glob == "" {
+			return fmt.Errorf("every input must have a glob to be combined with %s", combinator)
+		}
+		if combinator != ppsclient.Combinator_JOIN_ON_CAPTURE {
+			continue
+		}
+		_, _, captures, err := parseGlob(glob)
+		if err != nil {
+			return err
+		}
+		if captures != 1 {
+			return fmt.Errorf("glob %q must capture exactly one part of the path to be joined on, it captures %d", glob, captures)
+		}
+	}
+	return nil
+}
+
+func isJoin(combinator ppsclient.Combinator) bool {
+	return combinator == ppsclient.Combinator_JOIN_ON_PATH || combinator == ppsclient.Combinator_JOIN_ON_CAPTURE
+}
+
+// numChunks returns the number of chunks a job whose inputs are split into
+// moduli shards has.  Crossed inputs need a chunk for every combination of
+// their shards, unioned inputs a chunk for each shard of each input, and
+// joined inputs, which all have the same modulus, a chunk for each shard.
+func numChunks(combinator ppsclient.Combinator, moduli []uint64) uint64 {
+	switch {
+	case combinator == ppsclient.Combinator_UNION:
+		var sum uint64
+		for _, modulus := range moduli {
+			sum += modulus
+		}
+		return sum
+	case isJoin(combinator) && len(moduli) > 0:
+		return moduli[0]
+	}
+	return product(moduli)
+}
+
+// chunkFilterNumbers returns the shard number of each input in the chunk
+// with the given index, keyed by the input's index.  Inputs the chunk
+// doesn't cover aren't in the result, which only happens with a union.
+func chunkFilterNumbers(combinator ppsclient.Combinator, index uint64, moduli []uint64) map[int]uint64 {
+	result := make(map[int]uint64)
+	switch {
+	case combinator == ppsclient.Combinator_UNION:
+		for i, modulus := range moduli {
+			if index < modulus {
+				result[i] = index
+				break
+			}
+			index -= modulus
+		}
+	case isJoin(combinator):
+		for i := range moduli {
+			result[i] = index
+		}
+	default:
+		for i, number := range filterNumber(index, moduli) {
+			result[i] = number
+		}
+	}
+	return result
+}
+
+// parseGlob parses a glob whose parenthesized parts are captures.  It returns
+// the glob without the parentheses, which is what paths are matched against,
+// a regular expression which matches the same paths and has a subexpression
+// for each capture, and the number of captures.  The glob is cleaned first,
+// the way pfs cleans the globs and paths it matches, so "/(*).json" captures
+// "user1" from "user1.json".
+func parseGlob(glob string) (string, string, int, error) {
+	glob = cleanGlob(glob)
+	var pattern bytes.Buffer
+	var key bytes.Buffer
+	var captures, depth int
+	key.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		c := glob[i]
+		switch c {
+		case '(':
+			depth++
+			captures++
+			key.WriteByte('(')
+		case ')':
+			if depth == 0 {
+				return "", "", 0, fmt.Errorf("invalid glob %q: unmatched )", glob)
+			}
+			depth--
+			key.WriteByte(')')
+		case '*':
+			pattern.WriteByte(c)
+			key.WriteString("[^/]*")
+		case '?':
+			pattern.WriteByte(c)
+			key.WriteString("[^/]")
+		case '\\':
+			if i+1 == len(glob) {
+				return "", "", 0, fmt.Errorf("invalid glob %q: trailing \\", glob)
+			}
+			i++
+			pattern.WriteByte(c)
+			pattern.WriteByte(glob[i])
+			key.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		case '[':
+			end, class, err := parseClass(glob, i)
+			if err != nil {
+				return "", "", 0, err
+			}
+			pattern.WriteString(glob[i : end+1])
+			key.WriteString(class)
+			i = end
+		default:
+			pattern.WriteByte(c)
+			key.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+	if depth != 0 {
+		return "", "", 0, fmt.Errorf("invalid glob %q: unmatched (", glob)
+	}
+	key.WriteString("$")
+	return pattern.String(), key.String(), captures, nil
+}
+
+// cleanGlob removes leading slashes and "." components from glob.
+func cleanGlob(glob string) string {
+	glob = strings.TrimPrefix(path.Clean(glob), "/")
+	if glob == "." {
+		return ""
+	}
+	return glob
+}
+
+// parseClass parses the character class which starts at glob[start] and
+// returns the index of the ] which ends it, and the class as a regular
+// expression.
+func parseClass(glob string, start int) (int, string, error) {
+	var class bytes.Buffer
+	class.WriteByte('[')
+	i := start + 1
+	if i < len(glob) && glob[i] == '^' {
+		class.WriteByte('^')
+		i++
+	}
+	for ; i < len(glob); i++ {
+		c := glob[i]
+		switch c {
+		case ']':
+			class.WriteByte(']')
+			return i, class.String(), nil
+		case '-':
+			class.WriteByte(c)
+		case '\\':
+			if i+1 == len(glob) {
+				return 0, "", fmt.Errorf("invalid glob %q: trailing \\", glob)
+			}
+			i++
+			// QuoteMeta leaves '-' alone, but in a class it makes a range.
+			if glob[i] == '-' {
+				class.WriteString(`\-`)
+			} else {
+				class.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+			}
+		default:
+			class.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+	return 0, "", fmt.Errorf("invalid glob %q: unterminated [", glob)
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/sjezewski/pachyderm/src/client/pkg/require"
+	ppsclient "github.com/sjezewski/pachyderm/src/client/pps"
+)
+
+func TestParseGlob(t *testing.T) {
+	pattern, key, captures, err := parseGlob("*/(*)-[0-9]?.json")
+	require.NoError(t, err)
+	require.Equal(t, "*/*-[0-9]?.json", pattern)
+	require.Equal(t, 1, captures)
+	match := regexp.MustCompile(key).FindStringSubmatch("2016/foo-12.json")
+	require.Equal(t, []string{"2016/foo-12.json", "foo"}, match)
+	require.Nil(t, regexp.MustCompile(key).FindStringSubmatch("2016/bar/foo-12.json"))
+
+	pattern, key, captures, err = parseGlob(`\(a\)/(b)/(c)`)
+	require.NoError(t, err)
+	require.Equal(t, `\(a\)/b/c`, pattern)
+	require.Equal(t, 2, captures)
+	require.True(t, regexp.MustCompile(key).MatchString("(a)/b/c"))
+
+	// An escaped '-' in a class is a '-', not a range.
+	_, key, _, err = parseGlob(`([a\-z])`)
+	require.NoError(t, err)
+	require.True(t, regexp.MustCompile(key).MatchString("-"))
+	require.False(t, regexp.MustCompile(key).MatchString("b"))
+
+	// Globs are cleaned like paths, so leading slashes don't stop keys from
+	// matching.
+	for _, glob := range []string{"/(*).json", "./(*).json", "//(*).json"} {
+		pattern, key, captures, err = parseGlob(glob)
+		require.NoError(t, err)
+		require.Equal(t, "*.json", pattern)
+		require.Equal(t, 1, captures)
+		require.Equal(t, []string{"user1.json", "user1"}, regexp.MustCompile(key).FindStringSubmatch("user1.json"))
+	}
+
+	for _, glob := range []string{"(*", "*)", "[a", `a\`} {
+		_, _, _, err := parseGlob(glob)
+		require.YesError(t, err, glob)
+	}
+}
+
+func TestChunkFilterNumbers(t *testing.T) {
+	moduli := []uint64{2, 0, 4}
+	require.Equal(t, uint64(8), numChunks(ppsclient.Combinator_CROSS, []uint64{2, 4}))
+	require.Equal(t, uint64(6), numChunks(ppsclient.Combinator_UNION, moduli))
+	require.Equal(t, map[int]uint64{2: 1}, chunkFilterNumbers(ppsclient.Combinator_UNION, 3, moduli))
+	require.Equal(t, map[int]uint64{0: 1}, chunkFilterNumbers(ppsclient.Combinator_UNION, 1, moduli))
+
+	moduli = []uint64{4, 4}
+	require.Equal(t, uint64(4), numChunks(ppsclient.Combinator_JOIN_ON_PATH, moduli))
+	require.Equal(t, map[int]uint64{0: 3, 1: 3}, chunkFilterNumbers(ppsclient.Combinator_JOIN_ON_CAPTURE, 3, moduli))
+	require.Equal(t, uint64(16), numChunks(ppsclient.Combinator_CROSS, moduli))
+	require.Equal(t, map[int]uint64{0: 2, 1: 1}, chunkFilterNumbers(ppsclient.Combinator_CROSS, 9, moduli))
+}
+
+func TestValidateCombinator(t *testing.T) {
+	require.NoError(t, validateCombinator(ppsclient.Combinator_CROSS, []string{"", "*"}))
+	require.YesError(t, validateCombinator(ppsclient.Combinator_JOIN_ON_PATH, []string{"", "*"}))
+	require.NoError(t, validateCombinator(ppsclient.Combinator_JOIN_ON_CAPTURE, []string{"(*).json", "*/(*).csv"}))
+	require.NoError(t, validateCombinator(ppsclient.Combinator_JOIN_ON_CAPTURE, []string{"/(*).json", "./*/(*).csv"}))
+	require.YesError(t, validateCombinator(ppsclient.Combinator_JOIN_ON_CAPTURE, []string{"(*)/(*)"}))
+	require.YesError(t, validateCombinator(ppsclient.Combinator(10), nil))
+}
@@ -0,0 +1,175 @@
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sjezewski/pachyderm/src/client"
+	pfsclient "github.com/sjezewski/pachyderm/src/client/pfs"
+	ppsclient "github.com/sjezewski/pachyderm/src/client/pps"
+	"github.com/sjezewski/pachyderm/src/server/pkg/cron"
+
+	"go.pedge.io/lion/proto"
+	"go.pedge.io/proto/time"
+	"golang.org/x/net/context"
+)
+
+// cronRepoSuffix is appended to a pipeline's name to name the repo of its
+// cron input, if the input doesn't name one.
+const cronRepoSuffix = "_cron"
+
+// validateCronInputs validates the cron inputs of a pipeline and names the
+// repos of those which don't name one.  Only one cron input can go without a
+// name, since they'd all get the same one.
+func validateCronInputs(pipeline *ppsclient.Pipeline, inputs []*ppsclient.PipelineInput) error {
+	named := false
+	for _, input := range inputs {
+		if input.Cron == nil {
+			continue
+		}
+		if _, err := cron.Parse(input.Cron.Spec); err != nil {
+			return err
+		}
+		if input.Repo == nil || input.Repo.Name == "" {
+			if named {
+				return fmt.Errorf("pipeline %s has more than one cron input without a repo, only one can be named %s", pipeline.Name, pipeline.Name+cronRepoSuffix)
+			}
+			named = true
+			input.Repo = client.NewRepo(pipeline.Name + cronRepoSuffix)
+		}
+	}
+	return nil
+}
+
+// createCronRepos creates the repos of a pipeline's cron inputs, see
+// validateCronInputs.  A cron input's repo can't exist already, unless pps
+// created it for one of oldInputs, the cron inputs the pipeline had before
+// it was updated; otherwise the repo would be deleted along with a pipeline
+// that doesn't own it.  It returns the repos it created, even if it errors,
+// so that they can be deleted if the pipeline isn't created.
+func createCronRepos(ctx context.Context, pfsAPIClient pfsclient.APIClient, inputs []*ppsclient.PipelineInput, oldInputs []*ppsclient.PipelineInput) ([]*pfsclient.Repo, error) {
+	oldCronRepos := make(map[string]bool)
+	for _, input := range oldInputs {
+		if input.Cron != nil {
+			oldCronRepos[input.Repo.Name] = true
+		}
+	}
+	var created []*pfsclient.Repo
+	for _, input := range inputs {
+		if input.Cron == nil {
+			continue
+		}
+		if _, err := pfsAPIClient.InspectRepo(ctx, &pfsclient.InspectRepoRequest{Repo: input.Repo}); err == nil {
+			if oldCronRepos[input.Repo.Name] {
+				continue
+			}
+			return created, fmt.Errorf("repo %s of cron input already exists, pps creates the repos of cron inputs", input.Repo.Name)
+		}
+		if _, err := pfsAPIClient.CreateRepo(ctx, &pfsclient.CreateRepoRequest{Repo: input.Repo}); err != nil {
+			return created, err
+		}
+		created = append(created, input.Repo)
+	}
+	return created, nil
+}
+
+// deleteCronRepos deletes the repos that pps created for a pipeline's cron
+// inputs.  They're provenance of the pipeline's output repo, which is kept,
+// so they're deleted with force.
+func deleteCronRepos(ctx context.Context, pfsAPIClient pfsclient.APIClient, inputs []*ppsclient.PipelineInput) error {
+	for _, input := range inputs {
+		if input.Cron == nil {
+			continue
+		}
+		if _, err := pfsAPIClient.DeleteRepo(ctx, &pfsclient.DeleteRepoRequest{
+			Repo:  input.Repo,
+			Force: true,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runCron makes a commit in a cron input's repo each time its schedule
+// fires, until ctx is cancelled.  Each commit adds a file named for the time
+// the schedule fired, which contains the time too.  If pachd was down when
+// the schedule fired, the commit is made once pachd is back, but only once
+// however many times the schedule fired.
+func (a *apiServer) runCron(ctx context.Context, pipelineName string, input *ppsclient.PipelineInput) {
+	schedule, err := cron.Parse(input.Cron.Spec)
+	if err != nil {
+		protolion.Errorf("error parsing cron spec of pipeline %s: %s", pipelineName, err.Error())
+		return
+	}
+	pfsAPIClient, err := a.getPfsClient()
+	if err != nil {
+		protolion.Errorf("error running cron input of pipeline %s: %s", pipelineName, err.Error())
+		return
+	}
+	// Schedules are in UTC.
+	last := time.Now().UTC()
+	if commitInfo, err := pfsAPIClient.InspectCommit(ctx, &pfsclient.InspectCommitRequest{
+		Commit: client.NewCommit(input.Repo.Name, "master"),
+	}); err == nil && commitInfo.Finished != nil {
+		last = prototime.TimestampToTime(commitInfo.Finished).UTC()
+	}
+	for {
+		next := schedule.Next(last)
+		if next.IsZero() {
+			protolion.Errorf("cron spec %q of pipeline %s never fires", input.Cron.Spec, pipelineName)
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(next.Sub(time.Now())):
+		}
+		if err := makeCronCommit(ctx, pfsAPIClient, input.Repo, next); err != nil && !isContextCancelled(err) {
+			protolion.Errorf("error making cron commit in %s: %s", input.Repo.Name, err.Error())
+		}
+		last = next
+		if now := time.Now().UTC(); now.After(last) {
+			last = now
+		}
+	}
+}
+
+// makeCronCommit makes a commit in repo with a file for the tick at t.  If
+// the file can't be written the commit is cancelled, so that the pipeline
+// doesn't run on a commit without its tick.
+func makeCronCommit(ctx context.Context, pfsAPIClient pfsclient.APIClient, repo *pfsclient.Repo, t time.Time) (retErr error) {
+	commit, err := pfsAPIClient.StartCommit(ctx, &pfsclient.StartCommitRequest{
+		Parent: client.NewCommit(repo.Name, "master"),
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr == nil {
+			_, retErr = pfsAPIClient.FinishCommit(ctx, &pfsclient.FinishCommitRequest{Commit: commit})
+			return
+		}
+		// ctx may be what was cancelled, the commit is cancelled regardless.
+		if _, err := pfsAPIClient.FinishCommit(context.Background(), &pfsclient.FinishCommitRequest{
+			Commit: commit,
+			Cancel: true,
+		}); err != nil {
+			protolion.Errorf("error cancelling cron commit %s/%s: %s", commit.Repo.Name, commit.ID, err.Error())
+		}
+	}()
+	putFileClient, err := pfsAPIClient.PutFile(ctx)
+	if err != nil {
+		return err
+	}
+	timestamp := t.UTC().Format(time.RFC3339)
+	if err := putFileClient.Send(&pfsclient.PutFileRequest{
+		File:     client.NewFile(repo.Name, commit.ID, timestamp),
+		FileType: pfsclient.FileType_FILE_TYPE_REGULAR,
+		Value:    []byte(fmt.Sprintf("%s\n", timestamp)),
+	}); err != nil {
+		return err
+	}
+	_, err = putFileClient.CloseAndRecv()
+	return err
+}
@@ -0,0 +1,31 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/sjezewski/pachyderm/src/client"
+	"github.com/sjezewski/pachyderm/src/client/pkg/require"
+	ppsclient "github.com/sjezewski/pachyderm/src/client/pps"
+)
+
+func TestValidateCronInputs(t *testing.T) {
+	pipeline := client.NewPipeline("pipeline")
+	inputs := []*ppsclient.PipelineInput{
+		client.NewCronInput("", "@daily"),
+		client.NewCronInput("hourly", "@hourly"),
+		{Repo: client.NewRepo("data")},
+	}
+	require.NoError(t, validateCronInputs(pipeline, inputs))
+	require.Equal(t, "pipeline_cron", inputs[0].Repo.Name)
+	require.Equal(t, "hourly", inputs[1].Repo.Name)
+	require.Equal(t, "data", inputs[2].Repo.Name)
+
+	require.YesError(t, validateCronInputs(pipeline, []*ppsclient.PipelineInput{
+		client.NewCronInput("", "not a spec"),
+	}))
+	// Unnamed cron inputs would all get the same repo.
+	require.YesError(t, validateCronInputs(pipeline, []*ppsclient.PipelineInput{
+		client.NewCronInput("", "@daily"),
+		client.NewCronInput("", "@hourly"),
+	}))
+}
@@ -0,0 +1,21 @@
+package server
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// chunkRevocations counts the chunks taken back from pods, either because
+// the pod failed or because its lease on the chunk expired.
+var chunkRevocations = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "chunk",
+		Name:      "revocations_total",
+		Help:      "The number of chunks revoked from pods, by reason: pod_failed or lease_expired.",
+	},
+	[]string{"reason"},
+)
+
+func init() {
+	prometheus.MustRegister(chunkRevocations)
+}
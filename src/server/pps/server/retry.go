@@ -0,0 +1,57 @@
+package server
+
+import (
+	"time"
+
+	ppsclient "github.com/sjezewski/pachyderm/src/client/pps"
+	"github.com/sjezewski/pachyderm/src/server/pps/persist"
+)
+
+// maxBackoffDoublings caps how many times a chunk's backoff doubles, so that
+// chunks with many attempts aren't put off indefinitely.
+const maxBackoffDoublings = 10
+
+// revokeChunkRequest returns the request which revokes a chunk whose pod
+// failed, according to policy.  An exitCode of 0 means the failure wasn't the
+// transform exiting with a code it doesn't accept.
+func revokeChunkRequest(policy *ppsclient.RetryPolicy, chunk *persist.Chunk, podName string, reason string, exitCode int64, now time.Time) *persist.RevokeChunkRequest {
+	request := &persist.RevokeChunkRequest{
+		ChunkID: chunk.ID,
+		PodName: podName,
+		MaxPods: MaxPodsPerChunk,
+		Error:   reason,
+	}
+	if policy == nil {
+		return request
+	}
+	if policy.MaxAttempts != 0 {
+		request.MaxPods = policy.MaxAttempts
+	}
+	if !retryExitCode(policy, exitCode) {
+		// The pod which failed is the chunk's last.
+		request.MaxPods = 1
+	}
+	if policy.BackoffSeconds != 0 {
+		doublings := uint64(0)
+		if chunk.Attempts > 1 {
+			doublings = chunk.Attempts - 1
+		}
+		if doublings > maxBackoffDoublings {
+			doublings = maxBackoffDoublings
+		}
+		request.RetryTime = uint64(now.Unix()) + policy.BackoffSeconds<<doublings
+	}
+	return request
+}
+
+func retryExitCode(policy *ppsclient.RetryPolicy, exitCode int64) bool {
+	if exitCode == 0 || len(policy.RetryOnExitCode) == 0 {
+		return true
+	}
+	for _, code := range policy.RetryOnExitCode {
+		if code == exitCode {
+			return true
+		}
+	}
+	return false
+}
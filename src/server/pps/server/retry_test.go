@@ -0,0 +1,41 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sjezewski/pachyderm/src/client/pkg/require"
+	ppsclient "github.com/sjezewski/pachyderm/src/client/pps"
+	"github.com/sjezewski/pachyderm/src/server/pps/persist"
+)
+
+func TestRevokeChunkRequest(t *testing.T) {
+	now := time.Unix(1000, 0)
+	chunk := &persist.Chunk{ID: "chunk", Attempts: 1}
+
+	request := revokeChunkRequest(nil, chunk, "pod", "failed", 2, now)
+	require.Equal(t, uint64(MaxPodsPerChunk), request.MaxPods)
+	require.Equal(t, uint64(0), request.RetryTime)
+	require.Equal(t, "failed", request.Error)
+
+	policy := &ppsclient.RetryPolicy{
+		MaxAttempts:     5,
+		BackoffSeconds:  10,
+		RetryOnExitCode: []int64{2},
+	}
+	request = revokeChunkRequest(policy, chunk, "pod", "failed", 2, now)
+	require.Equal(t, uint64(5), request.MaxPods)
+	require.Equal(t, uint64(1010), request.RetryTime)
+
+	chunk.Attempts = 3
+	request = revokeChunkRequest(policy, chunk, "pod", "failed", 0, now)
+	require.Equal(t, uint64(5), request.MaxPods)
+	require.Equal(t, uint64(1040), request.RetryTime)
+
+	chunk.Attempts = 100
+	request = revokeChunkRequest(policy, chunk, "pod", "failed", 0, now)
+	require.Equal(t, uint64(1000+10<<maxBackoffDoublings), request.RetryTime)
+
+	request = revokeChunkRequest(policy, chunk, "pod", "failed", 1, now)
+	require.Equal(t, uint64(1), request.MaxPods)
+}
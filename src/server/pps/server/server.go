@@ -3,10 +3,11 @@ package server
 import (
 	"sync"
 
+	"github.com/sjezewski/pachyderm/src/client/pkg/grpcutil"
 	"github.com/sjezewski/pachyderm/src/client/pkg/shard"
 	ppsclient "github.com/sjezewski/pachyderm/src/client/pps"
+	"github.com/sjezewski/pachyderm/src/server/pkg/promutil"
 	ppsserver "github.com/sjezewski/pachyderm/src/server/pps"
-	"go.pedge.io/proto/rpclog"
 	kube "k8s.io/kubernetes/pkg/client/unversioned"
 )
 
@@ -18,7 +19,8 @@ type APIServer interface {
 	shard.Server
 }
 
-// NewAPIServer creates an APIServer.
+// NewAPIServer creates an APIServer. If tlsSecret is not empty, it's mounted
+// into job pods which then connect to pachd using tlsOptions.
 func NewAPIServer(
 	hasher *ppsserver.Hasher,
 	address string,
@@ -26,9 +28,11 @@ func NewAPIServer(
 	namespace string,
 	jobShimImage string,
 	jobImagePullPolicy string,
+	tlsSecret string,
+	tlsOptions *grpcutil.TLSOptions,
 ) APIServer {
 	return &apiServer{
-		Logger:                  protorpclog.NewLogger("pps.API"),
+		Logger:                  promutil.NewLogger("pps.API"),
 		hasher:                  hasher,
 		address:                 address,
 		pfsAPIClient:            nil,
@@ -47,5 +51,7 @@ func NewAPIServer(
 		namespace:               namespace,
 		jobShimImage:            jobShimImage,
 		jobImagePullPolicy:      jobImagePullPolicy,
+		tlsSecret:               tlsSecret,
+		tlsOptions:              tlsOptions,
 	}
 }